@@ -0,0 +1,62 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Command auditverify verifies the hash chain of a dcrwallet audit log
+// (written with the --auditlog option) without requiring a running wallet.
+//
+// Usage:
+//
+//	auditverify [-head hash] audit.log
+//
+// On success, the number of entries and the hash of the last entry are
+// printed.  When -head is set, the hash of the last entry must match it, which
+// detects entries removed from the end of a log by comparing against a
+// previously recorded hash.
+package main
+
+import (
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"os"
+
+	"decred.org/dcrwallet/internal/auditlog"
+)
+
+var head = flag.String("head", "", "expected hash of the last entry")
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-head hash] audit.log\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(flag.Arg(0)); err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", flag.Arg(0), err)
+		os.Exit(1)
+	}
+}
+
+func run(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	n, last, err := auditlog.Verify(f)
+	if err != nil {
+		return err
+	}
+	lastHash := hex.EncodeToString(last[:])
+	if *head != "" && *head != lastHash {
+		return fmt.Errorf("hash of the last of %d entries %s does not match "+
+			"expected hash %s", n, lastHash, *head)
+	}
+	fmt.Printf("%d entries verified\nlast entry hash: %s\n", n, lastHash)
+	return nil
+}
@@ -0,0 +1,181 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Command exporthistory writes the transaction history of a running dcrwallet
+// to a CSV or JSON file using the exporthistory JSON-RPC method.
+//
+// Usage:
+//
+//	exporthistory [flags] output.csv
+//
+// The output file is created and must not already exist.  Use - to write to
+// standard output.
+package main
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"decred.org/dcrwallet/internal/netparams"
+	"github.com/decred/dcrd/dcrutil/v2"
+)
+
+var (
+	walletDataDirectory = dcrutil.AppDataDir("dcrwallet", false)
+
+	testnet  = flag.Bool("testnet", false, "use the test network")
+	simnet   = flag.Bool("simnet", false, "use the simulation network")
+	connect  = flag.String("c", "localhost", "host[:port] of the wallet JSON-RPC server")
+	user     = flag.String("u", "", "JSON-RPC username")
+	pass     = flag.String("P", "", "JSON-RPC password")
+	cafile   = flag.String("cafile", filepath.Join(walletDataDirectory, "rpc.cert"), "wallet RPC TLS certificate")
+	format   = flag.String("format", "csv", `output format: "csv" or "json"`)
+	start    = flag.Int("start", 0, "height of the first exported block")
+	end      = flag.Int("end", -1, "height of the last exported block, or -1 for the main chain tip")
+	account  = flag.String("account", "", "export a single account")
+	labels   = flag.Bool("labels", false, "include transaction and output labels")
+	balances = flag.Bool("balances", false, "include running account balances")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [flags] output\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(flag.Arg(0)); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(output string) error {
+	if *testnet && *simnet {
+		return errors.New("testnet and simnet are mutually exclusive")
+	}
+	params := &netparams.MainNetParams
+	switch {
+	case *testnet:
+		params = &netparams.TestNet3Params
+	case *simnet:
+		params = &netparams.SimNetParams
+	}
+	host := *connect
+	if _, _, err := net.SplitHostPort(host); err != nil {
+		host = net.JoinHostPort(host, params.JSONRPCServerPort)
+	}
+
+	args := []interface{}{*format, *start, *end, nil, *labels, *balances}
+	if *account != "" {
+		args[3] = *account
+	}
+	result, err := call(host, "exporthistory", args)
+	if err != nil {
+		return err
+	}
+	var b []byte
+	if *format == "csv" {
+		var s string
+		err = json.Unmarshal(result, &s)
+		b = []byte(s)
+	} else {
+		var v interface{}
+		err = json.Unmarshal(result, &v)
+		if err == nil {
+			b, err = json.MarshalIndent(v, "", "  ")
+			b = append(b, '\n')
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("decode result: %v", err)
+	}
+
+	if output == "-" {
+		_, err = os.Stdout.Write(b)
+		return err
+	}
+	f, err := os.OpenFile(output, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(b)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(output)
+	}
+	return err
+}
+
+// call performs a JSON-RPC request over TLS and returns the raw result.
+func call(host, method string, params []interface{}) (json.RawMessage, error) {
+	pem, err := ioutil.ReadFile(*cafile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates in %s", *cafile)
+	}
+	client := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{RootCAs: pool},
+		},
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"jsonrpc": "1.0",
+		"id":      1,
+		"method":  method,
+		"params":  params,
+	})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", "https://"+host, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(*user, *pass)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, errors.New("authentication failed")
+	}
+
+	var r struct {
+		Result json.RawMessage `json:"result"`
+		Error  *struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&r)
+	if err != nil {
+		return nil, fmt.Errorf("decode response: %v (HTTP status %s)", err, resp.Status)
+	}
+	if r.Error != nil {
+		return nil, fmt.Errorf("%s: %s (code %d)", method, r.Error.Message, r.Error.Code)
+	}
+	return r.Result, nil
+}
@@ -21,6 +21,9 @@ import (
 
 	"decred.org/dcrwallet/internal/cfgutil"
 	"decred.org/dcrwallet/internal/netparams"
+	"decred.org/dcrwallet/internal/rpc/jsonrpc"
+	"decred.org/dcrwallet/internal/rpc/rpcauth"
+	"decred.org/dcrwallet/internal/vsp"
 	"github.com/decred/dcrd/connmgr"
 	"github.com/decred/dcrd/dcrutil/v2"
 	"github.com/decred/dcrwallet/errors/v2"
@@ -30,6 +33,7 @@ import (
 	"github.com/decred/go-socks/socks"
 	"github.com/decred/slog"
 	flags "github.com/jessevdk/go-flags"
+	"golang.org/x/crypto/ed25519"
 )
 
 const (
@@ -66,6 +70,7 @@ var (
 	defaultConfigFile  = filepath.Join(defaultAppDataDir, defaultConfigFilename)
 	defaultRPCKeyFile  = filepath.Join(defaultAppDataDir, "rpc.key")
 	defaultRPCCertFile = filepath.Join(defaultAppDataDir, "rpc.cert")
+	defaultMacaroonKey = filepath.Join(defaultAppDataDir, "macaroons.key")
 	defaultLogDir      = filepath.Join(defaultAppDataDir, defaultLogDirname)
 )
 
@@ -92,6 +97,7 @@ type config struct {
 	PromptPublicPass        bool                 `long:"promptpublicpass" description:"Prompt for public passphrase from terminal"`
 	EnableTicketBuyer       bool                 `long:"enableticketbuyer" description:"Enable the automatic ticket buyer"`
 	EnableVoting            bool                 `long:"enablevoting" description:"Automatically create votes and revocations"`
+	AutoRevoke              bool                 `long:"autorevoke" description:"Automatically revoke missed and expired tickets while the wallet is unlocked"`
 	PurchaseAccount         string               `long:"purchaseaccount" description:"Account to autobuy tickets from"`
 	PoolAddress             *cfgutil.AddressFlag `long:"pooladdress" description:"VSP fee address"`
 	poolAddress             dcrutil.Address
@@ -100,8 +106,16 @@ type config struct {
 	StakePoolColdExtKey     string              `long:"stakepoolcoldextkey" description:"xpub:maxindex for fee addresses (VSP-only option)"`
 	AllowHighFees           bool                `long:"allowhighfees" description:"Do not perform high fee checks"`
 	RelayFee                *cfgutil.AmountFlag `long:"txfee" description:"Transaction fee per kilobyte"`
+	TxFeeTarget             int32               `long:"txfeetarget" description:"Estimate transaction fees to be mined within this many blocks, using --txfee when no estimate is available (0 disables)"`
 	AccountGapLimit         int                 `long:"accountgaplimit" description:"Allowed gap of unused accounts"`
 	DisableCoinTypeUpgrades bool                `long:"disablecointypeupgrades" description:"Never upgrade from legacy to SLIP0044 coin type keys"`
+	ExternalSigner          string              `long:"externalsigner" description:"Path of a signer program used to sign transactions of watching-only wallets"`
+	ExternalSignerConnect   string              `long:"externalsignerconnect" description:"Network address (host:port, or absolute path of a unix socket) of a signer used to sign transactions of watching-only wallets"`
+	SpendPolicy             string              `long:"spendpolicy" description:"Path of a JSON file describing the spending limits of accounts"`
+	Webhooks                string              `long:"webhooks" description:"Path of a JSON file describing HTTP endpoints receiving wallet notifications"`
+	VSPURL                  string              `long:"vspurl" description:"Base URL of a fee-based VSP voting tickets purchased by purchasevspticket"`
+	VSPPubKey               string              `long:"vsppubkey" description:"Base64 public key signing the responses of the VSP; required on mainnet, trusted from the VSP when unset"`
+	vspPubKey               ed25519.PublicKey
 
 	// RPC client options
 	RPCConnect       string                  `short:"c" long:"rpcconnect" description:"Network address of dcrd RPC server"`
@@ -132,12 +146,20 @@ type config struct {
 	DisableServerTLS       bool                    `long:"noservertls" description:"Disable TLS for the RPC servers; only allowed when binding to localhost"`
 	GRPCListeners          []string                `long:"grpclisten" description:"Listen for gRPC connections on this interface"`
 	LegacyRPCListeners     []string                `long:"rpclisten" description:"Listen for JSON-RPC connections on this interface"`
+	RESTListeners          []string                `long:"restlisten" description:"Listen for REST gateway connections to the gRPC server on this interface"`
 	NoGRPC                 bool                    `long:"nogrpc" description:"Disable gRPC server"`
 	NoLegacyRPC            bool                    `long:"nolegacyrpc" description:"Disable JSON-RPC server"`
 	LegacyRPCMaxClients    int64                   `long:"rpcmaxclients" description:"Max JSON-RPC HTTP POST clients"`
 	LegacyRPCMaxWebsockets int64                   `long:"rpcmaxwebsockets" description:"Max JSON-RPC websocket clients"`
 	Username               string                  `short:"u" long:"username" description:"JSON-RPC username and default dcrd RPC username"`
 	Password               string                  `short:"P" long:"password" default-mask:"-" description:"JSON-RPC password and default dcrd RPC password"`
+	AuditLog               string                  `long:"auditlog" description:"Path of a tamper-evident log recording privileged operations invoked by RPC clients"`
+	RPCAuth                []string                `long:"rpcauth" description:"Additional JSON-RPC credentials authorized for a role, in the form role:username:password (roles: readonly, invoice, ticket, full)"`
+	rpcUsers               []jsonrpc.User
+	GRPCClientCerts        []string `long:"grpcclientcert" description:"Require gRPC clients to present a client certificate; PEM encoded certificate authorized for a role, in the form role:path"`
+	grpcClientCerts        rpcauth.ClientCerts
+	MacaroonRootKey        *cfgutil.ExplicitString `long:"macaroonrootkey" description:"Root key authenticating RPC macaroons; generated if missing"`
+	NoMacaroons            bool                    `long:"nomacaroons" description:"Disable macaroon authentication of RPC clients"`
 
 	// IPC options
 	PipeTx            *uint `long:"pipetx" description:"File descriptor or handle of write end pipe to enable child -> parent process communication"`
@@ -166,8 +188,13 @@ type ticketBuyerOptions struct {
 	BalanceToMaintainAbsolute *cfgutil.AmountFlag  `long:"balancetomaintainabsolute" description:"Amount of funds to keep in wallet when purchasing tickets"`
 	VotingAddress             *cfgutil.AddressFlag `long:"votingaddress" description:"Purchase tickets with voting rights assigned to this address"`
 	votingAddress             dcrutil.Address
-	Limit                     uint   `long:"limit" description:"Buy no more than specified number of tickets per block (0 disables limit)"`
-	VotingAccount             string `long:"votingaccount" description:"Account used to derive addresses specifying voting rights"`
+	Limit                     uint                `long:"limit" description:"Buy no more than specified number of tickets per block (0 disables limit)"`
+	VotingAccount             string              `long:"votingaccount" description:"Account used to derive addresses specifying voting rights"`
+	MaxPrice                  *cfgutil.AmountFlag `long:"maxprice" description:"Do not buy tickets priced above this amount (0 disables)"`
+	AverageWindows            int                 `long:"averagewindows" description:"Only buy tickets priced below the average price of this many previous ticket windows (0 disables)"`
+	Spread                    bool                `long:"spread" description:"Spread ticket purchases evenly across each ticket window"`
+	WindowBudget              *cfgutil.AmountFlag `long:"windowbudget" description:"Maximum total price of tickets bought per ticket window (0 disables)"`
+	TargetPoolSize            uint32              `long:"targetpoolsize" description:"Do not buy tickets while the projected ticket pool size is above this size (0 disables)"`
 }
 
 // cleanAndExpandPath expands environement variables and leading ~ in the
@@ -330,6 +357,7 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		PromptPublicPass:        defaultPromptPublicPass,
 		RPCKey:                  cfgutil.NewExplicitString(defaultRPCKeyFile),
 		RPCCert:                 cfgutil.NewExplicitString(defaultRPCCertFile),
+		MacaroonRootKey:         cfgutil.NewExplicitString(defaultMacaroonKey),
 		TLSCurve:                cfgutil.NewCurveFlag(cfgutil.PreferredCurve),
 		LegacyRPCMaxClients:     defaultRPCMaxClients,
 		LegacyRPCMaxWebsockets:  defaultRPCMaxWebsockets,
@@ -350,6 +378,8 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		TBOpts: ticketBuyerOptions{
 			BalanceToMaintainAbsolute: cfgutil.NewAmountFlag(defaultBalanceToMaintainAbsolute),
 			VotingAddress:             cfgutil.NewAddressFlag(),
+			MaxPrice:                  cfgutil.NewAmountFlag(0),
+			WindowBudget:              cfgutil.NewAmountFlag(0),
 		},
 	}
 
@@ -422,6 +452,9 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		if !cfg.RPCCert.ExplicitlySet() {
 			cfg.RPCCert.Value = filepath.Join(cfg.AppDataDir.Value, "rpc.cert")
 		}
+		if !cfg.MacaroonRootKey.ExplicitlySet() {
+			cfg.MacaroonRootKey.Value = filepath.Join(cfg.AppDataDir.Value, "macaroons.key")
+		}
 		if !cfg.LogDir.ExplicitlySet() {
 			cfg.LogDir.Value = filepath.Join(cfg.AppDataDir.Value, defaultLogDirname)
 		}
@@ -520,6 +553,26 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		return loadConfigError(err)
 	}
 
+	// Sanity check ticket buyer strategies
+	if cfg.TBOpts.MaxPrice.ToCoin() < 0 {
+		str := "%s: maxprice cannot be negative: %v"
+		err := errors.Errorf(str, funcName, cfg.TBOpts.MaxPrice)
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+	if cfg.TBOpts.WindowBudget.ToCoin() < 0 {
+		str := "%s: windowbudget cannot be negative: %v"
+		err := errors.Errorf(str, funcName, cfg.TBOpts.WindowBudget)
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+	if cfg.TBOpts.AverageWindows < 0 {
+		str := "%s: averagewindows cannot be negative: %v"
+		err := errors.Errorf(str, funcName, cfg.TBOpts.AverageWindows)
+		fmt.Fprintln(os.Stderr, err)
+		return loadConfigError(err)
+	}
+
 	// Exit if you try to use a simulation wallet with a standard
 	// data directory.
 	if !cfg.AppDataDir.ExplicitlySet() && cfg.CreateTemp {
@@ -622,6 +675,84 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		}
 	}
 
+	if cfg.TxFeeTarget < 0 || cfg.TxFeeTarget > wallet.MaxFeeEstimateTarget {
+		err := errors.E(errors.Invalid, errors.Errorf("txfeetarget %v is not "+
+			"between 0 and %d", cfg.TxFeeTarget, wallet.MaxFeeEstimateTarget))
+		fmt.Fprintln(os.Stderr, err.Error())
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return loadConfigError(err)
+	}
+
+	if cfg.ExternalSigner != "" && cfg.ExternalSignerConnect != "" {
+		err := errors.Errorf("externalsigner and externalsignerconnect " +
+			"may not be used together")
+		fmt.Fprintln(os.Stderr, err.Error())
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return loadConfigError(err)
+	}
+	if cfg.ExternalSigner != "" {
+		cfg.ExternalSigner = cleanAndExpandPath(cfg.ExternalSigner)
+	}
+	if cfg.SpendPolicy != "" {
+		cfg.SpendPolicy = cleanAndExpandPath(cfg.SpendPolicy)
+	}
+	if cfg.Webhooks != "" {
+		cfg.Webhooks = cleanAndExpandPath(cfg.Webhooks)
+	}
+	if cfg.AuditLog != "" {
+		cfg.AuditLog = cleanAndExpandPath(cfg.AuditLog)
+	}
+	// A VSP which is compromised or impersonated could otherwise request
+	// fees of any amount on mainnet, so its public key must be pinned.
+	if cfg.VSPURL != "" && cfg.VSPPubKey == "" && !cfg.TestNet && !cfg.SimNet {
+		err := errors.New("--vspurl requires --vsppubkey on mainnet")
+		fmt.Fprintln(os.Stderr, err.Error())
+		return loadConfigError(err)
+	}
+	if cfg.VSPPubKey != "" {
+		if cfg.VSPURL == "" {
+			err := errors.New("--vsppubkey requires --vspurl")
+			fmt.Fprintln(os.Stderr, err.Error())
+			return loadConfigError(err)
+		}
+		cfg.vspPubKey, err = vsp.DecodePubKey(cfg.VSPPubKey)
+		if err != nil {
+			err := errors.Errorf("Invalid --vsppubkey: %v", err)
+			fmt.Fprintln(os.Stderr, err.Error())
+			return loadConfigError(err)
+		}
+	}
+
+	// Parse the credentials and client certificates of RPC clients limited
+	// to a role.
+	for _, s := range cfg.RPCAuth {
+		role, username, password, err := rpcauth.ParseCredential(s)
+		if err != nil {
+			err := errors.Errorf("Invalid --rpcauth option: %v", err)
+			fmt.Fprintln(os.Stderr, err.Error())
+			return loadConfigError(err)
+		}
+		cfg.rpcUsers = append(cfg.rpcUsers, jsonrpc.User{
+			Username: username,
+			Password: password,
+			Role:     role,
+		})
+	}
+	if len(cfg.GRPCClientCerts) != 0 {
+		cfg.grpcClientCerts = make(rpcauth.ClientCerts)
+	}
+	for _, s := range cfg.GRPCClientCerts {
+		role, path, err := rpcauth.SplitRole(s)
+		if err == nil {
+			err = cfg.grpcClientCerts.AddClientCert(role, cleanAndExpandPath(path))
+		}
+		if err != nil {
+			err := errors.Errorf("Invalid --grpcclientcert option: %v", err)
+			fmt.Fprintln(os.Stderr, err.Error())
+			return loadConfigError(err)
+		}
+	}
+
 	ipNet := func(cidr string) net.IPNet {
 		_, ipNet, err := net.ParseCIDR(cidr)
 		if err != nil {
@@ -916,10 +1047,36 @@ func loadConfig(ctx context.Context) (*config, []string, error) {
 		}
 	}
 
+	// The REST gateway forwards requests to the gRPC server, and REST
+	// clients authenticate with macaroons.
+	if len(cfg.RESTListeners) != 0 {
+		var err error
+		switch {
+		case len(cfg.GRPCListeners) == 0:
+			err = errors.New("--restlisten requires the gRPC server")
+		case cfg.DisableServerTLS:
+			err = errors.New("--restlisten may not be used with --noservertls")
+		case cfg.NoMacaroons:
+			err = errors.New("--restlisten may not be used with --nomacaroons")
+		}
+		for _, addr := range cfg.RESTListeners {
+			if err != nil {
+				break
+			}
+			_, _, err = net.SplitHostPort(addr)
+		}
+		if err != nil {
+			err := errors.Errorf("Invalid REST gateway options: %v", err)
+			fmt.Fprintln(os.Stderr, err)
+			return loadConfigError(err)
+		}
+	}
+
 	// Expand environment variable and leading ~ for filepaths.
 	cfg.CAFile.Value = cleanAndExpandPath(cfg.CAFile.Value)
 	cfg.RPCCert.Value = cleanAndExpandPath(cfg.RPCCert.Value)
 	cfg.RPCKey.Value = cleanAndExpandPath(cfg.RPCKey.Value)
+	cfg.MacaroonRootKey.Value = cleanAndExpandPath(cfg.MacaroonRootKey.Value)
 
 	// If the dcrd username or password are unset, use the same auth as for
 	// the client.  The two settings were previously shared for dcrd and
@@ -17,11 +17,19 @@ import (
 	"path/filepath"
 	"runtime"
 	"runtime/pprof"
+	"sync"
 	"time"
 
+	"decred.org/dcrwallet/internal/auditlog"
+	"decred.org/dcrwallet/internal/extsigner"
 	ldr "decred.org/dcrwallet/internal/loader"
 	"decred.org/dcrwallet/internal/prompt"
+	"decred.org/dcrwallet/internal/revoker"
+	"decred.org/dcrwallet/internal/rpc/rpcauth"
 	"decred.org/dcrwallet/internal/rpc/rpcserver"
+	"decred.org/dcrwallet/internal/spendpolicy"
+	"decred.org/dcrwallet/internal/vsp"
+	"decred.org/dcrwallet/internal/webhook"
 	"github.com/decred/dcrd/addrmgr"
 	"github.com/decred/dcrd/wire"
 	"github.com/decred/dcrwallet/chain/v3"
@@ -165,6 +173,156 @@ func run(ctx context.Context) error {
 		cfg.GapLimit, cfg.AllowHighFees, cfg.RelayFee.ToCoin(),
 		cfg.AccountGapLimit, cfg.DisableCoinTypeUpgrades)
 
+	if cfg.TxFeeTarget != 0 {
+		loader.RunAfterLoad(func(w *wallet.Wallet) {
+			err := w.SetFeeEstimateTarget(cfg.TxFeeTarget)
+			if err != nil {
+				log.Errorf("Unable to set fee estimate target: %v", err)
+			}
+		})
+	}
+
+	// Start or connect to the external signer used by watching-only
+	// wallets.
+	var signer *extsigner.Client
+	switch {
+	case cfg.ExternalSigner != "":
+		signer, err = extsigner.StartProcess(cfg.ExternalSigner)
+	case cfg.ExternalSignerConnect != "":
+		network := "tcp"
+		if filepath.IsAbs(cfg.ExternalSignerConnect) {
+			network = "unix"
+		}
+		signer, err = extsigner.Dial(ctx, network, cfg.ExternalSignerConnect)
+	}
+	if err != nil {
+		log.Errorf("Unable to start external signer: %v", err)
+		return err
+	}
+	if signer != nil {
+		defer signer.Close()
+		loader.RunAfterLoad(func(w *wallet.Wallet) {
+			w.SetExternalSigner(signer)
+		})
+	}
+
+	// Enforce the spending policy of accounts once the wallet is loaded.
+	if cfg.SpendPolicy != "" {
+		policyFile, err := spendpolicy.Read(cfg.SpendPolicy)
+		if err != nil {
+			log.Errorf("Unable to read spending policy: %v", err)
+			return err
+		}
+		loader.RunAfterLoad(func(w *wallet.Wallet) {
+			policy, err := policyFile.Policy(w.ChainParams(), func(name string) (uint32, error) {
+				return w.AccountNumber(ctx, name)
+			})
+			if err != nil {
+				log.Errorf("Unable to apply spending policy: %v", err)
+				return
+			}
+			w.SetSpendPolicy(policy)
+		})
+	}
+
+	// Deliver wallet notifications to webhooks once the wallet is loaded.
+	// Undelivered notifications are queued in the network directory.
+	if cfg.Webhooks != "" {
+		hooks, err := webhook.Read(cfg.Webhooks)
+		if err != nil {
+			log.Errorf("Unable to read webhooks: %v", err)
+			return err
+		}
+		hookService, err := webhook.Open(ctx, filepath.Join(dbDir, "webhooks.db"), hooks)
+		if err != nil {
+			log.Errorf("Unable to open webhook queue: %v", err)
+			return err
+		}
+		var hookWG sync.WaitGroup
+		hookCtx, hookCancel := context.WithCancel(ctx)
+		defer func() {
+			hookCancel()
+			hookWG.Wait()
+			hookService.Close()
+		}()
+		loader.RunAfterLoad(func(w *wallet.Wallet) {
+			hookWG.Add(1)
+			go func() {
+				defer hookWG.Done()
+				err := hookService.Run(hookCtx, w)
+				if err != nil && !errors.Is(err, context.Canceled) {
+					log.Errorf("Webhook delivery stopped: %v", err)
+				}
+			}()
+		})
+	}
+
+	// Pay the fees of tickets purchased through a fee-based VSP once the
+	// wallet is loaded, retrying interrupted payments after each block.
+	if cfg.VSPURL != "" {
+		vspClient, err = vsp.NewClient(cfg.VSPURL, cfg.vspPubKey, activeNet.Params)
+		if err != nil {
+			log.Errorf("Unable to create VSP client: %v", err)
+			return err
+		}
+		var vspWG sync.WaitGroup
+		vspCtx, vspCancel := context.WithCancel(ctx)
+		defer func() {
+			vspCancel()
+			vspWG.Wait()
+		}()
+		loader.RunAfterLoad(func(w *wallet.Wallet) {
+			vspWG.Add(1)
+			go func() {
+				defer vspWG.Done()
+				err := vspClient.Run(vspCtx, w)
+				if err != nil && !errors.Is(err, context.Canceled) {
+					log.Errorf("VSP fee payments stopped: %v", err)
+				}
+			}()
+		})
+	}
+
+	// Revoke missed and expired tickets after each block once the wallet is
+	// loaded.  Revocations are only created while the wallet is unlocked.
+	if cfg.AutoRevoke {
+		var revokerWG sync.WaitGroup
+		revokerCtx, revokerCancel := context.WithCancel(ctx)
+		defer func() {
+			revokerCancel()
+			revokerWG.Wait()
+		}()
+		loader.RunAfterLoad(func(w *wallet.Wallet) {
+			revokerWG.Add(1)
+			go func() {
+				defer revokerWG.Done()
+				err := revoker.New(w).Run(revokerCtx)
+				if err != nil && !errors.Is(err, context.Canceled) {
+					log.Errorf("Ticket revocations stopped: %v", err)
+				}
+			}()
+		})
+	}
+
+	// Open the audit log of privileged operations invoked by RPC clients.
+	if cfg.AuditLog != "" {
+		auditLog, err = auditlog.Open(cfg.AuditLog)
+		if err != nil {
+			log.Errorf("Unable to open audit log: %v", err)
+			return err
+		}
+		defer auditLog.Close()
+	}
+
+	// Load or generate the root key authenticating RPC macaroons.
+	if !cfg.NoMacaroons {
+		macaroonRootKey, err = rpcauth.LoadRootKey(cfg.MacaroonRootKey.Value)
+		if err != nil {
+			log.Errorf("Unable to load macaroon root key: %v", err)
+			return err
+		}
+	}
+
 	// Stop any services started by the loader after the shutdown procedure is
 	// initialized and this function returns.
 	defer func() {
@@ -311,6 +469,11 @@ func run(ctx context.Context) error {
 				c.MixedAccountBranch = cfg.mixedBranch
 				c.TicketSplitAccount = ticketSplitAccount
 				c.ChangeAccount = changeAccount
+				c.MaxPrice = cfg.TBOpts.MaxPrice.Amount
+				c.AverageWindows = cfg.TBOpts.AverageWindows
+				c.Spread = cfg.TBOpts.Spread
+				c.WindowBudget = cfg.TBOpts.WindowBudget.Amount
+				c.TargetPoolSize = cfg.TBOpts.TargetPoolSize
 			})
 			log.Infof("Starting auto transaction creator")
 			tbdone := make(chan struct{})
@@ -336,7 +499,7 @@ func run(ctx context.Context) error {
 	//
 	// Servers will be associated with a loaded wallet if it has already been
 	// loaded, or after it is loaded later on.
-	gRPCServer, jsonRPCServer, err := startRPCServers(loader)
+	gRPCServer, jsonRPCServer, restServer, err := startRPCServers(loader)
 	if err != nil {
 		log.Errorf("Unable to create RPC servers: %v", err)
 		return err
@@ -365,6 +528,13 @@ func run(ctx context.Context) error {
 			log.Info("JSON-RPC server shutdown")
 		}()
 	}
+	if restServer != nil {
+		defer func() {
+			log.Warn("Stopping REST gateway...")
+			restServer.Stop()
+			log.Info("REST gateway shutdown")
+		}()
+	}
 
 	// When not running with --noinitialload, it is the main package's
 	// responsibility to synchronize the wallet with the network through SPV or
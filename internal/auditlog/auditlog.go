@@ -0,0 +1,227 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package auditlog implements an append-only, tamper-evident log of privileged
+// wallet operations.
+//
+// The log is a file of JSON objects, one entry per line.  Every entry commits
+// to the hash of the previous entry, so modifying, reordering or removing any
+// entry other than the most recent ones breaks the hash chain and is detected
+// by Verify.  Removing entries from the end of the log can only be detected by
+// comparing the hash of the last entry with a copy kept elsewhere.
+package auditlog
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/decred/dcrwallet/errors/v2"
+)
+
+// Entry is a single audit log entry.
+type Entry struct {
+	Seq       uint64          `json:"seq"`
+	Time      time.Time       `json:"time"`
+	Caller    string          `json:"caller"`
+	Operation string          `json:"operation"`
+	Params    json.RawMessage `json:"params,omitempty"`
+	Error     string          `json:"error,omitempty"`
+	PrevHash  string          `json:"prevhash"`
+	Hash      string          `json:"hash"`
+}
+
+// digest returns the hash committing to the entry and the hash of the previous
+// entry.  Variable length fields are length prefixed so that no two distinct
+// entries serialize to the same bytes.
+func (e *Entry) digest(prevHash *[sha256.Size]byte) [sha256.Size]byte {
+	var buf bytes.Buffer
+	var b [8]byte
+	buf.Write(prevHash[:])
+	binary.BigEndian.PutUint64(b[:], e.Seq)
+	buf.Write(b[:])
+	binary.BigEndian.PutUint64(b[:], uint64(e.Time.UnixNano()))
+	buf.Write(b[:])
+	for _, field := range [][]byte{[]byte(e.Caller), []byte(e.Operation), e.Params, []byte(e.Error)} {
+		binary.BigEndian.PutUint64(b[:], uint64(len(field)))
+		buf.Write(b[:])
+		buf.Write(field)
+	}
+	return sha256.Sum256(buf.Bytes())
+}
+
+// readEntries reads and verifies the hash chain of every entry of a log,
+// calling fn with each entry in order.  The number of entries and the hash of
+// the last entry are returned.
+func readEntries(r io.Reader, fn func(*Entry) error) (n uint64, head [sha256.Size]byte, err error) {
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 16*1024*1024)
+	for s.Scan() {
+		e := new(Entry)
+		err := json.Unmarshal(s.Bytes(), e)
+		if err != nil {
+			return n, head, errors.E(errors.Encoding, errors.Errorf("entry %d: %v", n, err))
+		}
+		if e.Seq != n {
+			return n, head, errors.E(errors.Invalid, errors.Errorf("entry %d: unexpected sequence number %d", n, e.Seq))
+		}
+		if e.PrevHash != hex.EncodeToString(head[:]) {
+			if n == 0 {
+				return n, head, errors.E(errors.Invalid, "entry 0: previous hash is not zero")
+			}
+			return n, head, errors.E(errors.Invalid, errors.Errorf("entry %d: previous hash does not match "+
+				"hash of entry %d", n, n-1))
+		}
+		hash := e.digest(&head)
+		if e.Hash != hex.EncodeToString(hash[:]) {
+			return n, head, errors.E(errors.Invalid, errors.Errorf("entry %d: hash mismatch", n))
+		}
+		if fn != nil {
+			err = fn(e)
+			if err != nil {
+				return n, head, err
+			}
+		}
+		head = hash
+		n++
+	}
+	if err := s.Err(); err != nil {
+		return n, head, errors.E(errors.IO, err)
+	}
+	return n, head, nil
+}
+
+// Verify reads an audit log and verifies its hash chain.  The number of
+// entries and the hash of the last entry are returned.
+func Verify(r io.Reader) (n uint64, head [sha256.Size]byte, err error) {
+	const op errors.Op = "auditlog.Verify"
+	n, head, err = readEntries(r, nil)
+	if err != nil {
+		return n, head, errors.E(op, err)
+	}
+	return n, head, nil
+}
+
+// Log is an open audit log.  It is safe for concurrent use.
+type Log struct {
+	mu   sync.Mutex
+	path string
+	f    *os.File
+	seq  uint64
+	head [sha256.Size]byte
+}
+
+// Open opens or creates the audit log at path.  The hash chain of an existing
+// log is verified, and opening a log which fails verification is an error.
+func Open(path string) (*Log, error) {
+	const op errors.Op = "auditlog.Open"
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	n, head, err := readEntries(f, nil)
+	if err != nil {
+		f.Close()
+		return nil, errors.E(op, errors.Errorf("%s: %v", path, err))
+	}
+	return &Log{path: path, f: f, seq: n, head: head}, nil
+}
+
+// Close closes the log.
+func (l *Log) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.f.Close()
+}
+
+// Record appends an entry describing an operation performed by caller.  params
+// describes the parameters of the operation (see Params) and may be nil.  A
+// non-nil opErr records that the operation failed.  The entry is synced to
+// disk before returning.
+func (l *Log) Record(caller, operation string, params json.RawMessage, opErr error) error {
+	const op errors.Op = "auditlog.Record"
+	e := &Entry{
+		Time:      time.Now().UTC(),
+		Caller:    caller,
+		Operation: operation,
+		Params:    params,
+	}
+	if opErr != nil {
+		e.Error = opErr.Error()
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	e.Seq = l.seq
+	e.PrevHash = hex.EncodeToString(l.head[:])
+	hash := e.digest(&l.head)
+	e.Hash = hex.EncodeToString(hash[:])
+	line, err := json.Marshal(e)
+	if err != nil {
+		return errors.E(op, errors.Bug, err)
+	}
+	line = append(line, '\n')
+	_, err = l.f.Write(line)
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	err = l.f.Sync()
+	if err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	l.seq++
+	l.head = hash
+	return nil
+}
+
+// Filter selects entries returned by Entries.  Zero values match every entry.
+type Filter struct {
+	Operation string
+	Caller    string
+	Since     time.Time
+
+	// Count limits the result to the most recent Count matching entries.
+	Count int
+}
+
+// Entries reads the log and returns the entries matching a filter, in the
+// order they were recorded.
+func (l *Log) Entries(filter *Filter) ([]*Entry, error) {
+	const op errors.Op = "auditlog.Entries"
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	f, err := os.Open(l.path)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	defer f.Close()
+	var entries []*Entry
+	_, _, err = readEntries(f, func(e *Entry) error {
+		switch {
+		case filter.Operation != "" && e.Operation != filter.Operation:
+		case filter.Caller != "" && e.Caller != filter.Caller:
+		case e.Time.Before(filter.Since):
+		default:
+			entries = append(entries, e)
+			if filter.Count > 0 && len(entries) > 2*filter.Count {
+				entries = append(entries[:0], entries[len(entries)-filter.Count:]...)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if filter.Count > 0 && len(entries) > filter.Count {
+		entries = entries[len(entries)-filter.Count:]
+	}
+	return entries, nil
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package auditlog
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/decred/dcrwallet/errors/v2"
+)
+
+type walletPassphraseCmd struct {
+	Passphrase string
+	Timeout    int64
+	Account    *string
+}
+
+func TestLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "auditlog")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "audit.log")
+
+	l, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	records := []struct {
+		caller, operation string
+		params            interface{}
+		secrets           []string
+		err               error
+	}{
+		{"jsonrpc:user@127.0.0.1:5000", "walletpassphrase", &walletPassphraseCmd{"secret", 60, nil}, []string{"Passphrase"}, nil},
+		{"jsonrpc:user@127.0.0.1:5000", "dumpprivkey", struct{ Address string }{"Dsaddr"}, nil, nil},
+		{"grpc:client@127.0.0.1:5001", "/walletrpc.WalletService/SignTransaction", nil, nil, errors.New("locked")},
+	}
+	for _, r := range records[:2] {
+		err := l.Record(r.caller, r.operation, Params(r.params, r.secrets...), r.err)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	l.Close()
+
+	// Reopening continues the hash chain.
+	l, err = Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	r := records[2]
+	err = l.Record(r.caller, r.operation, Params(r.params, r.secrets...), r.err)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := l.Entries(&Filter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != len(records) {
+		t.Fatalf("read %d entries, expected %d", len(entries), len(records))
+	}
+	for i, e := range entries {
+		if e.Seq != uint64(i) || e.Caller != records[i].caller || e.Operation != records[i].operation {
+			t.Errorf("entry %d: %+v", i, e)
+		}
+	}
+	if !bytes.Contains(entries[0].Params, []byte(Redacted)) || bytes.Contains(entries[0].Params, []byte("secret")) {
+		t.Errorf("passphrase not redacted: %s", entries[0].Params)
+	}
+	if entries[2].Error != "locked" || entries[2].Params != nil {
+		t.Errorf("unexpected failed entry %+v", entries[2])
+	}
+
+	filtered, err := l.Entries(&Filter{Caller: "jsonrpc:user@127.0.0.1:5000", Count: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(filtered) != 1 || filtered[0].Operation != "dumpprivkey" {
+		t.Errorf("unexpected filtered entries %+v", filtered)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n, head, err := Verify(f)
+	f.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 3 || entries[2].Hash != hex.EncodeToString(head[:]) {
+		t.Errorf("verified %d entries with head %x", n, head)
+	}
+}
+
+func TestVerifyTampered(t *testing.T) {
+	dir, err := ioutil.TempDir("", "auditlog")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "audit.log")
+	l, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, op := range []string{"importprivkey", "signmessage", "sendtoaddress"} {
+		err := l.Record("jsonrpc:user@127.0.0.1:5000", op, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	l.Close()
+	log, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := bytes.SplitAfter(log, []byte("\n"))
+
+	tests := []struct {
+		name string
+		log  []byte
+	}{
+		{"modified entry", bytes.Replace(log, []byte("signmessage"), []byte("getbalance"), 1)},
+		{"removed entry", append(append([]byte(nil), lines[0]...), lines[2]...)},
+		{"reordered entries", bytes.Join([][]byte{lines[1], lines[0], lines[2]}, nil)},
+	}
+	for _, test := range tests {
+		_, _, err := Verify(bytes.NewReader(test.log))
+		if err == nil {
+			t.Errorf("%s: verification succeeded", test.name)
+		}
+		err = ioutil.WriteFile(path, test.log, 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if l, err := Open(path); err == nil {
+			l.Close()
+			t.Errorf("%s: opened tampered log", test.name)
+		}
+	}
+}
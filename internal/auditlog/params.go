@@ -0,0 +1,70 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package auditlog
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// Redacted replaces the values of secret parameters.
+const Redacted = "[redacted]"
+
+// Params describes the parameters of an operation for an audit log entry.  v
+// must be a struct or a pointer to a struct, such as a JSON-RPC command or a
+// gRPC request message.  The result is a JSON object keyed by the lowercased
+// names of the exported fields.  Nil fields and fields with names beginning
+// with XXX_ are omitted, and the fields named by secrets, which are the Go
+// field names of passphrases, private keys, seeds and other secrets of the
+// operation, are redacted.  Nil is returned when v is not a struct or it can
+// not be encoded.
+func Params(v interface{}, secrets ...string) json.RawMessage {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+	rt := rv.Type()
+	params := make(map[string]interface{}, rt.NumField())
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if field.PkgPath != "" || strings.HasPrefix(field.Name, "XXX_") {
+			continue
+		}
+		fv := rv.Field(i)
+		switch fv.Kind() {
+		case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice:
+			if fv.IsNil() {
+				continue
+			}
+		}
+		key := strings.ToLower(field.Name)
+		if secretField(field.Name, secrets) {
+			params[key] = Redacted
+			continue
+		}
+		params[key] = fv.Interface()
+	}
+	b, err := json.Marshal(params)
+	if err != nil {
+		return nil
+	}
+	return b
+}
+
+func secretField(name string, secrets []string) bool {
+	for _, s := range secrets {
+		if s == name {
+			return true
+		}
+	}
+	return false
+}
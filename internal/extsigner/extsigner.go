@@ -0,0 +1,308 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package extsigner implements the protocol used to request signatures from
+// an external signer process, such as a hardware wallet bridge or HSM.
+//
+// The protocol is line-delimited JSON over a byte stream, which is either the
+// stdin and stdout of a signer process started by the wallet, or a TCP or unix
+// socket connection.  Each request is a single line containing a JSON object:
+//
+//	{"id":1,"method":"signhash","params":{"accountxpub":"dpub...","branch":0,
+//	 "child":7,"pubkey":"02...","sighash":"9b..."}}
+//
+// The signer responds with a single line for each request, identified by the
+// same id.  Responses may be written in any order.  Successful responses
+// contain the hex-encoded DER signature of the signature hash, without an
+// appended signature hash type:
+//
+//	{"id":1,"result":"3044..."}
+//
+// Failed requests are responded to with an error message:
+//
+//	{"id":1,"error":"user rejected signature"}
+//
+// The signhash method requests a secp256k1 signature of the 32 byte sighash by
+// the key derived from the BIP0044 account extended public key accountxpub
+// along the non-hardened path branch/child.  pubkey is the compressed public
+// key of the derived key, and the signer should refuse to sign if it does not
+// hold the extended private key of the account.  Signers must respond to
+// unknown methods with an error.
+package extsigner
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"sync"
+
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3"
+)
+
+// MethodSignHash is the method name of signature hash requests.
+const MethodSignHash = "signhash"
+
+// Request is a request sent to the signer.
+type Request struct {
+	ID     uint64          `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+// SignHashParams are the parameters of signhash requests.
+type SignHashParams struct {
+	AccountXpub string `json:"accountxpub"`
+	Branch      uint32 `json:"branch"`
+	Child       uint32 `json:"child"`
+	PubKey      string `json:"pubkey"`
+	SigHash     string `json:"sighash"`
+}
+
+// Response is a response written by the signer.  Exactly one of Result and
+// Error is set.
+type Response struct {
+	ID     uint64 `json:"id"`
+	Result string `json:"result,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Client requests signatures from an external signer and implements
+// wallet.ExternalSigner.  Requests may be made concurrently.
+type Client struct {
+	rwc io.ReadWriteCloser
+
+	writeMu sync.Mutex
+	enc     *json.Encoder
+
+	mu      sync.Mutex
+	nextID  uint64
+	pending map[uint64]chan *Response
+	err     error // set when the connection is lost
+}
+
+var _ wallet.ExternalSigner = (*Client)(nil)
+
+// New returns a client using rwc to communicate with a signer.  The client
+// owns rwc and closes it when the client is closed.
+func New(rwc io.ReadWriteCloser) *Client {
+	c := &Client{
+		rwc:     rwc,
+		enc:     json.NewEncoder(rwc),
+		pending: make(map[uint64]chan *Response),
+	}
+	go c.readResponses()
+	return c
+}
+
+type processConn struct {
+	io.ReadCloser
+	stdin io.WriteCloser
+	cmd   *exec.Cmd
+
+	closeOnce sync.Once
+	closeErr  error
+}
+
+func (p *processConn) Write(b []byte) (int, error) { return p.stdin.Write(b) }
+
+// Close closes the process's stdin and waits for it to exit.
+func (p *processConn) Close() error {
+	p.closeOnce.Do(func() {
+		p.stdin.Close()
+		p.closeErr = p.cmd.Wait()
+	})
+	return p.closeErr
+}
+
+// StartProcess starts a signer process and returns a client communicating
+// with it over the process's stdin and stdout.  The process's stderr is
+// inherited from the wallet.  The process is expected to exit when its stdin
+// is closed, which happens when the client is closed.
+func StartProcess(name string, args ...string) (*Client, error) {
+	const op errors.Op = "extsigner.StartProcess"
+	cmd := exec.Command(name, args...)
+	cmd.Stderr = os.Stderr
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	err = cmd.Start()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return New(&processConn{ReadCloser: stdout, stdin: stdin, cmd: cmd}), nil
+}
+
+// Dial connects to a signer listening on a network address.
+func Dial(ctx context.Context, network, address string) (*Client, error) {
+	const op errors.Op = "extsigner.Dial"
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, network, address)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return New(conn), nil
+}
+
+// Close closes the connection to the signer.  Pending requests fail.
+func (c *Client) Close() error {
+	return c.rwc.Close()
+}
+
+func (c *Client) readResponses() {
+	r := bufio.NewReader(c.rwc)
+	var err error
+	for {
+		var line []byte
+		line, err = r.ReadBytes('\n')
+		if err != nil {
+			break
+		}
+		resp := new(Response)
+		err = json.Unmarshal(line, resp)
+		if err != nil {
+			err = errors.E(errors.Encoding, errors.Errorf("invalid signer response: %v", err))
+			break
+		}
+		c.mu.Lock()
+		ch, ok := c.pending[resp.ID]
+		delete(c.pending, resp.ID)
+		c.mu.Unlock()
+		if ok {
+			ch <- resp
+		}
+	}
+
+	c.mu.Lock()
+	c.err = errors.E(errors.IO, errors.Errorf("signer connection lost: %v", err))
+	for id, ch := range c.pending {
+		close(ch)
+		delete(c.pending, id)
+	}
+	c.mu.Unlock()
+	c.rwc.Close()
+}
+
+// call sends a request and waits for its response.
+func (c *Client) call(ctx context.Context, method string, params interface{}) (string, error) {
+	rawParams, err := json.Marshal(params)
+	if err != nil {
+		return "", err
+	}
+
+	c.mu.Lock()
+	if c.err != nil {
+		err := c.err
+		c.mu.Unlock()
+		return "", err
+	}
+	c.nextID++
+	id := c.nextID
+	ch := make(chan *Response, 1)
+	c.pending[id] = ch
+	c.mu.Unlock()
+
+	c.writeMu.Lock()
+	err = c.enc.Encode(&Request{ID: id, Method: method, Params: rawParams})
+	c.writeMu.Unlock()
+	if err != nil {
+		c.mu.Lock()
+		delete(c.pending, id)
+		c.mu.Unlock()
+		return "", errors.E(errors.IO, err)
+	}
+
+	select {
+	case <-ctx.Done():
+		c.mu.Lock()
+		delete(c.pending, id)
+		c.mu.Unlock()
+		return "", ctx.Err()
+	case resp, ok := <-ch:
+		if !ok {
+			c.mu.Lock()
+			err := c.err
+			c.mu.Unlock()
+			return "", err
+		}
+		if resp.Error != "" {
+			return "", errors.Errorf("signer: %s", resp.Error)
+		}
+		return resp.Result, nil
+	}
+}
+
+// SignHash requests a signature of a signature hash from the signer.
+func (c *Client) SignHash(ctx context.Context, req *wallet.SignRequest) ([]byte, error) {
+	const op errors.Op = "extsigner.SignHash"
+	params := &SignHashParams{
+		AccountXpub: req.AccountXpub.String(),
+		Branch:      req.Branch,
+		Child:       req.Child,
+		PubKey:      hex.EncodeToString(req.PubKey),
+		SigHash:     hex.EncodeToString(req.SigHash),
+	}
+	result, err := c.call(ctx, MethodSignHash, params)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	sig, err := hex.DecodeString(result)
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	return sig, nil
+}
+
+// Serve reads requests from r and writes responses to w until r is closed,
+// answering signhash requests by calling signHash.  It may be used to
+// implement signer processes.
+func Serve(r io.Reader, w io.Writer, signHash func(*SignHashParams) ([]byte, error)) error {
+	br := bufio.NewReader(r)
+	enc := json.NewEncoder(w)
+	for {
+		line, err := br.ReadBytes('\n')
+		if err == io.EOF && len(line) == 0 {
+			return nil
+		}
+		if err != nil && err != io.EOF {
+			return err
+		}
+		var req Request
+		err = json.Unmarshal(line, &req)
+		if err != nil {
+			return errors.E(errors.Encoding, err)
+		}
+		resp := &Response{ID: req.ID}
+		switch req.Method {
+		case MethodSignHash:
+			var params SignHashParams
+			err = json.Unmarshal(req.Params, &params)
+			if err == nil {
+				var sig []byte
+				sig, err = signHash(&params)
+				resp.Result = hex.EncodeToString(sig)
+			}
+		default:
+			err = errors.Errorf("unknown method %q", req.Method)
+		}
+		if err != nil {
+			resp.Result = ""
+			resp.Error = err.Error()
+		}
+		err = enc.Encode(resp)
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package extsigner
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"os"
+	"testing"
+
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrd/dcrec/secp256k1/v2"
+	"github.com/decred/dcrd/hdkeychain/v2"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3"
+)
+
+// mockSignerEnv is set in the environment of the test binary when it is
+// started as a mock signer process.
+const mockSignerEnv = "DCRWALLET_MOCK_SIGNER_XPRV"
+
+func TestMain(m *testing.M) {
+	if xprv := os.Getenv(mockSignerEnv); xprv != "" {
+		err := serveMock(xprv)
+		if err != nil {
+			os.Stderr.WriteString(err.Error() + "\n")
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// serveMock serves signature requests over stdin and stdout using keys
+// derived from an account extended private key.
+func serveMock(s string) error {
+	xprv, err := hdkeychain.NewKeyFromString(s, chaincfg.SimNetParams())
+	if err != nil {
+		return err
+	}
+	xpub, err := xprv.Neuter()
+	if err != nil {
+		return err
+	}
+	return Serve(os.Stdin, os.Stdout, func(p *SignHashParams) ([]byte, error) {
+		if p.AccountXpub != xpub.String() {
+			return nil, errors.New("unknown account")
+		}
+		branch, err := xprv.Child(p.Branch)
+		if err != nil {
+			return nil, err
+		}
+		child, err := branch.Child(p.Child)
+		if err != nil {
+			return nil, err
+		}
+		key, err := child.ECPrivKey()
+		if err != nil {
+			return nil, err
+		}
+		hash, err := hex.DecodeString(p.SigHash)
+		if err != nil {
+			return nil, err
+		}
+		sig, err := key.Sign(hash)
+		if err != nil {
+			return nil, err
+		}
+		return sig.Serialize(), nil
+	})
+}
+
+func TestProcessSigner(t *testing.T) {
+	ctx := context.Background()
+	params := chaincfg.SimNetParams()
+	seed := bytes.Repeat([]byte{0x01}, hdkeychain.RecommendedSeedLen)
+	xprv, err := hdkeychain.NewMaster(seed, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	xpub, err := xprv.Neuter()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	os.Setenv(mockSignerEnv, xprv.String())
+	c, err := StartProcess(os.Args[0])
+	os.Unsetenv(mockSignerEnv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	childKey, err := xpub.Child(1)
+	if err == nil {
+		childKey, err = childKey.Child(5)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKey, err := childKey.ECPubKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sigHash := bytes.Repeat([]byte{0x02}, 32)
+	req := &wallet.SignRequest{
+		AccountXpub: xpub,
+		Branch:      1,
+		Child:       5,
+		PubKey:      pubKey.SerializeCompressed(),
+		SigHash:     sigHash,
+	}
+	sig, err := c.SignHash(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsedSig, err := secp256k1.ParseDERSignature(sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsedPubKey, err := secp256k1.ParsePubKey(req.PubKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !parsedSig.Verify(sigHash, parsedPubKey) {
+		t.Fatal("signature does not verify")
+	}
+
+	// Errors returned by the signer are returned to the caller.
+	other, err := xpub.Child(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.AccountXpub = other
+	_, err = c.SignHash(ctx, req)
+	if err == nil {
+		t.Fatal("signed with unknown account")
+	}
+
+	// Requests fail after the signer exits.
+	err = c.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = c.SignHash(ctx, req)
+	if err == nil {
+		t.Fatal("signed after signer exited")
+	}
+}
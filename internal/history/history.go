@@ -0,0 +1,268 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package history exports the mined transaction history of a wallet for
+// accounting.
+//
+// Each transaction produces one entry for every account it debits or credits.
+// Debits are the wallet outputs spent by the transaction and credits are the
+// outputs paying the account, so the net change of the account balance is the
+// credit minus the debit.  Transaction fees are included in the net change of
+// the account spending the first wallet input, and are also reported
+// separately for that account.  Ticket values remain in the account balances
+// while tickets are live; the reward of a vote is the net change of the
+// accounts it pays.
+package history
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3"
+)
+
+// Entry categories.
+const (
+	Receive        = "receive"
+	Send           = "send"
+	Coinbase       = "coinbase"
+	TicketPurchase = "ticketpurchase"
+	VoteReward     = "votereward"
+	Revocation     = "revocation"
+)
+
+var txTypes = map[wallet.TransactionType]string{
+	wallet.TransactionTypeRegular:        "regular",
+	wallet.TransactionTypeCoinbase:       "coinbase",
+	wallet.TransactionTypeTicketPurchase: "ticket",
+	wallet.TransactionTypeVote:           "vote",
+	wallet.TransactionTypeRevocation:     "revocation",
+}
+
+// Entry describes the effect of a mined transaction on the balance of an
+// account.  Balance is the total balance of the account after the transaction
+// and is only set when running balances are exported.
+type Entry struct {
+	Time          time.Time
+	BlockHeight   int32
+	BlockHash     string
+	TxHash        string
+	Type          string
+	Category      string
+	AccountNumber uint32
+	Account       string
+	Debit         dcrutil.Amount
+	Credit        dcrutil.Amount
+	Fee           dcrutil.Amount
+	Net           dcrutil.Amount
+	Balance       dcrutil.Amount
+	Label         string
+}
+
+// Options select the exported entries.
+type Options struct {
+	// Start and End are the heights of the first and last exported blocks.
+	// A negative End exports through the main chain tip.
+	Start, End int32
+
+	// Account limits the export to a single account when non-nil.
+	Account *uint32
+
+	// Labels includes the transaction label, or the first output label of
+	// the account, with each entry.
+	Labels bool
+
+	// Balances includes the running balance of the account with each
+	// entry.  Balances are computed from the first block of the wallet
+	// history regardless of Start.
+	Balances bool
+}
+
+// Export returns the entries of mined wallet transactions, ordered by block
+// height and transaction position.
+func Export(ctx context.Context, w *wallet.Wallet, opts *Options) ([]*Entry, error) {
+	const op errors.Op = "history.Export"
+	if opts.Start < 0 || opts.End >= 0 && opts.End < opts.Start {
+		return nil, errors.E(op, errors.Invalid, "invalid block range")
+	}
+	end := opts.End
+	if end < 0 {
+		_, end = w.MainChainTip(ctx)
+	}
+	if opts.Start > end {
+		return nil, nil
+	}
+	start := opts.Start
+	if opts.Balances {
+		start = 0
+	}
+
+	// Account names are looked up after the wallet database view of
+	// GetTransactions is released.
+	var entries []*Entry
+	balances := make(map[uint32]dcrutil.Amount)
+	f := func(b *wallet.Block) (bool, error) {
+		if b.Header == nil {
+			return false, nil
+		}
+		for i := range b.Transactions {
+			for _, e := range blockEntries(b, &b.Transactions[i]) {
+				balances[e.AccountNumber] += e.Net
+				e.Balance = balances[e.AccountNumber]
+				if e.BlockHeight < opts.Start {
+					continue
+				}
+				if opts.Account != nil && e.AccountNumber != *opts.Account {
+					continue
+				}
+				if !opts.Balances {
+					e.Balance = 0
+				}
+				if !opts.Labels {
+					e.Label = ""
+				}
+				entries = append(entries, e)
+			}
+		}
+		return false, nil
+	}
+	err := w.GetTransactions(ctx, f, wallet.NewBlockIdentifierFromHeight(start),
+		wallet.NewBlockIdentifierFromHeight(end))
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	names := make(map[uint32]string)
+	for _, e := range entries {
+		name, ok := names[e.AccountNumber]
+		if !ok {
+			name, err = w.AccountName(ctx, e.AccountNumber)
+			if err != nil {
+				return nil, errors.E(op, err)
+			}
+			names[e.AccountNumber] = name
+		}
+		e.Account = name
+	}
+	return entries, nil
+}
+
+// blockEntries returns an entry for each account debited or credited by a
+// transaction mined in a block, ordered by account number.  Labels are always
+// set.
+func blockEntries(b *wallet.Block, tx *wallet.TransactionSummary) []*Entry {
+	byAccount := make(map[uint32]*Entry)
+	entry := func(account uint32) *Entry {
+		e := byAccount[account]
+		if e == nil {
+			e = &Entry{
+				Time:          b.Header.Timestamp,
+				BlockHeight:   int32(b.Header.Height),
+				BlockHash:     b.Header.BlockHash().String(),
+				TxHash:        tx.Hash.String(),
+				Type:          txTypes[tx.Type],
+				AccountNumber: account,
+				Label:         tx.Label,
+			}
+			byAccount[account] = e
+		}
+		return e
+	}
+	for i := range tx.MyInputs {
+		in := &tx.MyInputs[i]
+		entry(in.PreviousAccount).Debit += in.PreviousAmount
+	}
+	for i := range tx.MyOutputs {
+		out := &tx.MyOutputs[i]
+		e := entry(out.Account)
+		e.Credit += out.Amount
+		if e.Label == "" {
+			e.Label = out.Label
+		}
+	}
+	if len(tx.MyInputs) != 0 {
+		entry(tx.MyInputs[0].PreviousAccount).Fee = tx.Fee
+	}
+
+	entries := make([]*Entry, 0, len(byAccount))
+	for _, e := range byAccount {
+		e.Net = e.Credit - e.Debit
+		switch tx.Type {
+		case wallet.TransactionTypeCoinbase:
+			e.Category = Coinbase
+		case wallet.TransactionTypeTicketPurchase:
+			e.Category = TicketPurchase
+		case wallet.TransactionTypeVote:
+			e.Category = VoteReward
+		case wallet.TransactionTypeRevocation:
+			e.Category = Revocation
+		default:
+			if e.Debit == 0 {
+				e.Category = Receive
+			} else {
+				e.Category = Send
+			}
+		}
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].AccountNumber < entries[j].AccountNumber
+	})
+	return entries
+}
+
+// WriteCSV writes entries as CSV with a header row.  Times are written in
+// RFC 3339 format in UTC and amounts in DCR.  The balance and label columns
+// are only written when selected by the options.
+func WriteCSV(w io.Writer, entries []*Entry, opts *Options) error {
+	header := []string{"time", "blockheight", "blockhash", "txid", "type", "category",
+		"account", "debit", "credit", "fee", "net"}
+	if opts.Balances {
+		header = append(header, "balance")
+	}
+	if opts.Labels {
+		header = append(header, "label")
+	}
+	cw := csv.NewWriter(w)
+	err := cw.Write(header)
+	if err != nil {
+		return err
+	}
+	amount := func(a dcrutil.Amount) string {
+		return strconv.FormatFloat(a.ToCoin(), 'f', 8, 64)
+	}
+	for _, e := range entries {
+		record := []string{
+			e.Time.UTC().Format(time.RFC3339),
+			strconv.FormatInt(int64(e.BlockHeight), 10),
+			e.BlockHash,
+			e.TxHash,
+			e.Type,
+			e.Category,
+			e.Account,
+			amount(e.Debit),
+			amount(e.Credit),
+			amount(e.Fee),
+			amount(e.Net),
+		}
+		if opts.Balances {
+			record = append(record, amount(e.Balance))
+		}
+		if opts.Labels {
+			record = append(record, e.Label)
+		}
+		err := cw.Write(record)
+		if err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
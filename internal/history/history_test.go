@@ -0,0 +1,116 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package history
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/wallet/v3"
+)
+
+func TestBlockEntries(t *testing.T) {
+	b := &wallet.Block{Header: &wire.BlockHeader{Height: 10, Timestamp: time.Unix(1e9, 0)}}
+	tests := []struct {
+		name    string
+		tx      wallet.TransactionSummary
+		entries []Entry
+	}{{
+		name: "receive",
+		tx: wallet.TransactionSummary{
+			Type:      wallet.TransactionTypeRegular,
+			MyOutputs: []wallet.TransactionSummaryOutput{{Account: 1, Amount: 3e8, Label: "salary"}},
+		},
+		entries: []Entry{{AccountNumber: 1, Category: Receive, Credit: 3e8, Net: 3e8, Label: "salary"}},
+	}, {
+		name: "transfer",
+		tx: wallet.TransactionSummary{
+			Type:     wallet.TransactionTypeRegular,
+			Fee:      1e5,
+			Label:    "move",
+			MyInputs: []wallet.TransactionSummaryInput{{PreviousAccount: 0, PreviousAmount: 5e8}},
+			MyOutputs: []wallet.TransactionSummaryOutput{
+				{Account: 2, Amount: 2e8},
+				{Account: 0, Amount: 3e8 - 1e5, Internal: true},
+			},
+		},
+		entries: []Entry{
+			{AccountNumber: 0, Category: Send, Debit: 5e8, Credit: 3e8 - 1e5, Fee: 1e5, Net: -2e8 - 1e5, Label: "move"},
+			{AccountNumber: 2, Category: Receive, Credit: 2e8, Net: 2e8, Label: "move"},
+		},
+	}, {
+		name: "vote",
+		tx: wallet.TransactionSummary{
+			Type:      wallet.TransactionTypeVote,
+			MyInputs:  []wallet.TransactionSummaryInput{{PreviousAccount: 0, PreviousAmount: 100e8}},
+			MyOutputs: []wallet.TransactionSummaryOutput{{Account: 0, Amount: 101e8}},
+		},
+		entries: []Entry{{AccountNumber: 0, Category: VoteReward, Debit: 100e8, Credit: 101e8, Net: 1e8}},
+	}}
+	for _, test := range tests {
+		test.tx.Hash = &chainhash.Hash{}
+		entries := blockEntries(b, &test.tx)
+		if len(entries) != len(test.entries) {
+			t.Errorf("%s: %d entries, expected %d", test.name, len(entries), len(test.entries))
+			continue
+		}
+		for i, e := range entries {
+			want := &test.entries[i]
+			if e.AccountNumber != want.AccountNumber || e.Category != want.Category ||
+				e.Debit != want.Debit || e.Credit != want.Credit || e.Fee != want.Fee ||
+				e.Net != want.Net || e.Label != want.Label {
+				t.Errorf("%s: entry %d %+v, expected %+v", test.name, i, e, want)
+			}
+			if e.BlockHeight != 10 || !e.Time.Equal(b.Header.Timestamp) {
+				t.Errorf("%s: entry %d block %d time %v", test.name, i, e.BlockHeight, e.Time)
+			}
+		}
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	entries := []*Entry{{
+		Time:        time.Unix(1e9, 0),
+		BlockHeight: 10,
+		BlockHash:   "bh",
+		TxHash:      "tx",
+		Type:        "regular",
+		Category:    Send,
+		Account:     "default",
+		Debit:       5e8,
+		Credit:      1,
+		Fee:         1e5,
+		Net:         -5e8 + 1,
+		Balance:     7e8,
+		Label:       "rent, march",
+	}}
+	var buf bytes.Buffer
+	err := WriteCSV(&buf, entries, &Options{Labels: true, Balances: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := strings.Join([]string{
+		"time,blockheight,blockhash,txid,type,category,account,debit,credit,fee,net,balance,label",
+		`2001-09-09T01:46:40Z,10,bh,tx,regular,send,default,5.00000000,0.00000001,0.00100000,-4.99999999,7.00000000,"rent, march"`,
+		"",
+	}, "\n")
+	if buf.String() != want {
+		t.Errorf("wrote\n%s\nexpected\n%s", buf.String(), want)
+	}
+
+	buf.Reset()
+	err = WriteCSV(&buf, entries, &Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(buf.String(), "time,blockheight,blockhash,txid,type,category,account,debit,credit,fee,net\n") ||
+		strings.Contains(buf.String(), "rent") {
+		t.Errorf("wrote without optional columns\n%s", buf.String())
+	}
+}
@@ -7,6 +7,7 @@ package loader
 
 import (
 	"context"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"sync"
@@ -415,3 +416,50 @@ func fileExists(filePath string) (bool, error) {
 	}
 	return true, nil
 }
+
+// BackupWallet writes a copy of the loaded wallet's database to the file
+// dest, or to a file named wallet.db in dest if it is a directory.  The copy
+// is consistent and may be made while the wallet is running.  The backup is
+// written to a temporary file which replaces any existing file at the
+// destination once it is complete.  The loaded wallet's own database file may
+// not be the destination.
+func (l *Loader) BackupWallet(ctx context.Context, dest string) error {
+	const op errors.Op = "loader.BackupWallet"
+
+	w, ok := l.LoadedWallet()
+	if !ok {
+		return errors.E(op, errors.Invalid, "wallet is unopened")
+	}
+
+	if fi, err := os.Stat(dest); err == nil && fi.IsDir() {
+		dest = filepath.Join(dest, walletDbName)
+	}
+	dbPath := filepath.Join(l.dbDirPath, walletDbName)
+	if fi, err := os.Stat(dest); err == nil {
+		dbfi, err := os.Stat(dbPath)
+		if err == nil && os.SameFile(fi, dbfi) {
+			return errors.E(op, errors.Invalid, "backup destination is the wallet database")
+		}
+	}
+
+	f, err := ioutil.TempFile(filepath.Dir(dest), filepath.Base(dest)+".tmp")
+	if err != nil {
+		return errors.E(op, err)
+	}
+	tmpPath := f.Name()
+	err = w.BackupDB(ctx, f)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, dest)
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		return errors.E(op, err)
+	}
+	return nil
+}
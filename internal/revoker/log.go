@@ -0,0 +1,15 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package revoker
+
+import "github.com/decred/slog"
+
+var log = slog.Disabled
+
+// UseLogger sets the package-wide logger.  Any calls to this function must be
+// made before a service is created and used (it is not concurrent safe).
+func UseLogger(logger slog.Logger) {
+	log = logger
+}
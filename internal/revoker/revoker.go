@@ -0,0 +1,116 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package revoker automatically revokes the missed and expired tickets of a
+// wallet.
+//
+// Tickets are checked after each change of the main chain tip.  When the
+// wallet is synced with a dcrd RPC server, the server is queried for both
+// missed and expired tickets.  Other network backends, such as SPV, can only
+// identify missed tickets with the live ticket pool, which is not available to
+// the wallet: block headers only record how many tickets voted, not which
+// tickets were selected.  With these backends, tickets are revoked once they
+// are expired, which includes any ticket missed before its expiry.
+package revoker
+
+import (
+	"context"
+	"sync"
+
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/rpc/client/dcrd"
+	"github.com/decred/dcrwallet/wallet/v3"
+)
+
+// Revoker revokes the missed and expired tickets of a wallet while it is
+// unlocked.
+type Revoker struct {
+	wallet *wallet.Wallet
+
+	// mu serializes revocations.
+	mu sync.Mutex
+
+	// expiredOnly warns once that missed tickets are only revoked after
+	// their expiry with the network backend.
+	expiredOnly sync.Once
+}
+
+// New returns a new Revoker to revoke the tickets of a wallet.
+func New(w *wallet.Wallet) *Revoker {
+	return &Revoker{wallet: w}
+}
+
+// Run revokes tickets after each block attached to the main chain until the
+// context is cancelled.  Failed revocations are logged and retried after the
+// next block.
+func (r *Revoker) Run(ctx context.Context) error {
+	c := r.wallet.NtfnServer.MainTipChangedNotifications()
+	defer c.Done()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case n := <-c.C:
+			if len(n.AttachedBlocks) == 0 {
+				continue
+			}
+			err := r.Revoke(ctx)
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if err != nil {
+				log.Errorf("Unable to revoke tickets: %v", err)
+			}
+		}
+	}
+}
+
+// Revoke creates and publishes revocations for any unrevoked missed and
+// expired tickets with voting rights controlled by the wallet.  Nothing is
+// revoked while the wallet is locked, is not synced through the main chain
+// tip, or has no network backend.
+func (r *Revoker) Revoke(ctx context.Context) error {
+	const op errors.Op = "revoker.Revoke"
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	w := r.wallet
+	if w.Locked() {
+		log.Debugf("Skipping revocations: wallet is locked")
+		return nil
+	}
+	rp, err := w.RescanPoint(ctx)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if rp != nil {
+		log.Debugf("Skipping revocations: transactions are not synced")
+		return nil
+	}
+	n, err := w.NetworkBackend()
+	if err != nil {
+		log.Debugf("Skipping revocations: no network backend")
+		return nil
+	}
+
+	if rpc, ok := n.(*dcrd.RPC); ok {
+		err = w.RevokeTickets(ctx, rpc)
+	} else {
+		r.expiredOnly.Do(func() {
+			log.Warnf("Missed tickets can not be identified without a dcrd " +
+				"RPC server and are only revoked once expired")
+		})
+		err = w.RevokeExpiredTickets(ctx, n)
+	}
+	// The wallet may have been locked after it was checked.
+	if errors.Is(err, errors.Locked) {
+		log.Debugf("Skipping revocations: wallet is locked")
+		return nil
+	}
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
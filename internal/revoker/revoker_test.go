@@ -0,0 +1,231 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package revoker
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/gcs/blockcf"
+	"github.com/decred/dcrd/txscript/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/rpc/client/dcrd"
+	"github.com/decred/dcrwallet/wallet/v3"
+	_ "github.com/decred/dcrwallet/wallet/v3/drivers/bdb"
+)
+
+// stubCaller is a dcrd RPC client which reports every ticket as missed and
+// records the called methods.
+type stubCaller struct {
+	mu    sync.Mutex
+	calls []string
+
+	// onMissed is called, if not nil, when missed tickets are queried.
+	onMissed func()
+}
+
+func (c *stubCaller) Call(ctx context.Context, method string, res interface{}, args ...interface{}) error {
+	c.mu.Lock()
+	c.calls = append(c.calls, method)
+	c.mu.Unlock()
+	switch method {
+	case "existsexpiredtickets":
+		*res.(*string) = "00"
+	case "existsmissedtickets":
+		if c.onMissed != nil {
+			c.onMissed()
+		}
+		*res.(*string) = "01"
+	}
+	return nil
+}
+
+func (c *stubCaller) called(method string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	n := 0
+	for _, m := range c.calls {
+		if m == method {
+			n++
+		}
+	}
+	return n
+}
+
+// revoking returns whether tickets were queried for revocation.
+func (c *stubCaller) revoking() bool {
+	return c.called("existsexpiredtickets") != 0 || c.called("existsmissedtickets") != 0 ||
+		c.called("sendrawtransaction") != 0
+}
+
+func (c *stubCaller) reset() {
+	c.mu.Lock()
+	c.calls = nil
+	c.mu.Unlock()
+}
+
+// testChain creates block nodes extending the main chain tip from height
+// start through end.
+func testChain(t *testing.T, params *chaincfg.Params, prev chainhash.Hash, start, end uint32) []*wallet.BlockNode {
+	var chain []*wallet.BlockNode
+	for height := start; height <= end; height++ {
+		coinbase := wire.NewMsgTx()
+		coinbase.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: wire.MaxPrevOutIndex}, 0, nil))
+		coinbase.AddTxOut(wire.NewTxOut(0, []byte{byte(height), byte(height >> 8)}))
+		block := &wire.MsgBlock{
+			Header: wire.BlockHeader{
+				Version:   1,
+				PrevBlock: prev,
+				Bits:      params.PowLimitBits,
+				Height:    height,
+				Timestamp: time.Unix(int64(1e9+height), 0),
+			},
+			Transactions: []*wire.MsgTx{coinbase},
+		}
+		filter, err := blockcf.Regular(block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		hash := block.BlockHash()
+		chain = append(chain, wallet.NewBlockNode(&block.Header, &hash, filter))
+		prev = hash
+	}
+	return chain
+}
+
+func TestRevoke(t *testing.T) {
+	ctx := context.Background()
+	params := chaincfg.SimNetParams()
+	dir, err := ioutil.TempDir("", "revoker")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	db, err := wallet.CreateDB("bdb", filepath.Join(dir, "wallet.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	pubPass, privPass := []byte(wallet.InsecurePubPassphrase), []byte("private")
+	err = wallet.Create(ctx, db, pubPass, privPass, nil, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := wallet.Open(ctx, &wallet.Config{
+		DB:            db,
+		PubPassphrase: pubPass,
+		GapLimit:      20,
+		RelayFee:      dcrutil.Amount(1e5).ToCoin(),
+		Params:        params,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := new(stubCaller)
+	w.SetNetworkBackend(dcrd.New(c))
+
+	// Record a ticket with voting rights controlled by the wallet in block 2
+	// of a chain in which it is mature.
+	a, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr, err := dcrutil.DecodeAddress(a.Address(), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	voteScript, err := txscript.PayToSStx(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	commitmentScript, err := txscript.GenerateSStxAddrPush(addr, 10e8, 0x5800)
+	if err != nil {
+		t.Fatal(err)
+	}
+	changeScript, err := txscript.PayToSStxChange(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	prevHash := chainhash.HashH([]byte("ticket input"))
+	ticket := wire.NewMsgTx()
+	ticket.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&prevHash, 0, wire.TxTreeRegular), 10e8, nil))
+	ticket.AddTxOut(wire.NewTxOut(10e8, voteScript))
+	ticket.AddTxOut(wire.NewTxOut(0, commitmentScript))
+	ticket.AddTxOut(wire.NewTxOut(0, changeScript))
+
+	tip := uint32(params.TicketMaturity) + 4
+	chain := testChain(t, params, params.GenesisHash, 1, tip)
+	relevant := map[chainhash.Hash][]*wire.MsgTx{*chain[1].Hash: {ticket}}
+	_, err = w.ChainSwitch(ctx, new(wallet.SidechainForest), chain, relevant)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := New(w)
+	c.reset()
+
+	// Nothing is revoked while the wallet is locked.
+	if err := r.Revoke(ctx); err != nil {
+		t.Fatalf("locked wallet: %v", err)
+	}
+	if c.revoking() {
+		t.Fatalf("locked wallet called %v", c.calls)
+	}
+
+	// Nothing is revoked until transactions are synced through the tip.
+	err = w.Unlock(ctx, privPass, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain = testChain(t, params, *chain[len(chain)-1].Hash, tip+1, tip+1)
+	_, err = w.ChainSwitch(ctx, new(wallet.SidechainForest), chain, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.reset()
+	if err := r.Revoke(ctx); err != nil {
+		t.Fatalf("unsynced wallet: %v", err)
+	}
+	if c.revoking() {
+		t.Fatalf("unsynced wallet called %v", c.calls)
+	}
+
+	// A wallet locked during the revocation is skipped without error.
+	chain = testChain(t, params, *chain[len(chain)-1].Hash, tip+2, tip+2)
+	_, err = w.ChainSwitch(ctx, new(wallet.SidechainForest), chain, map[chainhash.Hash][]*wire.MsgTx{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.reset()
+	c.onMissed = w.Lock
+	if err := r.Revoke(ctx); err != nil {
+		t.Fatalf("wallet locked during revocation: %v", err)
+	}
+	if c.called("existsmissedtickets") != 1 || c.called("sendrawtransaction") != 0 {
+		t.Fatalf("wallet locked during revocation called %v", c.calls)
+	}
+
+	// The missed ticket is revoked once the wallet is unlocked.
+	c.onMissed = nil
+	c.reset()
+	err = w.Unlock(ctx, privPass, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Revoke(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if c.called("sendrawtransaction") != 1 {
+		t.Fatalf("expected published revocation, called %v", c.calls)
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package jsonrpc
+
+import (
+	"context"
+	"encoding/json"
+
+	"decred.org/dcrwallet/internal/auditlog"
+	"github.com/decred/dcrd/dcrjson/v3"
+	"github.com/decred/dcrwallet/rpc/jsonrpc/types"
+)
+
+// auditedMethods are the privileged methods recorded in the audit log: unlocks,
+// passphrase changes, key and wallet dumps, imports, signing and publishing.
+// Each method maps to the fields of its parsed command which hold secrets,
+// such as passphrases and private keys, and are redacted from the log.
+// Descriptors and extended keys are redacted, as private keys may be passed in
+// their place.
+var auditedMethods = map[string][]string{
+	"backupwallet":            {},
+	"bumpfee":                 {},
+	"consolidate":             {},
+	"cpfp":                    {},
+	"dumpprivkey":             {},
+	"dumpwallet":              {},
+	"generatevote":            {},
+	"importdescriptoraccount": {"Descriptor"},
+	"importprivkey":           {"PrivKey"},
+	"importscript":            {},
+	"importwallet":            {},
+	"importxpub":              {"Xpub"},
+	"mixaccount":              {},
+	"mixoutput":               {},
+	"purchaseticket":          {},
+	"redeemmultisigout":       {},
+	"redeemmultisigouts":      {},
+	"revoketickets":           {},
+	"sendfrom":                {},
+	"sendmany":                {},
+	"sendrawtransaction":      {},
+	"sendtoaddress":           {},
+	"sendtomultisig":          {},
+	"setaccountpassphrase":    {"Passphrase"},
+	"signmessage":             {},
+	"signrawtransaction":      {"PrivKeys"},
+	"signrawtransactions":     {},
+	"sweepaccount":            {},
+	"walletpassphrase":        {"Passphrase"},
+	"walletpassphrasechange":  {"OldPassphrase", "NewPassphrase"},
+	"walletprocesspsbt":       {},
+}
+
+// auditCaller describes the client invoking a method in the audit log.
+func auditCaller(ctx context.Context) string {
+	return "jsonrpc:" + user(ctx) + "@" + remoteAddr(ctx)
+}
+
+// audited wraps the handler of an audited method to record the request and its
+// outcome in the audit log.  Failing to record the entry is logged but does not
+// change the response, as the operation has already been performed.
+func (s *Server) audited(ctx context.Context, request *dcrjson.Request, f lazyHandler) lazyHandler {
+	secrets := auditedMethods[request.Method]
+	return func() (interface{}, *dcrjson.RPCError) {
+		var params json.RawMessage
+		cmd, err := dcrjson.ParseParams(types.Method(request.Method), request.Params)
+		if err == nil {
+			params = auditlog.Params(cmd, secrets...)
+		}
+		res, jsonErr := f()
+		var opErr error
+		if jsonErr != nil {
+			opErr = jsonErr
+		}
+		err = s.cfg.AuditLog.Record(auditCaller(ctx), request.Method, params, opErr)
+		if err != nil {
+			log.Errorf("Failed to record RPC method %v invoked by %v in audit log: %v",
+				request.Method, remoteAddr(ctx), err)
+		}
+		return res, jsonErr
+	}
+}
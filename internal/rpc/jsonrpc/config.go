@@ -7,6 +7,10 @@ package jsonrpc
 import (
 	"context"
 	"net"
+
+	"decred.org/dcrwallet/internal/auditlog"
+	"decred.org/dcrwallet/internal/rpc/rpcauth"
+	"decred.org/dcrwallet/internal/vsp"
 )
 
 // Options contains the required options for running the legacy RPC server.
@@ -22,4 +26,25 @@ type Options struct {
 	MixAccount       string
 	MixBranch        uint32
 	MixChangeAccount string
+
+	// Users are additional clients which are authorized for a role.  The
+	// client authenticated by Username and Password has the full role.
+	Users []User
+
+	AuditLog *auditlog.Log
+
+	// VSP purchases tickets voted by a fee-based voting service provider.
+	// The purchasevspticket method is unavailable when nil.
+	VSP *vsp.Client
+
+	// MacaroonRootKey authenticates macaroons presented as bearer tokens.
+	// Macaroon authentication is disabled when nil.
+	MacaroonRootKey []byte
+}
+
+// User is a client credential authorized for a role.
+type User struct {
+	Username string
+	Password string
+	Role     rpcauth.Role
 }
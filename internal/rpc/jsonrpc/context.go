@@ -4,7 +4,11 @@
 
 package jsonrpc
 
-import "context"
+import (
+	"context"
+
+	"decred.org/dcrwallet/internal/rpc/rpcauth"
+)
 
 type contextKey string
 
@@ -19,3 +23,40 @@ func remoteAddr(ctx context.Context) string {
 	}
 	return v.(string)
 }
+
+func withUser(parent context.Context, u *authUser) context.Context {
+	return context.WithValue(parent, contextKey("user"), u)
+}
+
+func authenticatedUser(ctx context.Context) *authUser {
+	u, _ := ctx.Value(contextKey("user")).(*authUser)
+	return u
+}
+
+func user(ctx context.Context) string {
+	u := authenticatedUser(ctx)
+	if u == nil {
+		return "<unknown>"
+	}
+	return u.username
+}
+
+// role returns the role of the authenticated client.  Unauthenticated
+// contexts have no role and may not call any method.
+func role(ctx context.Context) rpcauth.Role {
+	u := authenticatedUser(ctx)
+	if u == nil {
+		return ""
+	}
+	return u.role
+}
+
+// macaroon returns the macaroon authenticating the client and its
+// restrictions, or nils if the client did not authenticate with a macaroon.
+func macaroon(ctx context.Context) (*rpcauth.Macaroon, *rpcauth.Restrictions) {
+	u := authenticatedUser(ctx)
+	if u == nil {
+		return nil, nil
+	}
+	return u.macaroon, u.restrictions
+}
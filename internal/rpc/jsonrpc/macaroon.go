@@ -0,0 +1,117 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package jsonrpc
+
+import (
+	"context"
+	"reflect"
+	"strings"
+
+	"decred.org/dcrwallet/internal/rpc/rpcauth"
+	"github.com/decred/dcrd/dcrjson/v3"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/rpc/jsonrpc/types"
+	"github.com/decred/dcrwallet/wallet/v3"
+)
+
+// macaroonUser verifies an encoded macaroon presented as a bearer token and
+// returns the client it authenticates.  Macaroons are minted by clients with
+// the full role, and are limited only by their caveats.
+func (s *Server) macaroonUser(encoded string) (*authUser, error) {
+	if s.cfg.MacaroonRootKey == nil {
+		return nil, errors.New("macaroon authentication is disabled")
+	}
+	m, err := rpcauth.DecodeMacaroon(encoded)
+	if err != nil {
+		return nil, err
+	}
+	r, err := m.Verify(s.cfg.MacaroonRootKey)
+	if err != nil {
+		return nil, err
+	}
+	return &authUser{
+		username:     "macaroon:" + m.ID(),
+		role:         rpcauth.Full,
+		macaroon:     m,
+		restrictions: r,
+	}, nil
+}
+
+// restrict applies the account and spend caveats of the macaroon
+// authenticating a client to a request.  The returned context limits the
+// amount spent by transactions created or published by the request handler.
+func (s *Server) restrict(ctx context.Context, request *dcrjson.Request) (context.Context, error) {
+	_, r := macaroon(ctx)
+	if r == nil {
+		return ctx, nil
+	}
+	if limit, ok := r.SpendLimit(); ok {
+		ctx = wallet.WithSpendLimit(ctx, limit)
+	}
+	if !r.AccountScoped() || rpcauth.MethodPermission(request.Method) == rpcauth.PermInfo {
+		return ctx, nil
+	}
+
+	cmd, err := dcrjson.ParseParams(types.Method(request.Method), request.Params)
+	if err != nil {
+		return ctx, errors.E(errors.Permission, errors.Errorf("macaroon is restricted "+
+			"to an account and the accounts of %s can not be determined", request.Method))
+	}
+	names, numbers, ok := requestAccounts(cmd)
+	if !ok || len(names)+len(numbers) == 0 {
+		return ctx, errors.E(errors.Permission, errors.Errorf("macaroon is restricted "+
+			"to an account and %s does not name an account", request.Method))
+	}
+	if len(names) != 0 {
+		w, ok := s.walletLoader.LoadedWallet()
+		if !ok {
+			return ctx, errUnloadedWallet
+		}
+		for _, name := range names {
+			account, err := w.AccountNumber(ctx, name)
+			if err != nil {
+				return ctx, err
+			}
+			numbers = append(numbers, account)
+		}
+	}
+	for _, account := range numbers {
+		err := r.CheckAccount(account)
+		if err != nil {
+			return ctx, err
+		}
+	}
+	return ctx, nil
+}
+
+// requestAccounts returns the account names and numbers of the fields of a
+// parsed request whose names end in Account.  ok is false when an optional
+// account was omitted, as the handler would then use a default account.
+func requestAccounts(cmd interface{}) (names []string, numbers []uint32, ok bool) {
+	v := reflect.Indirect(reflect.ValueOf(cmd))
+	if v.Kind() != reflect.Struct {
+		return nil, nil, true
+	}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if !strings.HasSuffix(t.Field(i).Name, "Account") {
+			continue
+		}
+		f := v.Field(i)
+		if f.Kind() == reflect.Ptr {
+			if f.IsNil() {
+				return nil, nil, false
+			}
+			f = f.Elem()
+		}
+		switch f.Kind() {
+		case reflect.String:
+			names = append(names, f.String())
+		case reflect.Uint32:
+			numbers = append(numbers, uint32(f.Uint()))
+		}
+	}
+	return names, numbers, true
+}
@@ -12,13 +12,20 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
+	"io/ioutil"
 	"math/big"
+	"os"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"decred.org/dcrwallet/internal/auditlog"
+	"decred.org/dcrwallet/internal/history"
+	"decred.org/dcrwallet/internal/rpc/rpcauth"
+	"decred.org/dcrwallet/internal/ticketreport"
 	"github.com/decred/dcrd/blockchain/stake/v2"
 	blockchain "github.com/decred/dcrd/blockchain/standalone"
 	"github.com/decred/dcrd/chaincfg/chainhash"
@@ -36,6 +43,8 @@ import (
 	"github.com/decred/dcrwallet/rpc/jsonrpc/types"
 	"github.com/decred/dcrwallet/version"
 	"github.com/decred/dcrwallet/wallet/v3"
+	"github.com/decred/dcrwallet/wallet/v3/paymenturi"
+	"github.com/decred/dcrwallet/wallet/v3/psbt"
 	"github.com/decred/dcrwallet/wallet/v3/txrules"
 	"github.com/decred/dcrwallet/wallet/v3/udb"
 	"golang.org/x/sync/errgroup"
@@ -43,9 +52,9 @@ import (
 
 // API version constants
 const (
-	jsonrpcSemverString = "6.2.0"
+	jsonrpcSemverString = "6.20.0"
 	jsonrpcSemverMajor  = 6
-	jsonrpcSemverMinor  = 2
+	jsonrpcSemverMinor  = 20
 	jsonrpcSemverPatch  = 0
 )
 
@@ -70,19 +79,36 @@ var handlers = map[string]handler{
 	"addmultisigaddress":      {fn: (*Server).addMultiSigAddress},
 	"addticket":               {fn: (*Server).addTicket},
 	"auditreuse":              {fn: (*Server).auditReuse},
+	"backupwallet":            {fn: (*Server).backupWallet},
+	"bakemacaroon":            {fn: (*Server).bakeMacaroon},
+	"bumpfee":                 {fn: (*Server).bumpFee},
+	"combinepsbt":             {fn: (*Server).combinePSBT},
 	"consolidate":             {fn: (*Server).consolidate},
+	"cpfp":                    {fn: (*Server).cpfp},
+	"createinvoice":           {fn: (*Server).createInvoice},
 	"createmultisig":          {fn: (*Server).createMultiSig},
+	"createmultisigaccount":   {fn: (*Server).createMultisigAccount},
+	"createmultisigaccounttx": {fn: (*Server).createMultisigAccountTx},
+	"createpsbt":              {fn: (*Server).createPSBT},
 	"createrawtransaction":    {fn: (*Server).createRawTransaction},
 	"dumpprivkey":             {fn: (*Server).dumpPrivKey},
+	"dumpwallet":              {fn: (*Server).dumpWallet},
+	"estimatesmartfee":        {fn: (*Server).estimateSmartFee},
+	"estimatestakediff":       {fn: (*Server).estimateStakeDiff},
+	"exporthistory":           {fn: (*Server).exportHistory},
+	"finalizepsbt":            {fn: (*Server).finalizePSBT},
 	"generatevote":            {fn: (*Server).generateVote},
 	"getaccount":              {fn: (*Server).getAccount},
 	"getaccountaddress":       {fn: (*Server).getAccountAddress},
 	"getaddressesbyaccount":   {fn: (*Server).getAddressesByAccount},
+	"getauditlog":             {fn: (*Server).getAuditLog},
 	"getbalance":              {fn: (*Server).getBalance},
 	"getbestblockhash":        {fn: (*Server).getBestBlockHash},
 	"getblockcount":           {fn: (*Server).getBlockCount},
 	"getblockhash":            {fn: (*Server).getBlockHash},
 	"getinfo":                 {fn: (*Server).getInfo},
+	"getinvoice":              {fn: (*Server).getInvoice},
+	"getlabel":                {fn: (*Server).getLabel},
 	"getmasterpubkey":         {fn: (*Server).getMasterPubkey},
 	"getmultisigoutinfo":      {fn: (*Server).getMultisigOutInfo},
 	"getnewaddress":           {fn: (*Server).getNewAddress},
@@ -96,10 +122,15 @@ var handlers = map[string]handler{
 	"getvotechoices":          {fn: (*Server).getVoteChoices},
 	"getwalletfee":            {fn: (*Server).getWalletFee},
 	"help":                    {fn: (*Server).help},
+	"importdescriptoraccount": {fn: (*Server).importDescriptorAccount},
 	"importprivkey":           {fn: (*Server).importPrivKey},
 	"importscript":            {fn: (*Server).importScript},
+	"importwallet":            {fn: (*Server).importWallet},
 	"importxpub":              {fn: (*Server).importXpub},
 	"listaccounts":            {fn: (*Server).listAccounts},
+	"listdescriptoraccounts":  {fn: (*Server).listDescriptorAccounts},
+	"listinvoices":            {fn: (*Server).listInvoices},
+	"listlabels":              {fn: (*Server).listLabels},
 	"listlockunspent":         {fn: (*Server).listLockUnspent},
 	"listreceivedbyaccount":   {fn: (*Server).listReceivedByAccount},
 	"listreceivedbyaddress":   {fn: (*Server).listReceivedByAddress},
@@ -107,16 +138,21 @@ var handlers = map[string]handler{
 	"listscripts":             {fn: (*Server).listScripts},
 	"listtransactions":        {fn: (*Server).listTransactions},
 	"listunspent":             {fn: (*Server).listUnspent},
+	"listvsptickets":          {fn: (*Server).listVSPTickets},
 	"lockunspent":             {fn: (*Server).lockUnspent},
 	"mixaccount":              {fn: (*Server).mixAccount},
 	"mixoutput":               {fn: (*Server).mixOutput},
+	"parsepaymenturi":         {fn: (*Server).parsePaymentURI},
 	"purchaseticket":          {fn: (*Server).purchaseTicket},
+	"purchasevspticket":       {fn: (*Server).purchaseVSPTicket},
 	"rescanwallet":            {fn: (*Server).rescanWallet},
 	"revoketickets":           {fn: (*Server).revokeTickets},
 	"sendfrom":                {fn: (*Server).sendFrom},
 	"sendmany":                {fn: (*Server).sendMany},
 	"sendtoaddress":           {fn: (*Server).sendToAddress},
 	"sendtomultisig":          {fn: (*Server).sendToMultiSig},
+	"setaccountpassphrase":    {fn: (*Server).setAccountPassphrase},
+	"setlabel":                {fn: (*Server).setLabel},
 	"setticketfee":            {fn: (*Server).setTicketFee},
 	"settxfee":                {fn: (*Server).setTxFee},
 	"setvotechoice":           {fn: (*Server).setVoteChoice},
@@ -127,6 +163,8 @@ var handlers = map[string]handler{
 	"redeemmultisigout":       {fn: (*Server).redeemMultiSigOut},
 	"redeemmultisigouts":      {fn: (*Server).redeemMultiSigOuts},
 	"stakepooluserinfo":       {fn: (*Server).stakePoolUserInfo},
+	"stakerewardestimate":     {fn: (*Server).stakeRewardEstimate},
+	"ticketreport":            {fn: (*Server).ticketReport},
 	"ticketsforaddress":       {fn: (*Server).ticketsForAddress},
 	"validateaddress":         {fn: (*Server).validateAddress},
 	"verifymessage":           {fn: (*Server).verifyMessage},
@@ -135,6 +173,7 @@ var handlers = map[string]handler{
 	"walletlock":              {fn: (*Server).walletLock},
 	"walletpassphrase":        {fn: (*Server).walletPassphrase},
 	"walletpassphrasechange":  {fn: (*Server).walletPassphraseChange},
+	"walletprocesspsbt":       {fn: (*Server).walletProcessPSBT},
 
 	// Extensions to the reference client JSON-RPC API
 	"getbestblock":     {fn: (*Server).getBestBlock},
@@ -150,14 +189,11 @@ var handlers = map[string]handler{
 	"walletislocked":          {fn: (*Server).walletIsLocked},
 
 	// Reference implementation methods (still unimplemented)
-	"backupwallet":         {fn: unimplemented, noHelp: true},
 	"getwalletinfo":        {fn: unimplemented, noHelp: true},
-	"importwallet":         {fn: unimplemented, noHelp: true},
 	"listaddressgroupings": {fn: unimplemented, noHelp: true},
 
 	// Reference methods which can't be implemented by dcrwallet due to
 	// design decision differences
-	"dumpwallet":    {fn: unsupported, noHelp: true},
 	"encryptwallet": {fn: unsupported, noHelp: true},
 	"move":          {fn: unsupported, noHelp: true},
 	"setaccount":    {fn: unsupported, noHelp: true},
@@ -287,6 +323,195 @@ func (s *Server) abandonTransaction(ctx context.Context, icmd interface{}) (inte
 	return nil, err
 }
 
+// backupWallet handles a backupwallet request by writing a copy of the wallet
+// database to the destination path.
+func (s *Server) backupWallet(ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.BackupWalletCmd)
+	if _, ok := s.walletLoader.LoadedWallet(); !ok {
+		return nil, errUnloadedWallet
+	}
+
+	err := s.walletLoader.BackupWallet(ctx, cmd.Destination)
+	if err != nil {
+		if errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	return nil, nil
+}
+
+// bakeMacaroon handles a bakemacaroon request by minting a macaroon restricted
+// by the requested caveats.  Clients authenticated by a macaroon may only
+// attenuate it, so the new macaroon is never less restricted than their own.
+func (s *Server) bakeMacaroon(ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.BakeMacaroonCmd)
+	if s.cfg.MacaroonRootKey == nil {
+		return nil, rpcErrorf(dcrjson.ErrRPCMisc, "macaroon authentication is disabled")
+	}
+
+	var caveats []rpcauth.Caveat
+	if cmd.Methods != nil {
+		if len(*cmd.Methods) == 0 {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "empty method list")
+		}
+		caveats = append(caveats, rpcauth.MethodsCaveat(*cmd.Methods...))
+	}
+	if cmd.Role != nil {
+		role, err := rpcauth.ParseRole(*cmd.Role)
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		caveats = append(caveats, rpcauth.RoleCaveat(role))
+	}
+	if cmd.Expiry != nil {
+		if *cmd.Expiry <= 0 {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "expiry must be positive")
+		}
+		expires := time.Now().Add(time.Duration(*cmd.Expiry) * time.Second)
+		caveats = append(caveats, rpcauth.ExpiryCaveat(expires))
+	}
+	if cmd.Account != nil {
+		w, ok := s.walletLoader.LoadedWallet()
+		if !ok {
+			return nil, errUnloadedWallet
+		}
+		account, err := w.AccountNumber(ctx, *cmd.Account)
+		if err != nil {
+			if errors.Is(err, errors.NotExist) {
+				return nil, errAccountNotFound
+			}
+			return nil, err
+		}
+		caveats = append(caveats, rpcauth.AccountCaveat(account))
+	}
+	if cmd.MaxSpend != nil {
+		amount, err := dcrutil.NewAmount(*cmd.MaxSpend)
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		if amount < 0 {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative maximum spend")
+		}
+		caveats = append(caveats, rpcauth.MaxSpendCaveat(amount))
+	}
+
+	var m *rpcauth.Macaroon
+	var err error
+	if parent, _ := macaroon(ctx); parent != nil {
+		m, err = parent.Attenuate(caveats...)
+	} else {
+		m, err = rpcauth.NewMacaroon(s.cfg.MacaroonRootKey, caveats...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	res := &types.BakeMacaroonResult{
+		Macaroon: m.Encode(),
+		ID:       m.ID(),
+		Caveats:  make([]string, 0, len(m.Caveats())),
+	}
+	for _, c := range m.Caveats() {
+		res.Caveats = append(res.Caveats, string(c))
+	}
+	return res, nil
+}
+
+// bumpFee replaces an unconfirmed transaction with a conflicting transaction
+// paying a higher fee.
+func (s *Server) bumpFee(ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.BumpFeeCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+	n, ok := s.walletLoader.NetworkBackend()
+	if !ok {
+		return nil, errNoNetwork
+	}
+
+	hash, err := chainhash.NewHashFromStr(cmd.TxHash)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+	}
+	var feeRate dcrutil.Amount
+	if cmd.FeeRate != nil {
+		feeRate, err = dcrutil.NewAmount(*cmd.FeeRate)
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		if feeRate <= 0 {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"fee rate must be positive")
+		}
+	}
+
+	bump, err := w.BumpFee(ctx, n, hash, feeRate)
+	if err != nil {
+		return nil, err
+	}
+	return &types.BumpFeeResult{
+		TxID:    bump.Tx.TxHash().String(),
+		OrigFee: bump.OrigFee.ToCoin(),
+		Fee:     bump.Fee.ToCoin(),
+	}, nil
+}
+
+// cpfp publishes a child transaction spending wallet outputs of an
+// unconfirmed transaction to increase the fee rate of both transactions.
+func (s *Server) cpfp(ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.CPFPCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+	n, ok := s.walletLoader.NetworkBackend()
+	if !ok {
+		return nil, errNoNetwork
+	}
+
+	hash, err := chainhash.NewHashFromStr(cmd.TxHash)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+	}
+	var feeRate dcrutil.Amount
+	if cmd.FeeRate != nil {
+		feeRate, err = dcrutil.NewAmount(*cmd.FeeRate)
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		if feeRate <= 0 {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"fee rate must be positive")
+		}
+	}
+	account := uint32(udb.DefaultAccountNum)
+	if cmd.Account != nil {
+		account, err = w.AccountNumber(ctx, *cmd.Account)
+		if err != nil {
+			if errors.Is(err, errors.NotExist) {
+				return nil, errAccountNotFound
+			}
+			return nil, err
+		}
+	}
+
+	bump, err := w.CPFP(ctx, n, hash, account, feeRate)
+	if err != nil {
+		return nil, err
+	}
+	res := &types.CPFPResult{
+		TxID:      bump.Tx.TxHash().String(),
+		ParentFee: bump.OrigFee.ToCoin(),
+		Fee:       bump.Fee.ToCoin(),
+	}
+	if bump.UnminedAncestors {
+		res.Warning = "parent transaction spends outputs of other " +
+			"unconfirmed transactions whose fees were not considered"
+	}
+	return res, nil
+}
+
 // accountAddressIndex returns the next address index for the passed
 // account and branch.
 func (s *Server) accountAddressIndex(ctx context.Context, icmd interface{}) (interface{}, error) {
@@ -606,10 +831,132 @@ func (s *Server) createMultiSig(ctx context.Context, icmd interface{}) (interfac
 	}, nil
 }
 
+// createMultisigAccount handles a createmultisigaccount request by creating
+// an M-of-N multisig vault account from the cosigner extended public keys.
+func (s *Server) createMultisigAccount(ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.CreateMultisigAccountCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	if cmd.Name == "*" {
+		return nil, errReservedAccountName
+	}
+
+	account, err := w.CreateMultisigAccount(ctx, cmd.Name, cmd.NRequired, cmd.XPubs)
+	if errors.Is(err, errors.Encoding) || errors.Is(err, errors.Invalid) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	props, err := w.DescriptorAccount(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	return &types.CreateMultisigAccountResult{
+		Account:    account,
+		Descriptor: props.Descriptor,
+	}, nil
+}
+
+// createMultisigAccountTx handles a createmultisigaccounttx request by
+// creating an unsigned transaction spending outputs of a multisig vault
+// account.  The transaction is returned both serialized and as a partially
+// signed transaction for the cosigners to sign.
+func (s *Server) createMultisigAccountTx(ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.CreateMultisigAccountTxCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	minConf := int32(*cmd.MinConf)
+	if minConf < 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative minconf")
+	}
+
+	pairs := make(map[string]dcrutil.Amount, len(cmd.Amounts))
+	for k, v := range cmd.Amounts {
+		amt, err := dcrutil.NewAmount(v)
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		pairs[k] = amt
+	}
+	outputs, err := makeOutputs(pairs, w.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+
+	atx, err := w.MultisigAccountTx(ctx, account, outputs, minConf)
+	if err != nil {
+		switch {
+		case errors.Is(err, errors.NotExist), errors.Is(err, errors.Invalid):
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		case errors.Is(err, errors.InsufficientBalance):
+			return nil, rpcError(dcrjson.ErrRPCWalletInsufficientFunds, err)
+		}
+		return nil, err
+	}
+
+	p, err := psbt.New(atx.Tx)
+	if err != nil {
+		return nil, err
+	}
+	err = w.ProcessPSBT(ctx, p, txscript.SigHashAll, false)
+	if err != nil {
+		return nil, err
+	}
+	b64, err := p.EncodeBase64()
+	if err != nil {
+		return nil, err
+	}
+	sb := new(strings.Builder)
+	err = atx.Tx.Serialize(hex.NewEncoder(sb))
+	if err != nil {
+		return nil, err
+	}
+	var outputTotal dcrutil.Amount
+	for _, out := range atx.Tx.TxOut {
+		outputTotal += dcrutil.Amount(out.Value)
+	}
+	return &types.CreateMultisigAccountTxResult{
+		Hex:  sb.String(),
+		PSBT: b64,
+		Fee:  (atx.TotalInput - outputTotal).ToCoin(),
+	}, nil
+}
+
 // createRawTransaction handles createrawtransaction commands.
 func (s *Server) createRawTransaction(ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*dcrdtypes.CreateRawTransactionCmd)
 
+	mtx, err := s.newRawTransaction(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the serialized and hex-encoded transaction.
+	sb := new(strings.Builder)
+	err = mtx.Serialize(hex.NewEncoder(sb))
+	if err != nil {
+		return nil, err
+	}
+	return sb.String(), nil
+}
+
+// newRawTransaction creates the unsigned transaction described by the
+// parameters of a createrawtransaction command.
+func (s *Server) newRawTransaction(cmd *dcrdtypes.CreateRawTransactionCmd) (*wire.MsgTx, error) {
 	// Validate expiry, if given.
 	if cmd.Expiry != nil && *cmd.Expiry < 0 {
 		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "Expiry out of range")
@@ -708,13 +1055,80 @@ func (s *Server) createRawTransaction(ctx context.Context, icmd interface{}) (in
 		mtx.Expiry = uint32(*cmd.Expiry)
 	}
 
-	// Return the serialized and hex-encoded transaction.
-	sb := new(strings.Builder)
-	err := mtx.Serialize(hex.NewEncoder(sb))
+	return mtx, nil
+}
+
+// createPSBT handles createpsbt commands.
+func (s *Server) createPSBT(ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.CreatePSBTCmd)
+
+	mtx, err := s.newRawTransaction(&dcrdtypes.CreateRawTransactionCmd{
+		Inputs:   cmd.Inputs,
+		Amounts:  cmd.Amounts,
+		LockTime: cmd.LockTime,
+		Expiry:   cmd.Expiry,
+	})
 	if err != nil {
 		return nil, err
 	}
-	return sb.String(), nil
+	p, err := psbt.New(mtx)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return p.EncodeBase64()
+}
+
+// combinePSBT handles combinepsbt commands.
+func (s *Server) combinePSBT(ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.CombinePSBTCmd)
+
+	packets := make([]*psbt.Packet, len(cmd.PSBTs))
+	for i, b64 := range cmd.PSBTs {
+		p, err := psbt.DecodeBase64(b64)
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCDeserialization, err)
+		}
+		packets[i] = p
+	}
+	p, err := psbt.Combine(packets...)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return p.EncodeBase64()
+}
+
+// finalizePSBT handles finalizepsbt commands.
+func (s *Server) finalizePSBT(ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.FinalizePSBTCmd)
+
+	p, err := psbt.DecodeBase64(cmd.PSBT)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCDeserialization, err)
+	}
+	err = p.Finalize()
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+
+	res := &types.FinalizePSBTResult{Complete: p.IsComplete()}
+	if res.Complete && *cmd.Extract {
+		tx, err := p.Extract()
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCVerify, err)
+		}
+		sb := new(strings.Builder)
+		err = tx.Serialize(hex.NewEncoder(sb))
+		if err != nil {
+			return nil, err
+		}
+		res.Hex = sb.String()
+		return res, nil
+	}
+	res.PSBT, err = p.EncodeBase64()
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
 // dumpPrivKey handles a dumpprivkey request with the private key
@@ -742,6 +1156,124 @@ func (s *Server) dumpPrivKey(ctx context.Context, icmd interface{}) (interface{}
 	return key, nil
 }
 
+// dumpWallet handles a dumpwallet request by writing the wallet state which
+// can not be recovered from the seed to a new file.  The file contains private
+// keys and is created readable only by the current user.
+func (s *Server) dumpWallet(ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.DumpWalletCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	filename, err := filepath.Abs(cmd.Filename)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+
+	export, err := w.Export(ctx)
+	if err != nil {
+		if errors.Is(err, errors.Locked) {
+			return nil, errWalletUnlockNeeded
+		}
+		return nil, err
+	}
+	b, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	// Never overwrite an existing file.
+	f, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		if os.IsExist(err) {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "file %v already exists", filename)
+		}
+		return nil, err
+	}
+	_, err = f.Write(b)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(filename)
+		return nil, err
+	}
+
+	return &types.DumpWalletResult{Filename: filename}, nil
+}
+
+// exportHistory handles an exporthistory request by returning the mined
+// transaction history of every account, or a single account, over a block
+// range as CSV text or JSON objects.
+func (s *Server) exportHistory(ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.ExportHistoryCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	switch *cmd.Format {
+	case "csv", "json":
+	default:
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "unknown format %q", *cmd.Format)
+	}
+	if *cmd.StartHeight < 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative start height")
+	}
+	if *cmd.EndHeight >= 0 && *cmd.EndHeight < *cmd.StartHeight {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "end height is below start height")
+	}
+	opts := &history.Options{
+		Start:    int32(*cmd.StartHeight),
+		End:      int32(*cmd.EndHeight),
+		Labels:   *cmd.Labels,
+		Balances: *cmd.Balances,
+	}
+	if cmd.Account != nil {
+		account, err := w.AccountNumber(ctx, *cmd.Account)
+		if err != nil {
+			if errors.Is(err, errors.NotExist) {
+				return nil, errAccountNotFound
+			}
+			return nil, err
+		}
+		opts.Account = &account
+	}
+
+	entries, err := history.Export(ctx, w, opts)
+	if err != nil {
+		return nil, err
+	}
+	if *cmd.Format == "csv" {
+		var buf bytes.Buffer
+		err := history.WriteCSV(&buf, entries, opts)
+		if err != nil {
+			return nil, err
+		}
+		return buf.String(), nil
+	}
+	res := make([]types.ExportHistoryResult, 0, len(entries))
+	for _, e := range entries {
+		res = append(res, types.ExportHistoryResult{
+			Time:        e.Time.Unix(),
+			BlockHeight: e.BlockHeight,
+			BlockHash:   e.BlockHash,
+			TxID:        e.TxHash,
+			Type:        e.Type,
+			Category:    e.Category,
+			Account:     e.Account,
+			Debit:       e.Debit.ToCoin(),
+			Credit:      e.Credit.ToCoin(),
+			Fee:         e.Fee.ToCoin(),
+			Net:         e.Net.ToCoin(),
+			Balance:     e.Balance.ToCoin(),
+			Label:       e.Label,
+		})
+	}
+	return res, nil
+}
+
 // generateVote handles a generatevote request by constructing a signed
 // vote and returning it.
 func (s *Server) generateVote(ctx context.Context, icmd interface{}) (interface{}, error) {
@@ -838,6 +1370,57 @@ func (s *Server) getAddressesByAccount(ctx context.Context, icmd interface{}) (i
 	return addrsStr, nil
 }
 
+// getAuditLog handles a getauditlog request by returning the audit log
+// entries matching the optional operation, caller and time filters.
+func (s *Server) getAuditLog(ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.GetAuditLogCmd)
+	if s.cfg.AuditLog == nil {
+		return nil, rpcErrorf(dcrjson.ErrRPCMisc, "audit log is disabled")
+	}
+
+	var filter auditlog.Filter
+	if cmd.Operation != nil {
+		filter.Operation = *cmd.Operation
+	}
+	if cmd.Caller != nil {
+		filter.Caller = *cmd.Caller
+	}
+	if cmd.Since != nil {
+		filter.Since = time.Unix(*cmd.Since, 0)
+	}
+	if cmd.Count != nil {
+		if *cmd.Count < 0 {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative count")
+		}
+		filter.Count = *cmd.Count
+	}
+	entries, err := s.cfg.AuditLog.Entries(&filter)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]types.GetAuditLogResult, 0, len(entries))
+	for _, e := range entries {
+		var params map[string]interface{}
+		if len(e.Params) != 0 {
+			err := json.Unmarshal(e.Params, &params)
+			if err != nil {
+				return nil, errors.E(errors.Encoding, err)
+			}
+		}
+		res = append(res, types.GetAuditLogResult{
+			Seq:       e.Seq,
+			Time:      e.Time.Unix(),
+			Caller:    e.Caller,
+			Operation: e.Operation,
+			Params:    params,
+			Error:     e.Error,
+			PrevHash:  e.PrevHash,
+			Hash:      e.Hash,
+		})
+	}
+	return res, nil
+}
+
 // getBalance handles a getbalance request by returning the balance for an
 // account (wallet), or an error if the requested account does not
 // exist.
@@ -1019,6 +1602,93 @@ func (s *Server) getBlockHash(ctx context.Context, icmd interface{}) (interface{
 	return info.Hash.String(), nil
 }
 
+// estimateSmartFee estimates the fee rate required for a transaction to be
+// mined within some number of blocks, using the confirmation times of recent
+// wallet transactions.  When the wallet has too little data for an estimate,
+// the request is passed through to dcrd when synced with dcrd RPC, and the
+// relay fee is returned otherwise.
+func (s *Server) estimateSmartFee(ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*dcrdtypes.EstimateSmartFeeCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	if cmd.Confirmations < 1 || cmd.Confirmations > wallet.MaxFeeEstimateTarget {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"confirmations must be between 1 and %d", wallet.MaxFeeEstimateTarget)
+	}
+	conservative := true
+	if cmd.Mode != nil {
+		switch *cmd.Mode {
+		case dcrdtypes.EstimateSmartFeeConservative:
+		case dcrdtypes.EstimateSmartFeeEconomical:
+			conservative = false
+		default:
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"unknown estimate mode %q", *cmd.Mode)
+		}
+	}
+
+	feeRate, blocks, err := w.EstimateFee(int32(cmd.Confirmations), conservative)
+	if errors.Is(err, errors.NotExist) {
+		n, _ := s.walletLoader.NetworkBackend()
+		if rpc, ok := n.(*dcrd.RPC); ok {
+			params := []interface{}{cmd.Confirmations}
+			if cmd.Mode != nil {
+				params = append(params, *cmd.Mode)
+			}
+			var resp json.RawMessage
+			err := rpc.Call(ctx, "estimatesmartfee", &resp, params...)
+			if err != nil {
+				return nil, err
+			}
+			return resp, nil
+		}
+		return &dcrdtypes.EstimateSmartFeeResult{
+			FeeRate: w.RelayFee().ToCoin(),
+			Errors:  []string{"Insufficient data to estimate fee; returning relay fee"},
+			Blocks:  cmd.Confirmations,
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &dcrdtypes.EstimateSmartFeeResult{
+		FeeRate: feeRate.ToCoin(),
+		Errors:  []string{},
+		Blocks:  int64(blocks),
+	}, nil
+}
+
+// estimateStakeDiff projects the ticket price of the next ticket window from
+// the ticket pool size and the tickets purchased in the current window.
+func (s *Server) estimateStakeDiff(ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*dcrdtypes.EstimateStakeDiffCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	est, err := w.EstimateStakeDifficulty(ctx, cmd.Tickets)
+	if err != nil {
+		if errors.Is(err, errors.Invalid) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	res := &dcrdtypes.EstimateStakeDiffResult{
+		Min:      est.Min.ToCoin(),
+		Max:      est.Max.ToCoin(),
+		Expected: est.Expected.ToCoin(),
+	}
+	if cmd.Tickets != nil {
+		user := est.User.ToCoin()
+		res.User = &user
+	}
+	return res, nil
+}
+
 // difficultyRatio returns the proof-of-work difficulty as a multiple of the
 // minimum difficulty using the passed bits field from the header of a block.
 func difficultyRatio(bits uint32, params *chaincfg.Params) float64 {
@@ -1204,13 +1874,71 @@ func (s *Server) getUnconfirmedBalance(ctx context.Context, icmd interface{}) (i
 		return nil, err
 	}
 
-	return (bals.Total - bals.Spendable).ToCoin(), nil
+	return (bals.Total - bals.Spendable).ToCoin(), nil
+}
+
+// importPrivKey handles an importprivkey request by parsing
+// a WIF-encoded private key and adding it to an account.
+func (s *Server) importPrivKey(ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.ImportPrivKeyCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	rescan := true
+	if cmd.Rescan != nil {
+		rescan = *cmd.Rescan
+	}
+	scanFrom := int32(0)
+	if cmd.ScanFrom != nil {
+		scanFrom = int32(*cmd.ScanFrom)
+	}
+	n, ok := s.walletLoader.NetworkBackend()
+	if rescan && !ok {
+		return nil, errNoNetwork
+	}
+
+	// Ensure that private keys are only imported to the correct account.
+	//
+	// Yes, Label is the account name.
+	if cmd.Label != nil && *cmd.Label != udb.ImportedAddrAccountName {
+		return nil, errNotImportedAccount
+	}
+
+	wif, err := dcrutil.DecodeWIF(cmd.PrivKey, w.ChainParams().PrivateKeyID)
+	if err != nil {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidAddressOrKey, "WIF decode failed: %v", err)
+	}
+
+	// Import the private key, handling any errors.
+	_, err = w.ImportPrivateKey(ctx, wif)
+	if err != nil {
+		switch {
+		case errors.Is(err, errors.Exist):
+			// Do not return duplicate key errors to the client.
+			return nil, nil
+		case errors.Is(err, errors.Locked):
+			return nil, errWalletUnlockNeeded
+		default:
+			return nil, err
+		}
+	}
+
+	if rescan {
+		// TODO: This is not synchronized with process shutdown and
+		// will cause panics when the DB is closed mid-transaction.
+		go w.RescanFromHeight(context.Background(), n, scanFrom)
+	}
+
+	return nil, nil
 }
 
-// importPrivKey handles an importprivkey request by parsing
-// a WIF-encoded private key and adding it to an account.
-func (s *Server) importPrivKey(ctx context.Context, icmd interface{}) (interface{}, error) {
-	cmd := icmd.(*types.ImportPrivKeyCmd)
+// importWallet restores the account names, xpub accounts, imported keys and
+// scripts, labels and vote preferences of a wallet export file created by
+// dumpwallet.
+func (s *Server) importWallet(ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.ImportWalletCmd)
 	w, ok := s.walletLoader.LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
@@ -1229,33 +1957,25 @@ func (s *Server) importPrivKey(ctx context.Context, icmd interface{}) (interface
 		return nil, errNoNetwork
 	}
 
-	// Ensure that private keys are only imported to the correct account.
-	//
-	// Yes, Label is the account name.
-	if cmd.Label != nil && *cmd.Label != udb.ImportedAddrAccountName {
-		return nil, errNotImportedAccount
+	b, err := ioutil.ReadFile(cmd.Filename)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
 	}
-
-	wif, err := dcrutil.DecodeWIF(cmd.PrivKey, w.ChainParams().PrivateKeyID)
+	var export wallet.Export
+	err = json.Unmarshal(b, &export)
 	if err != nil {
-		return nil, rpcErrorf(dcrjson.ErrRPCInvalidAddressOrKey, "WIF decode failed: %v", err)
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "invalid wallet export: %v", err)
 	}
 
-	// Import the private key, handling any errors.
-	_, err = w.ImportPrivateKey(ctx, wif)
+	err = w.RestoreExport(ctx, &export)
 	if err != nil {
-		switch {
-		case errors.Is(err, errors.Exist):
-			// Do not return duplicate key errors to the client.
-			return nil, nil
-		case errors.Is(err, errors.Locked):
+		if errors.Is(err, errors.Locked) {
 			return nil, errWalletUnlockNeeded
-		default:
-			return nil, err
 		}
+		return nil, err
 	}
 
-	if rescan {
+	if rescan && (len(export.PrivateKeys) != 0 || len(export.Scripts) != 0) {
 		// TODO: This is not synchronized with process shutdown and
 		// will cause panics when the DB is closed mid-transaction.
 		go w.RescanFromHeight(context.Background(), n, scanFrom)
@@ -1330,6 +2050,29 @@ func (s *Server) importXpub(ctx context.Context, icmd interface{}) (interface{},
 	return nil, w.ImportXpubAccount(ctx, cmd.Name, xpub)
 }
 
+// importDescriptorAccount handles an importdescriptoraccount request by
+// creating an account deriving addresses from an output script descriptor.
+func (s *Server) importDescriptorAccount(ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.ImportDescriptorAccountCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	if cmd.Name == "*" {
+		return nil, errReservedAccountName
+	}
+
+	account, err := w.ImportDescriptorAccount(ctx, cmd.Name, cmd.Descriptor)
+	if errors.Is(err, errors.Encoding) || errors.Is(err, errors.Invalid) {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
 // createNewAccount handles a createnewaccount request by creating and
 // returning a new account. If the last account has no transaction history
 // as per BIP 0044 a new account cannot be created so an error will be returned.
@@ -1912,47 +2655,427 @@ func (s *Server) help(ctx context.Context, icmd interface{}) (interface{}, error
 		return helpText, nil
 	}
 
-	// Return the chain server's detailed help if possible.
-	var chainHelp string
-	if rpc != nil {
-		err := rpc.Call(ctx, "help", &chainHelp, *cmd.Command)
-		if err != nil {
-			return nil, err
+	// Return the chain server's detailed help if possible.
+	var chainHelp string
+	if rpc != nil {
+		err := rpc.Call(ctx, "help", &chainHelp, *cmd.Command)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if chainHelp != "" {
+		return chainHelp, nil
+	}
+	return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "no help for method %q", *cmd.Command)
+}
+
+// listAccounts handles a listaccounts request by returning a map of account
+// names to their balances.
+func (s *Server) listAccounts(ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.ListAccountsCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	accountBalances := map[string]float64{}
+	results, err := w.CalculateAccountBalances(ctx, int32(*cmd.MinConf))
+	if err != nil {
+		return nil, err
+	}
+	for _, result := range results {
+		accountName, err := w.AccountName(ctx, result.Account)
+		if err != nil {
+			// Expect name lookup to succeed
+			if errors.Is(err, errors.NotExist) {
+				return nil, rpcError(dcrjson.ErrRPCInternal.Code, err)
+			}
+			return nil, err
+		}
+		accountBalances[accountName] = result.Spendable.ToCoin()
+	}
+	// Return the map.  This will be marshaled into a JSON object.
+	return accountBalances, nil
+}
+
+// parseLabelTarget parses the target of a label, which may be an address, a
+// transaction hash, or a transaction output in the form "txid:index".
+func parseLabelTarget(target string, params *chaincfg.Params) (*udb.LabelTarget, error) {
+	if i := strings.IndexByte(target, ':'); i != -1 {
+		hash, err := chainhash.NewHashFromStr(target[:i])
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+		}
+		index, err := strconv.ParseUint(target[i+1:], 10, 32)
+		if err != nil {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"invalid output index %q", target[i+1:])
+		}
+		t := udb.OutputLabelTarget(hash, uint32(index))
+		return &t, nil
+	}
+	if len(target) == 2*chainhash.HashSize {
+		hash, err := chainhash.NewHashFromStr(target)
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCDecodeHexString, err)
+		}
+		t := udb.TxLabelTarget(hash)
+		return &t, nil
+	}
+	addr, err := decodeAddress(target, params)
+	if err != nil {
+		return nil, err
+	}
+	t := udb.AddressLabelTarget(addr.Address())
+	return &t, nil
+}
+
+// labelTargetString returns the string form of a label target accepted by
+// parseLabelTarget.
+func labelTargetString(t *udb.LabelTarget) string {
+	switch t.Kind {
+	case udb.LabelAddress:
+		return t.Address
+	case udb.LabelTransaction:
+		return t.Hash.String()
+	default:
+		return t.Hash.String() + ":" + strconv.FormatUint(uint64(t.Index), 10)
+	}
+}
+
+// getLabel handles a getlabel request by returning the label of an address,
+// transaction or transaction output, or an empty string if it is unlabeled.
+func (s *Server) getLabel(ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.GetLabelCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	target, err := parseLabelTarget(cmd.Target, w.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+	label, err := w.Label(ctx, target)
+	if err != nil && !errors.Is(err, errors.NotExist) {
+		return nil, err
+	}
+	return label, nil
+}
+
+// listDescriptorAccounts handles a listdescriptoraccounts request by returning
+// all descriptor accounts and their address usage.
+func (s *Server) listDescriptorAccounts(ctx context.Context, icmd interface{}) (interface{}, error) {
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	accounts, err := w.DescriptorAccounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]types.ListDescriptorAccountsResult, 0, len(accounts))
+	for _, a := range accounts {
+		res = append(res, types.ListDescriptorAccountsResult{
+			Account:       a.AccountNumber,
+			Name:          a.AccountName,
+			Descriptor:    a.Descriptor,
+			UsedCount:     a.LastUsedIndex + 1,
+			ReturnedCount: a.LastReturnedIndex + 1,
+		})
+	}
+	return res, nil
+}
+
+// listLabels handles a listlabels request by returning every recorded label,
+// optionally limited to labels of a single kind.
+func (s *Server) listLabels(ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.ListLabelsCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	var kind udb.LabelKind
+	if cmd.Kind != nil {
+		switch *cmd.Kind {
+		case "address":
+			kind = udb.LabelAddress
+		case "transaction":
+			kind = udb.LabelTransaction
+		case "output":
+			kind = udb.LabelOutput
+		default:
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"unknown label kind %q", *cmd.Kind)
+		}
+	}
+
+	labels, err := w.Labels(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]types.ListLabelsResult, 0, len(labels))
+	for target, label := range labels {
+		if kind != 0 && target.Kind != kind {
+			continue
+		}
+		res = append(res, types.ListLabelsResult{
+			Kind:   target.Kind.String(),
+			Target: labelTargetString(&target),
+			Label:  label,
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Kind != res[j].Kind {
+			return res[i].Kind < res[j].Kind
+		}
+		return res[i].Target < res[j].Target
+	})
+	return res, nil
+}
+
+// invoiceResult converts an invoice to its JSON-RPC result.
+func invoiceResult(inv *wallet.Invoice, accountName string) *types.InvoiceResult {
+	res := &types.InvoiceResult{
+		ID:       inv.ID,
+		Account:  accountName,
+		Address:  inv.Address.Address(),
+		Amount:   inv.Amount.ToCoin(),
+		Memo:     inv.Memo,
+		Created:  inv.Created.Unix(),
+		Received: inv.Received.ToCoin(),
+		Pending:  inv.Pending.ToCoin(),
+		Status:   inv.Status.String(),
+		Payments: make([]types.InvoicePaymentResult, 0, len(inv.Payments)),
+	}
+	uri := &paymenturi.URI{
+		Address: inv.Address,
+		Amount:  inv.Amount,
+		Message: inv.Memo,
+		Invoice: strconv.FormatUint(inv.ID, 10),
+	}
+	res.URI = uri.String()
+	if !inv.Expires.IsZero() {
+		res.Expires = inv.Expires.Unix()
+	}
+	for i := range inv.Payments {
+		p := &inv.Payments[i]
+		res.Payments = append(res.Payments, types.InvoicePaymentResult{
+			TxID:          p.TxHash.String(),
+			Vout:          p.Index,
+			Amount:        p.Amount.ToCoin(),
+			Confirmations: p.Confirmations,
+			BlockHeight:   p.BlockHeight,
+		})
+	}
+	return res
+}
+
+// createInvoice handles a createinvoice request by requesting payment of an
+// amount to a new external address of an account.
+func (s *Server) createInvoice(ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.CreateInvoiceCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	var callOpts []wallet.NextAddressCallOption
+	if cmd.GapPolicy != nil {
+		switch *cmd.GapPolicy {
+		case "":
+		case "error":
+			callOpts = append(callOpts, wallet.WithGapPolicyError())
+		case "ignore":
+			callOpts = append(callOpts, wallet.WithGapPolicyIgnore())
+		case "wrap":
+			callOpts = append(callOpts, wallet.WithGapPolicyWrap())
+		default:
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "unknown gap policy %q", *cmd.GapPolicy)
+		}
+	}
+
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	amount, err := dcrutil.NewAmount(cmd.Amount)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	if amount < 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative amount")
+	}
+	var memo string
+	if cmd.Memo != nil {
+		memo = *cmd.Memo
+	}
+	var expiry time.Duration
+	if cmd.Expiry != nil {
+		if *cmd.Expiry < 0 {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative expiry")
+		}
+		expiry = time.Duration(*cmd.Expiry) * time.Second
+	}
+
+	inv, err := w.CreateInvoice(ctx, account, amount, memo, expiry, callOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return invoiceResult(inv, cmd.Account), nil
+}
+
+// getInvoice handles a getinvoice request by returning an invoice and the
+// status of its payments.
+func (s *Server) getInvoice(ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.GetInvoiceCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	if *cmd.MinConf < 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative minconf")
+	}
+	inv, err := w.Invoice(ctx, cmd.ID, int32(*cmd.MinConf))
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+		}
+		return nil, err
+	}
+	accountName, err := w.AccountName(ctx, inv.Account)
+	if err != nil {
+		return nil, err
+	}
+	return invoiceResult(inv, accountName), nil
+}
+
+// listInvoices handles a listinvoices request by returning every invoice,
+// optionally limited to invoices with a single status.
+func (s *Server) listInvoices(ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.ListInvoicesCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	var status string
+	if cmd.Status != nil {
+		switch *cmd.Status {
+		case "", "unpaid", "partiallypaid", "paid", "overpaid", "expired":
+			status = *cmd.Status
+		default:
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"unknown invoice status %q", *cmd.Status)
+		}
+	}
+	if *cmd.MinConf < 0 {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative minconf")
+	}
+
+	invs, err := w.Invoices(ctx, int32(*cmd.MinConf))
+	if err != nil {
+		return nil, err
+	}
+	accountNames := make(map[uint32]string)
+	res := make([]*types.InvoiceResult, 0, len(invs))
+	for _, inv := range invs {
+		if status != "" && inv.Status.String() != status {
+			continue
+		}
+		accountName, ok := accountNames[inv.Account]
+		if !ok {
+			accountName, err = w.AccountName(ctx, inv.Account)
+			if err != nil {
+				return nil, err
+			}
+			accountNames[inv.Account] = accountName
+		}
+		res = append(res, invoiceResult(inv, accountName))
+	}
+	return res, nil
+}
+
+// parsePaymentURI handles a parsepaymenturi request by decoding a decred:
+// payment URI.
+func (s *Server) parsePaymentURI(ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.ParsePaymentURICmd)
+
+	u, err := paymenturi.Parse(cmd.URI, s.activeNet)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	return &types.ParsePaymentURIResult{
+		Address: u.Address.Address(),
+		Amount:  u.Amount.ToCoin(),
+		Label:   u.Label,
+		Message: u.Message,
+		Invoice: u.Invoice,
+	}, nil
+}
+
+// setAccountPassphrase handles a setaccountpassphrase request by encrypting the
+// private keys of an account with its own passphrase.  An empty passphrase
+// removes the account passphrase.
+func (s *Server) setAccountPassphrase(ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.SetAccountPassphraseCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(ctx, cmd.Account)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
 		}
+		return nil, err
 	}
-	if chainHelp != "" {
-		return chainHelp, nil
-	}
-	return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "no help for method %q", *cmd.Command)
+	err = w.SetAccountPassphrase(ctx, account, []byte(cmd.Passphrase))
+	return nil, err
 }
 
-// listAccounts handles a listaccounts request by returning a map of account
-// names to their balances.
-func (s *Server) listAccounts(ctx context.Context, icmd interface{}) (interface{}, error) {
-	cmd := icmd.(*types.ListAccountsCmd)
+// setLabel handles a setlabel request by attaching a label to an address,
+// transaction or transaction output.  An empty label removes the label.
+func (s *Server) setLabel(ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.SetLabelCmd)
 	w, ok := s.walletLoader.LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
-	accountBalances := map[string]float64{}
-	results, err := w.CalculateAccountBalances(ctx, int32(*cmd.MinConf))
+	target, err := parseLabelTarget(cmd.Target, w.ChainParams())
 	if err != nil {
 		return nil, err
 	}
-	for _, result := range results {
-		accountName, err := w.AccountName(ctx, result.Account)
+	if len(cmd.Label) > udb.MaxLabelLen {
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+			"label exceeds maximum length %d", udb.MaxLabelLen)
+	}
+	return nil, w.SetLabel(ctx, target, cmd.Label)
+}
+
+// labelTransactions sets the label of listtransactions results from the
+// wallet's recorded labels.
+func labelTransactions(ctx context.Context, w *wallet.Wallet, txs []types.ListTransactionsResult) ([]types.ListTransactionsResult, error) {
+	labels, err := w.Labels(ctx)
+	if err != nil || len(labels) == 0 {
+		return txs, err
+	}
+	for i := range txs {
+		hash, err := chainhash.NewHashFromStr(txs[i].TxID)
 		if err != nil {
-			// Expect name lookup to succeed
-			if errors.Is(err, errors.NotExist) {
-				return nil, rpcError(dcrjson.ErrRPCInternal.Code, err)
-			}
-			return nil, err
+			continue
 		}
-		accountBalances[accountName] = result.Spendable.ToCoin()
+		txs[i].Label = wallet.OutputLabel(labels, hash, txs[i].Vout, txs[i].Address)
 	}
-	// Return the map.  This will be marshaled into a JSON object.
-	return accountBalances, nil
+	return txs, nil
 }
 
 // listLockUnspent handles a listlockunspent request by returning an slice of
@@ -2146,6 +3269,10 @@ func (s *Server) listSinceBlock(ctx context.Context, icmd interface{}) (interfac
 	if err != nil {
 		return nil, err
 	}
+	txInfoList, err = labelTransactions(ctx, w, txInfoList)
+	if err != nil {
+		return nil, err
+	}
 
 	res := &types.ListSinceBlockResult{
 		Transactions: txInfoList,
@@ -2204,7 +3331,11 @@ func (s *Server) listTransactions(ctx context.Context, icmd interface{}) (interf
 				`Use "*" to reference all accounts.`)
 	}
 
-	return w.ListTransactions(ctx, *cmd.From, *cmd.Count)
+	txs, err := w.ListTransactions(ctx, *cmd.From, *cmd.Count)
+	if err != nil {
+		return nil, err
+	}
+	return labelTransactions(ctx, w, txs)
 }
 
 // listAddressTransactions handles a listaddresstransactions request by
@@ -2234,7 +3365,11 @@ func (s *Server) listAddressTransactions(ctx context.Context, icmd interface{})
 		hash160Map[string(addr.ScriptAddress())] = struct{}{}
 	}
 
-	return w.ListAddressTransactions(ctx, hash160Map)
+	txs, err := w.ListAddressTransactions(ctx, hash160Map)
+	if err != nil {
+		return nil, err
+	}
+	return labelTransactions(ctx, w, txs)
 }
 
 // listAllTransactions handles a listalltransactions request by returning
@@ -2253,7 +3388,11 @@ func (s *Server) listAllTransactions(ctx context.Context, icmd interface{}) (int
 			"listing all transactions may only be done for all accounts")
 	}
 
-	return w.ListAllTransactions(ctx)
+	txs, err := w.ListAllTransactions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return labelTransactions(ctx, w, txs)
 }
 
 // listUnspent handles the listunspent command.
@@ -2284,9 +3423,65 @@ func (s *Server) listUnspent(ctx context.Context, icmd interface{}) (interface{}
 		}
 		return nil, err
 	}
+	labels, err := w.Labels(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range result {
+		hash, err := chainhash.NewHashFromStr(r.TxID)
+		if err != nil {
+			continue
+		}
+		r.Label = wallet.OutputLabel(labels, hash, r.Vout, r.Address)
+	}
 	return result, nil
 }
 
+// listVSPTickets handles a listvsptickets request by returning the fee payment
+// state of every ticket purchased through a VSP.
+func (s *Server) listVSPTickets(ctx context.Context, icmd interface{}) (interface{}, error) {
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	tickets, err := w.VSPTickets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[uint32]string)
+	res := make([]types.ListVSPTicketsResult, 0, len(tickets))
+	for _, t := range tickets {
+		name, ok := names[t.FeeAccount]
+		if !ok {
+			name, err = w.AccountName(ctx, t.FeeAccount)
+			if err != nil {
+				return nil, err
+			}
+			names[t.FeeAccount] = name
+		}
+		r := types.ListVSPTicketsResult{
+			TicketHash: t.TicketHash.String(),
+			VSP:        t.Host,
+			FeeAccount: name,
+			State:      t.State.String(),
+			FeeAddress: t.FeeAddress,
+			FeeAmount:  t.FeeAmount.ToCoin(),
+			Expiration: t.Expiration,
+			Attempts:   t.Attempts,
+			LastError:  t.LastError,
+		}
+		if t.FeeTx != nil {
+			var feeTx wire.MsgTx
+			if err := feeTx.FromBytes(t.FeeTx); err == nil {
+				r.FeeTxHash = feeTx.TxHash().String()
+			}
+		}
+		res = append(res, r)
+	}
+	return res, nil
+}
+
 // lockUnspent handles the lockunspent command.
 func (s *Server) lockUnspent(ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*types.LockUnspentCmd)
@@ -2411,8 +3606,7 @@ func (s *Server) purchaseTicket(ctx context.Context, icmd interface{}) (interfac
 	}
 
 	hashes, err := w.PurchaseTickets(ctx, 0, spendLimit, minConf, ticketAddr,
-		account, numTickets, poolAddr, poolFee, expiry, w.RelayFee(),
-		ticketFee)
+		account, numTickets, poolAddr, poolFee, expiry, 0, ticketFee)
 	if err != nil {
 		return nil, err
 	}
@@ -2425,6 +3619,67 @@ func (s *Server) purchaseTicket(ctx context.Context, icmd interface{}) (interfac
 	return hashStrs, err
 }
 
+// purchaseVSPTicket handles a purchasevspticket request by purchasing tickets
+// voted by the configured fee-based VSP and paying their fees from the
+// account.  Fee payments which fail are recorded and retried after each block.
+func (s *Server) purchaseVSPTicket(ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.PurchaseVSPTicketCmd)
+	if s.cfg.VSP == nil {
+		return nil, rpcErrorf(dcrjson.ErrRPCMisc, "no VSP is configured")
+	}
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	account, err := w.AccountNumber(ctx, cmd.FromAccount)
+	if err != nil {
+		if errors.Is(err, errors.NotExist) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+	numTickets := 1
+	if cmd.NumTickets != nil {
+		numTickets = *cmd.NumTickets
+		if numTickets < 1 {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "numtickets must be positive")
+		}
+	}
+	minConf := int32(1)
+	if cmd.MinConf != nil {
+		minConf = int32(*cmd.MinConf)
+		if minConf < 0 {
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative minconf")
+		}
+	}
+	expiry := int32(0)
+	if cmd.Expiry != nil {
+		expiry = int32(*cmd.Expiry)
+	}
+
+	req := &wallet.PurchaseTicketsRequest{
+		Count:         numTickets,
+		SourceAccount: account,
+		VotingAccount: account,
+		MinConf:       minConf,
+		Expiry:        expiry,
+	}
+	hashes, err := s.cfg.VSP.Purchase(ctx, w, req, account)
+	if err != nil {
+		if len(hashes) == 0 {
+			return nil, err
+		}
+		log.Warnf("Purchased %d of %d VSP tickets: %v", len(hashes), numTickets, err)
+	}
+
+	hashStrs := make([]string, len(hashes))
+	for i := range hashes {
+		hashStrs[i] = hashes[i].String()
+	}
+	return hashStrs, nil
+}
+
 func addressScript(addr dcrutil.Address) (pkScript []byte, version uint16, err error) {
 	switch addr := addr.(type) {
 	case wallet.V0Scripter:
@@ -2467,7 +3722,8 @@ func makeOutputs(pairs map[string]dcrutil.Amount, chainParams *chaincfg.Params)
 // sendPairs creates and sends payment transactions.
 // It returns the transaction hash in string format upon success
 // All errors are returned in dcrjson.RPCError format
-func (s *Server) sendPairs(ctx context.Context, w *wallet.Wallet, amounts map[string]dcrutil.Amount, account uint32, minconf int32) (string, error) {
+func (s *Server) sendPairs(ctx context.Context, w *wallet.Wallet, amounts map[string]dcrutil.Amount, account uint32, minconf int32,
+	algo wallet.OutputSelectionAlgorithm) (string, error) {
 	changeAccount := account
 	if s.cfg.CSPPServer != "" {
 		mixAccount, err := w.AccountNumber(ctx, s.cfg.MixAccount)
@@ -2486,7 +3742,7 @@ func (s *Server) sendPairs(ctx context.Context, w *wallet.Wallet, amounts map[st
 	if err != nil {
 		return "", err
 	}
-	txSha, err := w.SendOutputs(ctx, outputs, account, changeAccount, minconf)
+	txSha, err := w.SendOutputs(ctx, outputs, account, changeAccount, minconf, algo)
 	if err != nil {
 		if errors.Is(err, errors.Locked) {
 			return "", errWalletUnlockNeeded
@@ -2705,6 +3961,31 @@ func (s *Server) revokeTickets(ctx context.Context, icmd interface{}) (interface
 	return nil, err
 }
 
+// stakeRewardEstimate estimates the chance of voting, the average vote time
+// and the return of a ticket purchased at the next block.
+func (s *Server) stakeRewardEstimate(ctx context.Context, icmd interface{}) (interface{}, error) {
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	est, err := w.EstimateStakeReward(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.StakeRewardEstimateResult{
+		TicketPrice:       est.TicketPrice.ToCoin(),
+		PoolSize:          est.PoolSize,
+		VoteProbability:   est.VoteProbability,
+		ExpiryProbability: est.ExpiryProbability,
+		AverageVoteBlocks: est.AverageVoteBlocks,
+		AverageVoteTime:   int64(est.AverageVoteTime / time.Second),
+		VoteReward:        est.VoteReward.ToCoin(),
+		ROI:               est.ROI,
+		AnnualROI:         est.AnnualROI,
+	}, nil
+}
+
 // stakePoolUserInfo returns the ticket information for a given user from the
 // stake pool.
 func (s *Server) stakePoolUserInfo(ctx context.Context, icmd interface{}) (interface{}, error) {
@@ -2766,6 +4047,125 @@ func (s *Server) stakePoolUserInfo(ctx context.Context, icmd interface{}) (inter
 	return resp, nil
 }
 
+// ticketReport handles a ticketreport request by returning the lifecycle of
+// every ticket, or a summary of their profitability by account and period, as
+// CSV text or JSON objects.
+func (s *Server) ticketReport(ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.TicketReportCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	switch *cmd.Report {
+	case "tickets", "summary":
+	default:
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "unknown report %q", *cmd.Report)
+	}
+	switch *cmd.Format {
+	case "csv", "json":
+	default:
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "unknown format %q", *cmd.Format)
+	}
+	switch *cmd.Period {
+	case ticketreport.All, ticketreport.Day, ticketreport.Week, ticketreport.Month, ticketreport.Year:
+	default:
+		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "unknown period %q", *cmd.Period)
+	}
+	var account *uint32
+	if cmd.Account != nil {
+		n, err := w.AccountNumber(ctx, *cmd.Account)
+		if err != nil {
+			if errors.Is(err, errors.NotExist) {
+				return nil, errAccountNotFound
+			}
+			return nil, err
+		}
+		account = &n
+	}
+
+	tickets, err := ticketreport.Tickets(ctx, w, account)
+	if err != nil {
+		return nil, err
+	}
+
+	if *cmd.Report == "tickets" {
+		if *cmd.Format == "csv" {
+			var buf bytes.Buffer
+			err := ticketreport.WriteTicketsCSV(&buf, tickets)
+			if err != nil {
+				return nil, err
+			}
+			return buf.String(), nil
+		}
+		res := make([]types.TicketReportTicketResult, 0, len(tickets))
+		for _, t := range tickets {
+			r := types.TicketReportTicketResult{
+				TicketHash:     t.Hash.String(),
+				Account:        t.AccountName,
+				Status:         t.StatusString(),
+				Price:          t.Price.ToCoin(),
+				PurchaseFee:    t.PurchaseFee.ToCoin(),
+				Commitment:     t.Commitment.ToCoin(),
+				VSPFee:         t.VSPFee.ToCoin(),
+				PurchaseHeight: t.PurchaseHeight,
+				PurchaseTime:   t.PurchaseTime.Unix(),
+				MaturityHeight: t.MaturityHeight,
+				Subsidy:        t.Subsidy.ToCoin(),
+				Reward:         t.Reward().ToCoin(),
+				Profit:         t.Profit().ToCoin(),
+			}
+			if t.SpenderHash != nil {
+				r.SpenderHash = t.SpenderHash.String()
+				r.SpenderTime = t.SpenderTime.Unix()
+				if t.SpenderHeight != -1 {
+					r.SpenderHeight = t.SpenderHeight
+				}
+			}
+			if t.Status == wallet.TicketStatusVoted {
+				r.DaysToVote = ticketreport.Days(t.SpendDuration())
+			}
+			res = append(res, r)
+		}
+		return res, nil
+	}
+
+	summaries, err := ticketreport.Summarize(tickets, *cmd.Period)
+	if err != nil {
+		return nil, err
+	}
+	if *cmd.Format == "csv" {
+		var buf bytes.Buffer
+		err := ticketreport.WriteSummariesCSV(&buf, summaries)
+		if err != nil {
+			return nil, err
+		}
+		return buf.String(), nil
+	}
+	res := make([]types.TicketReportSummaryResult, 0, len(summaries))
+	for _, sum := range summaries {
+		r := types.TicketReportSummaryResult{
+			Account:           sum.Account,
+			Tickets:           sum.Tickets,
+			Voted:             sum.Voted,
+			Revoked:           sum.Revoked,
+			Unspent:           sum.Unspent,
+			Invested:          sum.Invested.ToCoin(),
+			PurchaseFees:      sum.PurchaseFees.ToCoin(),
+			VSPFees:           sum.VSPFees.ToCoin(),
+			Subsidy:           sum.Subsidy.ToCoin(),
+			Rewards:           sum.Rewards.ToCoin(),
+			Profit:            sum.Profit.ToCoin(),
+			AverageDaysToVote: ticketreport.Days(sum.AverageVoteTime),
+		}
+		if !sum.PeriodStart.IsZero() {
+			r.PeriodStart = sum.PeriodStart.Unix()
+		}
+		res = append(res, r)
+	}
+	return res, nil
+}
+
 // ticketsForAddress retrieves all ticket hashes that have the passed voting
 // address. It will only return tickets that are in the mempool or blockchain,
 // and should not return pruned tickets.
@@ -2838,7 +4238,7 @@ func (s *Server) sendFrom(ctx context.Context, icmd interface{}) (interface{}, e
 		cmd.ToAddress: amt,
 	}
 
-	return s.sendPairs(ctx, w, pairs, account, minConf)
+	return s.sendPairs(ctx, w, pairs, account, minConf, wallet.OutputSelectionAlgorithmDefault)
 }
 
 // sendMany handles a sendmany RPC request by creating a new transaction
@@ -2870,6 +4270,15 @@ func (s *Server) sendMany(ctx context.Context, icmd interface{}) (interface{}, e
 		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative minconf")
 	}
 
+	var algoName string
+	if cmd.SelectionAlgorithm != nil {
+		algoName = *cmd.SelectionAlgorithm
+	}
+	algo, err := wallet.ParseOutputSelectionAlgorithm(algoName)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+
 	// Recreate address/amount pairs, using dcrutil.Amount.
 	pairs := make(map[string]dcrutil.Amount, len(cmd.Amounts))
 	for k, v := range cmd.Amounts {
@@ -2880,7 +4289,7 @@ func (s *Server) sendMany(ctx context.Context, icmd interface{}) (interface{}, e
 		pairs[k] = amt
 	}
 
-	return s.sendPairs(ctx, w, pairs, account, minConf)
+	return s.sendPairs(ctx, w, pairs, account, minConf, algo)
 }
 
 // sendToAddress handles a sendtoaddress RPC request by creating a new
@@ -2911,13 +4320,32 @@ func (s *Server) sendToAddress(ctx context.Context, icmd interface{}) (interface
 		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "negative amount")
 	}
 
+	// A payment URI may be provided instead of an address.  A zero amount
+	// pays the amount requested by the URI.
+	addr := cmd.Address
+	if paymenturi.IsURI(addr) {
+		u, err := paymenturi.Parse(addr, w.ChainParams())
+		if err != nil {
+			return nil, rpcError(dcrjson.ErrRPCInvalidAddressOrKey, err)
+		}
+		addr = u.Address.Address()
+		switch {
+		case u.Amount == 0:
+		case amt == 0:
+			amt = u.Amount
+		case amt != u.Amount:
+			return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter,
+				"amount %v does not match payment URI amount %v", amt, u.Amount)
+		}
+	}
+
 	// Mock up map of address and amount pairs.
 	pairs := map[string]dcrutil.Amount{
-		cmd.Address: amt,
+		addr: amt,
 	}
 
 	// sendtoaddress always spends from the default account, this matches bitcoind
-	return s.sendPairs(ctx, w, pairs, udb.DefaultAccountNum, 1)
+	return s.sendPairs(ctx, w, pairs, udb.DefaultAccountNum, 1, wallet.OutputSelectionAlgorithmDefault)
 }
 
 // sendToMultiSig handles a sendtomultisig RPC request by creating a new
@@ -3087,6 +4515,31 @@ func (s *Server) signMessage(ctx context.Context, icmd interface{}) (interface{}
 	return base64.StdEncoding.EncodeToString(sig), nil
 }
 
+// parseSigHashFlags parses the signature hash flags accepted by the
+// signrawtransaction and walletprocesspsbt commands.
+func parseSigHashFlags(flags string) (txscript.SigHashType, error) {
+	switch flags {
+	case "ALL":
+		return txscript.SigHashAll, nil
+	case "NONE":
+		return txscript.SigHashNone, nil
+	case "SINGLE":
+		return txscript.SigHashSingle, nil
+	case "ALL|ANYONECANPAY":
+		return txscript.SigHashAll | txscript.SigHashAnyOneCanPay, nil
+	case "NONE|ANYONECANPAY":
+		return txscript.SigHashNone | txscript.SigHashAnyOneCanPay, nil
+	case "SINGLE|ANYONECANPAY":
+		return txscript.SigHashSingle | txscript.SigHashAnyOneCanPay, nil
+	case "ssgen": // Special case of SigHashAll
+		return txscript.SigHashAll, nil
+	case "ssrtx": // Special case of SigHashAll
+		return txscript.SigHashAll, nil
+	default:
+		return 0, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "invalid sighash flag")
+	}
+}
+
 // signRawTransaction handles the signrawtransaction command.
 //
 // chainClient may be nil, in which case it was called by the NoChainRPC
@@ -3104,26 +4557,9 @@ func (s *Server) signRawTransaction(ctx context.Context, icmd interface{}) (inte
 		return nil, rpcError(dcrjson.ErrRPCDeserialization, err)
 	}
 
-	var hashType txscript.SigHashType
-	switch *cmd.Flags {
-	case "ALL":
-		hashType = txscript.SigHashAll
-	case "NONE":
-		hashType = txscript.SigHashNone
-	case "SINGLE":
-		hashType = txscript.SigHashSingle
-	case "ALL|ANYONECANPAY":
-		hashType = txscript.SigHashAll | txscript.SigHashAnyOneCanPay
-	case "NONE|ANYONECANPAY":
-		hashType = txscript.SigHashNone | txscript.SigHashAnyOneCanPay
-	case "SINGLE|ANYONECANPAY":
-		hashType = txscript.SigHashSingle | txscript.SigHashAnyOneCanPay
-	case "ssgen": // Special case of SigHashAll
-		hashType = txscript.SigHashAll
-	case "ssrtx": // Special case of SigHashAll
-		hashType = txscript.SigHashAll
-	default:
-		return nil, rpcErrorf(dcrjson.ErrRPCInvalidParameter, "invalid sighash flag")
+	hashType, err := parseSigHashFlags(*cmd.Flags)
+	if err != nil {
+		return nil, err
 	}
 
 	// TODO: really we probably should look these up with dcrd anyway to
@@ -3728,20 +5164,35 @@ func (s *Server) walletIsLocked(ctx context.Context, icmd interface{}) (interfac
 
 // walletLock handles a walletlock request by locking the all account
 // wallets, returning an error if any wallet is not encrypted (for example,
-// a watching-only wallet).
+// a watching-only wallet).  When an account is specified, only that account
+// is locked and it must be encrypted by its own passphrase.
 func (s *Server) walletLock(ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.WalletLockCmd)
 	w, ok := s.walletLoader.LoadedWallet()
 	if !ok {
 		return nil, errUnloadedWallet
 	}
 
+	if cmd.Account != nil {
+		account, err := w.AccountNumber(ctx, *cmd.Account)
+		if err != nil {
+			if errors.Is(err, errors.NotExist) {
+				return nil, errAccountNotFound
+			}
+			return nil, err
+		}
+		return nil, w.LockAccount(ctx, account)
+	}
+
 	w.Lock()
 	return nil, nil
 }
 
 // walletPassphrase responds to the walletpassphrase request by unlocking
 // the wallet.  The decryption key is saved in the wallet until timeout
-// seconds expires, after which the wallet is locked.
+// seconds expires, after which the wallet is locked.  When an account is
+// specified, the account encrypted by its own passphrase is unlocked instead,
+// which requires the wallet to already be unlocked.
 func (s *Server) walletPassphrase(ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*types.WalletPassphraseCmd)
 	w, ok := s.walletLoader.LoadedWallet()
@@ -3754,6 +5205,17 @@ func (s *Server) walletPassphrase(ctx context.Context, icmd interface{}) (interf
 	if timeout != 0 {
 		unlockAfter = time.After(timeout)
 	}
+	if cmd.Account != nil {
+		account, err := w.AccountNumber(ctx, *cmd.Account)
+		if err != nil {
+			if errors.Is(err, errors.NotExist) {
+				return nil, errAccountNotFound
+			}
+			return nil, err
+		}
+		err = w.UnlockAccount(ctx, account, []byte(cmd.Passphrase), unlockAfter)
+		return nil, err
+	}
 	err := w.Unlock(ctx, []byte(cmd.Passphrase), unlockAfter)
 	return nil, err
 }
@@ -3783,6 +5245,42 @@ func (s *Server) walletPassphraseChange(ctx context.Context, icmd interface{}) (
 	return nil, nil
 }
 
+// walletProcessPSBT handles the walletprocesspsbt command.  The packet is
+// updated with the previous outputs, redeem scripts and key derivations known
+// to the wallet, and signed with wallet keys when requested.
+func (s *Server) walletProcessPSBT(ctx context.Context, icmd interface{}) (interface{}, error) {
+	cmd := icmd.(*types.WalletProcessPSBTCmd)
+	w, ok := s.walletLoader.LoadedWallet()
+	if !ok {
+		return nil, errUnloadedWallet
+	}
+
+	hashType, err := parseSigHashFlags(*cmd.SigHashType)
+	if err != nil {
+		return nil, err
+	}
+	p, err := psbt.DecodeBase64(cmd.PSBT)
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCDeserialization, err)
+	}
+	err = w.ProcessPSBT(ctx, p, hashType, *cmd.Sign)
+	if err != nil {
+		return nil, err
+	}
+	err = p.Finalize()
+	if err != nil {
+		return nil, rpcError(dcrjson.ErrRPCInvalidParameter, err)
+	}
+	b64, err := p.EncodeBase64()
+	if err != nil {
+		return nil, err
+	}
+	return &types.WalletProcessPSBTResult{
+		PSBT:     b64,
+		Complete: p.IsComplete(),
+	}, nil
+}
+
 func (s *Server) mixOutput(ctx context.Context, icmd interface{}) (interface{}, error) {
 	cmd := icmd.(*types.MixOutputCmd)
 	if s.cfg.CSPPServer == "" {
@@ -6,10 +6,21 @@
 package jsonrpc
 
 import (
+	"bytes"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
+
+	"decred.org/dcrwallet/internal/auditlog"
+	"decred.org/dcrwallet/internal/loader"
+	"decred.org/dcrwallet/internal/rpc/rpcauth"
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrd/dcrjson/v3"
+	"github.com/decred/dcrwallet/rpc/jsonrpc/types"
 )
 
 func TestThrottle(t *testing.T) {
@@ -52,3 +63,190 @@ func TestThrottle(t *testing.T) {
 		t.Fatalf("status codes: want: %v, got: %v", want, got)
 	}
 }
+
+func TestRoles(t *testing.T) {
+	opts := &Options{
+		Username:       "admin",
+		Password:       "adminpass",
+		MaxPOSTClients: 10,
+		Users: []User{
+			{Username: "monitor", Password: "monitorpass", Role: rpcauth.ReadOnly},
+		},
+	}
+	s := NewServer(opts, chaincfg.MainNetParams(), nil, nil)
+	srv := httptest.NewServer(s.httpServer.Handler)
+	defer srv.Close()
+
+	tests := []struct {
+		user, pass string
+		method     string
+		status     int
+		denied     bool
+	}{
+		{"monitor", "monitorpass", "sendtoaddress", http.StatusOK, true},
+		{"monitor", "monitorpass", "stop", http.StatusOK, true},
+		{"monitor", "adminpass", "getbalance", http.StatusUnauthorized, false},
+		{"admin", "adminpass", "stop", http.StatusOK, false},
+	}
+	for _, test := range tests {
+		body := `{"jsonrpc":"1.0","id":1,"method":"` + test.method + `","params":[]}`
+		req, err := http.NewRequest("POST", srv.URL, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.SetBasicAuth(test.user, test.pass)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var r dcrjson.Response
+		if resp.StatusCode == http.StatusOK {
+			err = json.NewDecoder(resp.Body).Decode(&r)
+		}
+		resp.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != test.status {
+			t.Errorf("%s %s: status %d", test.user, test.method, resp.StatusCode)
+			continue
+		}
+		denied := r.Error != nil && strings.Contains(r.Error.Message, "may not call")
+		if denied != test.denied {
+			t.Errorf("%s %s: denied=%v (%v)", test.user, test.method, denied, r.Error)
+		}
+	}
+}
+
+func TestMacaroons(t *testing.T) {
+	rootKey := make([]byte, rpcauth.RootKeySize)
+	opts := &Options{
+		Username:        "admin",
+		Password:        "adminpass",
+		MaxPOSTClients:  10,
+		MacaroonRootKey: rootKey,
+	}
+	params := chaincfg.MainNetParams()
+	walletLoader := loader.NewLoader(params, "", nil, 20, false, 1e-4, 5, false)
+	s := NewServer(opts, params, walletLoader, nil)
+	srv := httptest.NewServer(s.httpServer.Handler)
+	defer srv.Close()
+
+	call := func(token, method, params string) (int, *dcrjson.Response) {
+		body := `{"jsonrpc":"1.0","id":1,"method":"` + method + `","params":` + params + `}`
+		req, err := http.NewRequest("POST", srv.URL, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		var r dcrjson.Response
+		if resp.StatusCode == http.StatusOK {
+			err = json.NewDecoder(resp.Body).Decode(&r)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		return resp.StatusCode, &r
+	}
+	mint := func(key []byte, caveats ...rpcauth.Caveat) string {
+		m, err := rpcauth.NewMacaroon(key, caveats...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return m.Encode()
+	}
+
+	helpOnly := mint(rootKey, rpcauth.MethodsCaveat("version", "bakemacaroon"))
+	tests := []struct {
+		name   string
+		token  string
+		method string
+		status int
+		denied bool
+	}{
+		{"allowed method", helpOnly, "version", http.StatusOK, false},
+		{"method not in caveat", helpOnly, "stop", http.StatusOK, true},
+		{"expired", mint(rootKey, rpcauth.ExpiryCaveat(time.Now().Add(-time.Second))), "version",
+			http.StatusOK, true},
+		{"account scoped", mint(rootKey, rpcauth.AccountCaveat(3)), "getbalance", http.StatusOK, true},
+		{"other root key", mint(bytes.Repeat([]byte{1}, rpcauth.RootKeySize)), "version", http.StatusUnauthorized, false},
+		{"malformed", "not-a-macaroon", "version", http.StatusUnauthorized, false},
+	}
+	for _, test := range tests {
+		status, r := call(test.token, test.method, "[]")
+		if status != test.status {
+			t.Errorf("%s: status %d", test.name, status)
+			continue
+		}
+		denied := r.Error != nil && (strings.Contains(r.Error.Message, "may not call") ||
+			strings.Contains(r.Error.Message, "expired") ||
+			strings.Contains(r.Error.Message, "restricted to an account"))
+		if denied != test.denied {
+			t.Errorf("%s: denied=%v (%v)", test.name, denied, r.Error)
+		}
+	}
+
+	// A macaroon baked by a macaroon client keeps the caveats of the parent.
+	status, r := call(helpOnly, "bakemacaroon", `[null,"readonly"]`)
+	if status != http.StatusOK || r.Error != nil {
+		t.Fatalf("bakemacaroon: status %d error %v", status, r.Error)
+	}
+	var res struct {
+		Macaroon string   `json:"macaroon"`
+		Caveats  []string `json:"caveats"`
+	}
+	err := json.Unmarshal(r.Result, &res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Caveats) != 2 {
+		t.Errorf("baked macaroon caveats %q", res.Caveats)
+	}
+	if _, r := call(res.Macaroon, "bakemacaroon", "[]"); r.Error == nil {
+		t.Errorf("attenuated macaroon may call bakemacaroon")
+	}
+}
+
+func TestAuditedMethods(t *testing.T) {
+	// Audited methods are handled by the wallet, except sendrawtransaction,
+	// which is passed through to dcrd.
+	for method := range auditedMethods {
+		if _, ok := handlers[method]; !ok && method != "sendrawtransaction" {
+			t.Errorf("audited method %s does not exist", method)
+		}
+	}
+
+	// Every secret parameter of these requests is redacted.
+	tests := []struct {
+		method string
+		params string
+	}{
+		{"walletpassphrase", `["secret1",60]`},
+		{"walletpassphrasechange", `["secret1","secret2"]`},
+		{"setaccountpassphrase", `["default","secret1"]`},
+		{"importprivkey", `["secret1"]`},
+		{"importxpub", `["cold","secret1"]`},
+		{"importdescriptoraccount", `["cold","secret1"]`},
+		{"signrawtransaction", `["00",null,["secret1","secret2"]]`},
+	}
+	for _, test := range tests {
+		var params []json.RawMessage
+		err := json.Unmarshal([]byte(test.params), &params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cmd, err := dcrjson.ParseParams(types.Method(test.method), params)
+		if err != nil {
+			t.Fatalf("%s: %v", test.method, err)
+		}
+		logged := auditlog.Params(cmd, auditedMethods[test.method]...)
+		if !bytes.Contains(logged, []byte(auditlog.Redacted)) || bytes.Contains(logged, []byte("secret")) {
+			t.Errorf("%s: secrets not redacted: %s", test.method, logged)
+		}
+	}
+}
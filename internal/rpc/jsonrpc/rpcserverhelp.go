@@ -10,21 +10,38 @@ func helpDescsEnUS() map[string]string {
 		"addmultisigaddress":      "addmultisigaddress nrequired [\"key\",...] (\"account\")\n\nGenerates and imports a multisig address and redeeming script to the 'imported' account.\n\nArguments:\n1. nrequired (numeric, required)         The number of signatures required to redeem outputs paid to this address\n2. keys      (array of string, required) Pubkeys and/or pay-to-pubkey-hash addresses to partially control the multisig address\n3. account   (string, optional)          DEPRECATED -- Unused (all imported addresses belong to the imported account)\n\nResult:\n\"value\" (string) The imported pay-to-script-hash address\n",
 		"addticket":               "addticket \"tickethex\"\n\nAdd a ticket to the wallet for vote and revocation creation.  Added tickets are auxiliary to transaction history and do not appear in getstakeinfo stats.\n\nArguments:\n1. tickethex (string, required) Hex-encoded serialized transaction\n\nResult:\nNothing\n",
 		"auditreuse":              "auditreuse (since)\n\nReports outputs identifying address reuse\n\nArguments:\n1. since (numeric, optional) Only report reusage since some main chain block height\n\nResult:\n{\n \"Array of outpoints referencing the reused address\": Reused address, (object) Object keying reused addresses to arrays of outpoint strings\n ...\n}\n",
+		"backupwallet":            "backupwallet \"destination\"\n\nWrites a copy of the wallet database to a file.\nThe copy is consistent and may be made while the wallet is running.\n\nArguments:\n1. destination (string, required) Path of the backup file, or a directory to write a wallet.db file into\n\nResult:\nNothing\n",
+		"bakemacaroon":            "bakemacaroon ([\"method\",...] \"role\" expiry \"account\" maxspend)\n\nMints a macaroon bearer token authenticating RPC clients, restricted by the requested caveats.\nClients present the macaroon in an \"Authorization: Bearer\" header to the JSON-RPC server, or in \"macaroon\" metadata to the gRPC server.\nWhen invoked by a client authenticated with a macaroon, the new macaroon is that macaroon with the additional caveats.\n\nArguments:\n1. methods  (array of string, optional) Only allow calling these JSON-RPC method names or full gRPC method names\n2. role     (string, optional)          Only allow calling methods permitted to this RPC role (readonly, invoice, ticket or full)\n3. expiry   (numeric, optional)         Number of seconds until the macaroon expires\n4. account  (string, optional)          Only allow methods operating on this account\n5. maxspend (numeric, optional)         Maximum amount in DCR spent by each transaction created or published with the macaroon\n\nResult:\n{\n \"macaroon\": \"value\",      (string)          The encoded macaroon\n \"id\": \"value\",            (string)          Identifier shared by the macaroon and every macaroon attenuated from the same minted macaroon\n \"caveats\": [\"value\",...], (array of string) All caveats of the macaroon\n}                          \n",
+		"bumpfee":                 "bumpfee \"txhash\" (feerate)\n\nReplaces an unconfirmed wallet transaction with a transaction spending the same inputs and paying a higher fee from its change output.\nEvery input must spend a P2PKH or P2PK wallet output.\nThe replacement is published to the network before it replaces the original transaction in the wallet.\n\nArguments:\n1. txhash  (string, required)  Hash of the unconfirmed transaction to replace\n2. feerate (numeric, optional) Fee rate in DCR/kB of the replacement (default: the original fee rate increased by the relay fee)\n\nResult:\n{\n \"txid\": \"value\",  (string)  Hash of the replacement transaction\n \"origfee\": n.nnn, (numeric) Fee in DCR paid by the replaced transaction\n \"fee\": n.nnn,     (numeric) Fee in DCR paid by the replacement transaction\n}                  \n",
+		"combinepsbt":             "combinepsbt [\"psbt\",...]\n\nCombines the signatures and metadata of several partially signed transactions describing the same transaction.\n\nArguments:\n1. psbts (array of string, required) Base64-encoded partially signed transactions\n\nResult:\n\"value\" (string) The combined base64-encoded partially signed transaction\n",
 		"consolidate":             "consolidate inputs (\"account\" \"address\")\n\nConsolidate n many UTXOs into a single output in the wallet.\n\nArguments:\n1. inputs  (numeric, required) Number of UTXOs to consolidate as inputs\n2. account (string, optional)  Optional: Account from which unspent outputs are picked. When no address specified, also the account used to obtain an output address.\n3. address (string, optional)  Optional: Address to pay.  Default is obtained via getnewaddress from the account's address pool.\n\nResult:\n\"value\" (string) Transaction hash for the consolidation transaction\n",
+		"cpfp":                    "cpfp \"txhash\" (feerate \"account\")\n\nPublishes a child transaction spending the wallet's outputs of an unconfirmed transaction, and additional account outputs when necessary, to increase the fee rate of both transactions.\nThe fee of the child is chosen such that the combined parent and child transactions pay the requested fee rate.\n\nArguments:\n1. txhash  (string, required)  Hash of the unconfirmed parent transaction\n2. feerate (numeric, optional) Fee rate in DCR/kB of the combined parent and child transactions (default: the wallet's relay fee)\n3. account (string, optional)  Account to select additional inputs from and to receive the child output (default: \"default\")\n\nResult:\n{\n \"txid\": \"value\",    (string)  Hash of the child transaction\n \"parentfee\": n.nnn, (numeric) Fee in DCR paid by the parent transaction, or zero if unknown\n \"fee\": n.nnn,       (numeric) Fee in DCR paid by the child transaction\n \"warning\": \"value\", (string)  Set when the parent transaction spends outputs of other unconfirmed transactions\n}                    \n",
+		"createinvoice":           "createinvoice \"account\" amount (\"memo\" expiry \"gappolicy\")\n\nRequests payment of an amount to a new external address of an account.\nOutputs paying the address are recorded as payments of the invoice as they are received.\n\nArguments:\n1. account   (string, required)  Account to derive the invoice address from\n2. amount    (numeric, required) Requested amount in DCR, or zero to accept any amount\n3. memo      (string, optional)  Description of the payment\n4. expiry    (numeric, optional) Number of seconds after which the invoice expires unless fully paid, or zero to never expire\n5. gappolicy (string, optional)  String defining the policy to use when the BIP0044 gap limit would be violated, may be \"error\", \"ignore\", or \"wrap\"\n\nResult:\n{\n \"id\": n,             (numeric)         The invoice ID\n \"account\": \"value\",  (string)          The account of the invoice address\n \"address\": \"value\",  (string)          The address to pay\n \"amount\": n.nnn,     (numeric)         The requested amount in DCR, or zero if any amount is accepted\n \"memo\": \"value\",     (string)          Description of the payment\n \"created\": n,        (numeric)         The Unix time the invoice was created\n \"expires\": n,        (numeric)         The Unix time the invoice expires, or zero if it never expires\n \"received\": n.nnn,   (numeric)         The total of payments with at least minconf confirmations in DCR\n \"pending\": n.nnn,    (numeric)         The total of payments with fewer than minconf confirmations in DCR\n \"status\": \"value\",   (string)          The invoice status: \"unpaid\", \"partiallypaid\", \"paid\", \"overpaid\", or \"expired\"\n \"payments\": [{       (array of object) Transaction outputs paying the invoice address\n  \"txid\": \"value\",    (string)          The hash of the paying transaction\n  \"vout\": n,          (numeric)         The output index of the payment\n  \"amount\": n.nnn,    (numeric)         The amount paid in DCR\n  \"confirmations\": n, (numeric)         The number of block confirmations of the paying transaction\n  \"blockheight\": n,   (numeric)         The height of the block mining the paying transaction, or -1 if unmined\n },...],                                \n \"uri\": \"value\",      (string)          The decred: payment URI requesting payment of the invoice\n}                     \n",
 		"createmultisig":          "createmultisig nrequired [\"key\",...]\n\nGenerate a multisig address and redeem script.\n\nArguments:\n1. nrequired (numeric, required)         The number of signatures required to redeem outputs paid to this address\n2. keys      (array of string, required) Pubkeys and/or pay-to-pubkey-hash addresses to partially control the multisig address\n\nResult:\n{\n \"address\": \"value\",      (string) The generated pay-to-script-hash address\n \"redeemScript\": \"value\", (string) The script required to redeem outputs paid to the multisig address\n}                         \n",
+		"createmultisigaccount":   "createmultisigaccount \"name\" nrequired [\"xpub\",...]\n\nCreates an M-of-N multisig vault account from the account extended public keys of every cosigner.\nP2SH addresses are derived from the external branch of every key and are identical for every cosigner creating the account from the same keys in any order.\nWhen a key belongs to an account of this wallet, the wallet can sign transactions spending from the vault.\n\nArguments:\n1. name      (string, required)          Name of the new account\n2. nrequired (numeric, required)         The number of cosigner signatures required to spend from the vault\n3. xpubs     (array of string, required) Account extended public keys of all cosigners\n\nResult:\n{\n \"account\": n,          (numeric) The account number of the new account\n \"descriptor\": \"value\", (string)  The output script descriptor of the account\n}                       \n",
+		"createmultisigaccounttx": "createmultisigaccounttx \"account\" {\"address\":amount,...} (minconf=1)\n\nCreates an unsigned transaction spending outputs of a multisig vault account, returning change to the vault.\nCosigners add their signatures with signrawtransaction or walletprocesspsbt until the required number of signatures are present.\n\nArguments:\n1. account (string, required) Name of the multisig account\n2. amounts (object, required) JSON object with the destination addresses as keys and amounts as values\n{\n \"address\": n.nnn, (object) The destination address as the key and the amount in DCR as the value\n ...\n}\n3. minconf (numeric, optional, default=1) Minimum number of block confirmations required before a vault output is spent\n\nResult:\n{\n \"hex\": \"value\",  (string)  The serialized unsigned transaction\n \"psbt\": \"value\", (string)  The transaction as a base64-encoded partially signed transaction with the vault redeem scripts\n \"fee\": n.nnn,    (numeric) The transaction fee\n}                 \n",
 		"createnewaccount":        "createnewaccount \"account\"\n\nCreates a new account.\nThe wallet must be unlocked for this request to succeed.\n\nArguments:\n1. account (string, required) Name of the new account\n\nResult:\nNothing\n",
+		"createpsbt":              "createpsbt [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...] {\"address\":amount,...} (locktime expiry)\n\nReturns a new partially signed transaction spending the provided inputs and sending to the provided addresses.\nThe walletprocesspsbt command may be used to add wallet metadata and signatures to the result.\n\nArguments:\n1. inputs (array of object, required) The inputs to the transaction\n[{\n \"amount\": n.nnn, (numeric) The the previous output amount\n \"txid\": \"value\", (string)  The transaction hash of the referenced output\n \"vout\": n,       (numeric) The output index of the referenced output\n \"tree\": n,       (numeric) The tree to generate transaction for\n},...]\n2. amounts (object, required) JSON object with the destination addresses as keys and amounts as values\n{\n \"address\": n.nnn, (object) The destination address as the key and the amount in DCR as the value\n ...\n}\n3. locktime (numeric, optional) Locktime value; a non-zero value will also locktime-activate the inputs\n4. expiry   (numeric, optional) Expiry value; a non-zero value when the transaction expiry\n\nResult:\n\"value\" (string) The base64-encoded partially signed transaction\n",
 		"createrawtransaction":    "createrawtransaction [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...] {\"address\":amount,...} (locktime expiry)\n\nReturns a new transaction spending the provided inputs and sending to the provided addresses.\nThe transaction inputs are not signed in the created transaction.\nThe signrawtransaction RPC command provided by wallet must be used to sign the resulting transaction.\n\nArguments:\n1. inputs (array of object, required) The inputs to the transaction\n[{\n \"amount\": n.nnn, (numeric) The the previous output amount\n \"txid\": \"value\", (string)  The transaction hash of the referenced output\n \"vout\": n,       (numeric) The output index of the referenced output\n \"tree\": n,       (numeric) The tree to generate transaction for\n},...]\n2. amounts (object, required) JSON object with the destination addresses as keys and amounts as values\n{\n \"address\": n.nnn, (object) The destination address as the key and the amount in DCR as the value\n ...\n}\n3. locktime (numeric, optional) Locktime value; a non-zero value will also locktime-activate the inputs\n4. expiry   (numeric, optional) Expiry value; a non-zero value when the transaction expiry\n\nResult:\n\"value\" (string) Hex-encoded bytes of the serialized transaction\n",
 		"dumpprivkey":             "dumpprivkey \"address\"\n\nReturns the private key in WIF encoding that controls some wallet address.\n\nArguments:\n1. address (string, required) The address to return a private key for\n\nResult:\n\"value\" (string) The WIF-encoded private key\n",
+		"dumpwallet":              "dumpwallet \"filename\"\n\nWrites the wallet state which can not be recovered from the seed to a new JSON file.\nThis includes account names, xpub accounts, imported private keys and scripts, labels and vote preferences.\nThe file contains private keys and requires the wallet to be unlocked.\n\nArguments:\n1. filename (string, required) Path of the file to create; existing files are not overwritten\n\nResult:\n{\n \"filename\": \"value\", (string) Absolute path of the created file\n}                     \n",
+		"estimatesmartfee":        "estimatesmartfee confirmations (mode=\"conservative\")\n\nEstimates the fee rate required for a transaction to be mined within a number of blocks.\nEstimates are based on the number of blocks recent unconfirmed transactions took to be mined: transactions relevant to the wallet, and all transactions relayed by peers when syncing with SPV.\nWhen the wallet has too little data for an estimate, the request is passed through to dcrd if the wallet is synced using dcrd RPC.\n\nArguments:\n1. confirmations (numeric, required)                        The number of blocks the transaction should be mined within\n2. mode          (string, optional, default=\"conservative\") The estimate mode (\"economical\" or \"conservative\"); conservative estimates require more transactions paying the fee rate to have been mined within the target\n\nResult:\n{\n \"feerate\": n.nnn,        (numeric)         The estimated fee rate in DCR/kB, or the relay fee when no estimate is available from the wallet or dcrd\n \"errors\": [\"value\",...], (array of string) Errors encountered while estimating the fee rate\n \"blocks\": n,             (numeric)         The number of blocks the estimate is valid for\n}                         \n",
+		"estimatestakediff":       "estimatestakediff (tickets)\n\nEstimates the ticket price of the next ticket window from the ticket pool size and the tickets purchased in the current window.\n\nArguments:\n1. tickets (numeric, optional) Also estimate the price if this number of tickets is purchased in the remainder of the current window\n\nResult:\n{\n \"min\": n.nnn,      (numeric) The price if no more tickets are purchased in the current window\n \"max\": n.nnn,      (numeric) The price if the maximum number of tickets is purchased in the remainder of the current window\n \"expected\": n.nnn, (numeric) The price if tickets continue to be purchased at the average rate of the current window\n \"user\": n.nnn,     (numeric) The price if the requested number of tickets is purchased in the remainder of the current window\n}                   \n",
+		"exporthistory":           "exporthistory (format=\"csv\" startheight=0 endheight=-1 \"account\" labels=false balances=false)\n\nExports the mined transaction history for accounting, with an entry for each account debited or credited by each transaction.\nThe net change of an account balance is the credit minus the debit and includes any fee paid by the account.\nEntries are categorized as receive, send, coinbase, ticketpurchase, votereward, or revocation.\n\nArguments:\n1. format      (string, optional, default=\"csv\")  The output format: \"csv\" or \"json\"\n2. startheight (numeric, optional, default=0)     Height of the first exported block\n3. endheight   (numeric, optional, default=-1)    Height of the last exported block, or -1 to export through the main chain tip\n4. account     (string, optional)                 If set, limits the export to entries of a single account\n5. labels      (boolean, optional, default=false) Include the transaction label, or the first output label of the account, with each entry\n6. balances    (boolean, optional, default=false) Include the running balance of the account after each entry, computed from the start of the wallet history\n\nResult (format is \"csv\"):\n\"value\" (string) CSV text with a header row, times in RFC 3339 format and amounts in DCR\n\nResult (format is \"json\"):\n[{\n \"time\": n,            (numeric) The Unix time of the block\n \"blockheight\": n,     (numeric) The height of the block mining the transaction\n \"blockhash\": \"value\", (string)  The hash of the block mining the transaction\n \"txid\": \"value\",      (string)  The transaction hash\n \"type\": \"value\",      (string)  The transaction type: \"regular\", \"coinbase\", \"ticket\", \"vote\", or \"revocation\"\n \"category\": \"value\",  (string)  The accounting category: \"receive\", \"send\", \"coinbase\", \"ticketpurchase\", \"votereward\", or \"revocation\"\n \"account\": \"value\",   (string)  The account name\n \"debit\": n.nnn,       (numeric) The total of account outputs spent by the transaction in DCR\n \"credit\": n.nnn,      (numeric) The total of outputs paying the account in DCR\n \"fee\": n.nnn,         (numeric) The transaction fee paid by the account in DCR\n \"net\": n.nnn,         (numeric) The change of the account balance in DCR\n \"balance\": n.nnn,     (numeric) The balance of the account after the transaction in DCR, if requested\n \"label\": \"value\",     (string)  The transaction or output label, if requested\n},...]\n",
+		"finalizepsbt":            "finalizepsbt \"psbt\" (extract=true)\n\nCreates final signature scripts for all inputs of a partially signed transaction which have collected enough signatures.\n\nArguments:\n1. psbt    (string, required)                The base64-encoded partially signed transaction\n2. extract (boolean, optional, default=true) Return the signed transaction instead of the packet if all inputs were finalized\n\nResult:\n{\n \"psbt\": \"value\",        (string)  The base64-encoded partially signed transaction (omitted when the transaction is extracted)\n \"hex\": \"value\",         (string)  The signed transaction encoded as a hexadecimal string (only when extracted)\n \"complete\": true|false, (boolean) Whether all inputs have been finalized\n}                        \n",
 		"generatevote":            "generatevote \"blockhash\" height \"tickethash\" votebits \"votebitsext\"\n\nReturns the vote transaction encoded as a hexadecimal string\n\nArguments:\n1. blockhash   (string, required)  Block hash for the ticket\n2. height      (numeric, required) Block height for the ticket\n3. tickethash  (string, required)  The hash of the ticket\n4. votebits    (numeric, required) The voteBits to set for the ticket\n5. votebitsext (string, required)  The extended voteBits to set for the ticket\n\nResult:\n{\n \"hex\": \"value\", (string) The hex encoded transaction\n}                \n",
 		"getaccountaddress":       "getaccountaddress \"account\"\n\nDEPRECATED -- Returns the most recent external payment address for an account that has not been seen publicly.\nA new address is generated for the account if the most recently generated address has been seen on the blockchain or in mempool.\n\nArguments:\n1. account (string, required) The account of the returned address\n\nResult:\n\"value\" (string) The unused address for 'account'\n",
 		"getaccount":              "getaccount \"address\"\n\nLookup the account name that some wallet address belongs to.\n\nArguments:\n1. address (string, required) The address to query the account for\n\nResult:\n\"value\" (string) The name of the account that 'address' belongs to\n",
 		"getaddressesbyaccount":   "getaddressesbyaccount \"account\"\n\nDEPRECATED -- Returns all addresses strings controlled by a single account.\n\nArguments:\n1. account (string, required) Account name to fetch addresses for\n\nResult:\n[\"value\",...] (array of string) All addresses controlled by 'account'\n",
+		"getauditlog":             "getauditlog (\"operation\" \"caller\" since count)\n\nReturns entries of the audit log of privileged wallet operations, oldest first.\n\nArguments:\n1. operation (string, optional)  Only return entries recording this method\n2. caller    (string, optional)  Only return entries recorded for this caller, such as \"jsonrpc:user@127.0.0.1:50000\"\n3. since     (numeric, optional) Only return entries recorded at or after this unix time\n4. count     (numeric, optional) Only return the most recent count matching entries\n\nResult:\n[{\n \"seq\": n,             (numeric) Sequence number of the entry\n \"time\": n,            (numeric) Unix time the entry was recorded\n \"caller\": \"value\",    (string)  The client which invoked the operation\n \"operation\": \"value\", (string)  The invoked method\n \"params\": {           (object)  Parameters of the method, with secrets redacted\n  \"parameter\": value, (object) Parameters of the method keyed by name, with secrets redacted\n  ...\n }\n \"error\": \"value\",    (string) Error message if the operation failed\n \"prevhash\": \"value\", (string) Hash of the previous entry\n \"hash\": \"value\",     (string) Hash committing to this entry and all previous entries\n},...]\n",
 		"getbalance":              "getbalance (\"account\" minconf=1)\n\nCalculates and returns the balance of all accounts.\n\nArguments:\n1. account (string, optional)             The account name to query the balance for, or \"*\" to consider all accounts (default=\"*\")\n2. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an unspent output's value is included in the balance\n\nResult:\n{\n \"balances\": [{                         (array of object) Balances for all accounts.\n  \"accountname\": \"value\",               (string)          Name of account.\n  \"immaturecoinbaserewards\": n.nnn,     (numeric)         Immature Coinbase reward coins.\n  \"immaturestakegeneration\": n.nnn,     (numeric)         Number of immature stake coins.\n  \"lockedbytickets\": n.nnn,             (numeric)         Coins locked by tickets.\n  \"spendable\": n.nnn,                   (numeric)         Spendable number of coins.\n  \"total\": n.nnn,                       (numeric)         Total amount of coins.\n  \"unconfirmed\": n.nnn,                 (numeric)         Unconfirmed number of coins.\n  \"votingauthority\": n.nnn,             (numeric)         Coins for voting authority.\n },...],                                                  \n \"blockhash\": \"value\",                  (string)          Block hash.\n \"totalimmaturecoinbaserewards\": n.nnn, (numeric)         Total number of immature coinbase reward coins.\n \"totalimmaturestakegeneration\": n.nnn, (numeric)         Total number of immature stake coins.\n \"totallockedbytickets\": n.nnn,         (numeric)         Total number of coins locked by tickets.\n \"totalspendable\": n.nnn,               (numeric)         Total number of spendable number of coins.\n \"cumulativetotal\": n.nnn,              (numeric)         Total number of coins.\n \"totalunconfirmed\": n.nnn,             (numeric)         Total number of unconfirmed coins.\n \"totalvotingauthority\": n.nnn,         (numeric)         Total number of coins for voting authority.\n}                                       \n",
 		"getbestblockhash":        "getbestblockhash\n\nReturns the hash of the newest block in the best chain that wallet has finished syncing with.\n\nArguments:\nNone\n\nResult:\n\"value\" (string) The hash of the most recent synced-to block\n",
 		"getbestblock":            "getbestblock\n\nReturns the hash and height of the newest block in the best chain that wallet has finished syncing with.\n\nArguments:\nNone\n\nResult:\n{\n \"hash\": \"value\", (string)  The hash of the block\n \"height\": n,     (numeric) The blockchain height of the block\n}                 \n",
 		"getblockcount":           "getblockcount\n\nReturns the blockchain height of the newest block in the best chain that wallet has finished syncing with.\n\nArguments:\nNone\n\nResult:\nn.nnn (numeric) The blockchain height of the most recent synced-to block\n",
 		"getblockhash":            "getblockhash index\n\nReturns the hash of a main chain block at some height\n\nArguments:\n1. index (numeric, required) The block height\n\nResult:\n\"value\" (string) The main chain block hash\n",
 		"getinfo":                 "getinfo\n\nReturns a JSON object containing various state info.\n\nArguments:\nNone\n\nResult:\n{\n \"version\": n,          (numeric) The version of the server\n \"protocolversion\": n,  (numeric) The latest supported protocol version\n \"walletversion\": n,    (numeric) The version of the address manager database\n \"balance\": n.nnn,      (numeric) The balance of all accounts calculated with one block confirmation\n \"blocks\": n,           (numeric) The number of blocks processed\n \"timeoffset\": n,       (numeric) The time offset\n \"connections\": n,      (numeric) The number of connected peers\n \"proxy\": \"value\",      (string)  The proxy used by the server\n \"difficulty\": n.nnn,   (numeric) The current target difficulty\n \"testnet\": true|false, (boolean) Whether or not server is using testnet\n \"keypoololdest\": n,    (numeric) Unset\n \"keypoolsize\": n,      (numeric) Unset\n \"unlocked_until\": n,   (numeric) Unset\n \"paytxfee\": n.nnn,     (numeric) The fee per kB of the serialized tx size used each time more fee is required for an authored transaction\n \"relayfee\": n.nnn,     (numeric) The minimum relay fee for non-free transactions in DCR/KB\n \"errors\": \"value\",     (string)  Any current errors\n}                       \n",
+		"getinvoice":              "getinvoice id (minconf=1)\n\nReturns an invoice and the status of its payments.\n\nArguments:\n1. id      (numeric, required)            The invoice ID\n2. minconf (numeric, optional, default=1) Minimum number of block confirmations required before a payment is counted as received\n\nResult:\n{\n \"id\": n,             (numeric)         The invoice ID\n \"account\": \"value\",  (string)          The account of the invoice address\n \"address\": \"value\",  (string)          The address to pay\n \"amount\": n.nnn,     (numeric)         The requested amount in DCR, or zero if any amount is accepted\n \"memo\": \"value\",     (string)          Description of the payment\n \"created\": n,        (numeric)         The Unix time the invoice was created\n \"expires\": n,        (numeric)         The Unix time the invoice expires, or zero if it never expires\n \"received\": n.nnn,   (numeric)         The total of payments with at least minconf confirmations in DCR\n \"pending\": n.nnn,    (numeric)         The total of payments with fewer than minconf confirmations in DCR\n \"status\": \"value\",   (string)          The invoice status: \"unpaid\", \"partiallypaid\", \"paid\", \"overpaid\", or \"expired\"\n \"payments\": [{       (array of object) Transaction outputs paying the invoice address\n  \"txid\": \"value\",    (string)          The hash of the paying transaction\n  \"vout\": n,          (numeric)         The output index of the payment\n  \"amount\": n.nnn,    (numeric)         The amount paid in DCR\n  \"confirmations\": n, (numeric)         The number of block confirmations of the paying transaction\n  \"blockheight\": n,   (numeric)         The height of the block mining the paying transaction, or -1 if unmined\n },...],                                \n \"uri\": \"value\",      (string)          The decred: payment URI requesting payment of the invoice\n}                     \n",
+		"getlabel":                "getlabel \"target\"\n\nReturns the label of an address, transaction or transaction output, or an empty string if it is unlabeled.\n\nArguments:\n1. target (string, required) Labeled address, transaction hash, or transaction output in the form \"txid:index\"\n\nResult:\n\"value\" (string) The label\n",
 		"getmasterpubkey":         "getmasterpubkey (\"account\")\n\nRequests the master pubkey from the wallet.\n\nArguments:\n1. account (string, optional) The account to get the master pubkey for\n\nResult:\n\"value\" (string) The master pubkey for the wallet\n",
 		"getmultisigoutinfo":      "getmultisigoutinfo \"hash\" index\n\nReturns information about a multisignature output.\n\nArguments:\n1. hash  (string, required)  Input hash to check.\n2. index (numeric, required) Index of input.\n\nResult:\n{\n \"address\": \"value\",       (string)          Script address.\n \"redeemscript\": \"value\",  (string)          Hex of the redeeming script.\n \"m\": n,                   (numeric)         m (in m-of-n)\n \"n\": n,                   (numeric)         n (in m-of-n)\n \"pubkeys\": [\"value\",...], (array of string) Associated pubkeys.\n \"txhash\": \"value\",        (string)          txhash\n \"blockheight\": n,         (numeric)         Height of the containing block.\n \"blockhash\": \"value\",     (string)          Hash of the containing block.\n \"spent\": true|false,      (boolean)         If it has been spent.\n \"spentby\": \"value\",       (string)          Hash of spending tx.\n \"spentbyindex\": n,        (numeric)         Index of spending tx.\n \"amount\": n.nnn,          (numeric)         Amount of coins contained.\n}                          \n",
 		"getnewaddress":           "getnewaddress (\"account\" \"gappolicy\")\n\nGenerates and returns a new payment address.\n\nArguments:\n1. account   (string, optional) Account name the new address will belong to (default=\"default\")\n2. gappolicy (string, optional) String defining the policy to use when the BIP0044 gap limit would be violated, may be \"error\", \"ignore\", or \"wrap\"\n\nResult:\n\"value\" (string) The payment address\n",
@@ -39,32 +56,42 @@ func helpDescsEnUS() map[string]string {
 		"getvotechoices":          "getvotechoices\n\nRetrieve the currently configured vote choices for the latest supported stake agendas\n\nArguments:\nNone\n\nResult:\n{\n \"version\": n,                  (numeric)         The latest stake version supported by the software and the version of the included agendas\n \"choices\": [{                  (array of object) The currently configured agenda vote choices, including abstaining votes\n  \"agendaid\": \"value\",          (string)          The ID for the agenda the choice concerns\n  \"agendadescription\": \"value\", (string)          A description of the agenda the choice concerns\n  \"choiceid\": \"value\",          (string)          The ID of the current choice for this agenda\n  \"choicedescription\": \"value\", (string)          A description of the current choice for this agenda\n },...],                                          \n}                               \n",
 		"getwalletfee":            "getwalletfee\n\nGet currently set transaction fee for the wallet\n\nArguments:\nNone\n\nResult:\nn.nnn (numeric) Current tx fee (in DCR)\n",
 		"help":                    "help (\"command\")\n\nReturns a list of all commands or help for a specified command.\n\nArguments:\n1. command (string, optional) The command to retrieve help for\n\nResult (no command provided):\n\"value\" (string) List of commands\n\nResult (command specified):\n\"value\" (string) Help for specified command\n",
+		"importdescriptoraccount": "importdescriptoraccount \"name\" \"descriptor\"\n\nCreates a watching-only account deriving addresses from a ranged output script descriptor.\nSupported descriptors are pkh(KEY), sh(multi(k,KEY,...)) and sh(sortedmulti(k,KEY,...)), where KEY is a hex public key or an extended public key with non-hardened derivation steps ending in /*.\nAddresses through the gap limit are watched immediately; a rescan is required to discover previous transactions.\n\nArguments:\n1. name       (string, required) Name of the new account\n2. descriptor (string, required) Output script descriptor, e.g. sh(sortedmulti(2,xpubA/0/*,xpubB/0/*,xpubC/0/*))\n\nResult:\nn (numeric) The account number of the new account\n",
 		"importprivkey":           "importprivkey \"privkey\" (\"label\" rescan=true scanfrom)\n\nImports a WIF-encoded private key to the 'imported' account.\n\nArguments:\n1. privkey  (string, required)                The WIF-encoded private key\n2. label    (string, optional)                Unused (must be unset or 'imported')\n3. rescan   (boolean, optional, default=true) Rescan the blockchain (since the genesis block, or scanfrom block) for outputs controlled by the imported key\n4. scanfrom (numeric, optional)               Block number for where to start rescan from\n\nResult:\nNothing\n",
 		"importscript":            "importscript \"hex\" (rescan=true scanfrom)\n\nImport a redeem script.\n\nArguments:\n1. hex      (string, required)                Hex encoded script to import\n2. rescan   (boolean, optional, default=true) Rescans the blockchain (since the genesis block, or scanfrom block) for outputs controlled by the imported key\n3. scanfrom (numeric, optional)               Block number for where to start rescan from\n\nResult:\nNothing\n",
+		"importwallet":            "importwallet \"filename\" (rescan=true scanfrom)\n\nRestores the wallet state of a file created by dumpwallet.\nThe wallet must be created from the same seed as the exporting wallet and must be unlocked.\n\nArguments:\n1. filename (string, required)                Path of the file created by dumpwallet\n2. rescan   (boolean, optional, default=true) Rescan the blockchain (since the genesis block, or scanfrom block) for outputs controlled by imported keys and scripts\n3. scanfrom (numeric, optional)               Block number for where to start rescan from\n\nResult:\nNothing\n",
 		"importxpub":              "importxpub \"name\" \"xpub\"\n\nImport a HD extended public key as a new account.\n\nArguments:\n1. name (string, required) Name of new account\n2. xpub (string, required) Extended public key\n\nResult:\nNothing\n",
 		"mixaccount":              "mixaccount\n\nMix all outputs of an account.\n\nArguments:\nNone\n\nResult:\nNothing\n",
 		"mixoutput":               "mixoutput \"outpoint\"\n\nMix a specific output.\n\nArguments:\n1. outpoint (string, required) Outpoint (in form \"txhash:index\") to mix\n\nResult:\nNothing\n",
 		"listaccounts":            "listaccounts (minconf=1)\n\nDEPRECATED -- Returns a JSON object of all accounts and their balances.\n\nArguments:\n1. minconf (numeric, optional, default=1) Minimum number of block confirmations required before an unspent output's value is included in the balance\n\nResult:\n{\n \"The account name\": The account balance valued in decred, (object) JSON object with account names as keys and decred amounts as values\n ...\n}\n",
-		"listaddresstransactions": "listaddresstransactions [\"address\",...] (\"account\")\n\nReturns a JSON array of objects containing verbose details for wallet transactions pertaining some addresses.\n\nArguments:\n1. addresses (array of string, required) Addresses to filter transaction results by\n2. account   (string, optional)          Unused (must be unset or \"*\")\n\nResult:\n[{\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in decred\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n},...]\n",
-		"listalltransactions":     "listalltransactions (\"account\")\n\nReturns a JSON array of objects in the same format as 'listtransactions' without limiting the number of returned objects.\n\nArguments:\n1. account (string, optional) Unused (must be unset or \"*\")\n\nResult:\n[{\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in decred\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n},...]\n",
+		"listaddresstransactions": "listaddresstransactions [\"address\",...] (\"account\")\n\nReturns a JSON array of objects containing verbose details for wallet transactions pertaining some addresses.\n\nArguments:\n1. addresses (array of string, required) Addresses to filter transaction results by\n2. account   (string, optional)          Unused (must be unset or \"*\")\n\nResult:\n[{\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in decred\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n \"label\": \"value\",                 (string)          The label of the output, its transaction, or its address, if any\n},...]\n",
+		"listalltransactions":     "listalltransactions (\"account\")\n\nReturns a JSON array of objects in the same format as 'listtransactions' without limiting the number of returned objects.\n\nArguments:\n1. account (string, optional) Unused (must be unset or \"*\")\n\nResult:\n[{\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in decred\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n \"label\": \"value\",                 (string)          The label of the output, its transaction, or its address, if any\n},...]\n",
+		"listdescriptoraccounts":  "listdescriptoraccounts\n\nReturns all descriptor accounts.\n\nArguments:\nNone\n\nResult:\n[{\n \"account\": n,          (numeric) The account number\n \"name\": \"value\",       (string)  The account name\n \"descriptor\": \"value\", (string)  The canonical output script descriptor of the account\n \"usedcount\": n,        (numeric) The number of child addresses through the last used child\n \"returnedcount\": n,    (numeric) The number of child addresses through the last returned child\n},...]\n",
+		"listinvoices":            "listinvoices (\"status\" minconf=1)\n\nReturns every invoice and the status of its payments.\n\nArguments:\n1. status  (string, optional)             If set, limits the returned invoices to a single status: \"unpaid\", \"partiallypaid\", \"paid\", \"overpaid\", or \"expired\"\n2. minconf (numeric, optional, default=1) Minimum number of block confirmations required before a payment is counted as received\n\nResult:\n[{\n \"id\": n,             (numeric)         The invoice ID\n \"account\": \"value\",  (string)          The account of the invoice address\n \"address\": \"value\",  (string)          The address to pay\n \"amount\": n.nnn,     (numeric)         The requested amount in DCR, or zero if any amount is accepted\n \"memo\": \"value\",     (string)          Description of the payment\n \"created\": n,        (numeric)         The Unix time the invoice was created\n \"expires\": n,        (numeric)         The Unix time the invoice expires, or zero if it never expires\n \"received\": n.nnn,   (numeric)         The total of payments with at least minconf confirmations in DCR\n \"pending\": n.nnn,    (numeric)         The total of payments with fewer than minconf confirmations in DCR\n \"status\": \"value\",   (string)          The invoice status: \"unpaid\", \"partiallypaid\", \"paid\", \"overpaid\", or \"expired\"\n \"payments\": [{       (array of object) Transaction outputs paying the invoice address\n  \"txid\": \"value\",    (string)          The hash of the paying transaction\n  \"vout\": n,          (numeric)         The output index of the payment\n  \"amount\": n.nnn,    (numeric)         The amount paid in DCR\n  \"confirmations\": n, (numeric)         The number of block confirmations of the paying transaction\n  \"blockheight\": n,   (numeric)         The height of the block mining the paying transaction, or -1 if unmined\n },...],                                \n \"uri\": \"value\",      (string)          The decred: payment URI requesting payment of the invoice\n},...]\n",
+		"listlabels":              "listlabels (\"kind\")\n\nReturns all labels of addresses, transactions and transaction outputs.\n\nArguments:\n1. kind (string, optional) If set, limits the returned labels to a single kind: \"address\", \"transaction\", or \"output\"\n\nResult:\n[{\n \"kind\": \"value\",   (string) The kind of the labeled target: \"address\", \"transaction\", or \"output\"\n \"target\": \"value\", (string) The labeled address, transaction hash, or transaction output in the form \"txid:index\"\n \"label\": \"value\",  (string) The label\n},...]\n",
 		"listlockunspent":         "listlockunspent\n\nReturns a JSON array of outpoints marked as locked (with lockunspent) for this wallet session.\n\nArguments:\nNone\n\nResult:\n[{\n \"amount\": n.nnn, (numeric) The the previous output amount\n \"txid\": \"value\", (string)  The transaction hash of the referenced output\n \"vout\": n,       (numeric) The output index of the referenced output\n \"tree\": n,       (numeric) The tree to generate transaction for\n},...]\n",
 		"listreceivedbyaccount":   "listreceivedbyaccount (minconf=1 includeempty=false includewatchonly=false)\n\nReturns a JSON array of objects listing all accounts and the total amount received by each account.\n\nArguments:\n1. minconf          (numeric, optional, default=1)     Minimum number of block confirmations required before a transaction is considered\n2. includeempty     (boolean, optional, default=false) Unused\n3. includewatchonly (boolean, optional, default=false) Unused\n\nResult:\n[{\n \"account\": \"value\", (string)  The name of the account\n \"amount\": n.nnn,    (numeric) Total amount received by payment addresses of the account valued in decred\n \"confirmations\": n, (numeric) Number of block confirmations of the most recent transaction relevant to the account\n},...]\n",
 		"listreceivedbyaddress":   "listreceivedbyaddress (minconf=1 includeempty=false includewatchonly=false)\n\nReturns a JSON array of objects listing wallet payment addresses and their total received amounts.\n\nArguments:\n1. minconf          (numeric, optional, default=1)     Minimum number of block confirmations required before a transaction is considered\n2. includeempty     (boolean, optional, default=false) Unused\n3. includewatchonly (boolean, optional, default=false) Unused\n\nResult:\n[{\n \"account\": \"value\",              (string)          DEPRECATED -- Unset\n \"address\": \"value\",              (string)          The payment address\n \"amount\": n.nnn,                 (numeric)         Total amount received by the payment address valued in decred\n \"confirmations\": n,              (numeric)         Number of block confirmations of the most recent transaction relevant to the address\n \"txids\": [\"value\",...],          (array of string) Transaction hashes of all transactions involving this address\n \"involvesWatchonly\": true|false, (boolean)         Unset\n},...]\n",
 		"listscripts":             "listscripts\n\nList all scripts that have been added to wallet\n\nArguments:\nNone\n\nResult:\n{\n \"scripts\": [{             (array of object) A list of the imported scripts\n  \"hash160\": \"value\",      (string)          The script hash\n  \"address\": \"value\",      (string)          The script address\n  \"redeemscript\": \"value\", (string)          The redeem script\n },...],                                     \n}                          \n",
-		"listsinceblock":          "listsinceblock (\"blockhash\" targetconfirmations=1 includewatchonly=false)\n\nReturns a JSON array of objects listing details of all wallet transactions after some block.\n\nArguments:\n1. blockhash           (string, optional)                 Hash of the parent block of the first block to consider transactions from, or unset to list all transactions\n2. targetconfirmations (numeric, optional, default=1)     Minimum number of block confirmations of the last block in the result object.  Must be 1 or greater.  Note: The transactions array in the result object is not affected by this parameter\n3. includewatchonly    (boolean, optional, default=false) Unused\n\nResult:\n{\n \"transactions\": [{                 (array of object) JSON array of objects containing verbose details of the each transaction\n  \"account\": \"value\",               (string)          DEPRECATED -- Unset\n  \"address\": \"value\",               (string)          Payment address for a transaction output\n  \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in decred\n  \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n  \"blockindex\": n,                  (numeric)         Unset\n  \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n  \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n  \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n  \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n  \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n  \"involveswatchonly\": true|false,  (boolean)         Unset\n  \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n  \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n  \"txid\": \"value\",                  (string)          The hash of the transaction\n  \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n  \"vout\": n,                        (numeric)         The transaction output index\n  \"walletconflicts\": [\"value\",...], (array of string) Unset\n  \"comment\": \"value\",               (string)          Unset\n  \"otheraccount\": \"value\",          (string)          Unset\n },...],                                              \n \"lastblock\": \"value\",              (string)          Hash of the latest-synced block to be used in later calls to listsinceblock\n}                                   \n",
-		"listtransactions":        "listtransactions (\"account\" count=10 from=0 includewatchonly=false)\n\nReturns a JSON array of objects containing verbose details for wallet transactions.\n\nArguments:\n1. account          (string, optional)                 DEPRECATED -- Unused (must be unset or \"*\")\n2. count            (numeric, optional, default=10)    Maximum number of transactions to create results from\n3. from             (numeric, optional, default=0)     Number of transactions to skip before results are created\n4. includewatchonly (boolean, optional, default=false) Unused\n\nResult:\n[{\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in decred\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n},...]\n",
-		"listunspent":             "listunspent (minconf=1 maxconf=9999999 [\"address\",...])\n\nReturns a JSON array of objects representing unlocked unspent outputs controlled by wallet keys.\n\nArguments:\n1. minconf   (numeric, optional, default=1)       Minimum number of block confirmations required before a transaction output is considered\n2. maxconf   (numeric, optional, default=9999999) Maximum number of block confirmations required before a transaction output is excluded\n3. addresses (array of string, optional)          If set, limits the returned details to unspent outputs received by any of these payment addresses\n\nResult:\n{\n \"txid\": \"value\",         (string)  The transaction hash of the referenced output\n \"vout\": n,               (numeric) The output index of the referenced output\n \"tree\": n,               (numeric) The tree the transaction comes from\n \"txtype\": n,             (numeric) The type of the transaction\n \"address\": \"value\",      (string)  The payment address that received the output\n \"account\": \"value\",      (string)  The account associated with the receiving payment address\n \"scriptPubKey\": \"value\", (string)  The output script encoded as a hexadecimal string\n \"redeemScript\": \"value\", (string)  Unset\n \"amount\": n.nnn,         (numeric) The amount of the output valued in decred\n \"confirmations\": n,      (numeric) The number of block confirmations of the transaction\n \"spendable\": true|false, (boolean) Whether the output is entirely controlled by wallet keys/scripts (false for partially controlled multisig outputs or outputs to watch-only addresses)\n}                         \n",
+		"listsinceblock":          "listsinceblock (\"blockhash\" targetconfirmations=1 includewatchonly=false)\n\nReturns a JSON array of objects listing details of all wallet transactions after some block.\n\nArguments:\n1. blockhash           (string, optional)                 Hash of the parent block of the first block to consider transactions from, or unset to list all transactions\n2. targetconfirmations (numeric, optional, default=1)     Minimum number of block confirmations of the last block in the result object.  Must be 1 or greater.  Note: The transactions array in the result object is not affected by this parameter\n3. includewatchonly    (boolean, optional, default=false) Unused\n\nResult:\n{\n \"transactions\": [{                 (array of object) JSON array of objects containing verbose details of the each transaction\n  \"account\": \"value\",               (string)          DEPRECATED -- Unset\n  \"address\": \"value\",               (string)          Payment address for a transaction output\n  \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in decred\n  \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n  \"blockindex\": n,                  (numeric)         Unset\n  \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n  \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n  \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n  \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n  \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n  \"involveswatchonly\": true|false,  (boolean)         Unset\n  \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n  \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n  \"txid\": \"value\",                  (string)          The hash of the transaction\n  \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n  \"vout\": n,                        (numeric)         The transaction output index\n  \"walletconflicts\": [\"value\",...], (array of string) Unset\n  \"comment\": \"value\",               (string)          Unset\n  \"otheraccount\": \"value\",          (string)          Unset\n  \"label\": \"value\",                 (string)          The label of the output, its transaction, or its address, if any\n },...],                                              \n \"lastblock\": \"value\",              (string)          Hash of the latest-synced block to be used in later calls to listsinceblock\n}                                   \n",
+		"listtransactions":        "listtransactions (\"account\" count=10 from=0 includewatchonly=false)\n\nReturns a JSON array of objects containing verbose details for wallet transactions.\n\nArguments:\n1. account          (string, optional)                 DEPRECATED -- Unused (must be unset or \"*\")\n2. count            (numeric, optional, default=10)    Maximum number of transactions to create results from\n3. from             (numeric, optional, default=0)     Number of transactions to skip before results are created\n4. includewatchonly (boolean, optional, default=false) Unused\n\nResult:\n[{\n \"account\": \"value\",               (string)          DEPRECATED -- Unset\n \"address\": \"value\",               (string)          Payment address for a transaction output\n \"amount\": n.nnn,                  (numeric)         The value of the transaction output valued in decred\n \"blockhash\": \"value\",             (string)          The hash of the block this transaction is mined in, or the empty string if unmined\n \"blockindex\": n,                  (numeric)         Unset\n \"blocktime\": n,                   (numeric)         The Unix time of the block header this transaction is mined in, or 0 if unmined\n \"category\": \"value\",              (string)          The kind of transaction: \"send\" for sent transactions, \"immature\" for immature coinbase outputs, \"generate\" for mature coinbase outputs, or \"recv\" for all other received outputs.  Note: A single output may be included multiple times under different categories\n \"confirmations\": n,               (numeric)         The number of block confirmations of the transaction\n \"fee\": n.nnn,                     (numeric)         The total input value minus the total output value for sent transactions\n \"generated\": true|false,          (boolean)         Whether the transaction output is a coinbase output\n \"involveswatchonly\": true|false,  (boolean)         Unset\n \"time\": n,                        (numeric)         The earliest Unix time this transaction was known to exist\n \"timereceived\": n,                (numeric)         The earliest Unix time this transaction was known to exist\n \"txid\": \"value\",                  (string)          The hash of the transaction\n \"txtype\": \"value\",                (string)          The type of tx (regular tx, stake tx)\n \"vout\": n,                        (numeric)         The transaction output index\n \"walletconflicts\": [\"value\",...], (array of string) Unset\n \"comment\": \"value\",               (string)          Unset\n \"otheraccount\": \"value\",          (string)          Unset\n \"label\": \"value\",                 (string)          The label of the output, its transaction, or its address, if any\n},...]\n",
+		"listunspent":             "listunspent (minconf=1 maxconf=9999999 [\"address\",...])\n\nReturns a JSON array of objects representing unlocked unspent outputs controlled by wallet keys.\n\nArguments:\n1. minconf   (numeric, optional, default=1)       Minimum number of block confirmations required before a transaction output is considered\n2. maxconf   (numeric, optional, default=9999999) Maximum number of block confirmations required before a transaction output is excluded\n3. addresses (array of string, optional)          If set, limits the returned details to unspent outputs received by any of these payment addresses\n\nResult:\n{\n \"txid\": \"value\",         (string)  The transaction hash of the referenced output\n \"vout\": n,               (numeric) The output index of the referenced output\n \"tree\": n,               (numeric) The tree the transaction comes from\n \"txtype\": n,             (numeric) The type of the transaction\n \"address\": \"value\",      (string)  The payment address that received the output\n \"account\": \"value\",      (string)  The account associated with the receiving payment address\n \"scriptPubKey\": \"value\", (string)  The output script encoded as a hexadecimal string\n \"redeemScript\": \"value\", (string)  Unset\n \"amount\": n.nnn,         (numeric) The amount of the output valued in decred\n \"confirmations\": n,      (numeric) The number of block confirmations of the transaction\n \"spendable\": true|false, (boolean) Whether the output is entirely controlled by wallet keys/scripts (false for partially controlled multisig outputs or outputs to watch-only addresses)\n \"label\": \"value\",        (string)  The label of the output, its transaction, or its address, if any\n}                         \n",
+		"listvsptickets":          "listvsptickets\n\nReturns the fee payment state of every ticket purchased through a fee-based VSP.\n\nArguments:\nNone\n\nResult:\n[{\n \"tickethash\": \"value\", (string)  The hash of the ticket\n \"vsp\": \"value\",        (string)  The base URL of the VSP voting the ticket\n \"feeaccount\": \"value\", (string)  The account paying the fee\n \"state\": \"value\",      (string)  The state of the fee payment: \"unpaid\", \"addressed\", \"paid\", \"confirmed\", or \"abandoned\"\n \"feeaddress\": \"value\", (string)  The address the fee is paid to, omitted until requested from the VSP\n \"feeamount\": n.nnn,    (numeric) The fee amount in DCR, omitted until requested from the VSP\n \"expiration\": n,       (numeric) The Unix time after which the fee address can no longer be paid\n \"feetxhash\": \"value\",  (string)  The hash of the transaction paying the fee, omitted until created\n \"attempts\": n,         (numeric) The number of consecutive failed attempts to progress the fee payment\n \"lasterror\": \"value\",  (string)  The error of the last failed attempt\n},...]\n",
 		"lockunspent":             "lockunspent unlock [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...]\n\nLocks or unlocks an unspent output.\nLocked outputs are not chosen for transaction inputs of authored transactions and are not included in 'listunspent' results.\nLocked outputs are volatile and are not saved across wallet restarts.\nIf unlock is true and no transaction outputs are specified, all locked outputs are marked unlocked.\n\nArguments:\n1. unlock       (boolean, required)         True to unlock outputs, false to lock\n2. transactions (array of object, required) Transaction outputs to lock or unlock\n[{\n \"amount\": n.nnn, (numeric) The the previous output amount\n \"txid\": \"value\", (string)  The transaction hash of the referenced output\n \"vout\": n,       (numeric) The output index of the referenced output\n \"tree\": n,       (numeric) The tree to generate transaction for\n},...]\n\nResult:\ntrue|false (boolean) The boolean 'true'\n",
+		"parsepaymenturi":         "parsepaymenturi \"uri\"\n\nDecodes a decred: payment URI.\n\nArguments:\n1. uri (string, required) The payment URI\n\nResult:\n{\n \"address\": \"value\", (string)  The address to pay\n \"amount\": n.nnn,    (numeric) The requested amount in DCR, omitted if no amount is requested\n \"label\": \"value\",   (string)  The name of the recipient\n \"message\": \"value\", (string)  Description of the payment\n \"invoice\": \"value\", (string)  Identifier of the invoice being paid\n}                    \n",
 		"purchaseticket":          "purchaseticket \"fromaccount\" spendlimit (minconf=1 \"ticketaddress\" numtickets \"pooladdress\" poolfees expiry \"comment\" ticketfee)\n\nPurchase ticket using available funds.\n\nArguments:\n1.  fromaccount   (string, required)             The account to use for purchase (default=\"default\")\n2.  spendlimit    (numeric, required)            Limit on the amount to spend on ticket\n3.  minconf       (numeric, optional, default=1) Minimum number of block confirmations required\n4.  ticketaddress (string, optional)             Override the ticket address to which voting rights are given\n5.  numtickets    (numeric, optional)            The number of tickets to purchase\n6.  pooladdress   (string, optional)             The address to pay stake pool fees to\n7.  poolfees      (numeric, optional)            The amount of fees to pay to the stake pool\n8.  expiry        (numeric, optional)            Height at which the purchase tickets expire\n9.  comment       (string, optional)             Unused\n10. ticketfee     (numeric, optional)            The transaction fee rate (DCR/kB) to use (overrides fees set by the wallet config or settxfee RPC)\n\nResult:\n\"value\" (string) Hash of the resulting ticket\n",
+		"purchasevspticket":       "purchasevspticket \"fromaccount\" (numtickets=1 minconf=1 expiry)\n\nPurchase tickets voted by the fee-based VSP configured with --vspurl and pay their fees from the account.\n\nArguments:\n1. fromaccount (string, required)             The account purchasing the tickets, paying their fees, and voting them\n2. numtickets  (numeric, optional, default=1) The number of tickets to purchase\n3. minconf     (numeric, optional, default=1) Minimum number of block confirmations required by spent outputs\n4. expiry      (numeric, optional)            Height at which the purchased tickets expire\n\nResult:\n[\"value\",...] (array of string) Hashes of the purchased tickets\n",
 		"redeemmultisigout":       "redeemmultisigout \"hash\" index tree (\"address\")\n\nTakes the input and constructs a P2PKH paying to the specified address.\n\nArguments:\n1. hash    (string, required)  Hash of the input transaction\n2. index   (numeric, required) Idx of the input transaction\n3. tree    (numeric, required) Tree the transaction is on.\n4. address (string, optional)  Address to pay to.\n\nResult:\n{\n \"hex\": \"value\",         (string)          Resulting hash.\n \"complete\": true|false, (boolean)         Shows if opperation was completed.\n \"errors\": [{            (array of object) Any errors generated.\n  \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n  \"vout\": n,             (numeric)         The output index of the referenced previous output\n  \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n  \"sequence\": n,         (numeric)         Script sequence number\n  \"error\": \"value\",      (string)          Verification or signing error related to the input\n },...],                                   \n}                        \n",
 		"redeemmultisigouts":      "redeemmultisigouts \"fromscraddress\" (\"toaddress\" number)\n\nTakes a hash, looks up all unspent outpoints and generates list artially signed transactions spending to either an address specified or internal addresses\n\nArguments:\n1. fromscraddress (string, required)  Input script hash address.\n2. toaddress      (string, optional)  Address to look for (if not internal addresses).\n3. number         (numeric, optional) Number of outpoints found.\n\nResult:\n{\n \"hex\": \"value\",         (string)          Resulting hash.\n \"complete\": true|false, (boolean)         Shows if opperation was completed.\n \"errors\": [{            (array of object) Any errors generated.\n  \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n  \"vout\": n,             (numeric)         The output index of the referenced previous output\n  \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n  \"sequence\": n,         (numeric)         Script sequence number\n  \"error\": \"value\",      (string)          Verification or signing error related to the input\n },...],                                   \n}                        \n",
 		"renameaccount":           "renameaccount \"oldaccount\" \"newaccount\"\n\nRenames an account.\n\nArguments:\n1. oldaccount (string, required) The old account name to rename\n2. newaccount (string, required) The new name for the account\n\nResult:\nNothing\n",
 		"rescanwallet":            "rescanwallet (beginheight=0)\n\nRescan the block chain for wallet data, blocking until the rescan completes or exits with an error\n\nArguments:\n1. beginheight (numeric, optional, default=0) The height of the first block to begin the rescan from\n\nResult:\nNothing\n",
 		"revoketickets":           "revoketickets\n\nRequests the wallet create revovactions for any previously missed tickets.  Wallet must be unlocked.\n\nArguments:\nNone\n\nResult:\nNothing\n",
 		"sendfrom":                "sendfrom \"fromaccount\" \"toaddress\" amount (minconf=1 \"comment\" \"commentto\")\n\nAuthors, signs, and sends a transaction that outputs some amount to a payment address.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. fromaccount (string, required)             Account to pick unspent outputs from\n2. toaddress   (string, required)             Address to pay\n3. amount      (numeric, required)            Amount to send to the payment address valued in decred\n4. minconf     (numeric, optional, default=1) Minimum number of block confirmations required before a transaction output is eligible to be spent\n5. comment     (string, optional)             Unused\n6. commentto   (string, optional)             Unused\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
-		"sendmany":                "sendmany \"fromaccount\" {\"address\":amount,...} (minconf=1 \"comment\")\n\nAuthors, signs, and sends a transaction that outputs to many payment addresses.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. fromaccount (string, required) Account to pick unspent outputs from\n2. amounts     (object, required) Pairs of payment addresses and the output amount to pay each\n{\n \"Address to pay\": Amount to send to the payment address valued in decred, (object) JSON object using payment addresses as keys and output amounts valued in decred to send to each address\n ...\n}\n3. minconf (numeric, optional, default=1) Minimum number of block confirmations required before a transaction output is eligible to be spent\n4. comment (string, optional)             Unused\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
-		"sendtoaddress":           "sendtoaddress \"address\" amount (\"comment\" \"commentto\")\n\nAuthors, signs, and sends a transaction that outputs some amount to a payment address.\nUnlike sendfrom, outputs are always chosen from the default account.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. address   (string, required)  Address to pay\n2. amount    (numeric, required) Amount to send to the payment address valued in decred\n3. comment   (string, optional)  Unused\n4. commentto (string, optional)  Unused\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
+		"sendmany":                "sendmany \"fromaccount\" {\"address\":amount,...} (minconf=1 \"comment\" \"selectionalgorithm\")\n\nAuthors, signs, and sends a transaction that outputs to many payment addresses.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. fromaccount (string, required) Account to pick unspent outputs from\n2. amounts     (object, required) Pairs of payment addresses and the output amount to pay each\n{\n \"Address to pay\": Amount to send to the payment address valued in decred, (object) JSON object using payment addresses as keys and output amounts valued in decred to send to each address\n ...\n}\n3. minconf            (numeric, optional, default=1) Minimum number of block confirmations required before a transaction output is eligible to be spent\n4. comment            (string, optional)             Unused\n5. selectionalgorithm (string, optional)             Output selection algorithm (\"default\", \"all\", \"largestfirst\", \"smallestfirst\", \"oldestfirst\", \"branchandbound\" to avoid change outputs, or \"avoidmixing\" to never spend mixed and unmixed outputs together)\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
+		"sendtoaddress":           "sendtoaddress \"address\" amount (\"comment\" \"commentto\")\n\nAuthors, signs, and sends a transaction that outputs some amount to a payment address.\nUnlike sendfrom, outputs are always chosen from the default account.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. address   (string, required)  Address to pay, or a decred: payment URI\n2. amount    (numeric, required) Amount to send to the payment address valued in decred, or zero to pay the amount requested by a payment URI\n3. comment   (string, optional)  Unused\n4. commentto (string, optional)  Unused\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
 		"sendtomultisig":          "sendtomultisig \"fromaccount\" amount [\"pubkey\",...] (nrequired=1 minconf=1 \"comment\")\n\nAuthors, signs, and sends a transaction that outputs some amount to a multisig address.\nUnlike sendfrom, outputs are always chosen from the default account.\nA change output is automatically included to send extra output value back to the original account.\n\nArguments:\n1. fromaccount (string, required)             Unused\n2. amount      (numeric, required)            Amount to send to the payment address valued in decred\n3. pubkeys     (array of string, required)    Pubkey to send to.\n4. nrequired   (numeric, optional, default=1) The number of signatures required to redeem outputs paid to this address\n5. minconf     (numeric, optional, default=1) Minimum number of block confirmations required\n6. comment     (string, optional)             Unused\n\nResult:\n\"value\" (string) The transaction hash of the sent transaction\n",
+		"setaccountpassphrase":    "setaccountpassphrase \"account\" \"passphrase\"\n\nEncrypts the private keys of an account with a passphrase separate from the wallet passphrase.\nThe account must then be unlocked with walletpassphrase, after unlocking the wallet, before its keys can sign transactions.\nAn empty passphrase removes the account passphrase. The wallet, and an account with a passphrase, must be unlocked.\nThe account passphrase restricts the use of the account keys by the wallet, but does not protect them cryptographically:\nthe wallet passphrase still decrypts the coin type key from which every account key is derived.\n\nArguments:\n1. account    (string, required) Account to encrypt\n2. passphrase (string, required) The new account passphrase, or an empty string to remove the passphrase\n\nResult:\nNothing\n",
+		"setlabel":                "setlabel \"target\" \"label\"\n\nAttaches a label to an address, transaction or transaction output, replacing any previous label.\n\nArguments:\n1. target (string, required) Address, transaction hash, or transaction output in the form \"txid:index\" to label\n2. label  (string, required) The label, or an empty string to remove the label\n\nResult:\nNothing\n",
 		"setticketfee":            "setticketfee fee\n\nModify the fee per kB of the serialized tx size used each time more fee is required for an authored stake transaction.\n\nArguments:\n1. fee (numeric, required) The new fee per kB of the serialized tx size valued in decred\n\nResult:\ntrue|false (boolean) The boolean 'true'\n",
 		"settxfee":                "settxfee amount\n\nModify the fee per kB of the serialized tx size used each time more fee is required for an authored transaction.\n\nArguments:\n1. amount (numeric, required) The new fee per kB of the serialized tx size valued in decred\n\nResult:\ntrue|false (boolean) The boolean 'true'\n",
 		"setvotechoice":           "setvotechoice \"agendaid\" \"choiceid\"\n\nSets choices for defined agendas in the latest stake version supported by this software\n\nArguments:\n1. agendaid (string, required) The ID for the agenda to modify\n2. choiceid (string, required) The ID for the choice to choose\n\nResult:\nNothing\n",
@@ -72,16 +99,19 @@ func helpDescsEnUS() map[string]string {
 		"signrawtransaction":      "signrawtransaction \"rawtx\" ([{\"txid\":\"value\",\"vout\":n,\"tree\":n,\"scriptpubkey\":\"value\",\"redeemscript\":\"value\"},...] [\"privkey\",...] flags=\"ALL\")\n\nSigns transaction inputs using private keys from this wallet and request.\nThe valid flags options are ALL, NONE, SINGLE, ALL|ANYONECANPAY, NONE|ANYONECANPAY, and SINGLE|ANYONECANPAY.\n\nArguments:\n1. rawtx    (string, required)                Unsigned or partially unsigned transaction to sign encoded as a hexadecimal string\n2. inputs   (array of object, optional)       Additional data regarding inputs that this wallet may not be tracking\n3. privkeys (array of string, optional)       Additional WIF-encoded private keys to use when creating signatures\n4. flags    (string, optional, default=\"ALL\") Sighash flags\n\nResult:\n{\n \"hex\": \"value\",         (string)          The resulting transaction encoded as a hexadecimal string\n \"complete\": true|false, (boolean)         Whether all input signatures have been created\n \"errors\": [{            (array of object) Script verification errors (if exists)\n  \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n  \"vout\": n,             (numeric)         The output index of the referenced previous output\n  \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n  \"sequence\": n,         (numeric)         Script sequence number\n  \"error\": \"value\",      (string)          Verification or signing error related to the input\n },...],                                   \n}                        \n",
 		"signrawtransactions":     "signrawtransactions [\"rawtx\",...] (send=true)\n\nSigns transaction inputs using private keys from this wallet and request for a list of transactions.\n\n\nArguments:\n1. rawtxs (array of string, required)       A list of transactions to sign (and optionally send).\n2. send   (boolean, optional, default=true) Set true to send the transactions after signing.\n\nResult:\n{\n \"results\": [{             (array of object) Returned values from the signrawtransactions command.\n  \"signingresult\": {       (object)          Success or failure of signing.\n   \"hex\": \"value\",         (string)          The resulting transaction encoded as a hexadecimal string\n   \"complete\": true|false, (boolean)         Whether all input signatures have been created\n   \"errors\": [{            (array of object) Script verification errors (if exists)\n    \"txid\": \"value\",       (string)          The transaction hash of the referenced previous output\n    \"vout\": n,             (numeric)         The output index of the referenced previous output\n    \"scriptSig\": \"value\",  (string)          The hex-encoded signature script\n    \"sequence\": n,         (numeric)         Script sequence number\n    \"error\": \"value\",      (string)          Verification or signing error related to the input\n   },...],                                   \n  },                                         \n  \"sent\": true|false,      (boolean)         Tells if the transaction was sent.\n  \"txhash\": \"value\",       (string)          The hash of the signed tx.\n },...],                                     \n}                          \n",
 		"stakepooluserinfo":       "stakepooluserinfo \"user\"\n\nGet user info for stakepool\n\nArguments:\n1. user (string, required) The id of the user to be looked up\n\nResult:\n{\n \"tickets\": [{             (array of object) A list of valid tickets that the user has added\n  \"status\": \"value\",       (string)          The current status of the added ticket\n  \"ticket\": \"value\",       (string)          The hash of the added ticket\n  \"ticketheight\": n,       (numeric)         The height in which the ticket was added\n  \"spentby\": \"value\",      (string)          The vote in which the ticket was spent\n  \"spentbyheight\": n,      (numeric)         The height in which the ticket was spent\n },...],                                     \n \"invalid\": [\"value\",...], (array of string) A list of invalid tickets that the user has added\n}                          \n",
+		"stakerewardestimate":     "stakerewardestimate\n\nEstimates the chance of voting, the average vote time and the return of a ticket purchased at the next block.\nReturns are the vote subsidy expected at the average vote height and do not include transaction fees.\n\nArguments:\nNone\n\nResult:\n{\n \"ticketprice\": n.nnn,       (numeric) The price of a ticket purchased at the next block\n \"poolsize\": n,              (numeric) The live ticket pool size used for the estimate\n \"voteprobability\": n.nnn,   (numeric) The probability of the ticket being called to vote before it expires\n \"expiryprobability\": n.nnn, (numeric) The probability of the ticket expiring without voting\n \"averagevoteblocks\": n.nnn, (numeric) The average number of blocks from purchase until the ticket votes\n \"averagevotetime\": n,       (numeric) The average number of seconds from purchase until the ticket votes\n \"votereward\": n.nnn,        (numeric) The vote subsidy at the average vote height\n \"roi\": n.nnn,               (numeric) The expected reward, accounting for the chance of expiry, as a proportion of the ticket price\n \"annualroi\": n.nnn,         (numeric) The expected return scaled to one year by the average time the ticket price is locked\n}                            \n",
 		"sweepaccount":            "sweepaccount \"sourceaccount\" \"destinationaddress\" (requiredconfirmations feeperkb)\n\nMoves as much value as possible in a transaction from an account.\n\n\nArguments:\n1. sourceaccount         (string, required)  The account to be swept.\n2. destinationaddress    (string, required)  The destination address to pay to.\n3. requiredconfirmations (numeric, optional) The minimum utxo confirmation requirement (optional).\n4. feeperkb              (numeric, optional) The minimum relay fee policy (optional).\n\nResult:\n{\n \"unsignedtransaction\": \"value\",     (string)  The hex encoded string of the unsigned transaction.\n \"totalpreviousoutputamount\": n.nnn, (numeric) The total transaction input amount.\n \"totaloutputamount\": n.nnn,         (numeric) The total transaction output amount.\n \"estimatedsignedsize\": n,           (numeric) The estimated size of the transaction when signed.\n}                                    \n",
+		"ticketreport":            "ticketreport (report=\"tickets\" format=\"json\" \"account\" period=\"month\")\n\nReports the lifecycle of every ticket with commitments controlled by the wallet, or summarizes their profitability by account and period.\nThe reward of a ticket is the amount returned by its vote or revocation in excess of the wallet-controlled commitments, and its profit is the reward less the purchase and VSP fees.\nSummaries include tickets in the period they were voted or revoked, or the period they were purchased while unspent.\n\nArguments:\n1. report  (string, optional, default=\"tickets\") The report: \"tickets\" for the lifecycle of each ticket, or \"summary\" for profitability summaries\n2. format  (string, optional, default=\"json\")    The output format: \"csv\" or \"json\"\n3. account (string, optional)                    If set, limits the report to tickets of a single account\n4. period  (string, optional, default=\"month\")   The summary period: \"all\", \"day\", \"week\" (beginning Monday), \"month\", or \"year\"\n\nResult (format is \"csv\"):\n\"value\" (string) CSV text with a header row, times in RFC 3339 format and amounts in DCR\n\nResult (report is \"tickets\" and format is \"json\"):\n[{\n \"tickethash\": \"value\",  (string)  The ticket hash\n \"account\": \"value\",     (string)  The account of the first wallet-controlled commitment\n \"status\": \"value\",      (string)  The ticket status: \"unmined\", \"immature\", \"live\", \"expired\", \"voted\", or \"revoked\"\n \"price\": n.nnn,         (numeric) The ticket price in DCR\n \"purchasefee\": n.nnn,   (numeric) The transaction fee of the ticket purchase in DCR\n \"commitment\": n.nnn,    (numeric) The total of the wallet-controlled commitments in DCR\n \"vspfee\": n.nnn,        (numeric) The total of the commitments paid to other parties and the fee paid to a fee-based VSP in DCR\n \"purchaseheight\": n,    (numeric) The height of the block mining the ticket, or -1 if unmined\n \"purchasetime\": n,      (numeric) The Unix time of the block mining the ticket, or of when the ticket was received if unmined\n \"maturityheight\": n,    (numeric) The height at which the ticket is live, or -1 if unmined\n \"spenderhash\": \"value\", (string)  The hash of the vote or revocation, if spent\n \"spenderheight\": n,     (numeric) The height of the block mining the vote or revocation, if spent and mined\n \"spendertime\": n,       (numeric) The Unix time of the vote or revocation, if spent\n \"daystovote\": n.nnn,    (numeric) The days from purchase until vote, if voted\n \"subsidy\": n.nnn,       (numeric) The vote subsidy in DCR\n \"reward\": n.nnn,        (numeric) The amount returned in excess of the wallet-controlled commitments in DCR\n \"profit\": n.nnn,        (numeric) The reward less the purchase and VSP fees in DCR\n},...]\n\nResult (report is \"summary\" and format is \"json\"):\n[{\n \"account\": \"value\",         (string)  The account name\n \"periodstart\": n,           (numeric) The Unix time of the UTC start of the period, omitted for period \"all\"\n \"tickets\": n,               (numeric) The number of tickets\n \"voted\": n,                 (numeric) The number of voted tickets\n \"revoked\": n,               (numeric) The number of revoked tickets\n \"unspent\": n,               (numeric) The number of unspent tickets\n \"invested\": n.nnn,          (numeric) The total ticket price in DCR\n \"purchasefees\": n.nnn,      (numeric) The total purchase fees in DCR\n \"vspfees\": n.nnn,           (numeric) The total VSP fees in DCR\n \"subsidy\": n.nnn,           (numeric) The total vote subsidy in DCR\n \"rewards\": n.nnn,           (numeric) The total rewards in DCR\n \"profit\": n.nnn,            (numeric) The total profit in DCR\n \"averagedaystovote\": n.nnn, (numeric) The average days from purchase until vote of the voted tickets\n},...]\n",
 		"ticketsforaddress":       "ticketsforaddress \"address\"\n\nRequest all the tickets for an address.\n\nArguments:\n1. address (string, required) Address to look for.\n\nResult:\ntrue|false (boolean) Tickets owned by the specified address.\n",
 		"validateaddress":         "validateaddress \"address\"\n\nVerify that an address is valid.\nExtra details are returned if the address is controlled by this wallet.\nThe following fields are valid only when the address is controlled by this wallet (ismine=true): isscript, pubkey, iscompressed, account, addresses, hex, script, and sigsrequired.\nThe following fields are only valid when address has an associated public key: pubkey, iscompressed.\nThe following fields are only valid when address is a pay-to-script-hash address: addresses, hex, and script.\nIf the address is a multisig address controlled by this wallet, the multisig fields will be left unset if the wallet is locked since the redeem script cannot be decrypted.\n\nArguments:\n1. address (string, required) Address to validate\n\nResult:\n{\n \"isvalid\": true|false,      (boolean)         Whether or not the address is valid\n \"address\": \"value\",         (string)          The payment address (only when isvalid is true)\n \"ismine\": true|false,       (boolean)         Whether this address is controlled by the wallet (only when isvalid is true)\n \"iswatchonly\": true|false,  (boolean)         Unset\n \"isscript\": true|false,     (boolean)         Whether the payment address is a pay-to-script-hash address (only when isvalid is true)\n \"pubkeyaddr\": \"value\",      (string)          The pubkey for this payment address (only when isvalid is true)\n \"pubkey\": \"value\",          (string)          The associated public key of the payment address, if any (only when isvalid is true)\n \"iscompressed\": true|false, (boolean)         Whether the address was created by hashing a compressed public key, if any (only when isvalid is true)\n \"account\": \"value\",         (string)          The account this payment address belongs to (only when isvalid is true)\n \"addresses\": [\"value\",...], (array of string) All associated payment addresses of the script if address is a multisig address (only when isvalid is true)\n \"hex\": \"value\",             (string)          The redeem script \n \"script\": \"value\",          (string)          The class of redeem script for a multisig address\n \"sigsrequired\": n,          (numeric)         The number of required signatures to redeem outputs to the multisig address\n}                            \n",
 		"verifymessage":           "verifymessage \"address\" \"signature\" \"message\"\n\nVerify a message was signed with the associated private key of some address.\n\nArguments:\n1. address   (string, required) Address used to sign message\n2. signature (string, required) The signature to verify\n3. message   (string, required) The message to verify\n\nResult:\ntrue|false (boolean) Whether the message was signed with the private key of 'address'\n",
 		"version":                 "version\n\nReturns application and API versions (semver) keyed by their names\n\nArguments:\nNone\n\nResult:\n{\n \"Program or API name\": Object containing the semantic version, (object) Version objects keyed by the program or API name\n ...\n}\n",
 		"walletinfo":              "walletinfo\n\nReturns global information about the wallet\n\nArguments:\nNone\n\nResult:\n{\n \"daemonconnected\": true|false,  (boolean) Whether or not the wallet is currently connected to the daemon RPC\n \"unlocked\": true|false,         (boolean) Whether or not the wallet is unlocked\n \"cointype\": n,                  (numeric) Active coin type. Not available for watching-only wallets.\n \"txfee\": n.nnn,                 (numeric) Transaction fee per kB of the serialized tx size in coins\n \"ticketfee\": n.nnn,             (numeric) Ticket fee per kB of the serialized tx size in coins\n \"ticketpurchasing\": true|false, (boolean) Whether or not the wallet is currently purchasing tickets\n \"votebits\": n,                  (numeric) Vote bits setting\n \"votebitsextended\": \"value\",    (string)  Extended vote bits setting\n \"voteversion\": n,               (numeric) Version of votes that will be generated\n \"voting\": true|false,           (boolean) Whether or not the wallet is currently voting tickets\n}                                \n",
 		"walletislocked":          "walletislocked\n\nReturns whether or not the wallet is locked.\n\nArguments:\nNone\n\nResult:\ntrue|false (boolean) Whether the wallet is locked\n",
-		"walletlock":              "walletlock\n\nLock the wallet.\n\nArguments:\nNone\n\nResult:\nNothing\n",
+		"walletlock":              "walletlock (\"account\")\n\nLock the wallet, or only an account encrypted by its own passphrase.\n\nArguments:\n1. account (string, optional) Account to lock instead of the wallet\n\nResult:\nNothing\n",
 		"walletpassphrasechange":  "walletpassphrasechange \"oldpassphrase\" \"newpassphrase\"\n\nChange the wallet passphrase.\n\nArguments:\n1. oldpassphrase (string, required) The old wallet passphrase\n2. newpassphrase (string, required) The new wallet passphrase\n\nResult:\nNothing\n",
-		"walletpassphrase":        "walletpassphrase \"passphrase\" timeout\n\nUnlock the wallet.\n\nArguments:\n1. passphrase (string, required)  The wallet passphrase\n2. timeout    (numeric, required) The number of seconds to wait before the wallet automatically locks\n\nResult:\nNothing\n",
+		"walletpassphrase":        "walletpassphrase \"passphrase\" timeout (\"account\")\n\nUnlock the wallet, or an account encrypted by its own passphrase after unlocking the wallet.\n\nArguments:\n1. passphrase (string, required)  The wallet passphrase, or the account passphrase when an account is specified\n2. timeout    (numeric, required) The number of seconds to wait before the wallet or account automatically locks\n3. account    (string, optional)  Account to unlock with its own passphrase instead of the wallet\n\nResult:\nNothing\n",
+		"walletprocesspsbt":       "walletprocesspsbt \"psbt\" (sign=true sighashtype=\"ALL\")\n\nUpdates a partially signed transaction with previous outputs, redeem scripts and key derivations known by the wallet, optionally signs inputs with wallet keys, and finalizes all inputs which have collected enough signatures. Packets describing a previous output differently than the wallet are rejected.\n\nArguments:\n1. psbt        (string, required)                The base64-encoded partially signed transaction\n2. sign        (boolean, optional, default=true) Add signatures for inputs spending outputs controlled by wallet keys (requires an unlocked wallet)\n3. sighashtype (string, optional, default=\"ALL\") Sighash flags (ALL, NONE, SINGLE and combinations with ANYONECANPAY)\n\nResult:\n{\n \"psbt\": \"value\",        (string)  The updated base64-encoded partially signed transaction\n \"complete\": true|false, (boolean) Whether all inputs have been finalized\n}                        \n",
 	}
 }
 
@@ -89,4 +119,4 @@ var localeHelpDescs = map[string]func() map[string]string{
 	"en_US": helpDescsEnUS,
 }
 
-var requestUsages = "abandontransaction \"hash\"\naccountaddressindex \"account\" branch\naccountsyncaddressindex \"account\" branch index\naddmultisigaddress nrequired [\"key\",...] (\"account\")\naddticket \"tickethex\"\nauditreuse (since)\nconsolidate inputs (\"account\" \"address\")\ncreatemultisig nrequired [\"key\",...]\ncreatenewaccount \"account\"\ncreaterawtransaction [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...] {\"address\":amount,...} (locktime expiry)\ndumpprivkey \"address\"\ngeneratevote \"blockhash\" height \"tickethash\" votebits \"votebitsext\"\ngetaccountaddress \"account\"\ngetaccount \"address\"\ngetaddressesbyaccount \"account\"\ngetbalance (\"account\" minconf=1)\ngetbestblockhash\ngetbestblock\ngetblockcount\ngetblockhash index\ngetinfo\ngetmasterpubkey (\"account\")\ngetmultisigoutinfo \"hash\" index\ngetnewaddress (\"account\" \"gappolicy\")\ngetrawchangeaddress (\"account\")\ngetreceivedbyaccount \"account\" (minconf=1)\ngetreceivedbyaddress \"address\" (minconf=1)\ngetstakeinfo\ngetticketfee\ngettickets includeimmature\ngettransaction \"txid\" (includewatchonly=false)\ngetunconfirmedbalance (\"account\")\ngetvotechoices\ngetwalletfee\nhelp (\"command\")\nimportprivkey \"privkey\" (\"label\" rescan=true scanfrom)\nimportscript \"hex\" (rescan=true scanfrom)\nimportxpub \"name\" \"xpub\"\nmixaccount\nmixoutput \"outpoint\"\nlistaccounts (minconf=1)\nlistaddresstransactions [\"address\",...] (\"account\")\nlistalltransactions (\"account\")\nlistlockunspent\nlistreceivedbyaccount (minconf=1 includeempty=false includewatchonly=false)\nlistreceivedbyaddress (minconf=1 includeempty=false includewatchonly=false)\nlistscripts\nlistsinceblock (\"blockhash\" targetconfirmations=1 includewatchonly=false)\nlisttransactions (\"account\" count=10 from=0 includewatchonly=false)\nlistunspent (minconf=1 maxconf=9999999 [\"address\",...])\nlockunspent unlock [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...]\npurchaseticket \"fromaccount\" spendlimit (minconf=1 \"ticketaddress\" numtickets \"pooladdress\" poolfees expiry \"comment\" ticketfee)\nredeemmultisigout \"hash\" index tree (\"address\")\nredeemmultisigouts \"fromscraddress\" (\"toaddress\" number)\nrenameaccount \"oldaccount\" \"newaccount\"\nrescanwallet (beginheight=0)\nrevoketickets\nsendfrom \"fromaccount\" \"toaddress\" amount (minconf=1 \"comment\" \"commentto\")\nsendmany \"fromaccount\" {\"address\":amount,...} (minconf=1 \"comment\")\nsendtoaddress \"address\" amount (\"comment\" \"commentto\")\nsendtomultisig \"fromaccount\" amount [\"pubkey\",...] (nrequired=1 minconf=1 \"comment\")\nsetticketfee fee\nsettxfee amount\nsetvotechoice \"agendaid\" \"choiceid\"\nsignmessage \"address\" \"message\"\nsignrawtransaction \"rawtx\" ([{\"txid\":\"value\",\"vout\":n,\"tree\":n,\"scriptpubkey\":\"value\",\"redeemscript\":\"value\"},...] [\"privkey\",...] flags=\"ALL\")\nsignrawtransactions [\"rawtx\",...] (send=true)\nstakepooluserinfo \"user\"\nsweepaccount \"sourceaccount\" \"destinationaddress\" (requiredconfirmations feeperkb)\nticketsforaddress \"address\"\nvalidateaddress \"address\"\nverifymessage \"address\" \"signature\" \"message\"\nversion\nwalletinfo\nwalletislocked\nwalletlock\nwalletpassphrasechange \"oldpassphrase\" \"newpassphrase\"\nwalletpassphrase \"passphrase\" timeout"
+var requestUsages = "abandontransaction \"hash\"\naccountaddressindex \"account\" branch\naccountsyncaddressindex \"account\" branch index\naddmultisigaddress nrequired [\"key\",...] (\"account\")\naddticket \"tickethex\"\nauditreuse (since)\nbackupwallet \"destination\"\nbakemacaroon ([\"method\",...] \"role\" expiry \"account\" maxspend)\nbumpfee \"txhash\" (feerate)\ncombinepsbt [\"psbt\",...]\nconsolidate inputs (\"account\" \"address\")\ncpfp \"txhash\" (feerate \"account\")\ncreateinvoice \"account\" amount (\"memo\" expiry \"gappolicy\")\ncreatemultisig nrequired [\"key\",...]\ncreatemultisigaccount \"name\" nrequired [\"xpub\",...]\ncreatemultisigaccounttx \"account\" {\"address\":amount,...} (minconf=1)\ncreatenewaccount \"account\"\ncreatepsbt [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...] {\"address\":amount,...} (locktime expiry)\ncreaterawtransaction [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...] {\"address\":amount,...} (locktime expiry)\ndumpprivkey \"address\"\ndumpwallet \"filename\"\nestimatesmartfee confirmations (mode=\"conservative\")\nestimatestakediff (tickets)\nexporthistory (format=\"csv\" startheight=0 endheight=-1 \"account\" labels=false balances=false)\nfinalizepsbt \"psbt\" (extract=true)\ngeneratevote \"blockhash\" height \"tickethash\" votebits \"votebitsext\"\ngetaccountaddress \"account\"\ngetaccount \"address\"\ngetaddressesbyaccount \"account\"\ngetauditlog (\"operation\" \"caller\" since count)\ngetbalance (\"account\" minconf=1)\ngetbestblockhash\ngetbestblock\ngetblockcount\ngetblockhash index\ngetinfo\ngetinvoice id (minconf=1)\ngetlabel \"target\"\ngetmasterpubkey (\"account\")\ngetmultisigoutinfo \"hash\" index\ngetnewaddress (\"account\" \"gappolicy\")\ngetrawchangeaddress (\"account\")\ngetreceivedbyaccount \"account\" (minconf=1)\ngetreceivedbyaddress \"address\" (minconf=1)\ngetstakeinfo\ngetticketfee\ngettickets includeimmature\ngettransaction \"txid\" (includewatchonly=false)\ngetunconfirmedbalance (\"account\")\ngetvotechoices\ngetwalletfee\nhelp (\"command\")\nimportdescriptoraccount \"name\" \"descriptor\"\nimportprivkey \"privkey\" (\"label\" rescan=true scanfrom)\nimportscript \"hex\" (rescan=true scanfrom)\nimportwallet \"filename\" (rescan=true scanfrom)\nimportxpub \"name\" \"xpub\"\nmixaccount\nmixoutput \"outpoint\"\nlistaccounts (minconf=1)\nlistaddresstransactions [\"address\",...] (\"account\")\nlistalltransactions (\"account\")\nlistdescriptoraccounts\nlistinvoices (\"status\" minconf=1)\nlistlabels (\"kind\")\nlistlockunspent\nlistreceivedbyaccount (minconf=1 includeempty=false includewatchonly=false)\nlistreceivedbyaddress (minconf=1 includeempty=false includewatchonly=false)\nlistscripts\nlistsinceblock (\"blockhash\" targetconfirmations=1 includewatchonly=false)\nlisttransactions (\"account\" count=10 from=0 includewatchonly=false)\nlistunspent (minconf=1 maxconf=9999999 [\"address\",...])\nlistvsptickets\nlockunspent unlock [{\"amount\":n.nnn,\"txid\":\"value\",\"vout\":n,\"tree\":n},...]\nparsepaymenturi \"uri\"\npurchaseticket \"fromaccount\" spendlimit (minconf=1 \"ticketaddress\" numtickets \"pooladdress\" poolfees expiry \"comment\" ticketfee)\npurchasevspticket \"fromaccount\" (numtickets=1 minconf=1 expiry)\nredeemmultisigout \"hash\" index tree (\"address\")\nredeemmultisigouts \"fromscraddress\" (\"toaddress\" number)\nrenameaccount \"oldaccount\" \"newaccount\"\nrescanwallet (beginheight=0)\nrevoketickets\nsendfrom \"fromaccount\" \"toaddress\" amount (minconf=1 \"comment\" \"commentto\")\nsendmany \"fromaccount\" {\"address\":amount,...} (minconf=1 \"comment\" \"selectionalgorithm\")\nsendtoaddress \"address\" amount (\"comment\" \"commentto\")\nsendtomultisig \"fromaccount\" amount [\"pubkey\",...] (nrequired=1 minconf=1 \"comment\")\nsetaccountpassphrase \"account\" \"passphrase\"\nsetlabel \"target\" \"label\"\nsetticketfee fee\nsettxfee amount\nsetvotechoice \"agendaid\" \"choiceid\"\nsignmessage \"address\" \"message\"\nsignrawtransaction \"rawtx\" ([{\"txid\":\"value\",\"vout\":n,\"tree\":n,\"scriptpubkey\":\"value\",\"redeemscript\":\"value\"},...] [\"privkey\",...] flags=\"ALL\")\nsignrawtransactions [\"rawtx\",...] (send=true)\nstakepooluserinfo \"user\"\nstakerewardestimate\nsweepaccount \"sourceaccount\" \"destinationaddress\" (requiredconfirmations feeperkb)\nticketreport (report=\"tickets\" format=\"json\" \"account\" period=\"month\")\nticketsforaddress \"address\"\nvalidateaddress \"address\"\nverifymessage \"address\" \"signature\" \"message\"\nversion\nwalletinfo\nwalletislocked\nwalletlock (\"account\")\nwalletpassphrasechange \"oldpassphrase\" \"newpassphrase\"\nwalletpassphrase \"passphrase\" timeout (\"account\")\nwalletprocesspsbt \"psbt\" (sign=true sighashtype=\"ALL\")"
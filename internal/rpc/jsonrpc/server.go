@@ -16,11 +16,13 @@ import (
 	"net"
 	"net/http"
 	"runtime/trace"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"decred.org/dcrwallet/internal/loader"
+	"decred.org/dcrwallet/internal/rpc/rpcauth"
 	"github.com/decred/dcrd/chaincfg/v2"
 	"github.com/decred/dcrd/dcrjson/v3"
 	dcrdtypes "github.com/decred/dcrd/rpc/jsonrpc/types"
@@ -65,7 +67,7 @@ type Server struct {
 	httpServer   http.Server
 	walletLoader *loader.Loader
 	listeners    []net.Listener
-	authsha      [sha256.Size]byte
+	users        []authUser
 	upgrader     websocket.Upgrader
 
 	cfg Options
@@ -79,6 +81,17 @@ type Server struct {
 	activeNet *chaincfg.Params
 }
 
+// authUser is a client credential and the role it is authorized for.  The hash
+// of the HTTP basic auth string is used for a constant time comparison.
+// Clients authenticated by a macaroon are further limited by its restrictions.
+type authUser struct {
+	authsha      [sha256.Size]byte
+	username     string
+	role         rpcauth.Role
+	macaroon     *rpcauth.Macaroon
+	restrictions *rpcauth.Restrictions
+}
+
 type handler struct {
 	fn     func(*Server, context.Context, interface{}) (interface{}, error)
 	noHelp bool
@@ -106,9 +119,6 @@ func NewServer(opts *Options, activeNet *chaincfg.Params, walletLoader *loader.L
 		walletLoader: walletLoader,
 		cfg:          *opts,
 		listeners:    listeners,
-		// A hash of the HTTP basic auth string is used for a constant
-		// time comparison.
-		authsha: sha256.Sum256(httpBasicAuth(opts.Username, opts.Password)),
 		upgrader: websocket.Upgrader{
 			// Allow all origins.
 			CheckOrigin: func(r *http.Request) bool { return true },
@@ -118,27 +128,44 @@ func NewServer(opts *Options, activeNet *chaincfg.Params, walletLoader *loader.L
 		activeNet:           activeNet,
 	}
 
+	if opts.Username != "" && opts.Password != "" {
+		server.users = append(server.users, authUser{
+			authsha:  sha256.Sum256(httpBasicAuth(opts.Username, opts.Password)),
+			username: opts.Username,
+			role:     rpcauth.Full,
+		})
+	}
+	for _, u := range opts.Users {
+		server.users = append(server.users, authUser{
+			authsha:  sha256.Sum256(httpBasicAuth(u.Username, u.Password)),
+			username: u.Username,
+			role:     u.Role,
+		})
+	}
+
 	serveMux.Handle("/", throttledFn(opts.MaxPOSTClients,
 		func(w http.ResponseWriter, r *http.Request) {
 			w.Header().Set("Connection", "close")
 			w.Header().Set("Content-Type", "application/json")
 			r.Close = true
 
-			if err := server.checkAuthHeader(r); err != nil {
+			user, err := server.checkAuthHeader(r)
+			if err != nil {
 				log.Warnf("Failed authentication attempt from client %s",
 					r.RemoteAddr)
 				jsonAuthFail(w)
 				return
 			}
 			server.wg.Add(1)
-			server.postClientRPC(w, r)
+			server.postClientRPC(w, r, user)
 			server.wg.Done()
 		}))
 
 	serveMux.Handle("/ws", throttledFn(opts.MaxWebsocketClients,
 		func(w http.ResponseWriter, r *http.Request) {
 			authenticated := false
-			switch server.checkAuthHeader(r) {
+			user, err := server.checkAuthHeader(r)
+			switch err {
 			case nil:
 				authenticated = true
 			case errNoAuth:
@@ -159,6 +186,9 @@ func NewServer(opts *Options, activeNet *chaincfg.Params, walletLoader *loader.L
 				return
 			}
 			ctx := withRemoteAddr(r.Context(), r.RemoteAddr)
+			if authenticated {
+				ctx = withUser(ctx, user)
+			}
 			ctx, cancel := context.WithCancel(ctx)
 			wsc := newWebsocketClient(conn, cancel, authenticated)
 			server.websocketClientRPC(ctx, wsc)
@@ -240,29 +270,107 @@ func (s *Server) Stop() {
 // known) and handled accordingly.
 func (s *Server) handlerClosure(ctx context.Context, request *dcrjson.Request) lazyHandler {
 	log.Infof("RPC method %v invoked by %v", request.Method, remoteAddr(ctx))
-	return lazyApplyHandler(s, ctx, request)
+	if jsonErr := checkPermission(ctx, request.Method); jsonErr != nil {
+		return func() (interface{}, *dcrjson.RPCError) {
+			return nil, jsonErr
+		}
+	}
+	if jsonErr := checkRequestPermission(ctx, request); jsonErr != nil {
+		return func() (interface{}, *dcrjson.RPCError) {
+			return nil, jsonErr
+		}
+	}
+	ctx, err := s.restrict(ctx, request)
+	if err != nil {
+		log.Warnf("RPC method %v denied to %v@%v: %v", request.Method, user(ctx),
+			remoteAddr(ctx), err)
+		return func() (interface{}, *dcrjson.RPCError) {
+			return nil, convertError(err)
+		}
+	}
+	f := lazyApplyHandler(s, ctx, request)
+	if _, ok := auditedMethods[request.Method]; ok && s.cfg.AuditLog != nil {
+		f = s.audited(ctx, request, f)
+	}
+	return f
+}
+
+// checkPermission returns an error if the role of the authenticated client, or
+// the macaroon it authenticated with, does not allow calling a method.
+func checkPermission(ctx context.Context, method string) *dcrjson.RPCError {
+	err := role(ctx).CheckMethod(method)
+	if _, r := macaroon(ctx); err == nil && r != nil {
+		err = r.CheckMethod(method, time.Now())
+	}
+	if err != nil {
+		log.Warnf("RPC method %v denied to %v@%v: %v", method, user(ctx),
+			remoteAddr(ctx), err)
+		return rpcError(dcrjson.ErrRPCMisc, err)
+	}
+	return nil
+}
+
+// checkRequestPermission returns an error if the role of the authenticated
+// client, or the macaroon it authenticated with, does not allow calling a
+// method with the arguments of a request.  Requests which can not be parsed
+// are rejected by the method handler.
+func checkRequestPermission(ctx context.Context, request *dcrjson.Request) *dcrjson.RPCError {
+	if !rpcauth.RequestChecked(request.Method) {
+		return nil
+	}
+	cmd, err := dcrjson.ParseParams(types.Method(request.Method), request.Params)
+	if err != nil {
+		return nil
+	}
+	err = role(ctx).CheckRequest(request.Method, cmd)
+	if _, r := macaroon(ctx); err == nil && r != nil {
+		err = r.CheckRequest(request.Method, cmd)
+	}
+	if err != nil {
+		log.Warnf("RPC method %v denied to %v@%v: %v", request.Method, user(ctx),
+			remoteAddr(ctx), err)
+		return rpcError(dcrjson.ErrRPCMisc, err)
+	}
+	return nil
 }
 
 // errNoAuth represents an error where authentication could not succeed
 // due to a missing Authorization HTTP header.
 var errNoAuth = errors.E("missing Authorization header")
 
-// checkAuthHeader checks the HTTP Basic authentication supplied by a client
-// in the HTTP request r.
+// checkAuthHeader checks the HTTP Basic or macaroon bearer authentication
+// supplied by a client in the HTTP request r and returns the authenticated
+// user.
 //
 // The authentication comparison is time constant.
-func (s *Server) checkAuthHeader(r *http.Request) error {
+func (s *Server) checkAuthHeader(r *http.Request) (*authUser, error) {
 	authhdr := r.Header["Authorization"]
 	if len(authhdr) == 0 {
-		return errNoAuth
+		return nil, errNoAuth
+	}
+	if strings.HasPrefix(authhdr[0], "Bearer ") {
+		return s.macaroonUser(strings.TrimPrefix(authhdr[0], "Bearer "))
 	}
 
-	authsha := sha256.Sum256([]byte(authhdr[0]))
-	cmp := subtle.ConstantTimeCompare(authsha[:], s.authsha[:])
-	if cmp != 1 {
-		return errors.New("invalid Authorization header")
+	user := s.authUser([]byte(authhdr[0]))
+	if user == nil {
+		return nil, errors.New("invalid Authorization header")
 	}
-	return nil
+	return user, nil
+}
+
+// authUser returns the user authenticated by the HTTP basic auth string auth,
+// or nil if no user matches.  Every user is compared in constant time so the
+// matching user is not revealed by timing.
+func (s *Server) authUser(auth []byte) *authUser {
+	authsha := sha256.Sum256(auth)
+	var user *authUser
+	for i := range s.users {
+		if subtle.ConstantTimeCompare(authsha[:], s.users[i].authsha[:]) == 1 {
+			user = &s.users[i]
+		}
+	}
+	return user
 }
 
 // throttledFn wraps an http.HandlerFunc with throttling of concurrent active
@@ -301,23 +409,21 @@ func idPointer(id interface{}) (p *interface{}) {
 	return
 }
 
-// invalidAuth checks whether a websocket request is a valid (parsable)
+// websocketAuth checks whether a websocket request is a valid (parsable)
 // authenticate request and checks the supplied username and passphrase
-// against the server auth.
-func (s *Server) invalidAuth(req *dcrjson.Request) bool {
+// against the server users.  The authenticated user is returned, or nil if
+// authentication failed.
+func (s *Server) websocketAuth(req *dcrjson.Request) *authUser {
 	cmd, err := dcrjson.ParseParams(types.Method(req.Method), req.Params)
 	if err != nil {
-		return false
+		return nil
 	}
 	authCmd, ok := cmd.(*dcrdtypes.AuthenticateCmd)
 	if !ok {
-		return false
+		return nil
 	}
 	// Check credentials.
-	login := authCmd.Username + ":" + authCmd.Passphrase
-	auth := "Basic " + base64.StdEncoding.EncodeToString([]byte(login))
-	authSha := sha256.Sum256([]byte(auth))
-	return subtle.ConstantTimeCompare(authSha[:], s.authsha[:]) != 1
+	return s.authUser(httpBasicAuth(authCmd.Username, authCmd.Passphrase))
 }
 
 func (s *Server) websocketClientRead(ctx context.Context, wsc *websocketClient) {
@@ -379,17 +485,19 @@ out:
 			if req.Method == "authenticate" {
 				log.Infof("RPC method authenticate invoked by %s",
 					remoteAddr(ctx))
-				switch {
-				case wsc.authenticated:
+				if wsc.authenticated {
 					log.Warnf("Multiple authentication attempts from %s",
 						remoteAddr(ctx))
 					break out
-				case s.invalidAuth(&req):
+				}
+				user := s.websocketAuth(&req)
+				if user == nil {
 					log.Warnf("Failed authentication attempt from %s",
 						remoteAddr(ctx))
 					break out
 				}
 				wsc.authenticated = true
+				ctx = withUser(ctx, user)
 				resp := makeResponse(req.ID, nil, nil)
 				// Expected to never fail.
 				mresp, err := json.Marshal(resp)
@@ -411,6 +519,18 @@ out:
 			switch req.Method {
 			case "stop":
 				log.Infof("RPC method stop invoked by %s", remoteAddr(ctx))
+				if jsonErr := checkPermission(ctx, req.Method); jsonErr != nil {
+					mresp, err := json.Marshal(makeResponse(req.ID, nil, jsonErr))
+					// Expected to never fail.
+					if err != nil {
+						panic(err)
+					}
+					err = wsc.send(mresp)
+					if err != nil {
+						break out
+					}
+					continue
+				}
 				resp := makeResponse(req.ID,
 					"dcrwallet stopping.", nil)
 				mresp, err := json.Marshal(resp)
@@ -516,8 +636,9 @@ func (s *Server) websocketClientRPC(ctx context.Context, wsc *websocketClient) {
 const maxRequestSize = 1024 * 1024 * 4
 
 // postClientRPC processes and replies to a JSON-RPC client request.
-func (s *Server) postClientRPC(w http.ResponseWriter, r *http.Request) {
+func (s *Server) postClientRPC(w http.ResponseWriter, r *http.Request, user *authUser) {
 	ctx := withRemoteAddr(r.Context(), r.RemoteAddr)
+	ctx = withUser(ctx, user)
 
 	body := http.MaxBytesReader(w, r.Body, maxRequestSize)
 	rpcRequest, err := ioutil.ReadAll(body)
@@ -568,6 +689,10 @@ func (s *Server) postClientRPC(w http.ResponseWriter, r *http.Request) {
 		return
 	case "stop":
 		log.Infof("RPC method stop invoked by %s", r.RemoteAddr)
+		jsonErr = checkPermission(ctx, req.Method)
+		if jsonErr != nil {
+			break
+		}
 		stop = true
 		res = "dcrwallet stopping"
 	default:
@@ -0,0 +1,437 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package restgateway implements an HTTP/JSON gateway to the gRPC services of
+// the wallet for clients which can not use gRPC.
+//
+// Every method of the services described by rpc/api.proto is served at
+//
+//   /v1/<service>/<method>
+//
+// where service is the lowercase service name without the Service suffix and
+// method is the lowercase method name, for example /v1/wallet/balance.  Request
+// and response messages are encoded with the proto3 JSON mapping.  Requests are
+// forwarded to the gRPC server over a client connection, and are authorized by
+// the gRPC server using the macaroon presented as a bearer token.
+package restgateway
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"decred.org/dcrwallet/internal/rpc/rpcauth"
+	"github.com/decred/dcrwallet/errors/v2"
+	_ "github.com/decred/dcrwallet/rpc/walletrpc" // Registers api.proto
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// maxRequestSize is the maximum size of a request body.
+const maxRequestSize = 1024 * 1024 * 4
+
+// method describes a gRPC method served by the gateway.
+type method struct {
+	path          string
+	fullName      string
+	request       reflect.Type
+	response      reflect.Type
+	clientStreams bool
+	serverStreams bool
+
+	// query is whether the method may be called with GET requests.  Only
+	// methods which do not modify the wallet are queries.
+	query bool
+}
+
+// methods returns the methods of every service described by api.proto.
+func methods() (map[string]*method, error) {
+	gz := proto.FileDescriptor("api.proto")
+	if gz == nil {
+		return nil, errors.E(errors.Bug, "api.proto descriptor is not registered")
+	}
+	r, err := gzip.NewReader(bytes.NewReader(gz))
+	if err != nil {
+		return nil, errors.E(errors.Bug, err)
+	}
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.E(errors.Bug, err)
+	}
+	fd := new(descriptor.FileDescriptorProto)
+	err = proto.Unmarshal(b, fd)
+	if err != nil {
+		return nil, errors.E(errors.Bug, err)
+	}
+
+	methods := make(map[string]*method)
+	for _, svc := range fd.Service {
+		service := strings.ToLower(strings.TrimSuffix(svc.GetName(), "Service"))
+		for _, md := range svc.Method {
+			m := &method{
+				path:          "/v1/" + service + "/" + strings.ToLower(md.GetName()),
+				fullName:      "/" + fd.GetPackage() + "." + svc.GetName() + "/" + md.GetName(),
+				request:       proto.MessageType(strings.TrimPrefix(md.GetInputType(), ".")),
+				response:      proto.MessageType(strings.TrimPrefix(md.GetOutputType(), ".")),
+				clientStreams: md.GetClientStreaming(),
+				serverStreams: md.GetServerStreaming(),
+			}
+			if m.request == nil || m.response == nil {
+				return nil, errors.E(errors.Bug, errors.Errorf("unregistered message types of %s", m.fullName))
+			}
+			switch rpcauth.MethodPermission(m.fullName) {
+			case rpcauth.PermInfo, rpcauth.PermRead:
+				m.query = true
+			}
+			methods[m.path] = m
+		}
+	}
+	return methods, nil
+}
+
+// Server is a REST gateway serving HTTP clients.
+type Server struct {
+	httpServer http.Server
+	conn       *grpc.ClientConn
+	methods    map[string]*method
+	paths      []string
+	wg         sync.WaitGroup
+}
+
+// NewServer creates a gateway forwarding requests to the gRPC server of conn,
+// and serves HTTP clients on listeners.  The client connection is closed when
+// the server is stopped.
+func NewServer(conn *grpc.ClientConn, listeners []net.Listener) (*Server, error) {
+	const op errors.Op = "restgateway.NewServer"
+	methods, err := methods()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	s := &Server{
+		conn:    conn,
+		methods: methods,
+	}
+	for path := range methods {
+		s.paths = append(s.paths, path)
+	}
+	sort.Strings(s.paths)
+	s.httpServer.Handler = s
+	s.httpServer.ReadHeaderTimeout = 10 * time.Second
+	for _, lis := range listeners {
+		lis := lis
+		s.wg.Add(1)
+		go func() {
+			log.Infof("REST gateway listening on %s", lis.Addr())
+			err := s.httpServer.Serve(lis)
+			log.Tracef("Finished serving REST gateway: %v", err)
+			s.wg.Done()
+		}()
+	}
+	return s, nil
+}
+
+// Stop closes the listeners and every client connection, including streams
+// of notifications, and the gRPC client connection.
+func (s *Server) Stop() {
+	s.httpServer.Close()
+	s.wg.Wait()
+	s.conn.Close()
+}
+
+// errorResponse is the body of failed requests.
+type errorResponse struct {
+	Code  codes.Code `json:"code"`
+	Error string     `json:"error"`
+}
+
+// httpStatus returns the HTTP status code of a gRPC status code.
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return http.StatusRequestTimeout
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	w.Header().Set("Content-Type", "application/json")
+	if st.Code() == codes.Unauthenticated {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="dcrwallet REST"`)
+	}
+	w.WriteHeader(httpStatus(st.Code()))
+	json.NewEncoder(w).Encode(&errorResponse{Code: st.Code(), Error: st.Message()})
+}
+
+var marshaler = &jsonpb.Marshaler{OrigName: true, EmitDefaults: true}
+
+// endpoint describes a method in the endpoint listing served at /v1.
+type endpoint struct {
+	Path      string   `json:"path"`
+	Methods   []string `json:"methods"`
+	GRPC      string   `json:"grpc"`
+	Streaming bool     `json:"streaming"`
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.ToLower(strings.TrimSuffix(r.URL.Path, "/"))
+	if path == "/v1" && r.Method == http.MethodGet {
+		endpoints := make([]endpoint, 0, len(s.paths))
+		for _, p := range s.paths {
+			m := s.methods[p]
+			e := endpoint{Path: p, Methods: []string{http.MethodPost}, GRPC: m.fullName, Streaming: m.serverStreams}
+			if m.query {
+				e.Methods = []string{http.MethodGet, http.MethodPost}
+			}
+			endpoints = append(endpoints, e)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(endpoints)
+		return
+	}
+
+	m, ok := s.methods[path]
+	if !ok {
+		writeError(w, status.Errorf(codes.NotFound, "unknown endpoint %s", r.URL.Path))
+		return
+	}
+	switch {
+	case r.Method == http.MethodPost:
+	case r.Method == http.MethodGet && m.query:
+	default:
+		if m.query {
+			w.Header().Set("Allow", "GET, POST")
+		} else {
+			w.Header().Set("Allow", "POST")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		json.NewEncoder(w).Encode(&errorResponse{Code: codes.Unimplemented,
+			Error: "method " + r.Method + " not allowed"})
+		return
+	}
+
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "Bearer ") {
+		writeError(w, status.Error(codes.Unauthenticated, "missing macaroon bearer token"))
+		return
+	}
+	ctx := metadata.AppendToOutgoingContext(r.Context(), "macaroon", strings.TrimPrefix(auth, "Bearer "))
+
+	req, err := m.decodeRequest(w, r)
+	if err != nil {
+		writeError(w, status.Errorf(codes.InvalidArgument, "invalid request: %v", err))
+		return
+	}
+
+	log.Debugf("Forwarding %s %s from %s to %s", r.Method, r.URL.Path, r.RemoteAddr, m.fullName)
+	if m.clientStreams || m.serverStreams {
+		s.stream(ctx, w, m, req)
+		return
+	}
+	res := reflect.New(m.response.Elem()).Interface()
+	err = s.conn.Invoke(ctx, m.fullName, req, res)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	err = marshaler.Marshal(w, res.(proto.Message))
+	if err != nil {
+		log.Errorf("Cannot write %s response to %s: %v", m.fullName, r.RemoteAddr, err)
+	}
+}
+
+// decodeRequest decodes the request message from the query parameters of a GET
+// request or the JSON body of a POST request.
+func (m *method) decodeRequest(w http.ResponseWriter, r *http.Request) (proto.Message, error) {
+	req := reflect.New(m.request.Elem()).Interface().(proto.Message)
+	var body io.Reader
+	if r.Method == http.MethodGet {
+		b, err := m.queryJSON(r)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(b)
+	} else {
+		b, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestSize))
+		if err != nil {
+			return nil, err
+		}
+		if len(bytes.TrimSpace(b)) == 0 {
+			return req, nil
+		}
+		body = bytes.NewReader(b)
+	}
+	err := jsonpb.Unmarshal(body, req)
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// queryJSON converts the query parameters of a request to a JSON object of the
+// request message fields.  Parameters are named by the proto or JSON names of
+// the fields.  Repeated fields may be specified by repeating the parameter.
+func (m *method) queryJSON(r *http.Request) ([]byte, error) {
+	t := m.request.Elem()
+	fields := make(map[string]reflect.StructField)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("protobuf")
+		if tag == "" {
+			continue
+		}
+		var p proto.Properties
+		p.Parse(tag)
+		fields[p.OrigName] = f
+		fields[p.JSONName] = f
+	}
+
+	obj := make(map[string]interface{})
+	for key, values := range r.URL.Query() {
+		f, ok := fields[key]
+		if !ok {
+			return nil, errors.Errorf("unknown parameter %q", key)
+		}
+		switch {
+		case f.Type.Kind() == reflect.Bool:
+			v, err := strconv.ParseBool(values[0])
+			if err != nil {
+				return nil, errors.Errorf("parameter %q: %v", key, err)
+			}
+			obj[key] = v
+		case f.Type.Kind() == reflect.Slice && f.Type.Elem().Kind() != reflect.Uint8:
+			obj[key] = values
+		default:
+			obj[key] = values[0]
+		}
+	}
+	return json.Marshal(obj)
+}
+
+// stream forwards a streaming request, writing each response message as a
+// server-sent event.  The request message is the first and only message sent
+// by the client.  Streams in which the client sends multiple messages are left
+// open until the HTTP client disconnects, so that the server does not observe
+// the end of the client stream.
+func (s *Server) stream(ctx context.Context, w http.ResponseWriter, m *method, req proto.Message) {
+	desc := &grpc.StreamDesc{
+		ServerStreams: m.serverStreams,
+		ClientStreams: m.clientStreams,
+	}
+	stream, err := s.conn.NewStream(ctx, desc, m.fullName)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	err = stream.SendMsg(req)
+	if err != nil && err != io.EOF {
+		writeError(w, err)
+		return
+	}
+	if !m.clientStreams || !m.serverStreams {
+		err = stream.CloseSend()
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+	}
+
+	recv := func() (proto.Message, error) {
+		res := reflect.New(m.response.Elem()).Interface().(proto.Message)
+		err := stream.RecvMsg(res)
+		return res, err
+	}
+
+	// Errors before the first response are reported with the HTTP status.
+	res, err := recv()
+	if err == io.EOF && !m.serverStreams {
+		err = status.Error(codes.Unknown, "stream ended without a response")
+	}
+	if err != nil && err != io.EOF {
+		writeError(w, err)
+		return
+	}
+	if !m.serverStreams {
+		w.Header().Set("Content-Type", "application/json")
+		marshaler.Marshal(w, res)
+		return
+	}
+
+	flusher, _ := w.(http.Flusher)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	if err == io.EOF {
+		return
+	}
+	for {
+		var buf bytes.Buffer
+		err = marshaler.Marshal(&buf, res)
+		if err != nil {
+			return
+		}
+		_, err = io.WriteString(w, "data: "+buf.String()+"\n\n")
+		if err != nil {
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+
+		res, err = recv()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			// Report the error as the final event of the stream,
+			// unless the client disconnected.
+			if ctx.Err() == nil {
+				st := status.Convert(err)
+				b, _ := json.Marshal(&errorResponse{Code: st.Code(), Error: st.Message()})
+				io.WriteString(w, "event: error\ndata: "+string(b)+"\n\n")
+			}
+			return
+		}
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package restgateway
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pb "github.com/decred/dcrwallet/rpc/walletrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type testWalletServer struct {
+	pb.UnimplementedWalletServiceServer
+}
+
+func checkMacaroon(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if v := md.Get("macaroon"); len(v) != 1 || v[0] != "token" {
+		return status.Error(codes.Unauthenticated, "invalid macaroon")
+	}
+	return nil
+}
+
+func (*testWalletServer) Balance(ctx context.Context, req *pb.BalanceRequest) (*pb.BalanceResponse, error) {
+	if err := checkMacaroon(ctx); err != nil {
+		return nil, err
+	}
+	if req.AccountNumber != 3 {
+		return nil, status.Errorf(codes.NotFound, "account %d not found", req.AccountNumber)
+	}
+	return &pb.BalanceResponse{Total: 1e8, Spendable: int64(req.RequiredConfirmations)}, nil
+}
+
+func (*testWalletServer) TransactionNotifications(req *pb.TransactionNotificationsRequest,
+	svr pb.WalletService_TransactionNotificationsServer) error {
+
+	if err := checkMacaroon(svr.Context()); err != nil {
+		return err
+	}
+	for i := 0; i < 2; i++ {
+		err := svr.Send(&pb.TransactionNotificationsResponse{DetachedBlocks: [][]byte{{byte(i)}}})
+		if err != nil {
+			return err
+		}
+	}
+	return status.Error(codes.Unavailable, "wallet shutting down")
+}
+
+func TestGateway(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	grpcServer := grpc.NewServer()
+	pb.RegisterWalletServiceServer(grpcServer, &testWalletServer{})
+	go grpcServer.Serve(lis)
+	defer grpcServer.Stop()
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewServer(conn, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+	srv := httptest.NewServer(s)
+	defer srv.Close()
+
+	do := func(method, path, token, body string) *http.Response {
+		req, err := http.NewRequest(method, srv.URL+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+
+	tests := []struct {
+		name         string
+		method, path string
+		token, body  string
+		status       int
+		response     string
+	}{
+		{"query", "GET", "/v1/wallet/balance?account_number=3&requiredConfirmations=6", "token", "",
+			http.StatusOK, `"spendable":"6"`},
+		{"post", "POST", "/v1/wallet/balance", "token", `{"account_number":3}`,
+			http.StatusOK, `"total":"100000000"`},
+		{"grpc error", "POST", "/v1/wallet/balance", "token", `{"account_number":1}`,
+			http.StatusNotFound, `account 1 not found`},
+		{"unknown parameter", "GET", "/v1/wallet/balance?acct=3", "token", "",
+			http.StatusBadRequest, `unknown parameter`},
+		{"missing token", "GET", "/v1/wallet/balance?account_number=3", "", "",
+			http.StatusUnauthorized, `missing macaroon`},
+		{"invalid token", "GET", "/v1/wallet/balance?account_number=3", "other", "",
+			http.StatusUnauthorized, `invalid macaroon`},
+		{"get of modifying method", "GET", "/v1/wallet/signtransaction", "token", "",
+			http.StatusMethodNotAllowed, `not allowed`},
+		{"unimplemented", "POST", "/v1/wallet/ping", "token", "",
+			http.StatusNotImplemented, `not implemented`},
+		{"unknown endpoint", "GET", "/v1/wallet/nothing", "token", "",
+			http.StatusNotFound, `unknown endpoint`},
+		{"listing", "GET", "/v1", "", "",
+			http.StatusOK, `"grpc":"/walletrpc.VotingService/VoteChoices"`},
+	}
+	for _, test := range tests {
+		resp := do(test.method, test.path, test.token, test.body)
+		b, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != test.status || !strings.Contains(string(b), test.response) {
+			t.Errorf("%s: status %d response %s", test.name, resp.StatusCode, b)
+		}
+	}
+
+	// Streams are served as server-sent events, ending with an error event.
+	resp := do("GET", "/v1/wallet/transactionnotifications", "token", "")
+	defer resp.Body.Close()
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("stream content type %q", ct)
+	}
+	var events []string
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		if line := scanner.Text(); line != "" {
+			events = append(events, line)
+		}
+	}
+	if len(events) != 4 || events[2] != "event: error" {
+		t.Fatalf("unexpected events %q", events)
+	}
+	var n pb.TransactionNotificationsResponse
+	err = json.Unmarshal([]byte(strings.TrimPrefix(events[1], "data: ")), &n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(n.DetachedBlocks) != 1 || n.DetachedBlocks[0][0] != 1 {
+		t.Errorf("unexpected notification %v", events[1])
+	}
+}
@@ -0,0 +1,385 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcauth
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrwallet/errors/v2"
+)
+
+// RootKeySize is the size of the root key authenticating macaroons.
+const RootKeySize = 32
+
+// macaroonVersion is the version of the macaroon encoding.
+const macaroonVersion = 1
+
+// LoadRootKey reads the macaroon root key at path, generating and writing a
+// new random key if the file does not exist.  Removing the file revokes every
+// macaroon minted with the previous key.
+func LoadRootKey(path string) ([]byte, error) {
+	const op errors.Op = "rpcauth.LoadRootKey"
+	key, err := ioutil.ReadFile(path)
+	if err == nil {
+		if len(key) != RootKeySize {
+			return nil, errors.E(op, errors.Invalid, errors.Errorf("%s: root key is not %d bytes",
+				path, RootKeySize))
+		}
+		return key, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, errors.E(op, err)
+	}
+	key = make([]byte, RootKeySize)
+	_, err = rand.Read(key)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	_, err = f.Write(key)
+	if err != nil {
+		f.Close()
+		return nil, errors.E(op, errors.IO, err)
+	}
+	err = f.Close()
+	if err != nil {
+		return nil, errors.E(op, errors.IO, err)
+	}
+	return key, nil
+}
+
+// Caveat is a restriction of a macaroon, encoded as key=value.
+type Caveat string
+
+// Caveat keys.
+const (
+	caveatRole     = "role"
+	caveatMethods  = "methods"
+	caveatExpires  = "expires"
+	caveatAccount  = "account"
+	caveatMaxSpend = "maxspend"
+)
+
+func newCaveat(key, value string) Caveat {
+	return Caveat(key + "=" + value)
+}
+
+// RoleCaveat restricts a macaroon to the methods a role may call.
+func RoleCaveat(r Role) Caveat {
+	return newCaveat(caveatRole, string(r))
+}
+
+// MethodsCaveat restricts a macaroon to a list of JSON-RPC method names or full
+// gRPC method names.
+func MethodsCaveat(methods ...string) Caveat {
+	return newCaveat(caveatMethods, strings.Join(methods, ","))
+}
+
+// ExpiryCaveat restricts a macaroon to requests made before t.
+func ExpiryCaveat(t time.Time) Caveat {
+	return newCaveat(caveatExpires, t.UTC().Format(time.RFC3339))
+}
+
+// AccountCaveat restricts a macaroon to methods operating on a single account.
+func AccountCaveat(account uint32) Caveat {
+	return newCaveat(caveatAccount, strconv.FormatUint(uint64(account), 10))
+}
+
+// MaxSpendCaveat limits the amount spent by each transaction created or
+// published by a request authenticated with a macaroon.
+func MaxSpendCaveat(amount dcrutil.Amount) Caveat {
+	return newCaveat(caveatMaxSpend, strconv.FormatInt(int64(amount), 10))
+}
+
+// Restrictions are the combined caveats of a verified macaroon.  Every caveat
+// must be satisfied, so repeating a caveat can only narrow the restriction.
+type Restrictions struct {
+	roles       []Role
+	methods     []map[string]struct{}
+	expires     time.Time
+	accounts    []uint32
+	maxSpend    dcrutil.Amount
+	hasMaxSpend bool
+}
+
+func (r *Restrictions) add(c Caveat) error {
+	parts := strings.SplitN(string(c), "=", 2)
+	if len(parts) != 2 {
+		return errors.E(errors.Encoding, errors.Errorf("caveat %q is not in the form key=value", c))
+	}
+	key, value := parts[0], parts[1]
+	switch key {
+	case caveatRole:
+		role, err := ParseRole(value)
+		if err != nil {
+			return err
+		}
+		r.roles = append(r.roles, role)
+	case caveatMethods:
+		methods := make(map[string]struct{})
+		for _, m := range strings.Split(value, ",") {
+			if m != "" {
+				methods[m] = struct{}{}
+			}
+		}
+		r.methods = append(r.methods, methods)
+	case caveatExpires:
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return errors.E(errors.Encoding, errors.Errorf("caveat %q: %v", c, err))
+		}
+		if r.expires.IsZero() || t.Before(r.expires) {
+			r.expires = t
+		}
+	case caveatAccount:
+		account, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return errors.E(errors.Encoding, errors.Errorf("caveat %q: %v", c, err))
+		}
+		r.accounts = append(r.accounts, uint32(account))
+	case caveatMaxSpend:
+		amount, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || amount < 0 {
+			return errors.E(errors.Encoding, errors.Errorf("caveat %q: invalid amount", c))
+		}
+		if !r.hasMaxSpend || dcrutil.Amount(amount) < r.maxSpend {
+			r.maxSpend = dcrutil.Amount(amount)
+			r.hasMaxSpend = true
+		}
+	default:
+		// Unknown caveats can not be satisfied.
+		return errors.E(errors.Invalid, errors.Errorf("unknown caveat %q", key))
+	}
+	return nil
+}
+
+// CheckMethod returns a Permission error if the restrictions do not allow
+// calling a method at time now.
+func (r *Restrictions) CheckMethod(method string, now time.Time) error {
+	if !r.expires.IsZero() && !now.Before(r.expires) {
+		return errors.E(errors.Permission, errors.Errorf("macaroon expired at %v",
+			r.expires.Format(time.RFC3339)))
+	}
+	for _, role := range r.roles {
+		if !role.Allowed(method) {
+			return errors.E(errors.Permission, errors.Errorf("macaroon role %q may not call %s", role, method))
+		}
+	}
+	for _, methods := range r.methods {
+		if _, ok := methods[method]; !ok {
+			return errors.E(errors.Permission, errors.Errorf("macaroon may not call %s", method))
+		}
+	}
+	return nil
+}
+
+// CheckRequest returns a Permission error if the role caveats of the
+// restrictions do not allow calling a method with a request.
+func (r *Restrictions) CheckRequest(method string, req interface{}) error {
+	for _, role := range r.roles {
+		if !role.allows(RequestPermission(method, req)) {
+			return errors.E(errors.Permission, errors.Errorf("macaroon role %q may not "+
+				"call %s with these arguments", role, method))
+		}
+	}
+	return nil
+}
+
+// AccountScoped returns whether the restrictions limit requests to a single
+// account.  Servers must then check every account named by a request with
+// CheckAccount, and reject requests which do not name an account unless the
+// method has permission PermInfo.
+func (r *Restrictions) AccountScoped() bool {
+	return len(r.accounts) != 0
+}
+
+// CheckAccount returns a Permission error if the restrictions do not allow
+// operating on an account.
+func (r *Restrictions) CheckAccount(account uint32) error {
+	for _, a := range r.accounts {
+		if a != account {
+			return errors.E(errors.Permission, errors.Errorf("macaroon may not use account %d", account))
+		}
+	}
+	return nil
+}
+
+// SpendLimit returns the maximum amount each transaction created or published
+// by a request may spend, and whether spending is limited.
+func (r *Restrictions) SpendLimit() (dcrutil.Amount, bool) {
+	return r.maxSpend, r.hasMaxSpend
+}
+
+// Macaroon is a bearer token authenticating RPC clients.  A macaroon carries a
+// list of caveats restricting its use, and a signature chaining an HMAC of the
+// random macaroon ID, keyed by the wallet's root key, with an HMAC of each
+// caveat keyed by the previous signature.  Anyone holding a macaroon can add
+// further caveats to it, but no caveat can be removed without the root key.
+type Macaroon struct {
+	id      []byte
+	caveats []Caveat
+	sig     [sha256.Size]byte
+}
+
+func hmacSHA256(key, data []byte) [sha256.Size]byte {
+	var sum [sha256.Size]byte
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	copy(sum[:], mac.Sum(nil))
+	return sum
+}
+
+// NewMacaroon mints a macaroon with the root key and caveats.
+func NewMacaroon(rootKey []byte, caveats ...Caveat) (*Macaroon, error) {
+	const op errors.Op = "rpcauth.NewMacaroon"
+	id := make([]byte, 16)
+	_, err := rand.Read(id)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	m := &Macaroon{id: id, sig: hmacSHA256(rootKey, id)}
+	m, err = m.Attenuate(caveats...)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return m, nil
+}
+
+// ID returns the hex encoding of the macaroon ID, which identifies every
+// macaroon attenuated from the same minted macaroon.
+func (m *Macaroon) ID() string {
+	return hex.EncodeToString(m.id)
+}
+
+// Caveats returns the caveats of the macaroon.
+func (m *Macaroon) Caveats() []Caveat {
+	return append([]Caveat(nil), m.caveats...)
+}
+
+// Attenuate returns a copy of the macaroon restricted by additional caveats.
+// It does not require the root key.
+func (m *Macaroon) Attenuate(caveats ...Caveat) (*Macaroon, error) {
+	var r Restrictions
+	for _, c := range caveats {
+		if err := r.add(c); err != nil {
+			return nil, err
+		}
+	}
+	a := &Macaroon{
+		id:      m.id,
+		caveats: append(m.Caveats(), caveats...),
+		sig:     m.sig,
+	}
+	for _, c := range caveats {
+		a.sig = hmacSHA256(a.sig[:], []byte(c))
+	}
+	return a, nil
+}
+
+// Verify checks the signature of the macaroon with the root key and returns
+// the restrictions of its caveats.  Macaroons with unknown caveats fail
+// verification.
+func (m *Macaroon) Verify(rootKey []byte) (*Restrictions, error) {
+	const op errors.Op = "rpcauth.Macaroon.Verify"
+	sig := hmacSHA256(rootKey, m.id)
+	for _, c := range m.caveats {
+		sig = hmacSHA256(sig[:], []byte(c))
+	}
+	if !hmac.Equal(sig[:], m.sig[:]) {
+		return nil, errors.E(op, errors.Permission, "invalid macaroon signature")
+	}
+	r := new(Restrictions)
+	for _, c := range m.caveats {
+		if err := r.add(c); err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+	return r, nil
+}
+
+// Encode serializes the macaroon as unpadded URL-safe base64.
+func (m *Macaroon) Encode() string {
+	var buf bytes.Buffer
+	var b [binary.MaxVarintLen64]byte
+	writeBytes := func(p []byte) {
+		n := binary.PutUvarint(b[:], uint64(len(p)))
+		buf.Write(b[:n])
+		buf.Write(p)
+	}
+	buf.WriteByte(macaroonVersion)
+	writeBytes(m.id)
+	n := binary.PutUvarint(b[:], uint64(len(m.caveats)))
+	buf.Write(b[:n])
+	for _, c := range m.caveats {
+		writeBytes([]byte(c))
+	}
+	buf.Write(m.sig[:])
+	return base64.RawURLEncoding.EncodeToString(buf.Bytes())
+}
+
+// DecodeMacaroon parses a macaroon serialized by Encode.
+func DecodeMacaroon(s string) (*Macaroon, error) {
+	const op errors.Op = "rpcauth.DecodeMacaroon"
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	r := bytes.NewReader(b)
+	readBytes := func() ([]byte, error) {
+		n, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, err
+		}
+		if n > uint64(r.Len()) {
+			return nil, errors.New("length exceeds macaroon size")
+		}
+		p := make([]byte, n)
+		_, err = r.Read(p)
+		return p, err
+	}
+	version, err := r.ReadByte()
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, "empty macaroon")
+	}
+	if version != macaroonVersion {
+		return nil, errors.E(op, errors.Encoding, errors.Errorf("unknown macaroon version %d", version))
+	}
+	m := new(Macaroon)
+	m.id, err = readBytes()
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	count, err := binary.ReadUvarint(r)
+	if err != nil || count > uint64(r.Len()) {
+		return nil, errors.E(op, errors.Encoding, "invalid caveat count")
+	}
+	for i := uint64(0); i < count; i++ {
+		c, err := readBytes()
+		if err != nil {
+			return nil, errors.E(op, errors.Encoding, err)
+		}
+		m.caveats = append(m.caveats, Caveat(c))
+	}
+	if r.Len() != len(m.sig) {
+		return nil, errors.E(op, errors.Encoding, "invalid signature length")
+	}
+	r.Read(m.sig[:])
+	return m, nil
+}
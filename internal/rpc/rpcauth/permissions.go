@@ -0,0 +1,142 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcauth
+
+// permissions is the permission table of JSON-RPC and gRPC methods.  Methods
+// which are not listed require PermFull.
+var permissions = map[string]Permission{
+	// JSON-RPC methods
+	"help":                    PermInfo,
+	"version":                 PermInfo,
+	"getbestblock":            PermInfo,
+	"getbestblockhash":        PermInfo,
+	"getblockcount":           PermInfo,
+	"getblockhash":            PermInfo,
+	"estimatesmartfee":        PermInfo,
+	"estimatestakediff":       PermInfo,
+	"stakerewardestimate":     PermInfo,
+	"createmultisig":          PermInfo,
+	"createrawtransaction":    PermInfo,
+	"createpsbt":              PermInfo,
+	"combinepsbt":             PermInfo,
+	"finalizepsbt":            PermInfo,
+	"parsepaymenturi":         PermInfo,
+	"verifymessage":           PermInfo,
+	"accountaddressindex":     PermRead,
+	"auditreuse":              PermRead,
+	"exporthistory":           PermRead,
+	"getaccount":              PermRead,
+	"getaddressesbyaccount":   PermRead,
+	"getauditlog":             PermRead,
+	"getbalance":              PermRead,
+	"getinfo":                 PermRead,
+	"getinvoice":              PermRead,
+	"getlabel":                PermRead,
+	"getmasterpubkey":         PermRead,
+	"getmultisigoutinfo":      PermRead,
+	"getreceivedbyaccount":    PermRead,
+	"getreceivedbyaddress":    PermRead,
+	"getstakeinfo":            PermRead,
+	"getticketfee":            PermRead,
+	"gettickets":              PermRead,
+	"gettransaction":          PermRead,
+	"getunconfirmedbalance":   PermRead,
+	"getvotechoices":          PermRead,
+	"getwalletfee":            PermRead,
+	"getwalletinfo":           PermRead,
+	"listaccounts":            PermRead,
+	"listaddressgroupings":    PermRead,
+	"listdescriptoraccounts":  PermRead,
+	"listinvoices":            PermRead,
+	"listlabels":              PermRead,
+	"listlockunspent":         PermRead,
+	"listreceivedbyaccount":   PermRead,
+	"listreceivedbyaddress":   PermRead,
+	"listsinceblock":          PermRead,
+	"listscripts":             PermRead,
+	"listtransactions":        PermRead,
+	"listunspent":             PermRead,
+	"listvsptickets":          PermRead,
+	"stakepooluserinfo":       PermRead,
+	"ticketreport":            PermRead,
+	"ticketsforaddress":       PermRead,
+	"validateaddress":         PermRead,
+	"walletinfo":              PermRead,
+	"walletislocked":          PermRead,
+	"accountsyncaddressindex": PermAddress,
+	"createinvoice":           PermAddress,
+	"getaccountaddress":       PermAddress,
+	"getnewaddress":           PermAddress,
+	"getrawchangeaddress":     PermAddress,
+	"addticket":               PermTicket,
+	"generatevote":            PermTicket,
+	"purchaseticket":          PermTicket,
+	"purchasevspticket":       PermTicket,
+	"revoketickets":           PermTicket,
+	"setticketfee":            PermTicket,
+	"setvotechoice":           PermTicket,
+
+	// gRPC methods
+	"/walletrpc.VersionService/Version":                    PermInfo,
+	"/walletrpc.WalletService/Ping":                        PermInfo,
+	"/walletrpc.WalletService/Network":                     PermInfo,
+	"/walletrpc.WalletService/CoinType":                    PermInfo,
+	"/walletrpc.WalletService/BestBlock":                   PermInfo,
+	"/walletrpc.WalletService/BlockInfo":                   PermInfo,
+	"/walletrpc.WalletService/TicketPrice":                 PermInfo,
+	"/walletrpc.WalletService/CreatePsbt":                  PermInfo,
+	"/walletrpc.WalletService/CombinePsbts":                PermInfo,
+	"/walletrpc.WalletService/FinalizePsbt":                PermInfo,
+	"/walletrpc.WalletLoaderService/WalletExists":          PermInfo,
+	"/walletrpc.SeedService/GenerateRandomSeed":            PermInfo,
+	"/walletrpc.SeedService/DecodeSeed":                    PermInfo,
+	"/walletrpc.AgendaService/Agendas":                     PermInfo,
+	"/walletrpc.MessageVerificationService/VerifyMessage":  PermInfo,
+	"/walletrpc.DecodeMessageService/DecodeRawTransaction": PermInfo,
+	"/walletrpc.WalletService/AccountNumber":               PermRead,
+	"/walletrpc.WalletService/Accounts":                    PermRead,
+	"/walletrpc.WalletService/Balance":                     PermRead,
+	"/walletrpc.WalletService/GetAccountExtendedPubKey":    PermRead,
+	"/walletrpc.WalletService/GetTransaction":              PermRead,
+	"/walletrpc.WalletService/GetTransactions":             PermRead,
+	"/walletrpc.WalletService/GetTicket":                   PermRead,
+	"/walletrpc.WalletService/GetTickets":                  PermRead,
+	"/walletrpc.WalletService/StakeInfo":                   PermRead,
+	"/walletrpc.WalletService/GetLabel":                    PermRead,
+	"/walletrpc.WalletService/ListLabels":                  PermRead,
+	"/walletrpc.WalletService/ValidateAddress":             PermRead,
+	"/walletrpc.WalletService/CommittedTickets":            PermRead,
+	"/walletrpc.WalletService/UnspentOutputs":              PermRead,
+	"/walletrpc.WalletService/TransactionNotifications":    PermRead,
+	"/walletrpc.WalletService/AccountNotifications":        PermRead,
+	"/walletrpc.WalletService/ConfirmationNotifications":   PermRead,
+	"/walletrpc.WalletLoaderService/RescanPoint":           PermRead,
+	"/walletrpc.TicketBuyerService/TicketBuyerConfig":      PermRead,
+	"/walletrpc.VotingService/VoteChoices":                 PermRead,
+	"/walletrpc.WalletService/NextAddress":                 PermAddress,
+	"/walletrpc.WalletService/PurchaseTickets":             PermTicket,
+	"/walletrpc.WalletService/RevokeTickets":               PermTicket,
+	"/walletrpc.TicketBuyerV2Service/RunTicketBuyer":       PermTicket,
+	"/walletrpc.TicketBuyerService/StartAutoBuyer":         PermTicket,
+	"/walletrpc.TicketBuyerService/StopAutoBuyer":          PermTicket,
+	"/walletrpc.TicketBuyerService/SetAccount":             PermTicket,
+	"/walletrpc.TicketBuyerService/SetBalanceToMaintain":   PermTicket,
+	"/walletrpc.TicketBuyerService/SetMaxFee":              PermTicket,
+	"/walletrpc.TicketBuyerService/SetMaxPriceRelative":    PermTicket,
+	"/walletrpc.TicketBuyerService/SetMaxPriceAbsolute":    PermTicket,
+	"/walletrpc.TicketBuyerService/SetMaxPerBlock":         PermTicket,
+	"/walletrpc.VotingService/SetVoteChoices":              PermTicket,
+}
+
+// fullPermissionFields lists the request fields of methods with a lesser
+// permission which require PermFull when set.  The fields are the names of
+// both the parsed JSON-RPC command and the gRPC request message fields.
+var fullPermissionFields = map[string][]string{
+	"purchaseticket": {"TicketAddress", "PoolAddress", "PoolFees"},
+
+	"/walletrpc.WalletService/PurchaseTickets":       {"TicketAddress", "PoolAddress", "PoolFees"},
+	"/walletrpc.TicketBuyerV2Service/RunTicketBuyer": {"VotingAddress", "PoolAddress", "PoolFees"},
+	"/walletrpc.TicketBuyerService/StartAutoBuyer":   {"VotingAddress", "PoolAddress", "PoolFees"},
+}
@@ -0,0 +1,240 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package rpcauth defines the roles of RPC clients and the permission table,
+// shared by the JSON-RPC and gRPC servers, describing which methods each role
+// may call.
+package rpcauth
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"reflect"
+	"strings"
+
+	"github.com/decred/dcrwallet/errors/v2"
+)
+
+// Role describes the methods a RPC client may call.
+type Role string
+
+// Roles of RPC clients.
+const (
+	// ReadOnly clients may query the wallet but not modify it.
+	ReadOnly Role = "readonly"
+
+	// Invoice clients may only generate addresses to receive payments.
+	Invoice Role = "invoice"
+
+	// Ticket clients may query the wallet, generate addresses, and
+	// purchase, revoke and vote with tickets.
+	Ticket Role = "ticket"
+
+	// Full clients may call every method, including methods which spend
+	// funds or reveal private keys.
+	Full Role = "full"
+)
+
+// ParseRole parses a role by name.
+func ParseRole(s string) (Role, error) {
+	switch r := Role(s); r {
+	case ReadOnly, Invoice, Ticket, Full:
+		return r, nil
+	}
+	return "", errors.E(errors.Invalid, errors.Errorf("unknown RPC role %q", s))
+}
+
+// Permission is the class of a method, used to decide which roles may call it.
+type Permission int
+
+// Method permissions.
+const (
+	// PermInfo methods reveal nothing about the wallet and may be called by
+	// every role.
+	PermInfo Permission = iota
+
+	// PermRead methods query the wallet.
+	PermRead
+
+	// PermAddress methods generate addresses.
+	PermAddress
+
+	// PermTicket methods manage tickets and voting.
+	PermTicket
+
+	// PermFull methods spend funds, reveal or import keys, change
+	// passphrases and otherwise modify the wallet.
+	PermFull
+)
+
+var rolePermissions = map[Role][]Permission{
+	ReadOnly: {PermInfo, PermRead},
+	Invoice:  {PermInfo, PermAddress},
+	Ticket:   {PermInfo, PermRead, PermAddress, PermTicket},
+	Full:     {PermInfo, PermRead, PermAddress, PermTicket, PermFull},
+}
+
+// MethodPermission returns the permission of a JSON-RPC method name or full
+// gRPC method name (/package.service/method).  Methods missing from the
+// permission table, including JSON-RPC methods passed through to dcrd,
+// require PermFull.
+func MethodPermission(method string) Permission {
+	if p, ok := permissions[method]; ok {
+		return p
+	}
+	return PermFull
+}
+
+// RequestPermission returns the permission of a call to a method with a
+// request, which is either a parsed JSON-RPC command or a gRPC request
+// message.  Requests which choose the voting, pool or fee addresses of
+// tickets, or the fees paid to a pool, require PermFull, as these addresses
+// receive funds or control the votes of the wallet's tickets.  Other requests
+// have the permission of the method.
+func RequestPermission(method string, req interface{}) Permission {
+	fields := fullPermissionFields[method]
+	if len(fields) == 0 {
+		return MethodPermission(method)
+	}
+	v := reflect.Indirect(reflect.ValueOf(req))
+	if v.Kind() != reflect.Struct {
+		return MethodPermission(method)
+	}
+	for _, name := range fields {
+		f := v.FieldByName(name)
+		if f.Kind() == reflect.Ptr && !f.IsNil() {
+			f = f.Elem()
+		}
+		switch f.Kind() {
+		case reflect.String:
+			if f.String() != "" {
+				return PermFull
+			}
+		case reflect.Float64:
+			if f.Float() != 0 {
+				return PermFull
+			}
+		}
+	}
+	return MethodPermission(method)
+}
+
+// RequestChecked returns whether the permission of a method depends on its
+// request, and CheckRequest must be called once the request is parsed.
+func RequestChecked(method string) bool {
+	return len(fullPermissionFields[method]) != 0
+}
+
+func (r Role) allows(p Permission) bool {
+	for _, rp := range rolePermissions[r] {
+		if rp == p {
+			return true
+		}
+	}
+	return false
+}
+
+// Allowed returns whether the role may call a method.
+func (r Role) Allowed(method string) bool {
+	return r.allows(MethodPermission(method))
+}
+
+// CheckMethod returns a Permission error if the role may not call a method.
+func (r Role) CheckMethod(method string) error {
+	if !r.Allowed(method) {
+		return errors.E(errors.Permission, errors.Errorf("role %q may not call %s", r, method))
+	}
+	return nil
+}
+
+// CheckRequest returns a Permission error if the role may not call a method
+// with a request.
+func (r Role) CheckRequest(method string, req interface{}) error {
+	if !r.allows(RequestPermission(method, req)) {
+		return errors.E(errors.Permission, errors.Errorf("role %q may not call %s "+
+			"with these arguments", r, method))
+	}
+	return nil
+}
+
+// SplitRole splits an option in the form role:value.
+func SplitRole(s string) (Role, string, error) {
+	parts := strings.SplitN(s, ":", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return "", "", errors.E(errors.Invalid, errors.Errorf("%q is not in the form role:value", s))
+	}
+	role, err := ParseRole(parts[0])
+	if err != nil {
+		return "", "", err
+	}
+	return role, parts[1], nil
+}
+
+// ParseCredential parses a JSON-RPC credential in the form
+// role:username:password.  The password may contain colons.
+func ParseCredential(s string) (role Role, username, password string, err error) {
+	role, value, err := SplitRole(s)
+	if err != nil {
+		return "", "", "", err
+	}
+	parts := strings.SplitN(value, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", "", errors.E(errors.Invalid, "credential must be in the form role:username:password")
+	}
+	return role, parts[0], parts[1], nil
+}
+
+// CertFingerprint is the SHA-256 hash of a DER encoded certificate.
+type CertFingerprint [sha256.Size]byte
+
+// Fingerprint returns the fingerprint of a certificate.
+func Fingerprint(cert *x509.Certificate) CertFingerprint {
+	return sha256.Sum256(cert.Raw)
+}
+
+// ClientCerts maps client certificates to roles.
+type ClientCerts map[CertFingerprint]Role
+
+// AddClientCert reads the PEM encoded certificate at path and authorizes
+// clients presenting it for a role.
+func (c ClientCerts) AddClientCert(role Role, path string) error {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	block, _ := pem.Decode(b)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return errors.E(errors.Encoding, errors.Errorf("%s: no PEM encoded certificate", path))
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return errors.E(errors.Encoding, errors.Errorf("%s: %v", path, err))
+	}
+	c[Fingerprint(cert)] = role
+	return nil
+}
+
+// Role returns the role of the first certificate in a verified TLS peer
+// certificate chain.
+func (c ClientCerts) Role(peerCerts []*x509.Certificate) (Role, bool) {
+	if len(peerCerts) == 0 {
+		return "", false
+	}
+	role, ok := c[Fingerprint(peerCerts[0])]
+	return role, ok
+}
+
+// VerifyPeerCertificate is a crypto/tls.Config VerifyPeerCertificate callback
+// which rejects TLS clients not presenting one of the certificates.
+func (c ClientCerts) VerifyPeerCertificate(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	if len(rawCerts) == 0 {
+		return errors.E(errors.Permission, "no client certificate")
+	}
+	if _, ok := c[sha256.Sum256(rawCerts[0])]; !ok {
+		return errors.E(errors.Permission, "unknown client certificate")
+	}
+	return nil
+}
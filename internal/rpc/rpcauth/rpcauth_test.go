@@ -0,0 +1,247 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpcauth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrwallet/rpc/jsonrpc/types"
+	pb "github.com/decred/dcrwallet/rpc/walletrpc"
+)
+
+func TestAllowed(t *testing.T) {
+	tests := []struct {
+		method string
+		roles  []Role
+	}{
+		{"version", []Role{ReadOnly, Invoice, Ticket, Full}},
+		{"getbalance", []Role{ReadOnly, Ticket, Full}},
+		{"getnewaddress", []Role{Invoice, Ticket, Full}},
+		{"purchaseticket", []Role{Ticket, Full}},
+		{"sendtoaddress", []Role{Full}},
+		{"dumpprivkey", []Role{Full}},
+		{"stop", []Role{Full}},
+		{"getblock", []Role{Full}}, // passed through to dcrd
+		{"/walletrpc.WalletService/Balance", []Role{ReadOnly, Ticket, Full}},
+		{"/walletrpc.WalletService/NextAddress", []Role{Invoice, Ticket, Full}},
+		{"/walletrpc.TicketBuyerV2Service/RunTicketBuyer", []Role{Ticket, Full}},
+		{"/walletrpc.WalletService/SignTransaction", []Role{Full}},
+		{"/walletrpc.TicketBuyerService/SetMaxFee", []Role{Ticket, Full}},
+		{"/walletrpc.TicketBuyerService/SetVotingAddress", []Role{Full}},
+		{"/walletrpc.TicketBuyerService/SetPoolAddress", []Role{Full}},
+		{"/walletrpc.TicketBuyerService/SetPoolFees", []Role{Full}},
+	}
+	for _, test := range tests {
+		allowed := make(map[Role]bool)
+		for _, r := range test.roles {
+			allowed[r] = true
+		}
+		for _, r := range []Role{ReadOnly, Invoice, Ticket, Full, ""} {
+			if r.Allowed(test.method) != allowed[r] {
+				t.Errorf("%s: role %q allowed=%v", test.method, r, !allowed[r])
+			}
+		}
+	}
+}
+
+func TestCheckRequest(t *testing.T) {
+	addr := "TsR28UZRprhgQQhzWns2M6cAwchrNVvbYq2"
+	fees := 7.5
+	tests := []struct {
+		method string
+		req    interface{}
+		ticket bool
+	}{
+		{"purchaseticket", &types.PurchaseTicketCmd{FromAccount: "default", SpendLimit: 100}, true},
+		{"purchaseticket", &types.PurchaseTicketCmd{FromAccount: "default", TicketAddress: new(string)}, true},
+		{"purchaseticket", &types.PurchaseTicketCmd{FromAccount: "default", TicketAddress: &addr}, false},
+		{"purchaseticket", &types.PurchaseTicketCmd{FromAccount: "default", PoolAddress: &addr}, false},
+		{"purchaseticket", &types.PurchaseTicketCmd{FromAccount: "default", PoolFees: &fees}, false},
+		{"/walletrpc.WalletService/PurchaseTickets", &pb.PurchaseTicketsRequest{NumTickets: 1}, true},
+		{"/walletrpc.WalletService/PurchaseTickets", &pb.PurchaseTicketsRequest{TicketAddress: addr}, false},
+		{"/walletrpc.WalletService/PurchaseTickets", &pb.PurchaseTicketsRequest{PoolFees: fees}, false},
+		{"/walletrpc.TicketBuyerV2Service/RunTicketBuyer", &pb.RunTicketBuyerRequest{VotingAccount: 1}, true},
+		{"/walletrpc.TicketBuyerV2Service/RunTicketBuyer", &pb.RunTicketBuyerRequest{VotingAddress: addr}, false},
+		{"/walletrpc.TicketBuyerV2Service/RunTicketBuyer", &pb.RunTicketBuyerRequest{PoolAddress: addr}, false},
+		{"/walletrpc.TicketBuyerService/StartAutoBuyer", &pb.StartAutoBuyerRequest{PoolAddress: addr}, false},
+		{"/walletrpc.WalletService/Balance", &pb.BalanceRequest{}, true},
+	}
+	rootKey := make([]byte, RootKeySize)
+	m, err := NewMacaroon(rootKey, RoleCaveat(Ticket))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := m.Verify(rootKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, test := range tests {
+		if err := Full.CheckRequest(test.method, test.req); err != nil {
+			t.Errorf("%d: %s: full role denied: %v", i, test.method, err)
+		}
+		err := Ticket.CheckRequest(test.method, test.req)
+		if (err == nil) != test.ticket {
+			t.Errorf("%d: %s: ticket role allowed=%v", i, test.method, err == nil)
+		}
+		err = r.CheckRequest(test.method, test.req)
+		if (err == nil) != test.ticket {
+			t.Errorf("%d: %s: ticket macaroon allowed=%v", i, test.method, err == nil)
+		}
+		if err := ReadOnly.CheckRequest(test.method, test.req); err == nil && test.method != "/walletrpc.WalletService/Balance" {
+			t.Errorf("%d: %s: readonly role allowed", i, test.method)
+		}
+	}
+}
+
+func TestParseCredential(t *testing.T) {
+	role, username, password, err := ParseCredential("readonly:monitor:pass:word")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if role != ReadOnly || username != "monitor" || password != "pass:word" {
+		t.Errorf("parsed %q %q %q", role, username, password)
+	}
+	for _, s := range []string{"admin:user:pass", "full:user", "full::pass", "full:user:", "readonly"} {
+		if _, _, _, err := ParseCredential(s); err == nil {
+			t.Errorf("parsed invalid credential %q", s)
+		}
+	}
+}
+
+func TestClientCerts(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rpcauth")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	newCert := func(name string) *x509.Certificate {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: name},
+			NotBefore:    time.Now(),
+			NotAfter:     time.Now().Add(time.Hour),
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+		err = ioutil.WriteFile(filepath.Join(dir, name+".pem"), b, 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return cert
+	}
+	monitor, unknown := newCert("monitor"), newCert("unknown")
+
+	certs := make(ClientCerts)
+	err = certs.AddClientCert(ReadOnly, filepath.Join(dir, "monitor.pem"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if role, ok := certs.Role([]*x509.Certificate{monitor}); !ok || role != ReadOnly {
+		t.Errorf("monitor certificate role %q", role)
+	}
+	if _, ok := certs.Role([]*x509.Certificate{unknown}); ok {
+		t.Errorf("unknown certificate has a role")
+	}
+	if err := certs.VerifyPeerCertificate([][]byte{monitor.Raw}, nil); err != nil {
+		t.Errorf("monitor certificate rejected: %v", err)
+	}
+	if err := certs.VerifyPeerCertificate([][]byte{unknown.Raw}, nil); err == nil {
+		t.Errorf("unknown certificate accepted")
+	}
+	if err := certs.VerifyPeerCertificate(nil, nil); err == nil {
+		t.Errorf("missing certificate accepted")
+	}
+}
+
+func TestMacaroon(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rpcauth")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	keyPath := filepath.Join(dir, "macaroons.key")
+	rootKey, err := LoadRootKey(keyPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reloaded, err := LoadRootKey(keyPath); err != nil || string(reloaded) != string(rootKey) {
+		t.Fatalf("reloaded root key differs: %v", err)
+	}
+
+	now := time.Now()
+	m, err := NewMacaroon(rootKey, MethodsCaveat("getnewaddress", "getbalance"), ExpiryCaveat(now.Add(time.Hour)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, err := m.Attenuate(AccountCaveat(3), MethodsCaveat("getnewaddress"), MaxSpendCaveat(1e8))
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := DecodeMacaroon(a.Encode())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.ID() != m.ID() || len(decoded.Caveats()) != 5 {
+		t.Fatalf("decoded macaroon %s with caveats %q", decoded.ID(), decoded.Caveats())
+	}
+	r, err := decoded.Verify(rootKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.CheckMethod("getnewaddress", now); err != nil {
+		t.Errorf("getnewaddress: %v", err)
+	}
+	if err := r.CheckMethod("getbalance", now); err == nil {
+		t.Errorf("attenuated macaroon may call getbalance")
+	}
+	if err := r.CheckMethod("getnewaddress", now.Add(2*time.Hour)); err == nil {
+		t.Errorf("expired macaroon may call getnewaddress")
+	}
+	if !r.AccountScoped() || r.CheckAccount(3) != nil || r.CheckAccount(0) == nil {
+		t.Errorf("account caveat not enforced")
+	}
+	if limit, ok := r.SpendLimit(); !ok || limit != 1e8 {
+		t.Errorf("spend limit %v", limit)
+	}
+
+	// Removing a caveat or verifying with another key fails.
+	stripped := &Macaroon{id: a.id, caveats: a.caveats[:2], sig: a.sig}
+	if _, err := stripped.Verify(rootKey); err == nil {
+		t.Errorf("macaroon with removed caveats verified")
+	}
+	otherKey := make([]byte, RootKeySize)
+	if _, err := a.Verify(otherKey); err == nil {
+		t.Errorf("macaroon verified with another root key")
+	}
+	if _, err := m.Attenuate(Caveat("ip=127.0.0.1")); err == nil {
+		t.Errorf("attenuated with unknown caveat")
+	}
+	if _, err := DecodeMacaroon(a.Encode()[:20]); err == nil {
+		t.Errorf("decoded truncated macaroon")
+	}
+}
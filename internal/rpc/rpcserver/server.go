@@ -50,6 +50,8 @@ import (
 	"github.com/decred/dcrwallet/spv/v3"
 	"github.com/decred/dcrwallet/ticketbuyer/v4"
 	"github.com/decred/dcrwallet/wallet/v3"
+	"github.com/decred/dcrwallet/wallet/v3/paymenturi"
+	"github.com/decred/dcrwallet/wallet/v3/psbt"
 	"github.com/decred/dcrwallet/wallet/v3/txauthor"
 	"github.com/decred/dcrwallet/wallet/v3/txrules"
 	"github.com/decred/dcrwallet/wallet/v3/udb"
@@ -58,9 +60,9 @@ import (
 
 // Public API version constants
 const (
-	semverString = "7.2.0"
+	semverString = "7.10.0"
 	semverMajor  = 7
-	semverMinor  = 2
+	semverMinor  = 10
 	semverPatch  = 0
 )
 
@@ -877,12 +879,42 @@ func (s *walletServer) UnspentOutputs(req *pb.UnspentOutputsRequest, svr pb.Wall
 	return nil
 }
 
+// outputSelectionAlgorithm converts an output selection algorithm of the API to
+// the wallet's representation.
+func outputSelectionAlgorithm(a pb.ConstructTransactionRequest_OutputSelectionAlgorithm) (
+	wallet.OutputSelectionAlgorithm, error) {
+
+	switch a {
+	case pb.ConstructTransactionRequest_UNSPECIFIED:
+		return wallet.OutputSelectionAlgorithmDefault, nil
+	case pb.ConstructTransactionRequest_ALL:
+		return wallet.OutputSelectionAlgorithmAll, nil
+	case pb.ConstructTransactionRequest_LARGEST_FIRST:
+		return wallet.OutputSelectionAlgorithmLargestFirst, nil
+	case pb.ConstructTransactionRequest_SMALLEST_FIRST:
+		return wallet.OutputSelectionAlgorithmSmallestFirst, nil
+	case pb.ConstructTransactionRequest_OLDEST_FIRST:
+		return wallet.OutputSelectionAlgorithmOldestFirst, nil
+	case pb.ConstructTransactionRequest_BRANCH_AND_BOUND:
+		return wallet.OutputSelectionAlgorithmBranchAndBound, nil
+	case pb.ConstructTransactionRequest_AVOID_MIXING:
+		return wallet.OutputSelectionAlgorithmAvoidMixing, nil
+	default:
+		return 0, status.Errorf(codes.InvalidArgument, "unknown output selection algorithm")
+	}
+}
+
 func (s *walletServer) FundTransaction(ctx context.Context, req *pb.FundTransactionRequest) (
 	*pb.FundTransactionResponse, error) {
 
+	algo, err := outputSelectionAlgorithm(req.OutputSelectionAlgorithm)
+	if err != nil {
+		return nil, err
+	}
 	policy := wallet.OutputSelectionPolicy{
 		Account:               req.Account,
 		RequiredConfirmations: req.RequiredConfirmations,
+		Algorithm:             algo,
 	}
 	inputDetail, err := s.wallet.SelectInputs(ctx, dcrutil.Amount(req.TargetAmount), policy)
 	// Do not return errors to caller when there was insufficient spendable
@@ -937,9 +969,18 @@ func decodeDestination(dest *pb.ConstructTransactionRequest_OutputDestination,
 		return nil, 0, status.Errorf(codes.InvalidArgument, "unknown or missing output destination")
 
 	case dest.Address != "":
-		addr, err := decodeAddress(dest.Address, chainParams)
-		if err != nil {
-			return nil, 0, err
+		var addr dcrutil.Address
+		if paymenturi.IsURI(dest.Address) {
+			u, err := paymenturi.Parse(dest.Address, chainParams)
+			if err != nil {
+				return nil, 0, status.Errorf(codes.InvalidArgument, "%v", err)
+			}
+			addr = u.Address
+		} else {
+			addr, err = decodeAddress(dest.Address, chainParams)
+			if err != nil {
+				return nil, 0, err
+			}
 		}
 		pkScript, version, err = addressScript(addr)
 		if err != nil {
@@ -954,6 +995,32 @@ func decodeDestination(dest *pb.ConstructTransactionRequest_OutputDestination,
 	}
 }
 
+// destinationAmount returns the amount of an output paying a destination.
+// When the destination is a payment URI requesting an amount, a zero amount is
+// replaced by the requested amount, and any other amount must match it.
+func destinationAmount(dest *pb.ConstructTransactionRequest_OutputDestination, amount int64,
+	chainParams *chaincfg.Params) (int64, error) {
+
+	if dest == nil || !paymenturi.IsURI(dest.Address) {
+		return amount, nil
+	}
+	u, err := paymenturi.Parse(dest.Address, chainParams)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	switch {
+	case u.Amount == 0:
+		return amount, nil
+	case amount == 0:
+		return int64(u.Amount), nil
+	case amount != int64(u.Amount):
+		return 0, status.Errorf(codes.InvalidArgument,
+			"output amount %v does not match payment URI amount %v", dcrutil.Amount(amount), u.Amount)
+	default:
+		return amount, nil
+	}
+}
+
 type txChangeSource struct {
 	version uint16
 	script  []byte
@@ -996,31 +1063,38 @@ func (s *walletServer) ConstructTransaction(ctx context.Context, req *pb.Constru
 		if err != nil {
 			return nil, err
 		}
+		amount, err := destinationAmount(o.Destination, o.Amount, chainParams)
+		if err != nil {
+			return nil, err
+		}
 		output := &wire.TxOut{
-			Value:    o.Amount,
+			Value:    amount,
 			Version:  version,
 			PkScript: script,
 		}
 		outputs = append(outputs, output)
 	}
 
-	var algo wallet.OutputSelectionAlgorithm
-	switch req.OutputSelectionAlgorithm {
-	case pb.ConstructTransactionRequest_UNSPECIFIED:
-		algo = wallet.OutputSelectionAlgorithmDefault
-	case pb.ConstructTransactionRequest_ALL:
-		algo = wallet.OutputSelectionAlgorithmAll
-	default:
-		return nil, status.Errorf(codes.InvalidArgument, "unknown output selection algorithm")
+	algo, err := outputSelectionAlgorithm(req.OutputSelectionAlgorithm)
+	if err != nil {
+		return nil, err
 	}
 
 	feePerKb := txrules.DefaultRelayFeePerKb
-	if req.FeePerKb != 0 {
+	switch {
+	case req.FeePerKb != 0:
 		feePerKb = dcrutil.Amount(req.FeePerKb)
+	case req.ConfirmationTarget != 0:
+		estimate, _, err := s.wallet.EstimateFee(req.ConfirmationTarget, false)
+		if err != nil && !errors.Is(err, errors.NotExist) {
+			return nil, translateError(err)
+		}
+		if err == nil {
+			feePerKb = estimate
+		}
 	}
 
 	var changeSource txauthor.ChangeSource
-	var err error
 	if req.ChangeDestination != nil {
 		changeSource, err = makeTxChangeSource(req.ChangeDestination, chainParams)
 		if err != nil {
@@ -1504,6 +1578,283 @@ func (s *walletServer) CreateSignature(ctx context.Context, req *pb.CreateSignat
 	return &pb.CreateSignatureResponse{Signature: sig, PublicKey: pubkey}, nil
 }
 
+func (s *walletServer) CreatePsbt(ctx context.Context, req *pb.CreatePsbtRequest) (
+	*pb.CreatePsbtResponse, error) {
+
+	var tx wire.MsgTx
+	err := tx.Deserialize(bytes.NewReader(req.UnsignedTransaction))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Bytes do not represent a valid raw transaction: %v", err)
+	}
+
+	p, err := psbt.New(&tx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	b, err := p.Bytes()
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &pb.CreatePsbtResponse{Psbt: b}, nil
+}
+
+func (s *walletServer) ProcessPsbt(ctx context.Context, req *pb.ProcessPsbtRequest) (
+	*pb.ProcessPsbtResponse, error) {
+
+	defer zero(req.Passphrase)
+
+	p, err := psbt.Deserialize(bytes.NewReader(req.Psbt))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Bytes do not represent a valid partially signed transaction: %v", err)
+	}
+
+	hashType := txscript.SigHashType(req.HashType)
+	if hashType == 0 {
+		hashType = txscript.SigHashAll
+	}
+
+	if req.Sign {
+		lock := make(chan time.Time, 1)
+		defer func() {
+			lock <- time.Time{} // send matters, not the value
+		}()
+		err = s.wallet.Unlock(ctx, req.Passphrase, lock)
+		if err != nil {
+			return nil, translateError(err)
+		}
+	}
+
+	err = s.wallet.ProcessPSBT(ctx, p, hashType, req.Sign)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	err = p.Finalize()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	b, err := p.Bytes()
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &pb.ProcessPsbtResponse{Psbt: b, Complete: p.IsComplete()}, nil
+}
+
+func (s *walletServer) CombinePsbts(ctx context.Context, req *pb.CombinePsbtsRequest) (
+	*pb.CombinePsbtsResponse, error) {
+
+	packets := make([]*psbt.Packet, len(req.Psbts))
+	for i, b := range req.Psbts {
+		p, err := psbt.Deserialize(bytes.NewReader(b))
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"Bytes do not represent a valid partially signed transaction: %v", err)
+		}
+		packets[i] = p
+	}
+
+	p, err := psbt.Combine(packets...)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	b, err := p.Bytes()
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &pb.CombinePsbtsResponse{Psbt: b}, nil
+}
+
+func (s *walletServer) FinalizePsbt(ctx context.Context, req *pb.FinalizePsbtRequest) (
+	*pb.FinalizePsbtResponse, error) {
+
+	p, err := psbt.Deserialize(bytes.NewReader(req.Psbt))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Bytes do not represent a valid partially signed transaction: %v", err)
+	}
+	err = p.Finalize()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	resp := &pb.FinalizePsbtResponse{Complete: p.IsComplete()}
+	resp.Psbt, err = p.Bytes()
+	if err != nil {
+		return nil, translateError(err)
+	}
+	if resp.Complete && req.Extract {
+		tx, err := p.Extract()
+		if err != nil {
+			return nil, translateError(err)
+		}
+		var buf bytes.Buffer
+		buf.Grow(tx.SerializeSize())
+		err = tx.Serialize(&buf)
+		if err != nil {
+			return nil, translateError(err)
+		}
+		resp.Transaction = buf.Bytes()
+	}
+
+	return resp, nil
+}
+
+func (s *walletServer) BumpFee(ctx context.Context, req *pb.BumpFeeRequest) (
+	*pb.BumpFeeResponse, error) {
+
+	defer zero(req.Passphrase)
+
+	txHash, err := chainhash.NewHash(req.TransactionHash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "transaction_hash has invalid length")
+	}
+	if req.FeePerKb < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "fee_per_kb may not be negative")
+	}
+
+	n, err := s.requireNetworkBackend()
+	if err != nil {
+		return nil, err
+	}
+
+	lock := make(chan time.Time, 1)
+	defer func() {
+		lock <- time.Time{} // send matters, not the value
+	}()
+	err = s.wallet.Unlock(ctx, req.Passphrase, lock)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	bump, err := s.wallet.BumpFee(ctx, n, txHash, dcrutil.Amount(req.FeePerKb))
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	var buf bytes.Buffer
+	buf.Grow(bump.Tx.SerializeSize())
+	err = bump.Tx.Serialize(&buf)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	replacementHash := bump.Tx.TxHash()
+
+	return &pb.BumpFeeResponse{
+		Transaction:     buf.Bytes(),
+		TransactionHash: replacementHash[:],
+		OriginalFee:     int64(bump.OrigFee),
+		Fee:             int64(bump.Fee),
+	}, nil
+}
+
+func (s *walletServer) ChildPaysForParent(ctx context.Context, req *pb.ChildPaysForParentRequest) (
+	*pb.ChildPaysForParentResponse, error) {
+
+	defer zero(req.Passphrase)
+
+	txHash, err := chainhash.NewHash(req.TransactionHash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "transaction_hash has invalid length")
+	}
+	if req.FeePerKb < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "fee_per_kb may not be negative")
+	}
+
+	n, err := s.requireNetworkBackend()
+	if err != nil {
+		return nil, err
+	}
+
+	lock := make(chan time.Time, 1)
+	defer func() {
+		lock <- time.Time{} // send matters, not the value
+	}()
+	err = s.wallet.Unlock(ctx, req.Passphrase, lock)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	bump, err := s.wallet.CPFP(ctx, n, txHash, req.Account, dcrutil.Amount(req.FeePerKb))
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	var buf bytes.Buffer
+	buf.Grow(bump.Tx.SerializeSize())
+	err = bump.Tx.Serialize(&buf)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	childHash := bump.Tx.TxHash()
+
+	return &pb.ChildPaysForParentResponse{
+		Transaction:      buf.Bytes(),
+		TransactionHash:  childHash[:],
+		ParentFee:        int64(bump.OrigFee),
+		Fee:              int64(bump.Fee),
+		UnminedAncestors: bump.UnminedAncestors,
+	}, nil
+}
+
+func unmarshalLabelTarget(t *pb.LabelTarget) (*udb.LabelTarget, error) {
+	if t == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "target is required")
+	}
+	var target udb.LabelTarget
+	switch t.Kind {
+	case pb.LabelTarget_ADDRESS:
+		if t.Address == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "address is required")
+		}
+		target = udb.AddressLabelTarget(t.Address)
+	case pb.LabelTarget_TRANSACTION, pb.LabelTarget_OUTPUT:
+		txHash, err := chainhash.NewHash(t.TransactionHash)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "transaction_hash has invalid length")
+		}
+		target = udb.TxLabelTarget(txHash)
+		if t.Kind == pb.LabelTarget_OUTPUT {
+			target = udb.OutputLabelTarget(txHash, t.OutputIndex)
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown label kind %v", t.Kind)
+	}
+	return &target, nil
+}
+
+func marshalLabelTarget(t *udb.LabelTarget) *pb.LabelTarget {
+	switch t.Kind {
+	case udb.LabelAddress:
+		return &pb.LabelTarget{Kind: pb.LabelTarget_ADDRESS, Address: t.Address}
+	case udb.LabelTransaction:
+		return &pb.LabelTarget{Kind: pb.LabelTarget_TRANSACTION, TransactionHash: t.Hash[:]}
+	default:
+		return &pb.LabelTarget{
+			Kind:            pb.LabelTarget_OUTPUT,
+			TransactionHash: t.Hash[:],
+			OutputIndex:     t.Index,
+		}
+	}
+}
+
+func (s *walletServer) SetLabel(ctx context.Context, req *pb.SetLabelRequest) (
+	*pb.SetLabelResponse, error) {
+
+	target, err := unmarshalLabelTarget(req.Target)
+	if err != nil {
+		return nil, err
+	}
+	err = s.wallet.SetLabel(ctx, target, req.Label)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.SetLabelResponse{}, nil
+}
+
 func (s *walletServer) PublishTransaction(ctx context.Context, req *pb.PublishTransactionRequest) (
 	*pb.PublishTransactionResponse, error) {
 
@@ -1897,6 +2248,7 @@ func marshalTransactionOutputs(v []wallet.TransactionSummaryOutput) []*pb.Transa
 			Amount:       int64(output.Amount),
 			Address:      address,
 			OutputScript: output.OutputScript,
+			Label:        output.Label,
 		}
 	}
 	return outputs
@@ -1927,6 +2279,7 @@ func marshalTransactionDetails(tx *wallet.TransactionSummary) *pb.TransactionDet
 		Fee:             int64(tx.Fee),
 		Timestamp:       tx.Timestamp,
 		TransactionType: marshalTxType(tx.Type),
+		Label:           tx.Label,
 	}
 }
 
@@ -2188,6 +2541,15 @@ func (t *ticketbuyerV2Server) RunTicketBuyer(req *pb.RunTicketBuyerRequest, svr
 	if req.BalanceToMaintain < 0 {
 		return status.Errorf(codes.InvalidArgument, "Negative balance to maintain given")
 	}
+	if req.MaxPrice < 0 {
+		return status.Errorf(codes.InvalidArgument, "Negative max price given")
+	}
+	if req.AverageWindows < 0 {
+		return status.Errorf(codes.InvalidArgument, "Negative average windows given")
+	}
+	if req.WindowBudget < 0 {
+		return status.Errorf(codes.InvalidArgument, "Negative window budget given")
+	}
 
 	tb := ticketbuyer.New(wallet)
 
@@ -2200,6 +2562,11 @@ func (t *ticketbuyerV2Server) RunTicketBuyer(req *pb.RunTicketBuyerRequest, svr
 		c.VotingAddr = votingAddress
 		c.PoolFeeAddr = poolAddress
 		c.PoolFees = req.PoolFees
+		c.MaxPrice = dcrutil.Amount(req.MaxPrice)
+		c.AverageWindows = int(req.AverageWindows)
+		c.Spread = req.Spread
+		c.WindowBudget = dcrutil.Amount(req.WindowBudget)
+		c.TargetPoolSize = req.TargetPoolSize
 	})
 
 	lock := make(chan time.Time, 1)
@@ -2965,3 +3332,33 @@ func (s *walletServer) BestBlock(ctx context.Context, req *pb.BestBlockRequest)
 	}
 	return resp, nil
 }
+
+func (s *walletServer) GetLabel(ctx context.Context, req *pb.GetLabelRequest) (*pb.GetLabelResponse, error) {
+	target, err := unmarshalLabelTarget(req.Target)
+	if err != nil {
+		return nil, err
+	}
+	label, err := s.wallet.Label(ctx, target)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.GetLabelResponse{Label: label}, nil
+}
+
+func (s *walletServer) ListLabels(ctx context.Context, req *pb.ListLabelsRequest) (*pb.ListLabelsResponse, error) {
+	labels, err := s.wallet.Labels(ctx)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	resp := &pb.ListLabelsResponse{
+		Labels: make([]*pb.ListLabelsResponse_Label, 0, len(labels)),
+	}
+	for target, label := range labels {
+		target := target
+		resp.Labels = append(resp.Labels, &pb.ListLabelsResponse_Label{
+			Target: marshalLabelTarget(&target),
+			Label:  label,
+		})
+	}
+	return resp, nil
+}
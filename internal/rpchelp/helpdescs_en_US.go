@@ -3,7 +3,8 @@
 // Use of this source code is governed by an ISC
 // license that can be found in the LICENSE file.
 
-//+build !generate
+//go:build !generate
+// +build !generate
 
 package rpchelp
 
@@ -38,6 +39,43 @@ var helpDescsEnUS = map[string]string{
 	"auditreuse--result0--value": "Reused address",
 	"auditreuse--result0--key":   "Array of outpoints referencing the reused address",
 
+	// BackupWalletCmd help.
+	"backupwallet--synopsis": "Writes a copy of the wallet database to a file.\n" +
+		"The copy is consistent and may be made while the wallet is running.",
+	"backupwallet-destination": "Path of the backup file, or a directory to write a wallet.db file into",
+
+	// BakeMacaroonCmd help.
+	"bakemacaroon--synopsis": "Mints a macaroon bearer token authenticating RPC clients, restricted by the requested caveats.\n" +
+		"Clients present the macaroon in an \"Authorization: Bearer\" header to the JSON-RPC server, or in \"macaroon\" metadata to the gRPC server.\n" +
+		"When invoked by a client authenticated with a macaroon, the new macaroon is that macaroon with the additional caveats.",
+	"bakemacaroon-methods":  "Only allow calling these JSON-RPC method names or full gRPC method names",
+	"bakemacaroon-role":     "Only allow calling methods permitted to this RPC role (readonly, invoice, ticket or full)",
+	"bakemacaroon-expiry":   "Number of seconds until the macaroon expires",
+	"bakemacaroon-account":  "Only allow methods operating on this account",
+	"bakemacaroon-maxspend": "Maximum amount in DCR spent by each transaction created or published with the macaroon",
+
+	// BakeMacaroonResult help.
+	"bakemacaroonresult-macaroon": "The encoded macaroon",
+	"bakemacaroonresult-id":       "Identifier shared by the macaroon and every macaroon attenuated from the same minted macaroon",
+	"bakemacaroonresult-caveats":  "All caveats of the macaroon",
+
+	// BumpFeeCmd help.
+	"bumpfee--synopsis": "Replaces an unconfirmed wallet transaction with a transaction spending the same inputs and paying a higher fee from its change output.\n" +
+		"Every input must spend a P2PKH or P2PK wallet output.\n" +
+		"The replacement is published to the network before it replaces the original transaction in the wallet.",
+	"bumpfee-txhash":  "Hash of the unconfirmed transaction to replace",
+	"bumpfee-feerate": "Fee rate in DCR/kB of the replacement (default: the original fee rate increased by the relay fee)",
+
+	// BumpFeeResult help.
+	"bumpfeeresult-txid":    "Hash of the replacement transaction",
+	"bumpfeeresult-origfee": "Fee in DCR paid by the replaced transaction",
+	"bumpfeeresult-fee":     "Fee in DCR paid by the replacement transaction",
+
+	// CombinePSBTCmd help.
+	"combinepsbt--synopsis": "Combines the signatures and metadata of several partially signed transactions describing the same transaction.",
+	"combinepsbt-psbts":     "Base64-encoded partially signed transactions",
+	"combinepsbt--result0":  "The combined base64-encoded partially signed transaction",
+
 	// ConsolidateCmd help.
 	"consolidate--synopsis": "Consolidate n many UTXOs into a single output in the wallet.",
 	"consolidate-inputs":    "Number of UTXOs to consolidate as inputs",
@@ -45,6 +83,28 @@ var helpDescsEnUS = map[string]string{
 	"consolidate-address":   "Optional: Address to pay.  Default is obtained via getnewaddress from the account's address pool.",
 	"consolidate--result0":  "Transaction hash for the consolidation transaction",
 
+	// CPFPCmd help.
+	"cpfp--synopsis": "Publishes a child transaction spending the wallet's outputs of an unconfirmed transaction, and additional account outputs when necessary, to increase the fee rate of both transactions.\n" +
+		"The fee of the child is chosen such that the combined parent and child transactions pay the requested fee rate.",
+	"cpfp-txhash":  "Hash of the unconfirmed parent transaction",
+	"cpfp-feerate": "Fee rate in DCR/kB of the combined parent and child transactions (default: the wallet's relay fee)",
+	"cpfp-account": "Account to select additional inputs from and to receive the child output (default: \"default\")",
+
+	// CPFPResult help.
+	"cpfpresult-txid":      "Hash of the child transaction",
+	"cpfpresult-parentfee": "Fee in DCR paid by the parent transaction, or zero if unknown",
+	"cpfpresult-fee":       "Fee in DCR paid by the child transaction",
+	"cpfpresult-warning":   "Set when the parent transaction spends outputs of other unconfirmed transactions",
+
+	// CreateInvoiceCmd help.
+	"createinvoice--synopsis": "Requests payment of an amount to a new external address of an account.\n" +
+		"Outputs paying the address are recorded as payments of the invoice as they are received.",
+	"createinvoice-account":   "Account to derive the invoice address from",
+	"createinvoice-amount":    "Requested amount in DCR, or zero to accept any amount",
+	"createinvoice-memo":      "Description of the payment",
+	"createinvoice-expiry":    "Number of seconds after which the invoice expires unless fully paid, or zero to never expire",
+	"createinvoice-gappolicy": `String defining the policy to use when the BIP0044 gap limit would be violated, may be "error", "ignore", or "wrap"`,
+
 	// CreateMultisigCmd help.
 	"createmultisig--synopsis": "Generate a multisig address and redeem script.",
 	"createmultisig-keys":      "Pubkeys and/or pay-to-pubkey-hash addresses to partially control the multisig address",
@@ -54,6 +114,45 @@ var helpDescsEnUS = map[string]string{
 	"createmultisigresult-address":      "The generated pay-to-script-hash address",
 	"createmultisigresult-redeemScript": "The script required to redeem outputs paid to the multisig address",
 
+	// CreateMultisigAccountCmd help.
+	"createmultisigaccount--synopsis": "Creates an M-of-N multisig vault account from the account extended public keys of every cosigner.\n" +
+		"P2SH addresses are derived from the external branch of every key and are identical for every cosigner creating the account from the same keys in any order.\n" +
+		"When a key belongs to an account of this wallet, the wallet can sign transactions spending from the vault.",
+	"createmultisigaccount-name":      "Name of the new account",
+	"createmultisigaccount-nrequired": "The number of cosigner signatures required to spend from the vault",
+	"createmultisigaccount-xpubs":     "Account extended public keys of all cosigners",
+
+	// CreateMultisigAccountResult help.
+	"createmultisigaccountresult-account":    "The account number of the new account",
+	"createmultisigaccountresult-descriptor": "The output script descriptor of the account",
+
+	// CreateMultisigAccountTxCmd help.
+	"createmultisigaccounttx--synopsis": "Creates an unsigned transaction spending outputs of a multisig vault account, returning change to the vault.\n" +
+		"Cosigners add their signatures with signrawtransaction or walletprocesspsbt until the required number of signatures are present.",
+	"createmultisigaccounttx-account":        "Name of the multisig account",
+	"createmultisigaccounttx-amounts":        "JSON object with the destination addresses as keys and amounts as values",
+	"createmultisigaccounttx-amounts--key":   "address",
+	"createmultisigaccounttx-amounts--value": "n.nnn",
+	"createmultisigaccounttx-amounts--desc":  "The destination address as the key and the amount in DCR as the value",
+	"createmultisigaccounttx-minconf":        "Minimum number of block confirmations required before a vault output is spent",
+
+	// CreateMultisigAccountTxResult help.
+	"createmultisigaccounttxresult-hex":  "The serialized unsigned transaction",
+	"createmultisigaccounttxresult-psbt": "The transaction as a base64-encoded partially signed transaction with the vault redeem scripts",
+	"createmultisigaccounttxresult-fee":  "The transaction fee",
+
+	// CreatePSBTCmd help.
+	"createpsbt--synopsis": "Returns a new partially signed transaction spending the provided inputs and sending to the provided addresses.\n" +
+		"The walletprocesspsbt command may be used to add wallet metadata and signatures to the result.",
+	"createpsbt-inputs":         "The inputs to the transaction",
+	"createpsbt-amounts":        "JSON object with the destination addresses as keys and amounts as values",
+	"createpsbt-amounts--key":   "address",
+	"createpsbt-amounts--value": "n.nnn",
+	"createpsbt-amounts--desc":  "The destination address as the key and the amount in DCR as the value",
+	"createpsbt-locktime":       "Locktime value; a non-zero value will also locktime-activate the inputs",
+	"createpsbt-expiry":         "Expiry value; a non-zero value when the transaction expiry",
+	"createpsbt--result0":       "The base64-encoded partially signed transaction",
+
 	// CreateRawTransactionCmd help.
 	"createrawtransaction--synopsis": "Returns a new transaction spending the provided inputs and sending to the provided addresses.\n" +
 		"The transaction inputs are not signed in the created transaction.\n" +
@@ -72,6 +171,76 @@ var helpDescsEnUS = map[string]string{
 	"dumpprivkey-address":   "The address to return a private key for",
 	"dumpprivkey--result0":  "The WIF-encoded private key",
 
+	// DumpWalletCmd help.
+	"dumpwallet--synopsis": "Writes the wallet state which can not be recovered from the seed to a new JSON file.\n" +
+		"This includes account names, xpub accounts, imported private keys and scripts, labels and vote preferences.\n" +
+		"The file contains private keys and requires the wallet to be unlocked.",
+	"dumpwallet-filename": "Path of the file to create; existing files are not overwritten",
+
+	// DumpWalletResult help.
+	"dumpwalletresult-filename": "Absolute path of the created file",
+
+	// EstimateSmartFeeCmd help.
+	"estimatesmartfee--synopsis": "Estimates the fee rate required for a transaction to be mined within a number of blocks.\n" +
+		"Estimates are based on the number of blocks recent unconfirmed transactions took to be mined: transactions relevant to the wallet, and all transactions relayed by peers when syncing with SPV.\n" +
+		"When the wallet has too little data for an estimate, the request is passed through to dcrd if the wallet is synced using dcrd RPC.",
+	"estimatesmartfee-confirmations": "The number of blocks the transaction should be mined within",
+	"estimatesmartfee-mode":          "The estimate mode (\"economical\" or \"conservative\"); conservative estimates require more transactions paying the fee rate to have been mined within the target",
+
+	// EstimateSmartFeeResult help.
+	"estimatesmartfeeresult-feerate": "The estimated fee rate in DCR/kB, or the relay fee when no estimate is available from the wallet or dcrd",
+	"estimatesmartfeeresult-errors":  "Errors encountered while estimating the fee rate",
+	"estimatesmartfeeresult-blocks":  "The number of blocks the estimate is valid for",
+
+	// EstimateStakeDiffCmd help.
+	"estimatestakediff--synopsis": "Estimates the ticket price of the next ticket window from the ticket pool size and the tickets purchased in the current window.",
+	"estimatestakediff-tickets":   "Also estimate the price if this number of tickets is purchased in the remainder of the current window",
+
+	// EstimateStakeDiffResult help.
+	"estimatestakediffresult-min":      "The price if no more tickets are purchased in the current window",
+	"estimatestakediffresult-max":      "The price if the maximum number of tickets is purchased in the remainder of the current window",
+	"estimatestakediffresult-expected": "The price if tickets continue to be purchased at the average rate of the current window",
+	"estimatestakediffresult-user":     "The price if the requested number of tickets is purchased in the remainder of the current window",
+
+	// ExportHistoryCmd help.
+	"exporthistory--synopsis": "Exports the mined transaction history for accounting, with an entry for each account debited or credited by each transaction.\n" +
+		"The net change of an account balance is the credit minus the debit and includes any fee paid by the account.\n" +
+		"Entries are categorized as receive, send, coinbase, ticketpurchase, votereward, or revocation.",
+	"exporthistory-format":      `The output format: "csv" or "json"`,
+	"exporthistory-startheight": "Height of the first exported block",
+	"exporthistory-endheight":   "Height of the last exported block, or -1 to export through the main chain tip",
+	"exporthistory-account":     "If set, limits the export to entries of a single account",
+	"exporthistory-labels":      "Include the transaction label, or the first output label of the account, with each entry",
+	"exporthistory-balances":    "Include the running balance of the account after each entry, computed from the start of the wallet history",
+	"exporthistory--condition0": `format is "csv"`,
+	"exporthistory--condition1": `format is "json"`,
+	"exporthistory--result0":    "CSV text with a header row, times in RFC 3339 format and amounts in DCR",
+
+	// ExportHistoryResult help.
+	"exporthistoryresult-time":        "The Unix time of the block",
+	"exporthistoryresult-blockheight": "The height of the block mining the transaction",
+	"exporthistoryresult-blockhash":   "The hash of the block mining the transaction",
+	"exporthistoryresult-txid":        "The transaction hash",
+	"exporthistoryresult-type":        `The transaction type: "regular", "coinbase", "ticket", "vote", or "revocation"`,
+	"exporthistoryresult-category":    `The accounting category: "receive", "send", "coinbase", "ticketpurchase", "votereward", or "revocation"`,
+	"exporthistoryresult-account":     "The account name",
+	"exporthistoryresult-debit":       "The total of account outputs spent by the transaction in DCR",
+	"exporthistoryresult-credit":      "The total of outputs paying the account in DCR",
+	"exporthistoryresult-fee":         "The transaction fee paid by the account in DCR",
+	"exporthistoryresult-net":         "The change of the account balance in DCR",
+	"exporthistoryresult-balance":     "The balance of the account after the transaction in DCR, if requested",
+	"exporthistoryresult-label":       "The transaction or output label, if requested",
+
+	// FinalizePSBTCmd help.
+	"finalizepsbt--synopsis": "Creates final signature scripts for all inputs of a partially signed transaction which have collected enough signatures.",
+	"finalizepsbt-psbt":      "The base64-encoded partially signed transaction",
+	"finalizepsbt-extract":   "Return the signed transaction instead of the packet if all inputs were finalized",
+
+	// FinalizePSBTResult help.
+	"finalizepsbtresult-psbt":     "The base64-encoded partially signed transaction (omitted when the transaction is extracted)",
+	"finalizepsbtresult-hex":      "The signed transaction encoded as a hexadecimal string (only when extracted)",
+	"finalizepsbtresult-complete": "Whether all inputs have been finalized",
+
 	// GenerateVote help.
 	"generatevote--synopsis":   "Returns the vote transaction encoded as a hexadecimal string",
 	"generatevote-blockhash":   "Block hash for the ticket",
@@ -97,6 +266,26 @@ var helpDescsEnUS = map[string]string{
 	"getaddressesbyaccount-account":   "Account name to fetch addresses for",
 	"getaddressesbyaccount--result0":  "All addresses controlled by 'account'",
 
+	// GetAuditLogCmd help.
+	"getauditlog--synopsis": "Returns entries of the audit log of privileged wallet operations, oldest first.",
+	"getauditlog-operation": "Only return entries recording this method",
+	"getauditlog-caller":    `Only return entries recorded for this caller, such as "jsonrpc:user@127.0.0.1:50000"`,
+	"getauditlog-since":     "Only return entries recorded at or after this unix time",
+	"getauditlog-count":     "Only return the most recent count matching entries",
+
+	// GetAuditLogResult help.
+	"getauditlogresult-seq":           "Sequence number of the entry",
+	"getauditlogresult-time":          "Unix time the entry was recorded",
+	"getauditlogresult-caller":        "The client which invoked the operation",
+	"getauditlogresult-operation":     "The invoked method",
+	"getauditlogresult-params":        "Parameters of the method, with secrets redacted",
+	"getauditlogresult-params--key":   "parameter",
+	"getauditlogresult-params--value": "value",
+	"getauditlogresult-params--desc":  "Parameters of the method keyed by name, with secrets redacted",
+	"getauditlogresult-error":         "Error message if the operation failed",
+	"getauditlogresult-prevhash":      "Hash of the previous entry",
+	"getauditlogresult-hash":          "Hash committing to this entry and all previous entries",
+
 	// GetBalanceCmd help.
 	"getbalance--synopsis": "Calculates and returns the balance of all accounts.",
 	"getbalance-minconf":   "Minimum number of block confirmations required before an unspent output's value is included in the balance",
@@ -222,6 +411,37 @@ var helpDescsEnUS = map[string]string{
 	"inforesult-keypoolsize":     "Unset",
 	"inforesult-keypoololdest":   "Unset",
 
+	// GetInvoiceCmd help.
+	"getinvoice--synopsis": "Returns an invoice and the status of its payments.",
+	"getinvoice-id":        "The invoice ID",
+	"getinvoice-minconf":   "Minimum number of block confirmations required before a payment is counted as received",
+
+	// InvoiceResult help.
+	"invoiceresult-id":       "The invoice ID",
+	"invoiceresult-account":  "The account of the invoice address",
+	"invoiceresult-address":  "The address to pay",
+	"invoiceresult-amount":   "The requested amount in DCR, or zero if any amount is accepted",
+	"invoiceresult-memo":     "Description of the payment",
+	"invoiceresult-created":  "The Unix time the invoice was created",
+	"invoiceresult-expires":  "The Unix time the invoice expires, or zero if it never expires",
+	"invoiceresult-received": "The total of payments with at least minconf confirmations in DCR",
+	"invoiceresult-pending":  "The total of payments with fewer than minconf confirmations in DCR",
+	"invoiceresult-status":   `The invoice status: "unpaid", "partiallypaid", "paid", "overpaid", or "expired"`,
+	"invoiceresult-payments": "Transaction outputs paying the invoice address",
+	"invoiceresult-uri":      "The decred: payment URI requesting payment of the invoice",
+
+	// InvoicePaymentResult help.
+	"invoicepaymentresult-txid":          "The hash of the paying transaction",
+	"invoicepaymentresult-vout":          "The output index of the payment",
+	"invoicepaymentresult-amount":        "The amount paid in DCR",
+	"invoicepaymentresult-confirmations": "The number of block confirmations of the paying transaction",
+	"invoicepaymentresult-blockheight":   "The height of the block mining the paying transaction, or -1 if unmined",
+
+	// GetLabelCmd help.
+	"getlabel--synopsis": "Returns the label of an address, transaction or transaction output, or an empty string if it is unlabeled.",
+	"getlabel-target":    `Labeled address, transaction hash, or transaction output in the form "txid:index"`,
+	"getlabel--result0":  "The label",
+
 	// GetNewAddressCmd help.
 	"getnewaddress--synopsis": "Generates and returns a new payment address.",
 	"getnewaddress-account":   "Account name the new address will belong to (default=\"default\")",
@@ -283,6 +503,14 @@ var helpDescsEnUS = map[string]string{
 	"gettransactiondetailsresult-vout":              "The transaction output index",
 	"gettransactiondetailsresult-involveswatchonly": "Unset",
 
+	// ImportDescriptorAccountCmd help.
+	"importdescriptoraccount--synopsis": "Creates a watching-only account deriving addresses from a ranged output script descriptor.\n" +
+		"Supported descriptors are pkh(KEY), sh(multi(k,KEY,...)) and sh(sortedmulti(k,KEY,...)), where KEY is a hex public key or an extended public key with non-hardened derivation steps ending in /*.\n" +
+		"Addresses through the gap limit are watched immediately; a rescan is required to discover previous transactions.",
+	"importdescriptoraccount-name":       "Name of the new account",
+	"importdescriptoraccount-descriptor": "Output script descriptor, e.g. sh(sortedmulti(2,xpubA/0/*,xpubB/0/*,xpubC/0/*))",
+	"importdescriptoraccount--result0":   "The account number of the new account",
+
 	// ImportPrivKeyCmd help.
 	"importprivkey--synopsis": "Imports a WIF-encoded private key to the 'imported' account.",
 	"importprivkey-privkey":   "The WIF-encoded private key",
@@ -296,6 +524,13 @@ var helpDescsEnUS = map[string]string{
 	"importscript-rescan":    "Rescans the blockchain (since the genesis block, or scanfrom block) for outputs controlled by the imported key",
 	"importscript-scanfrom":  "Block number for where to start rescan from",
 
+	// ImportWalletCmd help.
+	"importwallet--synopsis": "Restores the wallet state of a file created by dumpwallet.\n" +
+		"The wallet must be created from the same seed as the exporting wallet and must be unlocked.",
+	"importwallet-filename": "Path of the file created by dumpwallet",
+	"importwallet-rescan":   "Rescan the blockchain (since the genesis block, or scanfrom block) for outputs controlled by imported keys and scripts",
+	"importwallet-scanfrom": "Block number for where to start rescan from",
+
 	// ImportXpub help.
 	"importxpub--synopsis": "Import a HD extended public key as a new account.",
 	"importxpub-name":      "Name of new account",
@@ -316,6 +551,30 @@ var helpDescsEnUS = map[string]string{
 	"listaccounts--result0--key":   "The account name",
 	"listaccounts--result0--value": "The account balance valued in decred",
 
+	// ListDescriptorAccountsCmd help.
+	"listdescriptoraccounts--synopsis": "Returns all descriptor accounts.",
+
+	// ListDescriptorAccountsResult help.
+	"listdescriptoraccountsresult-account":       "The account number",
+	"listdescriptoraccountsresult-name":          "The account name",
+	"listdescriptoraccountsresult-descriptor":    "The canonical output script descriptor of the account",
+	"listdescriptoraccountsresult-usedcount":     "The number of child addresses through the last used child",
+	"listdescriptoraccountsresult-returnedcount": "The number of child addresses through the last returned child",
+
+	// ListLabelsCmd help.
+	// ListInvoicesCmd help.
+	"listinvoices--synopsis": "Returns every invoice and the status of its payments.",
+	"listinvoices-status":    `If set, limits the returned invoices to a single status: "unpaid", "partiallypaid", "paid", "overpaid", or "expired"`,
+	"listinvoices-minconf":   "Minimum number of block confirmations required before a payment is counted as received",
+
+	"listlabels--synopsis": "Returns all labels of addresses, transactions and transaction outputs.",
+	"listlabels-kind":      `If set, limits the returned labels to a single kind: "address", "transaction", or "output"`,
+
+	// ListLabelsResult help.
+	"listlabelsresult-kind":   `The kind of the labeled target: "address", "transaction", or "output"`,
+	"listlabelsresult-target": `The labeled address, transaction hash, or transaction output in the form "txid:index"`,
+	"listlabelsresult-label":  "The label",
+
 	// ListLockUnspentCmd help.
 	"listlockunspent--synopsis": "Returns a JSON array of outpoints marked as locked (with lockunspent) for this wallet session.",
 
@@ -384,6 +643,7 @@ var helpDescsEnUS = map[string]string{
 	"listtransactionsresult-comment":           "Unset",
 	"listtransactionsresult-otheraccount":      "Unset",
 	"listtransactionsresult-txtype":            "The type of tx (regular tx, stake tx)",
+	"listtransactionsresult-label":             "The label of the output, its transaction, or its address, if any",
 
 	// ListTransactionsCmd help.
 	"listtransactions--synopsis":        "Returns a JSON array of objects containing verbose details for wallet transactions.",
@@ -410,6 +670,22 @@ var helpDescsEnUS = map[string]string{
 	"listunspentresult-spendable":     "Whether the output is entirely controlled by wallet keys/scripts (false for partially controlled multisig outputs or outputs to watch-only addresses)",
 	"listunspentresult-txtype":        "The type of the transaction",
 	"listunspentresult-tree":          "The tree the transaction comes from",
+	"listunspentresult-label":         "The label of the output, its transaction, or its address, if any",
+
+	// ListVSPTicketsCmd help.
+	"listvsptickets--synopsis": "Returns the fee payment state of every ticket purchased through a fee-based VSP.",
+
+	// ListVSPTicketsResult help.
+	"listvspticketsresult-tickethash": "The hash of the ticket",
+	"listvspticketsresult-vsp":        "The base URL of the VSP voting the ticket",
+	"listvspticketsresult-feeaccount": "The account paying the fee",
+	"listvspticketsresult-state":      `The state of the fee payment: "unpaid", "addressed", "paid", "confirmed", or "abandoned"`,
+	"listvspticketsresult-feeaddress": "The address the fee is paid to, omitted until requested from the VSP",
+	"listvspticketsresult-feeamount":  "The fee amount in DCR, omitted until requested from the VSP",
+	"listvspticketsresult-expiration": "The Unix time after which the fee address can no longer be paid",
+	"listvspticketsresult-feetxhash":  "The hash of the transaction paying the fee, omitted until created",
+	"listvspticketsresult-attempts":   "The number of consecutive failed attempts to progress the fee payment",
+	"listvspticketsresult-lasterror":  "The error of the last failed attempt",
 
 	// LockUnspentCmd help.
 	"lockunspent--synopsis": "Locks or unlocks an unspent output.\n" +
@@ -434,21 +710,22 @@ var helpDescsEnUS = map[string]string{
 	// SendManyCmd help.
 	"sendmany--synopsis": "Authors, signs, and sends a transaction that outputs to many payment addresses.\n" +
 		"A change output is automatically included to send extra output value back to the original account.",
-	"sendmany-fromaccount":    "Account to pick unspent outputs from",
-	"sendmany-amounts":        "Pairs of payment addresses and the output amount to pay each",
-	"sendmany-amounts--desc":  "JSON object using payment addresses as keys and output amounts valued in decred to send to each address",
-	"sendmany-amounts--key":   "Address to pay",
-	"sendmany-amounts--value": "Amount to send to the payment address valued in decred",
-	"sendmany-minconf":        "Minimum number of block confirmations required before a transaction output is eligible to be spent",
-	"sendmany-comment":        "Unused",
-	"sendmany--result0":       "The transaction hash of the sent transaction",
+	"sendmany-fromaccount":        "Account to pick unspent outputs from",
+	"sendmany-amounts":            "Pairs of payment addresses and the output amount to pay each",
+	"sendmany-amounts--desc":      "JSON object using payment addresses as keys and output amounts valued in decred to send to each address",
+	"sendmany-amounts--key":       "Address to pay",
+	"sendmany-amounts--value":     "Amount to send to the payment address valued in decred",
+	"sendmany-minconf":            "Minimum number of block confirmations required before a transaction output is eligible to be spent",
+	"sendmany-comment":            "Unused",
+	"sendmany-selectionalgorithm": "Output selection algorithm (\"default\", \"all\", \"largestfirst\", \"smallestfirst\", \"oldestfirst\", \"branchandbound\" to avoid change outputs, or \"avoidmixing\" to never spend mixed and unmixed outputs together)",
+	"sendmany--result0":           "The transaction hash of the sent transaction",
 
 	// SendToAddressCmd help.
 	"sendtoaddress--synopsis": "Authors, signs, and sends a transaction that outputs some amount to a payment address.\n" +
 		"Unlike sendfrom, outputs are always chosen from the default account.\n" +
 		"A change output is automatically included to send extra output value back to the original account.",
-	"sendtoaddress-address":   "Address to pay",
-	"sendtoaddress-amount":    "Amount to send to the payment address valued in decred",
+	"sendtoaddress-address":   "Address to pay, or a decred: payment URI",
+	"sendtoaddress-amount":    "Amount to send to the payment address valued in decred, or zero to pay the amount requested by a payment URI",
 	"sendtoaddress-comment":   "Unused",
 	"sendtoaddress-commentto": "Unused",
 	"sendtoaddress--result0":  "The transaction hash of the sent transaction",
@@ -474,6 +751,20 @@ var helpDescsEnUS = map[string]string{
 	"setticketmaxprice--synopsis": "Set the max price user is willing to pay for a ticket.",
 	"setticketmaxprice-max":       "The max price (in dcr).",
 
+	// SetAccountPassphraseCmd help.
+	"setaccountpassphrase--synopsis": "Encrypts the private keys of an account with a passphrase separate from the wallet passphrase.\n" +
+		"The account must then be unlocked with walletpassphrase, after unlocking the wallet, before its keys can sign transactions.\n" +
+		"An empty passphrase removes the account passphrase. The wallet, and an account with a passphrase, must be unlocked.\n" +
+		"The account passphrase restricts the use of the account keys by the wallet, but does not protect them cryptographically:\n" +
+		"the wallet passphrase still decrypts the coin type key from which every account key is derived.",
+	"setaccountpassphrase-account":    "Account to encrypt",
+	"setaccountpassphrase-passphrase": "The new account passphrase, or an empty string to remove the passphrase",
+
+	// SetLabelCmd help.
+	"setlabel--synopsis": "Attaches a label to an address, transaction or transaction output, replacing any previous label.",
+	"setlabel-target":    `Address, transaction hash, or transaction output in the form "txid:index" to label`,
+	"setlabel-label":     "The label, or an empty string to remove the label",
+
 	// SetTxFeeCmd help.
 	"settxfee--synopsis": "Modify the fee per kB of the serialized tx size used each time more fee is required for an authored transaction.",
 	"settxfee-amount":    "The new fee per kB of the serialized tx size valued in decred",
@@ -572,18 +863,30 @@ var helpDescsEnUS = map[string]string{
 	"version--result0--value": "Object containing the semantic version",
 
 	// WalletLockCmd help.
-	"walletlock--synopsis": "Lock the wallet.",
+	"walletlock--synopsis": "Lock the wallet, or only an account encrypted by its own passphrase.",
+	"walletlock-account":   "Account to lock instead of the wallet",
 
 	// WalletPassphraseCmd help.
-	"walletpassphrase--synopsis":  "Unlock the wallet.",
-	"walletpassphrase-passphrase": "The wallet passphrase",
-	"walletpassphrase-timeout":    "The number of seconds to wait before the wallet automatically locks",
+	"walletpassphrase--synopsis":  "Unlock the wallet, or an account encrypted by its own passphrase after unlocking the wallet.",
+	"walletpassphrase-passphrase": "The wallet passphrase, or the account passphrase when an account is specified",
+	"walletpassphrase-timeout":    "The number of seconds to wait before the wallet or account automatically locks",
+	"walletpassphrase-account":    "Account to unlock with its own passphrase instead of the wallet",
 
 	// WalletPassphraseChangeCmd help.
 	"walletpassphrasechange--synopsis":     "Change the wallet passphrase.",
 	"walletpassphrasechange-oldpassphrase": "The old wallet passphrase",
 	"walletpassphrasechange-newpassphrase": "The new wallet passphrase",
 
+	// WalletProcessPSBTCmd help.
+	"walletprocesspsbt--synopsis":   "Updates a partially signed transaction with previous outputs, redeem scripts and key derivations known by the wallet, optionally signs inputs with wallet keys, and finalizes all inputs which have collected enough signatures. Packets describing a previous output differently than the wallet are rejected.",
+	"walletprocesspsbt-psbt":        "The base64-encoded partially signed transaction",
+	"walletprocesspsbt-sign":        "Add signatures for inputs spending outputs controlled by wallet keys (requires an unlocked wallet)",
+	"walletprocesspsbt-sighashtype": "Sighash flags (ALL, NONE, SINGLE and combinations with ANYONECANPAY)",
+
+	// WalletProcessPSBTResult help.
+	"walletprocesspsbtresult-psbt":     "The updated base64-encoded partially signed transaction",
+	"walletprocesspsbtresult-complete": "Whether all inputs have been finalized",
+
 	// CreateNewAccountCmd help.
 	"createnewaccount--synopsis": "Creates a new account.\n" +
 		"The wallet must be unlocked for this request to succeed.",
@@ -673,6 +976,21 @@ var helpDescsEnUS = map[string]string{
 	"stakepooluserinforesult-invalid": "A list of invalid tickets that the user has added",
 	"stakepooluserinforesult-tickets": "A list of valid tickets that the user has added",
 
+	// StakeRewardEstimateCmd help.
+	"stakerewardestimate--synopsis": "Estimates the chance of voting, the average vote time and the return of a ticket purchased at the next block.\n" +
+		"Returns are the vote subsidy expected at the average vote height and do not include transaction fees.",
+
+	// StakeRewardEstimateResult help.
+	"stakerewardestimateresult-ticketprice":       "The price of a ticket purchased at the next block",
+	"stakerewardestimateresult-poolsize":          "The live ticket pool size used for the estimate",
+	"stakerewardestimateresult-voteprobability":   "The probability of the ticket being called to vote before it expires",
+	"stakerewardestimateresult-expiryprobability": "The probability of the ticket expiring without voting",
+	"stakerewardestimateresult-averagevoteblocks": "The average number of blocks from purchase until the ticket votes",
+	"stakerewardestimateresult-averagevotetime":   "The average number of seconds from purchase until the ticket votes",
+	"stakerewardestimateresult-votereward":        "The vote subsidy at the average vote height",
+	"stakerewardestimateresult-roi":               "The expected reward, accounting for the chance of expiry, as a proportion of the ticket price",
+	"stakerewardestimateresult-annualroi":         "The expected return scaled to one year by the average time the ticket price is locked",
+
 	"pooluserticket-spentbyheight": "The height in which the ticket was spent",
 	"pooluserticket-spentby":       "The vote in which the ticket was spent",
 	"pooluserticket-ticketheight":  "The height in which the ticket was added",
@@ -688,11 +1006,69 @@ var helpDescsEnUS = map[string]string{
 	"scriptinfo-address":      "The script address",
 	"scriptinfo-hash160":      "The script hash",
 
+	// TicketReportCmd help.
+	"ticketreport--synopsis": "Reports the lifecycle of every ticket with commitments controlled by the wallet, or summarizes their profitability by account and period.\n" +
+		"The reward of a ticket is the amount returned by its vote or revocation in excess of the wallet-controlled commitments, and its profit is the reward less the purchase and VSP fees.\n" +
+		"Summaries include tickets in the period they were voted or revoked, or the period they were purchased while unspent.",
+	"ticketreport-report":      `The report: "tickets" for the lifecycle of each ticket, or "summary" for profitability summaries`,
+	"ticketreport-format":      `The output format: "csv" or "json"`,
+	"ticketreport-account":     "If set, limits the report to tickets of a single account",
+	"ticketreport-period":      `The summary period: "all", "day", "week" (beginning Monday), "month", or "year"`,
+	"ticketreport--condition0": `format is "csv"`,
+	"ticketreport--condition1": `report is "tickets" and format is "json"`,
+	"ticketreport--condition2": `report is "summary" and format is "json"`,
+	"ticketreport--result0":    "CSV text with a header row, times in RFC 3339 format and amounts in DCR",
+
+	// TicketReportTicketResult help.
+	"ticketreportticketresult-tickethash":     "The ticket hash",
+	"ticketreportticketresult-account":        "The account of the first wallet-controlled commitment",
+	"ticketreportticketresult-status":         `The ticket status: "unmined", "immature", "live", "expired", "voted", or "revoked"`,
+	"ticketreportticketresult-price":          "The ticket price in DCR",
+	"ticketreportticketresult-purchasefee":    "The transaction fee of the ticket purchase in DCR",
+	"ticketreportticketresult-commitment":     "The total of the wallet-controlled commitments in DCR",
+	"ticketreportticketresult-vspfee":         "The total of the commitments paid to other parties and the fee paid to a fee-based VSP in DCR",
+	"ticketreportticketresult-purchaseheight": "The height of the block mining the ticket, or -1 if unmined",
+	"ticketreportticketresult-purchasetime":   "The Unix time of the block mining the ticket, or of when the ticket was received if unmined",
+	"ticketreportticketresult-maturityheight": "The height at which the ticket is live, or -1 if unmined",
+	"ticketreportticketresult-spenderhash":    "The hash of the vote or revocation, if spent",
+	"ticketreportticketresult-spenderheight":  "The height of the block mining the vote or revocation, if spent and mined",
+	"ticketreportticketresult-spendertime":    "The Unix time of the vote or revocation, if spent",
+	"ticketreportticketresult-daystovote":     "The days from purchase until vote, if voted",
+	"ticketreportticketresult-subsidy":        "The vote subsidy in DCR",
+	"ticketreportticketresult-reward":         "The amount returned in excess of the wallet-controlled commitments in DCR",
+	"ticketreportticketresult-profit":         "The reward less the purchase and VSP fees in DCR",
+
+	// TicketReportSummaryResult help.
+	"ticketreportsummaryresult-account":           "The account name",
+	"ticketreportsummaryresult-periodstart":       `The Unix time of the UTC start of the period, omitted for period "all"`,
+	"ticketreportsummaryresult-tickets":           "The number of tickets",
+	"ticketreportsummaryresult-voted":             "The number of voted tickets",
+	"ticketreportsummaryresult-revoked":           "The number of revoked tickets",
+	"ticketreportsummaryresult-unspent":           "The number of unspent tickets",
+	"ticketreportsummaryresult-invested":          "The total ticket price in DCR",
+	"ticketreportsummaryresult-purchasefees":      "The total purchase fees in DCR",
+	"ticketreportsummaryresult-vspfees":           "The total VSP fees in DCR",
+	"ticketreportsummaryresult-subsidy":           "The total vote subsidy in DCR",
+	"ticketreportsummaryresult-rewards":           "The total rewards in DCR",
+	"ticketreportsummaryresult-profit":            "The total profit in DCR",
+	"ticketreportsummaryresult-averagedaystovote": "The average days from purchase until vote of the voted tickets",
+
 	// TicketsForAddressCmd help.
 	"ticketsforaddress--synopsis": "Request all the tickets for an address.",
 	"ticketsforaddress-address":   "Address to look for.",
 	"ticketsforaddress--result0":  "Tickets owned by the specified address.",
 
+	// ParsePaymentURICmd help.
+	"parsepaymenturi--synopsis": "Decodes a decred: payment URI.",
+	"parsepaymenturi-uri":       "The payment URI",
+
+	// ParsePaymentURIResult help.
+	"parsepaymenturiresult-address": "The address to pay",
+	"parsepaymenturiresult-amount":  "The requested amount in DCR, omitted if no amount is requested",
+	"parsepaymenturiresult-label":   "The name of the recipient",
+	"parsepaymenturiresult-message": "Description of the payment",
+	"parsepaymenturiresult-invoice": "Identifier of the invoice being paid",
+
 	// PurchaseTicketCmd help.
 	"purchaseticket--synopsis":          "Purchase ticket using available funds.",
 	"purchaseticket--result0":           "Hash of the resulting ticket",
@@ -708,6 +1084,14 @@ var helpDescsEnUS = map[string]string{
 	"purchaseticket-comment":            "Unused",
 	"purchaseticket-ticketfee":          "The transaction fee rate (DCR/kB) to use (overrides fees set by the wallet config or settxfee RPC)",
 
+	// PurchaseVSPTicketCmd help.
+	"purchasevspticket--synopsis":   "Purchase tickets voted by the fee-based VSP configured with --vspurl and pay their fees from the account.",
+	"purchasevspticket--result0":    "Hashes of the purchased tickets",
+	"purchasevspticket-fromaccount": "The account purchasing the tickets, paying their fees, and voting them",
+	"purchasevspticket-numtickets":  "The number of tickets to purchase",
+	"purchasevspticket-minconf":     "Minimum number of block confirmations required by spent outputs",
+	"purchasevspticket-expiry":      "Height at which the purchased tickets expire",
+
 	// SetTicketFeeCmd help.
 	"setticketfee--synopsis": "Modify the fee per kB of the serialized tx size used each time more fee is required for an authored stake transaction.",
 	"setticketfee-fee":       "The new fee per kB of the serialized tx size valued in decred",
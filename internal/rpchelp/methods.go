@@ -33,21 +33,38 @@ var Methods = []struct {
 	{"addmultisigaddress", returnsString},
 	{"addticket", nil},
 	{"auditreuse", []interface{}{(*map[string][]string)(nil)}},
+	{"backupwallet", nil},
+	{"bakemacaroon", []interface{}{(*types.BakeMacaroonResult)(nil)}},
+	{"bumpfee", []interface{}{(*types.BumpFeeResult)(nil)}},
+	{"combinepsbt", returnsString},
 	{"consolidate", returnsString},
+	{"cpfp", []interface{}{(*types.CPFPResult)(nil)}},
+	{"createinvoice", []interface{}{(*types.InvoiceResult)(nil)}},
 	{"createmultisig", []interface{}{(*types.CreateMultiSigResult)(nil)}},
+	{"createmultisigaccount", []interface{}{(*types.CreateMultisigAccountResult)(nil)}},
+	{"createmultisigaccounttx", []interface{}{(*types.CreateMultisigAccountTxResult)(nil)}},
 	{"createnewaccount", nil},
+	{"createpsbt", returnsString},
 	{"createrawtransaction", returnsString},
 	{"dumpprivkey", returnsString},
+	{"dumpwallet", []interface{}{(*types.DumpWalletResult)(nil)}},
+	{"estimatesmartfee", []interface{}{(*dcrdtypes.EstimateSmartFeeResult)(nil)}},
+	{"estimatestakediff", []interface{}{(*dcrdtypes.EstimateStakeDiffResult)(nil)}},
+	{"exporthistory", []interface{}{(*string)(nil), (*[]types.ExportHistoryResult)(nil)}},
+	{"finalizepsbt", []interface{}{(*types.FinalizePSBTResult)(nil)}},
 	{"generatevote", []interface{}{(*types.GenerateVoteResult)(nil)}},
 	{"getaccountaddress", returnsString},
 	{"getaccount", returnsString},
 	{"getaddressesbyaccount", returnsStringArray},
+	{"getauditlog", []interface{}{(*[]types.GetAuditLogResult)(nil)}},
 	{"getbalance", []interface{}{(*types.GetBalanceResult)(nil)}},
 	{"getbestblockhash", returnsString},
 	{"getbestblock", []interface{}{(*dcrdtypes.GetBestBlockResult)(nil)}},
 	{"getblockcount", returnsNumber},
 	{"getblockhash", returnsString},
 	{"getinfo", []interface{}{(*types.InfoWalletResult)(nil)}},
+	{"getinvoice", []interface{}{(*types.InvoiceResult)(nil)}},
+	{"getlabel", returnsString},
 	{"getmasterpubkey", []interface{}{(*string)(nil)}},
 	{"getmultisigoutinfo", []interface{}{(*types.GetMultisigOutInfoResult)(nil)}},
 	{"getnewaddress", returnsString},
@@ -62,14 +79,19 @@ var Methods = []struct {
 	{"getvotechoices", []interface{}{(*types.GetVoteChoicesResult)(nil)}},
 	{"getwalletfee", returnsNumber},
 	{"help", append(returnsString, returnsString[0])},
+	{"importdescriptoraccount", []interface{}{(*uint32)(nil)}},
 	{"importprivkey", nil},
 	{"importscript", nil},
+	{"importwallet", nil},
 	{"importxpub", nil},
 	{"mixaccount", nil},
 	{"mixoutput", nil},
 	{"listaccounts", []interface{}{(*map[string]float64)(nil)}},
 	{"listaddresstransactions", returnsLTRArray},
 	{"listalltransactions", returnsLTRArray},
+	{"listdescriptoraccounts", []interface{}{(*[]types.ListDescriptorAccountsResult)(nil)}},
+	{"listinvoices", []interface{}{(*[]types.InvoiceResult)(nil)}},
+	{"listlabels", []interface{}{(*[]types.ListLabelsResult)(nil)}},
 	{"listlockunspent", []interface{}{(*[]dcrdtypes.TransactionInput)(nil)}},
 	{"listreceivedbyaccount", []interface{}{(*[]types.ListReceivedByAccountResult)(nil)}},
 	{"listreceivedbyaddress", []interface{}{(*[]types.ListReceivedByAddressResult)(nil)}},
@@ -77,8 +99,11 @@ var Methods = []struct {
 	{"listsinceblock", []interface{}{(*types.ListSinceBlockResult)(nil)}},
 	{"listtransactions", returnsLTRArray},
 	{"listunspent", []interface{}{(*types.ListUnspentResult)(nil)}},
+	{"listvsptickets", []interface{}{(*[]types.ListVSPTicketsResult)(nil)}},
 	{"lockunspent", returnsBool},
+	{"parsepaymenturi", []interface{}{(*types.ParsePaymentURIResult)(nil)}},
 	{"purchaseticket", returnsString},
+	{"purchasevspticket", returnsStringArray},
 	{"redeemmultisigout", []interface{}{(*types.RedeemMultiSigOutResult)(nil)}},
 	{"redeemmultisigouts", []interface{}{(*types.RedeemMultiSigOutResult)(nil)}},
 	{"renameaccount", nil},
@@ -88,6 +113,8 @@ var Methods = []struct {
 	{"sendmany", returnsString},
 	{"sendtoaddress", returnsString},
 	{"sendtomultisig", returnsString},
+	{"setaccountpassphrase", nil},
+	{"setlabel", nil},
 	{"setticketfee", returnsBool},
 	{"settxfee", returnsBool},
 	{"setvotechoice", nil},
@@ -95,7 +122,9 @@ var Methods = []struct {
 	{"signrawtransaction", []interface{}{(*types.SignRawTransactionResult)(nil)}},
 	{"signrawtransactions", []interface{}{(*types.SignRawTransactionsResult)(nil)}},
 	{"stakepooluserinfo", []interface{}{(*types.StakePoolUserInfoResult)(nil)}},
+	{"stakerewardestimate", []interface{}{(*types.StakeRewardEstimateResult)(nil)}},
 	{"sweepaccount", []interface{}{(*types.SweepAccountResult)(nil)}},
+	{"ticketreport", []interface{}{(*string)(nil), (*[]types.TicketReportTicketResult)(nil), (*[]types.TicketReportSummaryResult)(nil)}},
 	{"ticketsforaddress", returnsBool},
 	{"validateaddress", []interface{}{(*types.ValidateAddressWalletResult)(nil)}},
 	{"verifymessage", returnsBool},
@@ -105,6 +134,7 @@ var Methods = []struct {
 	{"walletlock", nil},
 	{"walletpassphrasechange", nil},
 	{"walletpassphrase", nil},
+	{"walletprocesspsbt", []interface{}{(*types.WalletProcessPSBTResult)(nil)}},
 }
 
 // HelpDescs contains the locale-specific help strings along with the locale.
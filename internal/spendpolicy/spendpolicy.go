@@ -0,0 +1,145 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package spendpolicy reads spending policy files, which describe the limits
+// the wallet enforces on transactions sending funds from accounts.
+//
+// A policy file is a JSON object with an optional default policy, applied to
+// every account without its own policy, and policies keyed by account name:
+//
+//	{
+//	  "default": {"dailylimit": 10},
+//	  "accounts": {
+//	    "hot": {
+//	      "dailylimit": 50,
+//	      "weeklylimit": 200,
+//	      "maxoutput": 25,
+//	      "alloweddestinations": ["Dsa..."],
+//	      "largespend": 20,
+//	      "largespenddelay": "12h"
+//	    }
+//	  }
+//	}
+//
+// Amounts are in DCR and the delay is a Go duration string.  Omitted or zero
+// values disable the limit.
+package spendpolicy
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3"
+)
+
+// File is the decoded policy file.
+type File struct {
+	Default  *Account            `json:"default"`
+	Accounts map[string]*Account `json:"accounts"`
+}
+
+// Account is the spending policy of an account in a policy file.
+type Account struct {
+	DailyLimit          float64  `json:"dailylimit"`
+	WeeklyLimit         float64  `json:"weeklylimit"`
+	MaxOutput           float64  `json:"maxoutput"`
+	AllowedDestinations []string `json:"alloweddestinations"`
+	LargeSpend          float64  `json:"largespend"`
+	LargeSpendDelay     string   `json:"largespenddelay"`
+}
+
+// Read reads and decodes a policy file.
+func Read(path string) (*File, error) {
+	const op errors.Op = "spendpolicy.Read"
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	defer f.Close()
+	dec := json.NewDecoder(f)
+	dec.DisallowUnknownFields()
+	file := new(File)
+	err = dec.Decode(file)
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	return file, nil
+}
+
+// Policy converts the policy file to the wallet spending policy.  Account names
+// are resolved to account numbers by accountNumber and destination addresses
+// are decoded for the network params.
+func (f *File) Policy(params dcrutil.AddressParams, accountNumber func(name string) (uint32, error)) (*wallet.SpendPolicy, error) {
+	const op errors.Op = "spendpolicy.Policy"
+	p := &wallet.SpendPolicy{
+		Accounts: make(map[uint32]*wallet.AccountSpendPolicy, len(f.Accounts)),
+	}
+	if f.Default != nil {
+		ap, err := f.Default.policy(params)
+		if err != nil {
+			return nil, errors.E(op, errors.Errorf("default policy: %v", err))
+		}
+		p.Default = ap
+	}
+	for name, a := range f.Accounts {
+		account, err := accountNumber(name)
+		if err != nil {
+			return nil, errors.E(op, errors.Errorf("account %q: %v", name, err))
+		}
+		ap, err := a.policy(params)
+		if err != nil {
+			return nil, errors.E(op, errors.Errorf("account %q policy: %v", name, err))
+		}
+		p.Accounts[account] = ap
+	}
+	return p, nil
+}
+
+func (a *Account) policy(params dcrutil.AddressParams) (*wallet.AccountSpendPolicy, error) {
+	var p wallet.AccountSpendPolicy
+	amounts := []struct {
+		name  string
+		value float64
+		amt   *dcrutil.Amount
+	}{
+		{"dailylimit", a.DailyLimit, &p.DailyLimit},
+		{"weeklylimit", a.WeeklyLimit, &p.WeeklyLimit},
+		{"maxoutput", a.MaxOutput, &p.MaxOutput},
+		{"largespend", a.LargeSpend, &p.LargeSpend},
+	}
+	for _, amt := range amounts {
+		v, err := dcrutil.NewAmount(amt.value)
+		if err != nil {
+			return nil, errors.E(errors.Invalid, errors.Errorf("%s: %v", amt.name, err))
+		}
+		if v < 0 {
+			return nil, errors.E(errors.Invalid, errors.Errorf("%s: negative amount", amt.name))
+		}
+		*amt.amt = v
+	}
+	for _, s := range a.AllowedDestinations {
+		addr, err := dcrutil.DecodeAddress(s, params)
+		if err != nil {
+			return nil, errors.E(errors.Encoding, errors.Errorf("destination %q: %v", s, err))
+		}
+		p.AllowedDestinations = append(p.AllowedDestinations, addr)
+	}
+	if a.LargeSpendDelay != "" {
+		d, err := time.ParseDuration(a.LargeSpendDelay)
+		if err != nil {
+			return nil, errors.E(errors.Invalid, errors.Errorf("largespenddelay: %v", err))
+		}
+		if d < 0 {
+			return nil, errors.E(errors.Invalid, "largespenddelay: negative duration")
+		}
+		p.LargeSpendDelay = d
+	}
+	if p.LargeSpend != 0 && p.LargeSpendDelay == 0 {
+		return nil, errors.E(errors.Invalid, "largespend requires a largespenddelay")
+	}
+	return &p, nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package spendpolicy
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrwallet/errors/v2"
+)
+
+func TestPolicy(t *testing.T) {
+	dir, err := ioutil.TempDir("", "spendpolicy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	params := chaincfg.MainNetParams()
+	accounts := map[string]uint32{"default": 0, "hot": 1}
+	accountNumber := func(name string) (uint32, error) {
+		account, ok := accounts[name]
+		if !ok {
+			return 0, errors.E(errors.NotExist, "no account")
+		}
+		return account, nil
+	}
+	const dest = "DsUZxxoHJSty8DCfwfartwTYbuhmVct7tJu"
+
+	tests := []struct {
+		name    string
+		file    string
+		readErr bool
+		err     bool
+	}{
+		{
+			name: "valid",
+			file: `{"default":{"dailylimit":10},"accounts":{"hot":{"dailylimit":1.5,"weeklylimit":5,` +
+				`"maxoutput":1,"alloweddestinations":["` + dest + `"],"largespend":1,"largespenddelay":"12h"}}}`,
+		},
+		{name: "unknown field", file: `{"accounts":{"hot":{"limit":1}}}`, readErr: true},
+		{name: "unknown account", file: `{"accounts":{"cold":{"dailylimit":1}}}`, err: true},
+		{name: "bad destination", file: `{"accounts":{"hot":{"alloweddestinations":["Dsbad"]}}}`, err: true},
+		{name: "negative limit", file: `{"accounts":{"hot":{"dailylimit":-1}}}`, err: true},
+		{name: "large spend without delay", file: `{"accounts":{"hot":{"largespend":1}}}`, err: true},
+	}
+	for _, test := range tests {
+		path := filepath.Join(dir, "policy.json")
+		err := ioutil.WriteFile(path, []byte(test.file), 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f, err := Read(path)
+		if (err != nil) != test.readErr {
+			t.Errorf("%s: unexpected read error %v", test.name, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		p, err := f.Policy(params, accountNumber)
+		if (err != nil) != test.err {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+			continue
+		}
+		if err != nil || test.name != "valid" {
+			continue
+		}
+		if p.Default == nil || p.Default.DailyLimit != 10e8 {
+			t.Errorf("default policy %+v", p.Default)
+		}
+		hot := p.Accounts[1]
+		if hot == nil || hot.DailyLimit != 15e7 || hot.WeeklyLimit != 5e8 || hot.MaxOutput != 1e8 ||
+			hot.LargeSpend != 1e8 || hot.LargeSpendDelay != 12*time.Hour ||
+			len(hot.AllowedDestinations) != 1 || hot.AllowedDestinations[0].Address() != dest {
+			t.Errorf("hot account policy %+v", hot)
+		}
+	}
+}
@@ -0,0 +1,303 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package ticketreport reports the lifecycle of the tickets purchased by a
+// wallet and summarizes their profitability by account and period.
+//
+// The reward of a ticket is the amount returned to the wallet by its vote or
+// revocation in excess of the wallet-controlled commitments, and its profit
+// is the reward less the ticket purchase fee and any fees paid to VSPs.
+// Tickets are summarized in the period they were voted or revoked, or the
+// period they were purchased while unspent.
+package ticketreport
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3"
+)
+
+// Summary periods.
+const (
+	All   = "all"
+	Day   = "day"
+	Week  = "week"
+	Month = "month"
+	Year  = "year"
+)
+
+var statuses = map[wallet.TicketStatus]string{
+	wallet.TicketStatusUnknown:  "unknown",
+	wallet.TicketStatusUnmined:  "unmined",
+	wallet.TicketStatusImmature: "immature",
+	wallet.TicketStatusLive:     "live",
+	wallet.TicketStatusVoted:    "voted",
+	wallet.TicketStatusRevoked:  "revoked",
+	wallet.TicketStatusMissed:   "missed",
+	wallet.TicketStatusExpired:  "expired",
+}
+
+// Ticket is the lifecycle of a ticket with the name of its account.
+type Ticket struct {
+	*wallet.TicketLifecycle
+	AccountName string
+}
+
+// StatusString returns the name of the ticket status.
+func (t *Ticket) StatusString() string {
+	return statuses[t.Status]
+}
+
+// Tickets returns the lifecycles of the wallet tickets, ordered by purchase
+// height with unmined tickets last.  Only tickets of a single account are
+// returned when account is non-nil.
+func Tickets(ctx context.Context, w *wallet.Wallet, account *uint32) ([]*Ticket, error) {
+	const op errors.Op = "ticketreport.Tickets"
+	lifecycles, err := w.TicketLifecycles(ctx)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	names := make(map[uint32]string)
+	tickets := make([]*Ticket, 0, len(lifecycles))
+	for _, lc := range lifecycles {
+		if account != nil && lc.Account != *account {
+			continue
+		}
+		name, ok := names[lc.Account]
+		if !ok {
+			name, err = w.AccountName(ctx, lc.Account)
+			if err != nil {
+				return nil, errors.E(op, err)
+			}
+			names[lc.Account] = name
+		}
+		tickets = append(tickets, &Ticket{TicketLifecycle: lc, AccountName: name})
+	}
+	return tickets, nil
+}
+
+// Summary describes the profitability of the tickets of an account in a
+// period.
+type Summary struct {
+	AccountNumber uint32
+	Account       string
+
+	// PeriodStart is the UTC start time of the period, and is zero when
+	// summarizing all tickets.
+	PeriodStart time.Time
+
+	Tickets int
+	Voted   int
+	Revoked int
+	Unspent int
+
+	// Invested is the total price of the tickets.
+	Invested     dcrutil.Amount
+	PurchaseFees dcrutil.Amount
+	VSPFees      dcrutil.Amount
+	Subsidy      dcrutil.Amount
+	Rewards      dcrutil.Amount
+	Profit       dcrutil.Amount
+
+	// AverageVoteTime is the average time from purchase until vote of the
+	// voted tickets.
+	AverageVoteTime time.Duration
+}
+
+// periodStart returns the UTC start of the period containing t.  Weeks begin
+// on Monday.
+func periodStart(period string, t time.Time) (time.Time, error) {
+	t = t.UTC()
+	y, m, d := t.Date()
+	switch period {
+	case All:
+		return time.Time{}, nil
+	case Day:
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC), nil
+	case Week:
+		offset := (int(t.Weekday()) + 6) % 7
+		return time.Date(y, m, d-offset, 0, 0, 0, 0, time.UTC), nil
+	case Month:
+		return time.Date(y, m, 1, 0, 0, 0, 0, time.UTC), nil
+	case Year:
+		return time.Date(y, 1, 1, 0, 0, 0, 0, time.UTC), nil
+	default:
+		return time.Time{}, errors.E(errors.Invalid, errors.Errorf("unknown period %q", period))
+	}
+}
+
+// Summarize summarizes tickets by account and period, ordered by account
+// number and period start.
+func Summarize(tickets []*Ticket, period string) ([]*Summary, error) {
+	const op errors.Op = "ticketreport.Summarize"
+	type key struct {
+		account uint32
+		start   time.Time
+	}
+	summaries := make(map[key]*Summary)
+	voteTimes := make(map[key]time.Duration)
+	for _, t := range tickets {
+		when := t.PurchaseTime
+		if t.Spent() {
+			when = t.SpenderTime
+		}
+		start, err := periodStart(period, when)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		k := key{t.Account, start}
+		s := summaries[k]
+		if s == nil {
+			s = &Summary{
+				AccountNumber: t.Account,
+				Account:       t.AccountName,
+				PeriodStart:   start,
+			}
+			summaries[k] = s
+		}
+		s.Tickets++
+		switch t.Status {
+		case wallet.TicketStatusVoted:
+			s.Voted++
+			voteTimes[k] += t.SpendDuration()
+		case wallet.TicketStatusRevoked:
+			s.Revoked++
+		default:
+			s.Unspent++
+		}
+		s.Invested += t.Price
+		s.PurchaseFees += t.PurchaseFee
+		s.VSPFees += t.VSPFee
+		s.Subsidy += t.Subsidy
+		s.Rewards += t.Reward()
+		s.Profit += t.Profit()
+	}
+
+	res := make([]*Summary, 0, len(summaries))
+	for k, s := range summaries {
+		if s.Voted != 0 {
+			s.AverageVoteTime = voteTimes[k] / time.Duration(s.Voted)
+		}
+		res = append(res, s)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].AccountNumber != res[j].AccountNumber {
+			return res[i].AccountNumber < res[j].AccountNumber
+		}
+		return res[i].PeriodStart.Before(res[j].PeriodStart)
+	})
+	return res, nil
+}
+
+// Days returns a duration in days.
+func Days(d time.Duration) float64 {
+	return d.Hours() / 24
+}
+
+func amount(a dcrutil.Amount) string {
+	return strconv.FormatFloat(a.ToCoin(), 'f', 8, 64)
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// WriteTicketsCSV writes tickets as CSV with a header row.  Times are written
+// in RFC 3339 format in UTC and amounts in DCR.  Spender columns are empty for
+// unspent tickets.
+func WriteTicketsCSV(w io.Writer, tickets []*Ticket) error {
+	cw := csv.NewWriter(w)
+	err := cw.Write([]string{"tickethash", "account", "status", "price", "purchasefee",
+		"commitment", "vspfee", "purchaseheight", "purchasetime", "maturityheight",
+		"spenderhash", "spenderheight", "spendertime", "daystovote", "subsidy",
+		"reward", "profit"})
+	if err != nil {
+		return err
+	}
+	for _, t := range tickets {
+		var spenderHash, spenderHeight, spenderTime, days string
+		if t.SpenderHash != nil {
+			spenderHash = t.SpenderHash.String()
+			spenderTime = formatTime(t.SpenderTime)
+			if t.SpenderHeight != -1 {
+				spenderHeight = strconv.FormatInt(int64(t.SpenderHeight), 10)
+			}
+		}
+		if t.Status == wallet.TicketStatusVoted {
+			days = strconv.FormatFloat(Days(t.SpendDuration()), 'f', 2, 64)
+		}
+		err := cw.Write([]string{
+			t.Hash.String(),
+			t.AccountName,
+			t.StatusString(),
+			amount(t.Price),
+			amount(t.PurchaseFee),
+			amount(t.Commitment),
+			amount(t.VSPFee),
+			strconv.FormatInt(int64(t.PurchaseHeight), 10),
+			formatTime(t.PurchaseTime),
+			strconv.FormatInt(int64(t.MaturityHeight), 10),
+			spenderHash,
+			spenderHeight,
+			spenderTime,
+			days,
+			amount(t.Subsidy),
+			amount(t.Reward()),
+			amount(t.Profit()),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteSummariesCSV writes summaries as CSV with a header row.  Period start
+// times are written as dates, and are empty when summarizing all tickets.
+func WriteSummariesCSV(w io.Writer, summaries []*Summary) error {
+	cw := csv.NewWriter(w)
+	err := cw.Write([]string{"account", "period", "tickets", "voted", "revoked",
+		"unspent", "invested", "purchasefees", "vspfees", "subsidy", "rewards",
+		"profit", "averagedaystovote"})
+	if err != nil {
+		return err
+	}
+	for _, s := range summaries {
+		var period string
+		if !s.PeriodStart.IsZero() {
+			period = s.PeriodStart.Format("2006-01-02")
+		}
+		err := cw.Write([]string{
+			s.Account,
+			period,
+			strconv.Itoa(s.Tickets),
+			strconv.Itoa(s.Voted),
+			strconv.Itoa(s.Revoked),
+			strconv.Itoa(s.Unspent),
+			amount(s.Invested),
+			amount(s.PurchaseFees),
+			amount(s.VSPFees),
+			amount(s.Subsidy),
+			amount(s.Rewards),
+			amount(s.Profit),
+			strconv.FormatFloat(Days(s.AverageVoteTime), 'f', 2, 64),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,181 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package ticketreport
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrwallet/wallet/v3"
+)
+
+func testTickets() []*Ticket {
+	bought := time.Date(2019, 12, 30, 12, 0, 0, 0, time.UTC) // Monday
+	voteHash := chainhash.Hash{2}
+	revokeHash := chainhash.Hash{3}
+	return []*Ticket{{
+		AccountName: "default",
+		TicketLifecycle: &wallet.TicketLifecycle{
+			Hash:           chainhash.Hash{1},
+			Status:         wallet.TicketStatusVoted,
+			Price:          100e8,
+			PurchaseFee:    1e4,
+			Commitment:     100e8 + 1e4,
+			VSPFee:         1e6,
+			PurchaseHeight: 100,
+			PurchaseTime:   bought,
+			MaturityHeight: 357,
+			SpenderHash:    &voteHash,
+			SpenderHeight:  400,
+			SpenderTime:    bought.Add(7 * 24 * time.Hour),
+			Subsidy:        2e8,
+			Returned:       102e8 + 1e4,
+		},
+	}, {
+		AccountName: "default",
+		TicketLifecycle: &wallet.TicketLifecycle{
+			Hash:           chainhash.Hash{4},
+			Status:         wallet.TicketStatusRevoked,
+			Price:          100e8,
+			PurchaseFee:    1e4,
+			Commitment:     100e8 + 1e4,
+			PurchaseHeight: 101,
+			PurchaseTime:   bought,
+			MaturityHeight: 358,
+			SpenderHash:    &revokeHash,
+			SpenderHeight:  402,
+			SpenderTime:    bought.Add(50 * time.Hour),
+			Returned:       100e8,
+		},
+	}, {
+		AccountName: "default",
+		TicketLifecycle: &wallet.TicketLifecycle{
+			Hash:           chainhash.Hash{5},
+			Status:         wallet.TicketStatusLive,
+			Price:          110e8,
+			PurchaseFee:    1e4,
+			Commitment:     110e8 + 1e4,
+			PurchaseHeight: 102,
+			PurchaseTime:   bought,
+			MaturityHeight: 359,
+			SpenderHeight:  -1,
+		},
+	}, {
+		AccountName: "staking",
+		TicketLifecycle: &wallet.TicketLifecycle{
+			Hash:           chainhash.Hash{6},
+			Account:        1,
+			Status:         wallet.TicketStatusUnmined,
+			Price:          120e8,
+			Commitment:     120e8,
+			PurchaseHeight: -1,
+			PurchaseTime:   bought,
+			MaturityHeight: -1,
+			SpenderHeight:  -1,
+		},
+	}}
+}
+
+func TestSummarize(t *testing.T) {
+	tickets := testTickets()
+
+	all, err := Summarize(tickets, All)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 2 {
+		t.Fatalf("got %d summaries, want 2", len(all))
+	}
+	s := all[0]
+	if s.Account != "default" || !s.PeriodStart.IsZero() || s.Tickets != 3 || s.Voted != 1 ||
+		s.Revoked != 1 || s.Unspent != 1 {
+		t.Errorf("unexpected default account summary %+v", s)
+	}
+	if s.Invested != 310e8 || s.PurchaseFees != 3e4 || s.VSPFees != 1e6 || s.Subsidy != 2e8 {
+		t.Errorf("unexpected default account amounts %+v", s)
+	}
+	// Vote reward 2e8, revocation reward -1e4.
+	if s.Rewards != 2e8-1e4 || s.Profit != 2e8-1e4-3e4-1e6 {
+		t.Errorf("rewards %v, profit %v", s.Rewards, s.Profit)
+	}
+	if s.AverageVoteTime != 7*24*time.Hour {
+		t.Errorf("average vote time %v", s.AverageVoteTime)
+	}
+	if all[1].AccountNumber != 1 || all[1].Unspent != 1 || all[1].Profit != 0 {
+		t.Errorf("unexpected staking account summary %+v", all[1])
+	}
+
+	// The revocation is in the first week of 2020 and the vote in the week
+	// after.  Unspent tickets are summarized by purchase time.
+	weekly, err := Summarize(tickets[:3], Week)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(weekly) != 2 {
+		t.Fatalf("got %d weekly summaries, want 2", len(weekly))
+	}
+	if !weekly[0].PeriodStart.Equal(time.Date(2019, 12, 30, 0, 0, 0, 0, time.UTC)) ||
+		weekly[0].Revoked != 1 || weekly[0].Unspent != 1 {
+		t.Errorf("unexpected first week %+v", weekly[0])
+	}
+	if !weekly[1].PeriodStart.Equal(time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC)) ||
+		weekly[1].Voted != 1 {
+		t.Errorf("unexpected second week %+v", weekly[1])
+	}
+
+	monthly, err := Summarize(tickets[:3], Month)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(monthly) != 2 || !monthly[1].PeriodStart.Equal(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)) ||
+		monthly[1].Voted != 1 || monthly[1].Revoked != 1 {
+		t.Errorf("unexpected monthly summaries %+v %+v", monthly[0], monthly[len(monthly)-1])
+	}
+
+	if _, err := Summarize(tickets, "decade"); err == nil {
+		t.Errorf("unknown period did not error")
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	tickets := testTickets()
+	var buf bytes.Buffer
+	err := WriteTicketsCSV(&buf, tickets[:1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want 2", len(lines))
+	}
+	want := chainhash.Hash{1}.String() + ",default,voted,100.00000000,0.00010000,100.00010000," +
+		"0.01000000,100,2019-12-30T12:00:00Z,357," + chainhash.Hash{2}.String() +
+		",400,2020-01-06T12:00:00Z,7.00,2.00000000,2.00000000,1.98990000"
+	if lines[1] != want {
+		t.Errorf("ticket row\n%s\nwant\n%s", lines[1], want)
+	}
+
+	summaries, err := Summarize(tickets, Year)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf.Reset()
+	err = WriteSummariesCSV(&buf, summaries)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines = strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("got %d lines, want 4", len(lines))
+	}
+	want = "default,2019-01-01,1,0,0,1,110.00000000,0.00010000,0.00000000,0.00000000," +
+		"0.00000000,-0.00010000,0.00"
+	if lines[1] != want {
+		t.Errorf("summary row\n%s\nwant\n%s", lines[1], want)
+	}
+}
@@ -0,0 +1,376 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package vsp
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/errors/v2"
+	"golang.org/x/crypto/ed25519"
+)
+
+// HTTP headers carrying the signatures of request and response bodies.
+const (
+	clientSignatureHeader = "VSP-Client-Signature"
+	serverSignatureHeader = "VSP-Server-Signature"
+)
+
+const (
+	apiVersion     = 3
+	requestTimeout = 30 * time.Second
+	maxResponse    = 1 << 20
+)
+
+// Error codes of API errors returned by the VSP.
+const (
+	CodeBadRequest         = 0
+	CodeInternalError      = 1
+	CodeVSPClosed          = 2
+	CodeFeeAlreadyReceived = 3
+	CodeInvalidFeeTx       = 4
+	CodeFeeTooSmall        = 5
+	CodeUnknownTicket      = 6
+	CodeTicketCannotVote   = 7
+	CodeFeeExpired         = 8
+	CodeInvalidVoteChoices = 9
+	CodeBadSignature       = 10
+)
+
+// APIError is an error response of the VSP.
+type APIError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("VSP error %d: %s", e.Code, e.Message)
+}
+
+// Signer signs messages with the private key of a wallet address.  Requests
+// about a ticket are signed by the address of the ticket commitment, proving
+// the client is the ticket owner.  *wallet.Wallet implements Signer.
+type Signer interface {
+	SignMessage(ctx context.Context, msg string, addr dcrutil.Address) ([]byte, error)
+}
+
+// Client makes requests to the HTTP API of a voting service provider.
+type Client struct {
+	url    string
+	params *chaincfg.Params
+	http   *http.Client
+
+	pubKeyMu sync.Mutex
+	pubKey   ed25519.PublicKey
+
+	// processMu serializes the processing of ticket fee payments.
+	processMu sync.Mutex
+}
+
+// NewClient returns a client of the VSP at the base URL rawurl.  Responses
+// must be signed by pubKey.  When pubKey is nil, the public key advertised by
+// the VSP is trusted by the first request and a warning is logged.
+func NewClient(rawurl string, pubKey ed25519.PublicKey, params *chaincfg.Params) (*Client, error) {
+	const op errors.Op = "vsp.NewClient"
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, errors.E(op, errors.Invalid, err)
+	}
+	if u.Scheme != "https" && u.Scheme != "http" || u.Host == "" {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("VSP URL %q is not an absolute HTTP(S) URL", rawurl))
+	}
+	if pubKey != nil && len(pubKey) != ed25519.PublicKeySize {
+		return nil, errors.E(op, errors.Invalid, "VSP public key has invalid length")
+	}
+	if pubKey == nil {
+		log.Warnf("The public key of VSP %s is not pinned and will be trusted from "+
+			"the VSP; set --vsppubkey to pin it", rawurl)
+	}
+	return &Client{
+		url:    strings.TrimSuffix(u.String(), "/"),
+		params: params,
+		http:   &http.Client{Timeout: requestTimeout},
+		pubKey: pubKey,
+	}, nil
+}
+
+// DecodePubKey decodes a base64 VSP public key.
+func DecodePubKey(s string) (ed25519.PublicKey, error) {
+	const op errors.Op = "vsp.DecodePubKey"
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	if len(b) != ed25519.PublicKeySize {
+		return nil, errors.E(op, errors.Encoding, "VSP public key has invalid length")
+	}
+	return ed25519.PublicKey(b), nil
+}
+
+// URL returns the base URL of the VSP.
+func (c *Client) URL() string {
+	return c.url
+}
+
+// Info describes a VSP.
+type Info struct {
+	APIVersions   []int64 `json:"apiversions"`
+	Timestamp     int64   `json:"timestamp"`
+	PubKey        []byte  `json:"pubkey"`
+	FeePercentage float64 `json:"feepercentage"`
+	VSPClosed     bool    `json:"vspclosed"`
+	Network       string  `json:"network"`
+}
+
+// Info requests the description of the VSP.  The VSP must support the API
+// version of the client and use the same network.
+func (c *Client) Info(ctx context.Context) (*Info, error) {
+	const op errors.Op = "vsp.Info"
+	resp, body, err := c.do(ctx, "GET", "/api/v3/vspinfo", nil, nil)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	info := new(Info)
+	err = json.Unmarshal(body, info)
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	if len(info.PubKey) != ed25519.PublicKeySize {
+		return nil, errors.E(op, errors.Protocol, "VSP public key has invalid length")
+	}
+
+	c.pubKeyMu.Lock()
+	if c.pubKey == nil {
+		log.Infof("Trusting public key %s of VSP %s", base64.StdEncoding.EncodeToString(info.PubKey), c.url)
+		c.pubKey = info.PubKey
+	}
+	pubKey := c.pubKey
+	c.pubKeyMu.Unlock()
+	if !bytes.Equal(info.PubKey, pubKey) {
+		return nil, errors.E(op, errors.Protocol, "VSP advertised an unexpected public key")
+	}
+	err = verify(pubKey, resp, body)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	supported := false
+	for _, v := range info.APIVersions {
+		supported = supported || v == apiVersion
+	}
+	if !supported {
+		return nil, errors.E(op, errors.Protocol, errors.Errorf("VSP does not support API version %d", apiVersion))
+	}
+	if info.Network != c.params.Name {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("VSP network %q does not match wallet network %q",
+			info.Network, c.params.Name))
+	}
+	return info, nil
+}
+
+// FeeAddress is the address and amount of the fee of a ticket.
+type FeeAddress struct {
+	Timestamp  int64  `json:"timestamp"`
+	FeeAddress string `json:"feeaddress"`
+	FeeAmount  int64  `json:"feeamount"`
+	Expiration int64  `json:"expiration"`
+}
+
+// FeeAddress requests the address and amount of the fee of a ticket.  The
+// ticket and the transaction funding it are submitted to the VSP, and the
+// request is signed by the commitment address of the ticket.
+func (c *Client) FeeAddress(ctx context.Context, s Signer, ticket, parent *wire.MsgTx) (*FeeAddress, error) {
+	const op errors.Op = "vsp.FeeAddress"
+	ticketHex, err := txHex(ticket)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	parentHex, err := txHex(parent)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	req := &struct {
+		Timestamp  int64  `json:"timestamp"`
+		TicketHash string `json:"tickethash"`
+		TicketHex  string `json:"tickethex"`
+		ParentHex  string `json:"parenthex"`
+	}{time.Now().Unix(), ticket.TxHash().String(), ticketHex, parentHex}
+	resp := new(FeeAddress)
+	err = c.post(ctx, s, ticket, "/api/v3/feeaddress", req, resp)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return resp, nil
+}
+
+// PayFee submits the signed fee transaction of a ticket, the private key of
+// the ticket voting address, and the vote choices of the ticket.  The VSP
+// broadcasts the fee transaction.
+func (c *Client) PayFee(ctx context.Context, s Signer, ticket, feeTx *wire.MsgTx, votingKey string,
+	voteChoices map[string]string) error {
+
+	const op errors.Op = "vsp.PayFee"
+	feeTxHex, err := txHex(feeTx)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	req := &struct {
+		Timestamp   int64             `json:"timestamp"`
+		TicketHash  string            `json:"tickethash"`
+		FeeTx       string            `json:"feetx"`
+		VotingKey   string            `json:"votingkey"`
+		VoteChoices map[string]string `json:"votechoices"`
+	}{time.Now().Unix(), ticket.TxHash().String(), feeTxHex, votingKey, voteChoices}
+	err = c.post(ctx, s, ticket, "/api/v3/payfee", req, new(struct{}))
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// Fee transaction statuses reported by the VSP.
+const (
+	FeeTxNone      = "none"
+	FeeTxReceived  = "received"
+	FeeTxBroadcast = "broadcast"
+	FeeTxConfirmed = "confirmed"
+	FeeTxError     = "error"
+)
+
+// TicketStatus is the status of a ticket and its fee at the VSP.
+type TicketStatus struct {
+	Timestamp       int64             `json:"timestamp"`
+	TicketConfirmed bool              `json:"ticketconfirmed"`
+	FeeTxStatus     string            `json:"feetxstatus"`
+	FeeTxHash       string            `json:"feetxhash"`
+	VoteChoices     map[string]string `json:"votechoices"`
+}
+
+// TicketStatus requests the status of a ticket and its fee.
+func (c *Client) TicketStatus(ctx context.Context, s Signer, ticket *wire.MsgTx) (*TicketStatus, error) {
+	const op errors.Op = "vsp.TicketStatus"
+	req := &struct {
+		TicketHash string `json:"tickethash"`
+	}{ticket.TxHash().String()}
+	resp := new(TicketStatus)
+	err := c.post(ctx, s, ticket, "/api/v3/ticketstatus", req, resp)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return resp, nil
+}
+
+// post signs a JSON request about a ticket with the commitment address of the
+// ticket and decodes the verified response.
+func (c *Client) post(ctx context.Context, s Signer, ticket *wire.MsgTx, path string, req, resp interface{}) error {
+	pubKey, err := c.serverPubKey(ctx)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return errors.E(errors.Encoding, err)
+	}
+	addr, err := commitmentAddress(ticket, c.params)
+	if err != nil {
+		return err
+	}
+	sig, err := s.SignMessage(ctx, string(body), addr)
+	if err != nil {
+		return err
+	}
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	header.Set(clientSignatureHeader, base64.StdEncoding.EncodeToString(sig))
+	httpResp, respBody, err := c.do(ctx, "POST", path, header, body)
+	if err != nil {
+		return err
+	}
+	err = verify(pubKey, httpResp, respBody)
+	if err != nil {
+		return err
+	}
+	err = json.Unmarshal(respBody, resp)
+	if err != nil {
+		return errors.E(errors.Encoding, err)
+	}
+	return nil
+}
+
+// serverPubKey returns the public key signing VSP responses, requesting it
+// when it was not configured.
+func (c *Client) serverPubKey(ctx context.Context) (ed25519.PublicKey, error) {
+	c.pubKeyMu.Lock()
+	pubKey := c.pubKey
+	c.pubKeyMu.Unlock()
+	if pubKey != nil {
+		return pubKey, nil
+	}
+	info, err := c.Info(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return info.PubKey, nil
+}
+
+// do performs an HTTP request and reads the response body.  Error responses
+// are returned as an *APIError when the body describes the error.
+func (c *Client) do(ctx context.Context, method, path string, header http.Header, body []byte) (*http.Response, []byte, error) {
+	req, err := http.NewRequest(method, c.url+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, errors.E(errors.Invalid, err)
+	}
+	req = req.WithContext(ctx)
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, nil, errors.E(errors.IO, err)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(http.MaxBytesReader(nil, resp.Body, maxResponse))
+	if err != nil {
+		return nil, nil, errors.E(errors.IO, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		apiErr := new(APIError)
+		if json.Unmarshal(respBody, apiErr) == nil && apiErr.Message != "" {
+			return nil, nil, apiErr
+		}
+		return nil, nil, errors.E(errors.IO, errors.Errorf("VSP responded with HTTP status %s", resp.Status))
+	}
+	return resp, respBody, nil
+}
+
+// verify checks the signature of a response body by the VSP.
+func verify(pubKey ed25519.PublicKey, resp *http.Response, body []byte) error {
+	sig, err := base64.StdEncoding.DecodeString(resp.Header.Get(serverSignatureHeader))
+	if err != nil || !ed25519.Verify(pubKey, body, sig) {
+		return errors.E(errors.Protocol, "invalid VSP response signature")
+	}
+	return nil
+}
+
+func txHex(tx *wire.MsgTx) (string, error) {
+	b, err := tx.Bytes()
+	if err != nil {
+		return "", errors.E(errors.Encoding, err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,471 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package vsp purchases tickets voted by a fee-based voting service provider.
+//
+// Tickets are purchased as solo tickets voted by an address of the wallet.
+// The VSP is then asked for a fee address and amount for each ticket, and the
+// wallet pays the fee with a separate transaction which is submitted to the
+// VSP, together with the private key of the voting address and the vote
+// choices of the wallet.  The VSP broadcasts the fee transaction and votes the
+// ticket once the fee is confirmed.
+//
+// The progress of each fee payment is recorded in the wallet database, and
+// payments interrupted by errors or restarts are retried after each block.
+package vsp
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/decred/dcrd/blockchain/stake/v2"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/txscript/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3"
+	"github.com/decred/dcrwallet/wallet/v3/txrules"
+	"github.com/decred/dcrwallet/wallet/v3/udb"
+)
+
+// Purchase buys tickets voted by the VSP and pays their fees from feeAccount.
+// The tickets of the request must be voted by an address of the voting
+// account, so a voting address, legacy stakepool, or configured ticket
+// address may not be used.  The hashes of purchased tickets are returned even
+// when fee payments fail; failed payments are retried by Run.
+func (c *Client) Purchase(ctx context.Context, w *wallet.Wallet, req *wallet.PurchaseTicketsRequest,
+	feeAccount uint32) ([]*chainhash.Hash, error) {
+
+	const op errors.Op = "vsp.Purchase"
+	if req.VotingAddress != nil || req.VSPAddress != nil {
+		return nil, errors.E(op, errors.Invalid, "VSP tickets must be voted by the voting account")
+	}
+	if w.TicketAddress() != nil || w.PoolAddress() != nil {
+		return nil, errors.E(op, errors.Invalid, "VSP tickets can not be purchased with a ticket or pool address configured")
+	}
+	info, err := c.Info(ctx)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if info.VSPClosed {
+		return nil, errors.E(op, errors.Invalid, "VSP is closed to new tickets")
+	}
+	n, err := w.NetworkBackend()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	hashes, purchaseErr := w.PurchaseTicketsContext(ctx, n, req)
+	for _, hash := range hashes {
+		err := w.PutVSPTicket(ctx, &udb.VSPTicket{
+			TicketHash: *hash,
+			Host:       c.url,
+			FeeAccount: feeAccount,
+			State:      udb.VSPFeeUnpaid,
+		})
+		if err != nil {
+			return hashes, errors.E(op, err)
+		}
+	}
+	for _, hash := range hashes {
+		err := c.Process(ctx, w, hash)
+		if err != nil {
+			log.Warnf("Unable to pay VSP fee of ticket %v: %v", hash, err)
+		}
+	}
+	if purchaseErr != nil {
+		return hashes, errors.E(op, purchaseErr)
+	}
+	return hashes, nil
+}
+
+// Run processes the fee payments of the tickets of the VSP after every
+// attached block until the context is cancelled.  The inputs of created fee
+// transactions are locked again so they are not spent by other transactions
+// before the VSP broadcasts the fee.
+func (c *Client) Run(ctx context.Context, w *wallet.Wallet) error {
+	const op errors.Op = "vsp.Run"
+	n := w.NtfnServer.TransactionNotifications()
+	defer n.Done()
+
+	tickets, err := w.VSPTickets(ctx)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	for _, t := range tickets {
+		if t.Host != c.url {
+			log.Warnf("Ticket %v was purchased through VSP %s, which is not configured", &t.TicketHash, t.Host)
+			continue
+		}
+		if t.State == udb.VSPFeeAddressed || t.State == udb.VSPFeePaid {
+			lockFeeInputs(w, t.FeeTx, true)
+		}
+	}
+	c.processAll(ctx, w)
+
+	for {
+		select {
+		case v := <-n.C:
+			if len(v.AttachedBlocks) != 0 {
+				c.processAll(ctx, w)
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// processAll processes the fee payments of each unconfirmed ticket of the VSP,
+// logging failures.
+func (c *Client) processAll(ctx context.Context, w *wallet.Wallet) {
+	tickets, err := w.VSPTickets(ctx)
+	if err != nil {
+		log.Errorf("Unable to read VSP tickets: %v", err)
+		return
+	}
+	for _, t := range tickets {
+		if t.Host != c.url || t.State == udb.VSPFeeConfirmed || t.State == udb.VSPFeeAbandoned {
+			continue
+		}
+		err := c.Process(ctx, w, &t.TicketHash)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			log.Warnf("Unable to pay VSP fee of ticket %v: %v", &t.TicketHash, err)
+		}
+	}
+}
+
+// Process advances the fee payment of a ticket as far as possible and records
+// its new state.  Errors are also recorded with the ticket, and the attempt
+// is retried by the next call.
+func (c *Client) Process(ctx context.Context, w *wallet.Wallet, ticketHash *chainhash.Hash) error {
+	const op errors.Op = "vsp.Process"
+	c.processMu.Lock()
+	defer c.processMu.Unlock()
+
+	t, err := w.VSPTicket(ctx, ticketHash)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if t.Host != c.url {
+		return errors.E(op, errors.Invalid, errors.Errorf("ticket %v was purchased through VSP %s", ticketHash, t.Host))
+	}
+	processErr := c.process(ctx, w, t)
+	if processErr != nil {
+		t.Attempts++
+		t.LastError = processErr.Error()
+	} else {
+		t.Attempts = 0
+		t.LastError = ""
+	}
+	err = w.PutVSPTicket(ctx, t)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	if processErr != nil {
+		return errors.E(op, processErr)
+	}
+	return nil
+}
+
+// process advances the state of t.  The caller records t.
+func (c *Client) process(ctx context.Context, w *wallet.Wallet, t *udb.VSPTicket) error {
+	if t.State == udb.VSPFeeConfirmed || t.State == udb.VSPFeeAbandoned {
+		return nil
+	}
+
+	summary, _, err := w.GetTicketInfo(ctx, &t.TicketHash)
+	if errors.Is(err, errors.NotExist) {
+		log.Infof("Abandoning VSP fee payment of removed ticket %v", &t.TicketHash)
+		c.abandon(w, t)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	switch summary.Status {
+	case wallet.TicketStatusVoted, wallet.TicketStatusRevoked,
+		wallet.TicketStatusMissed, wallet.TicketStatusExpired:
+		log.Infof("Abandoning VSP fee payment of spent or unvotable ticket %v", &t.TicketHash)
+		c.abandon(w, t)
+		return nil
+	}
+	ticket := new(wire.MsgTx)
+	err = ticket.FromBytes(summary.Ticket.Transaction)
+	if err != nil {
+		return errors.E(errors.Encoding, err)
+	}
+
+	if t.State == udb.VSPFeeAddressed && t.Expiration <= time.Now().Unix() {
+		log.Infof("Fee address of ticket %v expired", &t.TicketHash)
+		resetFee(w, t)
+	}
+	if t.State == udb.VSPFeeUnpaid {
+		err := c.feeAddress(ctx, w, t, ticket)
+		if err != nil {
+			return err
+		}
+	}
+	if t.State == udb.VSPFeeAddressed {
+		err := c.payFee(ctx, w, t, ticket)
+		if err != nil {
+			return err
+		}
+	}
+	if t.State == udb.VSPFeePaid {
+		return c.ticketStatus(ctx, w, t, ticket)
+	}
+	return nil
+}
+
+func (c *Client) feeAddress(ctx context.Context, w *wallet.Wallet, t *udb.VSPTicket, ticket *wire.MsgTx) error {
+	parentHash := &ticket.TxIn[0].PreviousOutPoint.Hash
+	parents, _, err := w.GetTransactionsByHashes(ctx, []*chainhash.Hash{parentHash})
+	if err != nil {
+		return err
+	}
+	if len(parents) != 1 {
+		return errors.E(errors.NotExist, errors.Errorf("missing parent transaction %v of ticket", parentHash))
+	}
+	info, err := c.Info(ctx)
+	if err != nil {
+		return err
+	}
+	resp, err := c.FeeAddress(ctx, w, ticket, parents[0])
+	if err != nil {
+		return err
+	}
+	if _, err := dcrutil.DecodeAddress(resp.FeeAddress, c.params); err != nil {
+		return errors.E(errors.Protocol, errors.Errorf("invalid fee address %q: %v", resp.FeeAddress, err))
+	}
+	ticketPrice := dcrutil.Amount(ticket.TxOut[0].Value)
+	err = checkFeeAmount(dcrutil.Amount(resp.FeeAmount), ticketPrice, info.FeePercentage)
+	if err != nil {
+		return err
+	}
+	t.State = udb.VSPFeeAddressed
+	t.FeeAddress = resp.FeeAddress
+	t.FeeAmount = dcrutil.Amount(resp.FeeAmount)
+	t.Expiration = resp.Expiration
+	t.FeeTx = nil
+	log.Infof("VSP fee of ticket %v is %v paid to %s", &t.TicketHash, t.FeeAmount, t.FeeAddress)
+	return nil
+}
+
+func (c *Client) payFee(ctx context.Context, w *wallet.Wallet, t *udb.VSPTicket, ticket *wire.MsgTx) error {
+	feeTx := new(wire.MsgTx)
+	if t.FeeTx == nil {
+		var err error
+		feeTx, err = createFeeTx(ctx, w, t)
+		if err != nil {
+			return err
+		}
+		t.FeeTx, err = feeTx.Bytes()
+		if err != nil {
+			return errors.E(errors.Encoding, err)
+		}
+		// Record the fee transaction before it is submitted, so its
+		// inputs are locked again after a restart.
+		err = w.PutVSPTicket(ctx, t)
+		if err != nil {
+			return err
+		}
+	} else {
+		err := feeTx.FromBytes(t.FeeTx)
+		if err != nil {
+			return errors.E(errors.Encoding, err)
+		}
+	}
+
+	votingAddr, err := votingAddress(ticket, c.params)
+	if err != nil {
+		return err
+	}
+	votingKey, err := w.DumpWIFPrivateKey(ctx, votingAddr)
+	if err != nil {
+		return err
+	}
+	choices, _, err := w.AgendaChoices(ctx)
+	if err != nil {
+		return err
+	}
+	voteChoices := make(map[string]string, len(choices))
+	for _, c := range choices {
+		voteChoices[c.AgendaID] = c.ChoiceID
+	}
+
+	err = c.PayFee(ctx, w, ticket, feeTx, votingKey, voteChoices)
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.Code {
+		case CodeFeeAlreadyReceived:
+			err = nil
+		case CodeFeeExpired:
+			log.Infof("Fee address of ticket %v expired", &t.TicketHash)
+			resetFee(w, t)
+			return err
+		}
+	}
+	if err != nil {
+		return err
+	}
+	t.State = udb.VSPFeePaid
+	log.Infof("Paid VSP fee of ticket %v with transaction %v", &t.TicketHash, feeTx.TxHash())
+	return nil
+}
+
+func (c *Client) ticketStatus(ctx context.Context, w *wallet.Wallet, t *udb.VSPTicket, ticket *wire.MsgTx) error {
+	status, err := c.TicketStatus(ctx, w, ticket)
+	if err != nil {
+		return err
+	}
+	switch status.FeeTxStatus {
+	case FeeTxConfirmed:
+		lockFeeInputs(w, t.FeeTx, false)
+		t.State = udb.VSPFeeConfirmed
+		log.Infof("VSP fee of ticket %v is confirmed", &t.TicketHash)
+	case FeeTxError:
+		// The VSP could not broadcast the fee transaction.  A new fee
+		// transaction is created and paid for the same fee address.
+		lockFeeInputs(w, t.FeeTx, false)
+		t.FeeTx = nil
+		t.State = udb.VSPFeeAddressed
+		return errors.E(errors.Protocol, "VSP failed to broadcast fee transaction")
+	}
+	return nil
+}
+
+// feeTolerance is the fraction by which a fee may exceed the fee percentage
+// advertised by the VSP, allowing for rounding by the VSP.
+const feeTolerance = 0.01
+
+// checkFeeAmount checks that a fee requested by a VSP is positive and does not
+// exceed the advertised fee percentage of the ticket price.
+func checkFeeAmount(fee, ticketPrice dcrutil.Amount, feePercentage float64) error {
+	if fee <= 0 {
+		return errors.E(errors.Protocol, errors.Errorf("invalid fee amount %v", fee))
+	}
+	if !(feePercentage >= 0 && feePercentage <= 100) {
+		return errors.E(errors.Protocol, errors.Errorf("invalid fee percentage %v", feePercentage))
+	}
+	maxFee := dcrutil.Amount(float64(ticketPrice) * feePercentage / 100 * (1 + feeTolerance))
+	if fee > maxFee {
+		return errors.E(errors.Protocol, errors.Errorf("fee amount %v exceeds %v%% of ticket price %v",
+			fee, feePercentage, ticketPrice))
+	}
+	return nil
+}
+
+// createFeeTx creates and signs a transaction paying the fee of a ticket from
+// the fee account, and locks its inputs.  The VSP publishes the transaction,
+// so the spend is authorized by the spending policy of the wallet before it
+// is signed.
+func createFeeTx(ctx context.Context, w *wallet.Wallet, t *udb.VSPTicket) (*wire.MsgTx, error) {
+	feeAddr, err := dcrutil.DecodeAddress(t.FeeAddress, w.ChainParams())
+	if err != nil {
+		return nil, errors.E(errors.Encoding, err)
+	}
+	script, err := txscript.PayToAddrScript(feeAddr)
+	if err != nil {
+		return nil, errors.E(errors.Encoding, err)
+	}
+	outputs := []*wire.TxOut{wire.NewTxOut(int64(t.FeeAmount), script)}
+	if txrules.IsDustOutput(outputs[0], w.RelayFee()) {
+		return nil, errors.E(errors.Invalid, errors.Errorf("fee amount %v is dust", t.FeeAmount))
+	}
+	atx, err := w.NewUnsignedTransaction(ctx, outputs, w.RelayFee(), t.FeeAccount, 1,
+		wallet.OutputSelectionAlgorithmDefault, nil)
+	if err != nil {
+		return nil, err
+	}
+	atx.RandomizeChangePosition()
+	err = w.AuthorizeSpend(ctx, atx)
+	if err != nil {
+		return nil, err
+	}
+	sigErrs, err := w.SignTransaction(ctx, atx.Tx, txscript.SigHashAll, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(sigErrs) != 0 {
+		return nil, sigErrs[0].Error
+	}
+	for _, in := range atx.Tx.TxIn {
+		w.LockOutpoint(in.PreviousOutPoint)
+	}
+	return atx.Tx, nil
+}
+
+// resetFee discards the expired fee address of a ticket and the transaction
+// paying it.
+func resetFee(w *wallet.Wallet, t *udb.VSPTicket) {
+	lockFeeInputs(w, t.FeeTx, false)
+	t.State = udb.VSPFeeUnpaid
+	t.FeeAddress = ""
+	t.FeeAmount = 0
+	t.Expiration = 0
+	t.FeeTx = nil
+}
+
+// abandon stops processing the fee payment of a ticket.
+func (c *Client) abandon(w *wallet.Wallet, t *udb.VSPTicket) {
+	if t.State != udb.VSPFeePaid {
+		lockFeeInputs(w, t.FeeTx, false)
+	}
+	t.State = udb.VSPFeeAbandoned
+}
+
+// lockFeeInputs locks or unlocks the inputs of a serialized fee transaction.
+func lockFeeInputs(w *wallet.Wallet, serializedTx []byte, lock bool) {
+	if serializedTx == nil {
+		return
+	}
+	tx := new(wire.MsgTx)
+	err := tx.Deserialize(bytes.NewReader(serializedTx))
+	if err != nil {
+		log.Errorf("Unable to decode fee transaction: %v", err)
+		return
+	}
+	for _, in := range tx.TxIn {
+		if lock {
+			w.LockOutpoint(in.PreviousOutPoint)
+		} else {
+			w.UnlockOutpoint(in.PreviousOutPoint)
+		}
+	}
+}
+
+// votingAddress returns the address voting a ticket.
+func votingAddress(ticket *wire.MsgTx, params *chaincfg.Params) (dcrutil.Address, error) {
+	if !stake.IsSStx(ticket) {
+		return nil, errors.E(errors.Invalid, "transaction is not a ticket")
+	}
+	out := ticket.TxOut[0]
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(out.Version, out.PkScript, params)
+	if err != nil {
+		return nil, errors.E(errors.Invalid, err)
+	}
+	if len(addrs) != 1 {
+		return nil, errors.E(errors.Invalid, "ticket voting script does not pay a single address")
+	}
+	return addrs[0], nil
+}
+
+// commitmentAddress returns the address of the first commitment of a ticket,
+// which signs requests about the ticket.
+func commitmentAddress(ticket *wire.MsgTx, params *chaincfg.Params) (dcrutil.Address, error) {
+	if !stake.IsSStx(ticket) {
+		return nil, errors.E(errors.Invalid, "transaction is not a ticket")
+	}
+	addr, err := stake.AddrFromSStxPkScrCommitment(ticket.TxOut[1].PkScript, params)
+	if err != nil {
+		return nil, errors.E(errors.Invalid, err)
+	}
+	return addr, nil
+}
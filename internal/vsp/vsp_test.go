@@ -0,0 +1,391 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package vsp
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrec/secp256k1/v2"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/txscript/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3"
+	"golang.org/x/crypto/ed25519"
+)
+
+var params = chaincfg.SimNetParams()
+
+// keySigner signs messages with a single secp256k1 key.
+type keySigner struct {
+	key  *secp256k1.PrivateKey
+	addr dcrutil.Address
+}
+
+func newKeySigner(t *testing.T) *keySigner {
+	key, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkh := dcrutil.Hash160(key.PubKey().SerializeCompressed())
+	addr, err := dcrutil.NewAddressPubKeyHash(pkh, params, dcrec.STEcdsaSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &keySigner{key, addr}
+}
+
+func (s *keySigner) SignMessage(ctx context.Context, msg string, addr dcrutil.Address) ([]byte, error) {
+	if addr.Address() != s.addr.Address() {
+		return nil, errors.E(errors.NotExist, "unknown address")
+	}
+	var buf bytes.Buffer
+	wire.WriteVarString(&buf, 0, "Decred Signed Message:\n")
+	wire.WriteVarString(&buf, 0, msg)
+	return secp256k1.SignCompact(s.key, chainhash.HashB(buf.Bytes()), true)
+}
+
+// testTicket returns a ticket voted by and committing to the signer address,
+// and the transaction funding it.
+func testTicket(t *testing.T, s *keySigner) (ticket, parent *wire.MsgTx) {
+	script := func(b []byte, err error) []byte {
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+	parent = wire.NewMsgTx()
+	parent.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, 101e8, nil))
+	parent.AddTxOut(wire.NewTxOut(100e8, script(txscript.PayToAddrScript(s.addr))))
+	ticket = wire.NewMsgTx()
+	ticket.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: parent.TxHash()}, 100e8, nil))
+	ticket.AddTxOut(wire.NewTxOut(100e8, script(txscript.PayToSStx(s.addr))))
+	ticket.AddTxOut(wire.NewTxOut(0, script(txscript.GenerateSStxAddrPush(s.addr, 100e8, 0x5800))))
+	ticket.AddTxOut(wire.NewTxOut(0, script(txscript.PayToSStxChange(s.addr))))
+	return ticket, parent
+}
+
+// standIn is a local stand-in of the VSP HTTP API.  It verifies the client
+// signatures of requests and signs responses.
+type standIn struct {
+	t       *testing.T
+	pubKey  ed25519.PublicKey
+	privKey ed25519.PrivateKey
+	network string
+	closed  bool
+
+	mu      sync.Mutex
+	tickets map[string]*standInTicket
+	badSig  bool // responses are signed with the wrong key
+}
+
+type standInTicket struct {
+	ticket      *wire.MsgTx
+	feeAddress  string
+	feeTx       string
+	votingKey   string
+	voteChoices map[string]string
+	feeStatus   string
+}
+
+func newStandIn(t *testing.T) (*standIn, *httptest.Server) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &standIn{
+		t:       t,
+		pubKey:  pub,
+		privKey: priv,
+		network: params.Name,
+		tickets: make(map[string]*standInTicket),
+	}
+	return s, httptest.NewServer(s)
+}
+
+func (s *standIn) respond(w http.ResponseWriter, status int, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		s.t.Error(err)
+	}
+	key := s.privKey
+	if s.badSig {
+		_, key, _ = ed25519.GenerateKey(nil)
+	}
+	w.Header().Set(serverSignatureHeader, base64.StdEncoding.EncodeToString(ed25519.Sign(key, b)))
+	w.WriteHeader(status)
+	w.Write(b)
+}
+
+func (s *standIn) error(w http.ResponseWriter, code int, msg string) {
+	s.respond(w, http.StatusBadRequest, &APIError{Code: code, Message: msg})
+}
+
+func (s *standIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r.URL.Path == "/api/v3/vspinfo" {
+		s.respond(w, http.StatusOK, &Info{
+			APIVersions:   []int64{apiVersion},
+			Timestamp:     time.Now().Unix(),
+			PubKey:        s.pubKey,
+			FeePercentage: 1,
+			VSPClosed:     s.closed,
+			Network:       s.network,
+		})
+		return
+	}
+
+	body, _ := ioutil.ReadAll(r.Body)
+	var req struct {
+		TicketHash  string            `json:"tickethash"`
+		TicketHex   string            `json:"tickethex"`
+		ParentHex   string            `json:"parenthex"`
+		FeeTx       string            `json:"feetx"`
+		VotingKey   string            `json:"votingkey"`
+		VoteChoices map[string]string `json:"votechoices"`
+	}
+	if err := json.Unmarshal(body, &req); err != nil {
+		s.error(w, CodeBadRequest, err.Error())
+		return
+	}
+	st := s.tickets[req.TicketHash]
+	if st == nil && req.TicketHex != "" {
+		b, _ := hex.DecodeString(req.TicketHex)
+		ticket := new(wire.MsgTx)
+		if err := ticket.FromBytes(b); err != nil || ticket.TxHash().String() != req.TicketHash {
+			s.error(w, CodeBadRequest, "invalid ticket")
+			return
+		}
+		st = &standInTicket{ticket: ticket, feeStatus: FeeTxNone}
+	}
+	if st == nil {
+		s.error(w, CodeUnknownTicket, "unknown ticket")
+		return
+	}
+	addr, err := commitmentAddress(st.ticket, params)
+	if err != nil {
+		s.error(w, CodeBadRequest, err.Error())
+		return
+	}
+	sig, _ := base64.StdEncoding.DecodeString(r.Header.Get(clientSignatureHeader))
+	if ok, _ := wallet.VerifyMessage(string(body), addr, sig, params); !ok {
+		s.error(w, CodeBadSignature, "bad signature")
+		return
+	}
+
+	switch r.URL.Path {
+	case "/api/v3/feeaddress":
+		if req.ParentHex == "" {
+			s.error(w, CodeBadRequest, "missing parent")
+			return
+		}
+		s.tickets[req.TicketHash] = st
+		st.feeAddress = "SsWKp7wtdTZYabYFYSc9cnxhwFEjA5g4pFc"
+		s.respond(w, http.StatusOK, &FeeAddress{
+			Timestamp:  time.Now().Unix(),
+			FeeAddress: st.feeAddress,
+			FeeAmount:  1e6,
+			Expiration: time.Now().Add(time.Hour).Unix(),
+		})
+	case "/api/v3/payfee":
+		if st.feeStatus != FeeTxNone {
+			s.error(w, CodeFeeAlreadyReceived, "fee already received")
+			return
+		}
+		st.feeTx, st.votingKey, st.voteChoices = req.FeeTx, req.VotingKey, req.VoteChoices
+		st.feeStatus = FeeTxBroadcast
+		s.respond(w, http.StatusOK, &struct{}{})
+	case "/api/v3/ticketstatus":
+		s.respond(w, http.StatusOK, &TicketStatus{
+			Timestamp:   time.Now().Unix(),
+			FeeTxStatus: st.feeStatus,
+			VoteChoices: st.voteChoices,
+		})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+	vsp, server := newStandIn(t)
+	defer server.Close()
+	signer := newKeySigner(t)
+	ticket, parent := testTicket(t, signer)
+
+	// An unpinned client trusts the key advertised by the VSP.
+	c, err := NewClient(server.URL+"/", nil, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.URL() != server.URL {
+		t.Errorf("client URL %q, expected %q", c.URL(), server.URL)
+	}
+	fee, err := c.FeeAddress(ctx, signer, ticket, parent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fee.FeeAmount != 1e6 || fee.FeeAddress != vsp.tickets[ticket.TxHash().String()].feeAddress {
+		t.Errorf("fee address response %+v", fee)
+	}
+
+	feeTx := wire.NewMsgTx()
+	feeTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 1}, 2e6, nil))
+	choices := map[string]string{"treasury": "yes"}
+	err = c.PayFee(ctx, signer, ticket, feeTx, "votingkey", choices)
+	if err != nil {
+		t.Fatal(err)
+	}
+	st := vsp.tickets[ticket.TxHash().String()]
+	if st.votingKey != "votingkey" || st.voteChoices["treasury"] != "yes" || st.feeTx == "" {
+		t.Errorf("VSP received %+v", st)
+	}
+	err = c.PayFee(ctx, signer, ticket, feeTx, "votingkey", choices)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != CodeFeeAlreadyReceived {
+		t.Errorf("second payment: expected fee already received error, got %v", err)
+	}
+	status, err := c.TicketStatus(ctx, signer, ticket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.FeeTxStatus != FeeTxBroadcast {
+		t.Errorf("fee status %q", status.FeeTxStatus)
+	}
+
+	// Requests must be signed by the ticket commitment address.
+	other := newKeySigner(t)
+	_, err = c.TicketStatus(ctx, other, ticket)
+	if err == nil {
+		t.Errorf("signing with another key succeeded")
+	}
+	otherTicket, _ := testTicket(t, other)
+	_, err = c.TicketStatus(ctx, other, otherTicket)
+	if !errors.As(err, &apiErr) || apiErr.Code != CodeUnknownTicket {
+		t.Errorf("unknown ticket: expected unknown ticket error, got %v", err)
+	}
+
+	// Responses must be signed by the pinned key.
+	vsp.badSig = true
+	_, err = c.TicketStatus(ctx, signer, ticket)
+	if !errors.Is(err, errors.Protocol) {
+		t.Errorf("bad response signature: expected Protocol error, got %v", err)
+	}
+	vsp.badSig = false
+	pinned, err := NewClient(server.URL, vsp.pubKey, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := pinned.TicketStatus(ctx, signer, ticket); err != nil {
+		t.Errorf("pinned key: %v", err)
+	}
+	otherPub, _, _ := ed25519.GenerateKey(nil)
+	wrongKey, err := NewClient(server.URL, otherPub, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := wrongKey.TicketStatus(ctx, signer, ticket); !errors.Is(err, errors.Protocol) {
+		t.Errorf("wrong pinned key: expected Protocol error, got %v", err)
+	}
+	if _, err := wrongKey.Info(ctx); !errors.Is(err, errors.Protocol) {
+		t.Errorf("wrong pinned key info: expected Protocol error, got %v", err)
+	}
+
+	// The VSP must use the wallet network.
+	vsp.network = "mainnet"
+	if _, err := pinned.Info(ctx); !errors.Is(err, errors.Invalid) {
+		t.Errorf("network mismatch: expected Invalid error, got %v", err)
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	tests := []struct {
+		url    string
+		pubKey ed25519.PublicKey
+		err    bool
+	}{
+		{"https://vsp.example.com", nil, false},
+		{"http://127.0.0.1:3000/", make([]byte, ed25519.PublicKeySize), false},
+		{"ftp://vsp.example.com", nil, true},
+		{"vsp.example.com", nil, true},
+		{"https://vsp.example.com", make([]byte, 31), true},
+	}
+	for _, test := range tests {
+		_, err := NewClient(test.url, test.pubKey, params)
+		if (err != nil) != test.err {
+			t.Errorf("%s: unexpected error %v", test.url, err)
+		}
+	}
+
+	pub, _, _ := ed25519.GenerateKey(nil)
+	decoded, err := DecodePubKey(base64.StdEncoding.EncodeToString(pub))
+	if err != nil || !bytes.Equal(decoded, pub) {
+		t.Errorf("decoded public key %x (%v), expected %x", decoded, err, pub)
+	}
+	if _, err := DecodePubKey("AAAA"); !errors.Is(err, errors.Encoding) {
+		t.Errorf("short public key: expected Encoding error, got %v", err)
+	}
+}
+
+func TestTicketAddresses(t *testing.T) {
+	signer := newKeySigner(t)
+	ticket, parent := testTicket(t, signer)
+	votingAddr, err := votingAddress(ticket, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	commitmentAddr, err := commitmentAddress(ticket, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := signer.addr.Address()
+	if votingAddr.Address() != want || commitmentAddr.Address() != want {
+		t.Errorf("voting address %v, commitment address %v, expected %v", votingAddr, commitmentAddr, want)
+	}
+	if _, err := commitmentAddress(parent, params); !errors.Is(err, errors.Invalid) {
+		t.Errorf("non-ticket: expected Invalid error, got %v", err)
+	}
+}
+
+func TestCheckFeeAmount(t *testing.T) {
+	tests := []struct {
+		fee, ticketPrice dcrutil.Amount
+		feePercentage    float64
+		err              bool
+	}{
+		{1e6, 100e8, 1, false},
+		{1e8, 100e8, 1, false},
+		{1e8 + 5e5, 100e8, 1, false},
+		{102e6, 100e8, 1, true},
+		{50e8, 100e8, 1, true},
+		{0, 100e8, 1, true},
+		{-1, 100e8, 1, true},
+		{1e6, 100e8, 0, true},
+		{1e6, 100e8, -1, true},
+		{1e6, 100e8, 101, true},
+	}
+	for _, test := range tests {
+		err := checkFeeAmount(test.fee, test.ticketPrice, test.feePercentage)
+		if test.err != errors.Is(err, errors.Protocol) || (!test.err && err != nil) {
+			t.Errorf("fee %v of ticket price %v at %v%%: unexpected error %v",
+				test.fee, test.ticketPrice, test.feePercentage, err)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package webhook
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3/walletdb"
+)
+
+// The queue database persists deliveries which have not yet been accepted by
+// their endpoint, and transactions whose confirmations are still watched, so
+// that notifications survive restarts.
+var (
+	// deliveriesBucketKey maps big endian sequence numbers to JSON encoded
+	// deliveries.
+	deliveriesBucketKey = []byte("deliveries")
+
+	// watchedBucketKey maps transaction hashes to JSON encoded watchedTx.
+	watchedBucketKey = []byte("watched")
+)
+
+// delivery is a request which is retried until the endpoint accepts it.
+// Times are unix nanoseconds.
+type delivery struct {
+	seq      uint64
+	URL      string          `json:"url"`
+	ID       string          `json:"id"`
+	Event    string          `json:"event"`
+	Body     json.RawMessage `json:"body"`
+	Created  int64           `json:"created"`
+	Attempts int             `json:"attempts"`
+	Next     int64           `json:"next"`
+}
+
+// watchedTx is a transaction reported by tx.confirmed events and the highest
+// confirmation count already reported.
+type watchedTx struct {
+	Transaction *Transaction `json:"transaction"`
+	Notified    int32        `json:"notified"`
+}
+
+func seqKey(seq uint64) []byte {
+	k := make([]byte, 8)
+	binary.BigEndian.PutUint64(k, seq)
+	return k
+}
+
+func createBuckets(ctx context.Context, db walletdb.DB) error {
+	return walletdb.Update(ctx, db, func(dbtx walletdb.ReadWriteTx) error {
+		for _, k := range [][]byte{deliveriesBucketKey, watchedBucketKey} {
+			if dbtx.ReadWriteBucket(k) != nil {
+				continue
+			}
+			_, err := dbtx.CreateTopLevelBucket(k)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// putDeliveries appends new deliveries to the queue, assigning their sequence
+// numbers.
+func putDeliveries(dbtx walletdb.ReadWriteTx, ds []*delivery) error {
+	b := dbtx.ReadWriteBucket(deliveriesBucketKey)
+	var seq uint64
+	c := b.ReadWriteCursor()
+	k, _ := c.Last()
+	c.Close()
+	if k != nil {
+		seq = binary.BigEndian.Uint64(k) + 1
+	}
+	for _, d := range ds {
+		d.seq = seq
+		seq++
+		err := putDelivery(dbtx, d)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func putDelivery(dbtx walletdb.ReadWriteTx, d *delivery) error {
+	v, err := json.Marshal(d)
+	if err != nil {
+		return errors.E(errors.Encoding, err)
+	}
+	return dbtx.ReadWriteBucket(deliveriesBucketKey).Put(seqKey(d.seq), v)
+}
+
+func deleteDelivery(dbtx walletdb.ReadWriteTx, seq uint64) error {
+	return dbtx.ReadWriteBucket(deliveriesBucketKey).Delete(seqKey(seq))
+}
+
+// queuedDeliveries returns every queued delivery in sequence order.
+func queuedDeliveries(dbtx walletdb.ReadTx) ([]*delivery, error) {
+	var ds []*delivery
+	err := dbtx.ReadBucket(deliveriesBucketKey).ForEach(func(k, v []byte) error {
+		d := &delivery{seq: binary.BigEndian.Uint64(k)}
+		err := json.Unmarshal(v, d)
+		if err != nil {
+			return errors.E(errors.Encoding, errors.Errorf("delivery %d: %v", d.seq, err))
+		}
+		ds = append(ds, d)
+		return nil
+	})
+	return ds, err
+}
+
+func putWatched(dbtx walletdb.ReadWriteTx, hash *chainhash.Hash, w *watchedTx) error {
+	v, err := json.Marshal(w)
+	if err != nil {
+		return errors.E(errors.Encoding, err)
+	}
+	return dbtx.ReadWriteBucket(watchedBucketKey).Put(hash[:], v)
+}
+
+func deleteWatched(dbtx walletdb.ReadWriteTx, hash *chainhash.Hash) error {
+	return dbtx.ReadWriteBucket(watchedBucketKey).Delete(hash[:])
+}
+
+// fetchWatched returns the watched transaction, or nil if the transaction is
+// not watched.
+func fetchWatched(dbtx walletdb.ReadTx, hash *chainhash.Hash) (*watchedTx, error) {
+	v := dbtx.ReadBucket(watchedBucketKey).Get(hash[:])
+	if v == nil {
+		return nil, nil
+	}
+	w := new(watchedTx)
+	err := json.Unmarshal(v, w)
+	if err != nil {
+		return nil, errors.E(errors.Encoding, errors.Errorf("watched tx %v: %v", hash, err))
+	}
+	return w, nil
+}
+
+// watchedHashes returns the hashes of every watched transaction.
+func watchedHashes(dbtx walletdb.ReadTx) ([]*chainhash.Hash, error) {
+	var hashes []*chainhash.Hash
+	err := dbtx.ReadBucket(watchedBucketKey).ForEach(func(k, _ []byte) error {
+		var h chainhash.Hash
+		copy(h[:], k)
+		hashes = append(hashes, &h)
+		return nil
+	})
+	return hashes, err
+}
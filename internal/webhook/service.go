@@ -0,0 +1,537 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3"
+	"github.com/decred/dcrwallet/wallet/v3/walletdb"
+	"golang.org/x/sync/errgroup"
+
+	_ "github.com/decred/dcrwallet/wallet/v3/drivers/bdb" // driver loaded during init
+)
+
+// Retry policy of failed deliveries.  The delay before each retry doubles
+// until reaching maxBackoff, and deliveries still failing after maxAge are
+// dropped.
+const (
+	initialBackoff  = 5 * time.Second
+	maxBackoff      = time.Hour
+	maxAge          = 72 * time.Hour
+	deliveryTimeout = 30 * time.Second
+)
+
+// Service delivers wallet notifications to the endpoints of a webhook file.
+type Service struct {
+	db         walletdb.DB
+	hooks      []*Hook
+	byURL      map[string]*Hook
+	thresholds []int32
+	client     *http.Client
+	wake       chan struct{}
+
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	maxAge         time.Duration
+}
+
+// Open opens or creates the delivery queue database at path and returns a
+// service delivering notifications to the endpoints of f.  Deliveries queued
+// for endpoints no longer in f are dropped when the service runs.
+func Open(ctx context.Context, path string, f *File) (*Service, error) {
+	const op errors.Op = "webhook.Open"
+	db, err := walletdb.Create("bdb", path)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	err = createBuckets(ctx, db)
+	if err != nil {
+		db.Close()
+		return nil, errors.E(op, err)
+	}
+	s := &Service{
+		db:             db,
+		hooks:          f.Hooks,
+		byURL:          make(map[string]*Hook, len(f.Hooks)),
+		thresholds:     f.thresholds(),
+		client:         &http.Client{Timeout: deliveryTimeout},
+		wake:           make(chan struct{}, 1),
+		initialBackoff: initialBackoff,
+		maxBackoff:     maxBackoff,
+		maxAge:         maxAge,
+	}
+	for _, h := range f.Hooks {
+		s.byURL[h.URL] = h
+	}
+	return s, nil
+}
+
+// Close closes the delivery queue database.  It must not be called until Run
+// returns.
+func (s *Service) Close() error {
+	return s.db.Close()
+}
+
+// Run delivers notifications of the wallet until the context is cancelled or
+// the queue database errors.
+func (s *Service) Run(ctx context.Context, w *wallet.Wallet) error {
+	g, ctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		return s.deliverLoop(ctx)
+	})
+	g.Go(func() error {
+		return s.notificationLoop(ctx, w)
+	})
+	return g.Wait()
+}
+
+func (s *Service) notificationLoop(ctx context.Context, w *wallet.Wallet) error {
+	n := w.NtfnServer.TransactionNotifications()
+	defer n.Done()
+	invs := w.NtfnServer.InvoiceNotifications()
+	defer invs.Done()
+
+	// Confirmations are only watched when an endpoint reports them.  The
+	// watched transactions of previous runs are watched again.
+	watch := func([]*chainhash.Hash) {}
+	var errc chan error
+	if len(s.thresholds) != 0 {
+		stopAfter := s.thresholds[len(s.thresholds)-1]
+		c := w.NtfnServer.ConfirmationNotifications(ctx)
+		errc = make(chan error, 1)
+		go func() {
+			for {
+				r, err := c.Recv()
+				if err == nil {
+					err = s.confirmations(ctx, r)
+				}
+				if err != nil {
+					errc <- err
+					return
+				}
+			}
+		}()
+		// Watch blocks until its result is received, so it is never
+		// called by the goroutine receiving confirmations.
+		watch = func(hashes []*chainhash.Hash) {
+			if len(hashes) != 0 {
+				go c.Watch(hashes, stopAfter)
+			}
+		}
+		var hashes []*chainhash.Hash
+		err := walletdb.View(ctx, s.db, func(dbtx walletdb.ReadTx) error {
+			var err error
+			hashes, err = watchedHashes(dbtx)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		watch(hashes)
+	}
+
+	for {
+		select {
+		case v := <-n.C:
+			hashes, err := s.transactionNotification(ctx, v)
+			if err != nil {
+				return err
+			}
+			watch(hashes)
+		case inv := <-invs.C:
+			err := s.invoiceNotification(ctx, inv)
+			if err != nil {
+				return err
+			}
+		case err := <-errc:
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func newEvent(eventType string) *Event {
+	id := make([]byte, 16)
+	rand.Read(id)
+	return &Event{
+		ID:   hex.EncodeToString(id),
+		Type: eventType,
+		Time: time.Now().Unix(),
+	}
+}
+
+var txTypes = map[wallet.TransactionType]string{
+	wallet.TransactionTypeRegular:        "regular",
+	wallet.TransactionTypeCoinbase:       "coinbase",
+	wallet.TransactionTypeTicketPurchase: "ticket",
+	wallet.TransactionTypeVote:           "vote",
+	wallet.TransactionTypeRevocation:     "revocation",
+}
+
+func transaction(summary *wallet.TransactionSummary) *Transaction {
+	tx := &Transaction{
+		Hash:    summary.Hash.String(),
+		Type:    txTypes[summary.Type],
+		Fee:     summary.Fee.ToCoin(),
+		Debits:  make([]Debit, 0, len(summary.MyInputs)),
+		Credits: make([]Credit, 0, len(summary.MyOutputs)),
+	}
+	for _, in := range summary.MyInputs {
+		tx.Debits = append(tx.Debits, Debit{
+			Index:   in.Index,
+			Account: in.PreviousAccount,
+			Amount:  in.PreviousAmount.ToCoin(),
+		})
+	}
+	for _, out := range summary.MyOutputs {
+		c := Credit{
+			Index:    out.Index,
+			Account:  out.Account,
+			Internal: out.Internal,
+			Amount:   out.Amount.ToCoin(),
+		}
+		if out.Address != nil {
+			c.Address = out.Address.Address()
+		}
+		tx.Credits = append(tx.Credits, c)
+	}
+	return tx
+}
+
+// transactionNotification queues the events of a transaction notification and
+// returns the hashes of transactions which must be watched for confirmations.
+func (s *Service) transactionNotification(ctx context.Context, v *wallet.TransactionNotifications) ([]*chainhash.Hash, error) {
+	var events []*Event
+	var watched []*chainhash.Hash
+	var watchedTxs []*Transaction
+	add := func(eventType string, hash *chainhash.Hash, tx *Transaction) {
+		e := newEvent(eventType)
+		e.Transaction = tx
+		events = append(events, e)
+		if len(s.thresholds) != 0 && eventType != TicketVoted && eventType != TicketRevoked {
+			watched = append(watched, hash)
+			watchedTxs = append(watchedTxs, tx)
+		}
+	}
+	for i := range v.UnminedTransactions {
+		summary := &v.UnminedTransactions[i]
+		add(TxUnmined, summary.Hash, transaction(summary))
+	}
+	for _, b := range v.AttachedBlocks {
+		blockHash := b.Header.BlockHash()
+		for i := range b.Transactions {
+			summary := &b.Transactions[i]
+			tx := transaction(summary)
+			tx.BlockHash = blockHash.String()
+			tx.BlockHeight = int32(b.Header.Height)
+			add(TxMined, summary.Hash, tx)
+			switch summary.Type {
+			case wallet.TransactionTypeVote:
+				add(TicketVoted, summary.Hash, tx)
+			case wallet.TransactionTypeRevocation:
+				add(TicketRevoked, summary.Hash, tx)
+			}
+		}
+	}
+	if len(v.AttachedBlocks) != 0 {
+		tip := v.AttachedBlocks[len(v.AttachedBlocks)-1].Header
+		e := newEvent(TipChanged)
+		e.Tip = &Block{Hash: tip.BlockHash().String(), Height: int32(tip.Height)}
+		for _, h := range v.DetachedBlocks {
+			e.Detached = append(e.Detached, h.String())
+		}
+		events = append(events, e)
+	}
+	if len(events) == 0 {
+		return nil, nil
+	}
+
+	err := walletdb.Update(ctx, s.db, func(dbtx walletdb.ReadWriteTx) error {
+		for i, hash := range watched {
+			w, err := fetchWatched(dbtx, hash)
+			if err != nil {
+				return err
+			}
+			if w == nil {
+				w = new(watchedTx)
+			}
+			w.Transaction = watchedTxs[i]
+			err = putWatched(dbtx, hash, w)
+			if err != nil {
+				return err
+			}
+		}
+		return s.enqueue(dbtx, events)
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.signal()
+	return watched, nil
+}
+
+// invoiceNotification queues an invoice.updated event.
+func (s *Service) invoiceNotification(ctx context.Context, inv *wallet.Invoice) error {
+	e := newEvent(InvoiceUpdated)
+	e.Invoice = &Invoice{
+		ID:       inv.ID,
+		Account:  inv.Account,
+		Address:  inv.Address.Address(),
+		Amount:   inv.Amount.ToCoin(),
+		Memo:     inv.Memo,
+		Received: inv.Received.ToCoin(),
+		Pending:  inv.Pending.ToCoin(),
+		Status:   inv.Status.String(),
+	}
+	if !inv.Expires.IsZero() {
+		e.Invoice.Expires = inv.Expires.Unix()
+	}
+	err := walletdb.Update(ctx, s.db, func(dbtx walletdb.ReadWriteTx) error {
+		return s.enqueue(dbtx, []*Event{e})
+	})
+	if err != nil {
+		return err
+	}
+	s.signal()
+	return nil
+}
+
+// confirmations queues tx.confirmed events for every threshold passed by
+// watched transactions.
+func (s *Service) confirmations(ctx context.Context, ns []wallet.ConfirmationNotification) error {
+	if len(ns) == 0 {
+		return nil
+	}
+	var events []*Event
+	stopAfter := s.thresholds[len(s.thresholds)-1]
+	err := walletdb.Update(ctx, s.db, func(dbtx walletdb.ReadWriteTx) error {
+		for i := range ns {
+			n := &ns[i]
+			w, err := fetchWatched(dbtx, n.TxHash)
+			if err != nil {
+				return err
+			}
+			if w == nil {
+				continue
+			}
+			if n.Confirmations < 0 {
+				// Removed from the wallet or invalidated.
+				err := deleteWatched(dbtx, n.TxHash)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			for _, t := range s.thresholds {
+				if t <= w.Notified || t > n.Confirmations {
+					continue
+				}
+				tx := *w.Transaction
+				if n.BlockHash != nil {
+					tx.BlockHash = n.BlockHash.String()
+					tx.BlockHeight = n.BlockHeight
+				}
+				e := newEvent(TxConfirmed)
+				e.Transaction = &tx
+				e.Confirmations = t
+				events = append(events, e)
+			}
+			if n.Confirmations > w.Notified {
+				w.Notified = n.Confirmations
+			}
+			if w.Notified >= stopAfter {
+				err = deleteWatched(dbtx, n.TxHash)
+			} else {
+				err = putWatched(dbtx, n.TxHash, w)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return s.enqueue(dbtx, events)
+	})
+	if err != nil {
+		return err
+	}
+	s.signal()
+	return nil
+}
+
+// enqueue queues a delivery of each event to every endpoint receiving it.
+func (s *Service) enqueue(dbtx walletdb.ReadWriteTx, events []*Event) error {
+	now := time.Now().UnixNano()
+	var ds []*delivery
+	for _, e := range events {
+		for _, h := range s.hooks {
+			if !h.wants(e) {
+				continue
+			}
+			body, err := h.body(e)
+			if err != nil {
+				log.Errorf("Webhook %s: %s event %s: %v", h.URL, e.Type, e.ID, err)
+				continue
+			}
+			ds = append(ds, &delivery{
+				URL:     h.URL,
+				ID:      e.ID,
+				Event:   e.Type,
+				Body:    body,
+				Created: now,
+				Next:    now,
+			})
+		}
+	}
+	if len(ds) == 0 {
+		return nil
+	}
+	return putDeliveries(dbtx, ds)
+}
+
+// signal wakes the delivery loop after new deliveries are queued.
+func (s *Service) signal() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// deliverLoop attempts every due delivery, then waits until the next delivery
+// is due or new deliveries are queued.
+func (s *Service) deliverLoop(ctx context.Context) error {
+	for {
+		var ds []*delivery
+		err := walletdb.View(ctx, s.db, func(dbtx walletdb.ReadTx) error {
+			var err error
+			ds, err = queuedDeliveries(dbtx)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		var next int64
+		for _, d := range ds {
+			if d.Next > time.Now().UnixNano() {
+				if next == 0 || d.Next < next {
+					next = d.Next
+				}
+				continue
+			}
+			queued, err := s.attempt(ctx, d)
+			if err != nil {
+				return err
+			}
+			if queued && (next == 0 || d.Next < next) {
+				next = d.Next
+			}
+		}
+
+		var timer *time.Timer
+		var timeout <-chan time.Time
+		if next != 0 {
+			timer = time.NewTimer(time.Until(time.Unix(0, next)))
+			timeout = timer.C
+		}
+		select {
+		case <-ctx.Done():
+		case <-s.wake:
+		case <-timeout:
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+}
+
+// backoff returns the delay before retrying a delivery after a number of
+// failed attempts.
+func (s *Service) backoff(attempts int) time.Duration {
+	d := s.initialBackoff
+	for i := 1; i < attempts && d < s.maxBackoff; i++ {
+		d *= 2
+	}
+	if d > s.maxBackoff {
+		d = s.maxBackoff
+	}
+	return d
+}
+
+// attempt posts a delivery to its endpoint, removing it from the queue when
+// accepted or dropped, and otherwise scheduling a retry.  It returns whether
+// the delivery remains queued.
+func (s *Service) attempt(ctx context.Context, d *delivery) (queued bool, err error) {
+	h := s.byURL[d.URL]
+	var postErr error
+	if h != nil {
+		postErr = s.post(ctx, h, d)
+		if ctx.Err() != nil {
+			return true, ctx.Err()
+		}
+	}
+	now := time.Now()
+	switch {
+	case h == nil:
+		log.Warnf("Dropping %s event %s queued for removed webhook %s", d.Event, d.ID, d.URL)
+	case postErr == nil:
+		log.Debugf("Delivered %s event %s to webhook %s", d.Event, d.ID, d.URL)
+	case now.Sub(time.Unix(0, d.Created)) >= s.maxAge:
+		log.Errorf("Dropping %s event %s after %d failed deliveries to webhook %s: %v",
+			d.Event, d.ID, d.Attempts+1, d.URL, postErr)
+	default:
+		d.Attempts++
+		delay := s.backoff(d.Attempts)
+		d.Next = now.Add(delay).UnixNano()
+		log.Warnf("Delivery of %s event %s to webhook %s failed (attempt %d, retrying in %v): %v",
+			d.Event, d.ID, d.URL, d.Attempts, delay, postErr)
+		err := walletdb.Update(ctx, s.db, func(dbtx walletdb.ReadWriteTx) error {
+			return putDelivery(dbtx, d)
+		})
+		return true, err
+	}
+	err = walletdb.Update(ctx, s.db, func(dbtx walletdb.ReadWriteTx) error {
+		return deleteDelivery(dbtx, d.seq)
+	})
+	return false, err
+}
+
+// post delivers a signed request to an endpoint.  Any 2xx status accepts the
+// delivery.
+func (s *Service) post(ctx context.Context, h *Hook, d *delivery) error {
+	req, err := http.NewRequest(http.MethodPost, h.URL, bytes.NewReader(d.Body))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, d.Event)
+	req.Header.Set(DeliveryHeader, d.ID)
+	req.Header.Set(SignatureHeader, Signature(h.Secret, time.Now().Unix(), d.Body))
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 64*1024))
+	resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return errors.Errorf("HTTP status %s", resp.Status)
+	}
+	return nil
+}
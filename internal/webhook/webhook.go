@@ -0,0 +1,319 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package webhook delivers wallet notifications to HTTP endpoints.
+//
+// Webhooks are described by a JSON file listing the URL of each endpoint, the
+// secret used to sign deliveries, the events the endpoint is subscribed to, and
+// the confirmation counts reported by tx.confirmed events:
+//
+//	{
+//	  "hooks": [
+//	    {
+//	      "url": "https://shop.example.com/dcrwallet",
+//	      "secret": "a long random string",
+//	      "events": ["tx.unmined", "tx.mined", "tx.confirmed"],
+//	      "confirmations": [1, 6]
+//	    },
+//	    {
+//	      "url": "http://127.0.0.1:8080/tip",
+//	      "secret": "another secret",
+//	      "events": ["tip.changed"],
+//	      "payload": "{\"height\": {{.Tip.Height}}, \"hash\": {{json .Tip.Hash}}}"
+//	    }
+//	  ]
+//	}
+//
+// An omitted events list subscribes the endpoint to every event.  By default
+// the JSON encoding of the Event is posted.  A payload, a text/template
+// executed with the Event, replaces it; the json function encodes any value as
+// JSON and the template must produce valid JSON.
+//
+// Every delivery is signed with the endpoint's secret.  The Dcrwallet-Signature
+// header has the form t=<unix time>,v1=<hex HMAC-SHA256 of "<unix time>.<body>">
+// and the Dcrwallet-Event and Dcrwallet-Delivery headers carry the event type
+// and ID.  Failed deliveries are retried with exponential backoff and the event
+// ID is unchanged by retries, so endpoints may discard duplicate events.
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"text/template"
+
+	"github.com/decred/dcrwallet/errors/v2"
+)
+
+// Event types.
+const (
+	// TxUnmined events report transactions relevant to the wallet which
+	// were added to the mempool.
+	TxUnmined = "tx.unmined"
+
+	// TxMined events report relevant transactions mined in a block of the
+	// main chain.
+	TxMined = "tx.mined"
+
+	// TxConfirmed events report relevant transactions reaching one of the
+	// confirmation counts of the endpoint.
+	TxConfirmed = "tx.confirmed"
+
+	// TipChanged events report a new main chain tip block, and any blocks
+	// removed from the main chain by a reorganization.
+	TipChanged = "tip.changed"
+
+	// TicketVoted events report mined votes of wallet tickets.
+	TicketVoted = "ticket.voted"
+
+	// TicketRevoked events report mined revocations of wallet tickets.
+	TicketRevoked = "ticket.revoked"
+
+	// InvoiceUpdated events report payments of invoices.
+	InvoiceUpdated = "invoice.updated"
+)
+
+var eventTypes = []string{TxUnmined, TxMined, TxConfirmed, TipChanged, TicketVoted, TicketRevoked, InvoiceUpdated}
+
+// Headers of each delivery.
+const (
+	SignatureHeader = "Dcrwallet-Signature"
+	EventHeader     = "Dcrwallet-Event"
+	DeliveryHeader  = "Dcrwallet-Delivery"
+)
+
+// File is the decoded webhook file.
+type File struct {
+	Hooks []*Hook `json:"hooks"`
+}
+
+// Hook describes an endpoint receiving wallet notifications.
+type Hook struct {
+	URL           string   `json:"url"`
+	Secret        string   `json:"secret"`
+	Events        []string `json:"events"`
+	Confirmations []int32  `json:"confirmations"`
+	Payload       string   `json:"payload"`
+
+	payload *template.Template
+}
+
+// Read reads, decodes and checks a webhook file.
+func Read(path string) (*File, error) {
+	const op errors.Op = "webhook.Read"
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	defer f.Close()
+	dec := json.NewDecoder(f)
+	dec.DisallowUnknownFields()
+	file := new(File)
+	err = dec.Decode(file)
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	err = file.check()
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return file, nil
+}
+
+func (f *File) check() error {
+	urls := make(map[string]struct{}, len(f.Hooks))
+	for i, h := range f.Hooks {
+		u, err := url.Parse(h.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.E(errors.Invalid, errors.Errorf("hook %d: invalid URL %q", i, h.URL))
+		}
+		if _, ok := urls[h.URL]; ok {
+			return errors.E(errors.Invalid, errors.Errorf("hook %d: duplicate URL %q", i, h.URL))
+		}
+		urls[h.URL] = struct{}{}
+		if h.Secret == "" {
+			return errors.E(errors.Invalid, errors.Errorf("hook %d: missing secret", i))
+		}
+		for _, e := range h.Events {
+			if !knownEvent(e) {
+				return errors.E(errors.Invalid, errors.Errorf("hook %d: unknown event %q", i, e))
+			}
+		}
+		for _, c := range h.Confirmations {
+			if c < 1 {
+				return errors.E(errors.Invalid, errors.Errorf("hook %d: confirmations must be positive", i))
+			}
+		}
+		if h.Subscribed(TxConfirmed) && len(h.Confirmations) == 0 && len(h.Events) != 0 {
+			return errors.E(errors.Invalid, errors.Errorf("hook %d: %s requires confirmations", i, TxConfirmed))
+		}
+		if h.Payload != "" {
+			h.payload, err = template.New(h.URL).Funcs(templateFuncs).Parse(h.Payload)
+			if err != nil {
+				return errors.E(errors.Invalid, errors.Errorf("hook %d: payload: %v", i, err))
+			}
+		}
+	}
+	return nil
+}
+
+func knownEvent(e string) bool {
+	for _, t := range eventTypes {
+		if e == t {
+			return true
+		}
+	}
+	return false
+}
+
+// Subscribed returns whether the endpoint receives events of a type.
+func (h *Hook) Subscribed(eventType string) bool {
+	if len(h.Events) == 0 {
+		return true
+	}
+	for _, e := range h.Events {
+		if e == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// wants returns whether the endpoint receives an event.
+func (h *Hook) wants(e *Event) bool {
+	if !h.Subscribed(e.Type) {
+		return false
+	}
+	if e.Type != TxConfirmed {
+		return true
+	}
+	for _, c := range h.Confirmations {
+		if c == e.Confirmations {
+			return true
+		}
+	}
+	return false
+}
+
+// body returns the payload posted to the endpoint for an event.
+func (h *Hook) body(e *Event) ([]byte, error) {
+	if h.payload == nil {
+		return json.Marshal(e)
+	}
+	var buf bytes.Buffer
+	err := h.payload.Execute(&buf, e)
+	if err != nil {
+		return nil, errors.E(errors.Invalid, errors.Errorf("payload: %v", err))
+	}
+	if !json.Valid(buf.Bytes()) {
+		return nil, errors.E(errors.Encoding, "payload is not valid JSON")
+	}
+	return buf.Bytes(), nil
+}
+
+var templateFuncs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+}
+
+// thresholds returns the sorted confirmation counts of every hook subscribed
+// to tx.confirmed events.
+func (f *File) thresholds() []int32 {
+	set := make(map[int32]struct{})
+	for _, h := range f.Hooks {
+		if !h.Subscribed(TxConfirmed) {
+			continue
+		}
+		for _, c := range h.Confirmations {
+			set[c] = struct{}{}
+		}
+	}
+	t := make([]int32, 0, len(set))
+	for c := range set {
+		t = append(t, c)
+	}
+	sort.Slice(t, func(i, j int) bool { return t[i] < t[j] })
+	return t
+}
+
+// Signature returns the Dcrwallet-Signature header value of a body delivered
+// at a unix time.
+func Signature(secret string, timestamp int64, body []byte) string {
+	t := strconv.FormatInt(timestamp, 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(t))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+	return "t=" + t + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Event is a wallet notification delivered to endpoints.
+type Event struct {
+	ID            string       `json:"id"`
+	Type          string       `json:"type"`
+	Time          int64        `json:"time"`
+	Transaction   *Transaction `json:"transaction,omitempty"`
+	Confirmations int32        `json:"confirmations,omitempty"`
+	Tip           *Block       `json:"tip,omitempty"`
+	Detached      []string     `json:"detached,omitempty"`
+	Invoice       *Invoice     `json:"invoice,omitempty"`
+}
+
+// Transaction describes a transaction relevant to the wallet.  Debits are the
+// inputs spending wallet outputs and credits the outputs paying the wallet.
+// Amounts are in DCR.
+type Transaction struct {
+	Hash        string   `json:"hash"`
+	Type        string   `json:"type"`
+	Fee         float64  `json:"fee"`
+	Debits      []Debit  `json:"debits"`
+	Credits     []Credit `json:"credits"`
+	BlockHash   string   `json:"blockhash,omitempty"`
+	BlockHeight int32    `json:"blockheight,omitempty"`
+}
+
+// Debit is a transaction input spending a wallet output.
+type Debit struct {
+	Index   uint32  `json:"index"`
+	Account uint32  `json:"account"`
+	Amount  float64 `json:"amount"`
+}
+
+// Credit is a transaction output paying the wallet.
+type Credit struct {
+	Index    uint32  `json:"index"`
+	Account  uint32  `json:"account"`
+	Internal bool    `json:"internal"`
+	Amount   float64 `json:"amount"`
+	Address  string  `json:"address,omitempty"`
+}
+
+// Invoice describes an invoice and the total of its payments with at least one
+// confirmation (received) and without confirmations (pending).  Amounts are in
+// DCR.
+type Invoice struct {
+	ID       uint64  `json:"id"`
+	Account  uint32  `json:"account"`
+	Address  string  `json:"address"`
+	Amount   float64 `json:"amount"`
+	Memo     string  `json:"memo,omitempty"`
+	Expires  int64   `json:"expires,omitempty"`
+	Received float64 `json:"received"`
+	Pending  float64 `json:"pending"`
+	Status   string  `json:"status"`
+}
+
+// Block identifies a block.
+type Block struct {
+	Hash   string `json:"hash"`
+	Height int32  `json:"height"`
+}
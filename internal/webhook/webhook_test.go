@@ -0,0 +1,301 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/wallet/v3"
+	"github.com/decred/dcrwallet/wallet/v3/walletdb"
+)
+
+func TestRead(t *testing.T) {
+	dir, err := ioutil.TempDir("", "webhook")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		name string
+		file string
+		err  bool
+	}{
+		{"valid", `{"hooks": [{"url": "https://example.com/a", "secret": "s", "events": ["tx.confirmed"], "confirmations": [1, 6]},
+			{"url": "http://127.0.0.1/b", "secret": "s", "payload": "{\"id\": {{json .ID}}}"}]}`, false},
+		{"unknown field", `{"hooks": [{"url": "https://example.com", "secret": "s", "retries": 3}]}`, true},
+		{"invalid url", `{"hooks": [{"url": "ftp://example.com", "secret": "s"}]}`, true},
+		{"duplicate url", `{"hooks": [{"url": "https://example.com", "secret": "s"}, {"url": "https://example.com", "secret": "t"}]}`, true},
+		{"missing secret", `{"hooks": [{"url": "https://example.com"}]}`, true},
+		{"unknown event", `{"hooks": [{"url": "https://example.com", "secret": "s", "events": ["tx.deposit"]}]}`, true},
+		{"no confirmations", `{"hooks": [{"url": "https://example.com", "secret": "s", "events": ["tx.confirmed"]}]}`, true},
+		{"zero confirmations", `{"hooks": [{"url": "https://example.com", "secret": "s", "confirmations": [0]}]}`, true},
+		{"invalid payload", `{"hooks": [{"url": "https://example.com", "secret": "s", "payload": "{{.ID"}]}`, true},
+	}
+	for _, test := range tests {
+		path := filepath.Join(dir, "webhooks.json")
+		err := ioutil.WriteFile(path, []byte(test.file), 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = Read(path)
+		if (err != nil) != test.err {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+		}
+	}
+}
+
+type receiver struct {
+	fail   int
+	events chan *http.Request
+	bodies chan []byte
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if r.fail > 0 {
+		r.fail--
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	b, _ := ioutil.ReadAll(req.Body)
+	r.events <- req
+	r.bodies <- b
+}
+
+func testService(t *testing.T, path string, f *File) *Service {
+	if err := f.check(); err != nil {
+		t.Fatal(err)
+	}
+	s, err := Open(context.Background(), path, f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.initialBackoff = 10 * time.Millisecond
+	s.maxBackoff = 20 * time.Millisecond
+	return s
+}
+
+func queueLen(t *testing.T, s *Service) int {
+	var ds []*delivery
+	err := walletdb.View(context.Background(), s.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		ds, err = queuedDeliveries(dbtx)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return len(ds)
+}
+
+func TestDelivery(t *testing.T) {
+	dir, err := ioutil.TempDir("", "webhook")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "webhooks.db")
+
+	r := &receiver{fail: 2, events: make(chan *http.Request, 10), bodies: make(chan []byte, 10)}
+	srv := httptest.NewServer(r)
+	defer srv.Close()
+	f := &File{Hooks: []*Hook{
+		{URL: srv.URL + "/tip", Secret: "tip secret", Events: []string{TipChanged},
+			Payload: `{"height": {{.Tip.Height}}}`},
+		{URL: srv.URL + "/tx", Secret: "tx secret", Events: []string{TxMined, TicketVoted}},
+	}}
+
+	// Queue a notification while the service is not running, and reopen the
+	// queue to deliver it.
+	s := testService(t, path, f)
+	header := &wire.BlockHeader{Height: 100}
+	vote := &chainhash.Hash{1}
+	n := &wallet.TransactionNotifications{
+		AttachedBlocks: []wallet.Block{{
+			Header: header,
+			Transactions: []wallet.TransactionSummary{{
+				Hash: vote,
+				Type: wallet.TransactionTypeVote,
+				MyOutputs: []wallet.TransactionSummaryOutput{
+					{Index: 2, Account: 1, Amount: 1e8},
+				},
+			}},
+		}},
+	}
+	_, err = s.transactionNotification(context.Background(), n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l := queueLen(t, s); l != 3 {
+		t.Fatalf("queued %d deliveries, expected 3", l)
+	}
+	s.Close()
+	s = testService(t, path, f)
+	defer s.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- s.deliverLoop(ctx) }()
+
+	received := make(map[string]*Event)
+	for i := 0; i < 3; i++ {
+		var req *http.Request
+		var body []byte
+		select {
+		case req = <-r.events:
+			body = <-r.bodies
+		case <-time.After(5 * time.Second):
+			t.Fatalf("received %d deliveries, expected 3", i)
+		}
+		h := s.byURL[srv.URL+req.URL.Path]
+		sig := req.Header.Get(SignatureHeader)
+		var ts int64
+		var mac string
+		if _, err := fmt.Sscanf(sig, "t=%d,v1=%s", &ts, &mac); err != nil {
+			t.Fatalf("signature header %q: %v", sig, err)
+		}
+		if Signature(h.Secret, ts, body) != sig {
+			t.Errorf("%s: invalid signature %q", req.URL.Path, sig)
+		}
+		e := new(Event)
+		if err := json.Unmarshal(body, e); err != nil {
+			t.Fatal(err)
+		}
+		switch req.URL.Path {
+		case "/tip":
+			if e.Tip != nil || string(body) != `{"height":100}` {
+				t.Errorf("tip payload %s", body)
+			}
+		case "/tx":
+			if req.Header.Get(DeliveryHeader) != e.ID || req.Header.Get(EventHeader) != e.Type {
+				t.Errorf("event headers %v do not match %s", req.Header, body)
+			}
+			if e.Transaction == nil || e.Transaction.Hash != vote.String() ||
+				e.Transaction.BlockHeight != 100 || len(e.Transaction.Credits) != 1 ||
+				e.Transaction.Credits[0].Amount != 1 {
+				t.Errorf("transaction payload %s", body)
+			}
+			received[e.Type] = e
+		}
+	}
+	if received[TxMined] == nil || received[TicketVoted] == nil {
+		t.Errorf("missing %s or %s event", TxMined, TicketVoted)
+	}
+
+	// Accepted deliveries are removed from the queue after the response.
+	for i := 0; queueLen(t, s) != 0; i++ {
+		if i == 100 {
+			t.Fatalf("%d deliveries remain queued", queueLen(t, s))
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	cancel()
+	<-done
+}
+
+func TestConfirmations(t *testing.T) {
+	dir, err := ioutil.TempDir("", "webhook")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	f := &File{Hooks: []*Hook{
+		{URL: "http://127.0.0.1/a", Secret: "s", Events: []string{TxConfirmed}, Confirmations: []int32{1, 6}},
+		{URL: "http://127.0.0.1/b", Secret: "s", Events: []string{TxConfirmed}, Confirmations: []int32{2}},
+	}}
+	s := testService(t, filepath.Join(dir, "webhooks.db"), f)
+	defer s.Close()
+	ctx := context.Background()
+
+	hash := &chainhash.Hash{2}
+	n := &wallet.TransactionNotifications{
+		UnminedTransactions: []wallet.TransactionSummary{{Hash: hash}},
+	}
+	watch, err := s.transactionNotification(ctx, n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(watch) != 1 || *watch[0] != *hash {
+		t.Fatalf("watching %v", watch)
+	}
+	queued := func() []int32 {
+		var confs []int32
+		err := walletdb.View(ctx, s.db, func(dbtx walletdb.ReadTx) error {
+			ds, err := queuedDeliveries(dbtx)
+			for _, d := range ds {
+				e := new(Event)
+				if err := json.Unmarshal(d.Body, e); err != nil {
+					return err
+				}
+				if e.Type == TxConfirmed {
+					confs = append(confs, e.Confirmations)
+				}
+			}
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return confs
+	}
+	watched := func() bool {
+		var w *watchedTx
+		err := walletdb.View(ctx, s.db, func(dbtx walletdb.ReadTx) error {
+			var err error
+			w, err = fetchWatched(dbtx, hash)
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return w != nil
+	}
+
+	steps := []struct {
+		confs   int32
+		queued  []int32
+		watched bool
+	}{
+		{0, nil, true},
+		{2, []int32{1, 2}, true},
+		{3, []int32{1, 2}, true},
+		{7, []int32{1, 2, 6}, false},
+	}
+	for _, step := range steps {
+		err := s.confirmations(ctx, []wallet.ConfirmationNotification{{
+			TxHash:        hash,
+			Confirmations: step.confs,
+			BlockHash:     &chainhash.Hash{3},
+			BlockHeight:   50,
+		}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		q := queued()
+		if len(q) != len(step.queued) {
+			t.Fatalf("%d confirmations: queued %v, expected %v", step.confs, q, step.queued)
+		}
+		for i := range q {
+			if q[i] != step.queued[i] {
+				t.Fatalf("%d confirmations: queued %v, expected %v", step.confs, q, step.queued)
+			}
+		}
+		if watched() != step.watched {
+			t.Fatalf("%d confirmations: watched %v", step.confs, !step.watched)
+		}
+	}
+}
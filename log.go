@@ -11,8 +11,12 @@ import (
 	"path/filepath"
 
 	"decred.org/dcrwallet/internal/loader"
+	"decred.org/dcrwallet/internal/revoker"
 	"decred.org/dcrwallet/internal/rpc/jsonrpc"
+	"decred.org/dcrwallet/internal/rpc/restgateway"
 	"decred.org/dcrwallet/internal/rpc/rpcserver"
+	"decred.org/dcrwallet/internal/vsp"
+	"decred.org/dcrwallet/internal/webhook"
 	"github.com/decred/dcrd/connmgr/v2"
 	"github.com/decred/dcrwallet/chain/v3"
 	"github.com/decred/dcrwallet/p2p/v2"
@@ -60,6 +64,9 @@ var (
 	grpcLog    = backendLog.Logger("GRPC")
 	jsonrpcLog = backendLog.Logger("RPCS")
 	cmgrLog    = backendLog.Logger("CMGR")
+	hookLog    = backendLog.Logger("HOOK")
+	vspLog     = backendLog.Logger("VSPC")
+	rvkrLog    = backendLog.Logger("RVKR")
 )
 
 // Initialize package-global logger variables.
@@ -72,8 +79,12 @@ func init() {
 	spv.UseLogger(syncLog)
 	p2p.UseLogger(syncLog)
 	rpcserver.UseLogger(grpcLog)
+	restgateway.UseLogger(grpcLog)
 	jsonrpc.UseLogger(jsonrpcLog)
 	connmgr.UseLogger(cmgrLog)
+	webhook.UseLogger(hookLog)
+	vsp.UseLogger(vspLog)
+	revoker.UseLogger(rvkrLog)
 }
 
 // subsystemLoggers maps each subsystem identifier to its associated logger.
@@ -86,6 +97,9 @@ var subsystemLoggers = map[string]slog.Logger{
 	"GRPC": grpcLog,
 	"RPCS": jsonrpcLog,
 	"CMGR": cmgrLog,
+	"HOOK": hookLog,
+	"VSPC": vspLog,
+	"RVKR": rvkrLog,
 }
 
 // initLogRotator initializes the logging rotater to write logs to logFile and
@@ -98,6 +98,71 @@ type AuditReuseCmd struct {
 	Since *int32 `json:"since"`
 }
 
+// BakeMacaroonCmd defines the bakemacaroon JSON-RPC command.
+type BakeMacaroonCmd struct {
+	Methods  *[]string
+	Role     *string
+	Expiry   *int64
+	Account  *string
+	MaxSpend *float64
+}
+
+// NewBakeMacaroonCmd returns a new instance which can be used to issue a
+// bakemacaroon JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will omit the caveat from the macaroon.
+func NewBakeMacaroonCmd(methods *[]string, role *string, expiry *int64, account *string,
+	maxSpend *float64) *BakeMacaroonCmd {
+
+	return &BakeMacaroonCmd{
+		Methods:  methods,
+		Role:     role,
+		Expiry:   expiry,
+		Account:  account,
+		MaxSpend: maxSpend,
+	}
+}
+
+// BackupWalletCmd defines the backupwallet JSON-RPC command.
+type BackupWalletCmd struct {
+	Destination string
+}
+
+// NewBackupWalletCmd returns a new instance which can be used to issue a
+// backupwallet JSON-RPC command.
+func NewBackupWalletCmd(destination string) *BackupWalletCmd {
+	return &BackupWalletCmd{Destination: destination}
+}
+
+// BumpFeeCmd defines the bumpfee JSON-RPC command.
+type BumpFeeCmd struct {
+	TxHash  string
+	FeeRate *float64
+}
+
+// NewBumpFeeCmd returns a new instance which can be used to issue a bumpfee
+// JSON-RPC command.
+func NewBumpFeeCmd(txHash string, feeRate *float64) *BumpFeeCmd {
+	return &BumpFeeCmd{
+		TxHash:  txHash,
+		FeeRate: feeRate,
+	}
+}
+
+// CombinePSBTCmd defines the combinepsbt JSON-RPC command.
+type CombinePSBTCmd struct {
+	PSBTs []string
+}
+
+// NewCombinePSBTCmd returns a new instance which can be used to issue a
+// combinepsbt JSON-RPC command.
+func NewCombinePSBTCmd(psbts []string) *CombinePSBTCmd {
+	return &CombinePSBTCmd{
+		PSBTs: psbts,
+	}
+}
+
 // ConsolidateCmd is a type handling custom marshaling and
 // unmarshaling of consolidate JSON wallet extension
 // commands.
@@ -107,6 +172,23 @@ type ConsolidateCmd struct {
 	Address *string
 }
 
+// CPFPCmd defines the cpfp JSON-RPC command.
+type CPFPCmd struct {
+	TxHash  string
+	FeeRate *float64
+	Account *string
+}
+
+// NewCPFPCmd returns a new instance which can be used to issue a cpfp JSON-RPC
+// command.
+func NewCPFPCmd(txHash string, feeRate *float64, account *string) *CPFPCmd {
+	return &CPFPCmd{
+		TxHash:  txHash,
+		FeeRate: feeRate,
+		Account: account,
+	}
+}
+
 // CreateEncryptedWalletCmd defines the createencryptedwallet JSON-RPC command.
 //
 // Deprecated: This method is not implemented by the RPC server.
@@ -136,6 +218,32 @@ func NewConsolidateCmd(inputs int, acct *string, addr *string) *ConsolidateCmd {
 	return &ConsolidateCmd{Inputs: inputs, Account: acct, Address: addr}
 }
 
+// CreateInvoiceCmd defines the createinvoice JSON-RPC command.
+type CreateInvoiceCmd struct {
+	Account   string
+	Amount    float64
+	Memo      *string
+	Expiry    *int64
+	GapPolicy *string
+}
+
+// NewCreateInvoiceCmd returns a new instance which can be used to issue a
+// createinvoice JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewCreateInvoiceCmd(account string, amount float64, memo *string, expiry *int64,
+	gapPolicy *string) *CreateInvoiceCmd {
+
+	return &CreateInvoiceCmd{
+		Account:   account,
+		Amount:    amount,
+		Memo:      memo,
+		Expiry:    expiry,
+		GapPolicy: gapPolicy,
+	}
+}
+
 // CreateMultisigCmd defines the createmultisig JSON-RPC command.
 type CreateMultisigCmd struct {
 	NRequired int
@@ -151,6 +259,44 @@ func NewCreateMultisigCmd(nRequired int, keys []string) *CreateMultisigCmd {
 	}
 }
 
+// CreateMultisigAccountCmd defines the createmultisigaccount JSON-RPC command.
+type CreateMultisigAccountCmd struct {
+	Name      string
+	NRequired int
+	XPubs     []string
+}
+
+// NewCreateMultisigAccountCmd returns a new instance which can be used to issue
+// a createmultisigaccount JSON-RPC command.
+func NewCreateMultisigAccountCmd(name string, nRequired int, xpubs []string) *CreateMultisigAccountCmd {
+	return &CreateMultisigAccountCmd{
+		Name:      name,
+		NRequired: nRequired,
+		XPubs:     xpubs,
+	}
+}
+
+// CreateMultisigAccountTxCmd defines the createmultisigaccounttx JSON-RPC
+// command.
+type CreateMultisigAccountTxCmd struct {
+	Account string
+	Amounts map[string]float64 `jsonrpcusage:"{\"address\":amount,...}"` // In DCR
+	MinConf *int               `jsonrpcdefault:"1"`
+}
+
+// NewCreateMultisigAccountTxCmd returns a new instance which can be used to
+// issue a createmultisigaccounttx JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewCreateMultisigAccountTxCmd(account string, amounts map[string]float64, minConf *int) *CreateMultisigAccountTxCmd {
+	return &CreateMultisigAccountTxCmd{
+		Account: account,
+		Amounts: amounts,
+		MinConf: minConf,
+	}
+}
+
 // CreateNewAccountCmd defines the createnewaccount JSON-RPC command.
 type CreateNewAccountCmd struct {
 	Account string
@@ -164,6 +310,29 @@ func NewCreateNewAccountCmd(account string) *CreateNewAccountCmd {
 	}
 }
 
+// CreatePSBTCmd defines the createpsbt JSON-RPC command.
+type CreatePSBTCmd struct {
+	Inputs   []dcrdtypes.TransactionInput
+	Amounts  map[string]float64 `jsonrpcusage:"{\"address\":amount,...}"` // In DCR
+	LockTime *int64
+	Expiry   *int64
+}
+
+// NewCreatePSBTCmd returns a new instance which can be used to issue a
+// createpsbt JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewCreatePSBTCmd(inputs []dcrdtypes.TransactionInput, amounts map[string]float64,
+	lockTime *int64, expiry *int64) *CreatePSBTCmd {
+	return &CreatePSBTCmd{
+		Inputs:   inputs,
+		Amounts:  amounts,
+		LockTime: lockTime,
+		Expiry:   expiry,
+	}
+}
+
 // CreateVotingAccountCmd is a type for handling custom marshaling and
 // unmarshalling of createvotingaccount JSON-RPC command.
 type CreateVotingAccountCmd struct {
@@ -200,6 +369,17 @@ func NewDumpPrivKeyCmd(address string) *DumpPrivKeyCmd {
 	}
 }
 
+// DumpWalletCmd defines the dumpwallet JSON-RPC command.
+type DumpWalletCmd struct {
+	Filename string
+}
+
+// NewDumpWalletCmd returns a new instance which can be used to issue a
+// dumpwallet JSON-RPC command.
+func NewDumpWalletCmd(filename string) *DumpWalletCmd {
+	return &DumpWalletCmd{Filename: filename}
+}
+
 // EstimatePriorityCmd defines the estimatepriority JSON-RPC command.
 //
 // Deprecated: This method is not implemented by the RPC server.
@@ -239,6 +419,52 @@ func NewExportWatchingWalletCmd(account *string, download *bool) *ExportWatching
 	}
 }
 
+// ExportHistoryCmd defines the exporthistory JSON-RPC command.
+type ExportHistoryCmd struct {
+	Format      *string `jsonrpcdefault:"\"csv\""`
+	StartHeight *int    `jsonrpcdefault:"0"`
+	EndHeight   *int    `jsonrpcdefault:"-1"`
+	Account     *string
+	Labels      *bool `jsonrpcdefault:"false"`
+	Balances    *bool `jsonrpcdefault:"false"`
+}
+
+// NewExportHistoryCmd returns a new instance which can be used to issue an
+// exporthistory JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewExportHistoryCmd(format *string, startHeight, endHeight *int, account *string,
+	labels, balances *bool) *ExportHistoryCmd {
+
+	return &ExportHistoryCmd{
+		Format:      format,
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+		Account:     account,
+		Labels:      labels,
+		Balances:    balances,
+	}
+}
+
+// FinalizePSBTCmd defines the finalizepsbt JSON-RPC command.
+type FinalizePSBTCmd struct {
+	PSBT    string
+	Extract *bool `jsonrpcdefault:"true"`
+}
+
+// NewFinalizePSBTCmd returns a new instance which can be used to issue a
+// finalizepsbt JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewFinalizePSBTCmd(psbt string, extract *bool) *FinalizePSBTCmd {
+	return &FinalizePSBTCmd{
+		PSBT:    psbt,
+		Extract: extract,
+	}
+}
+
 // FundRawTransactionOptions represents the optional inputs to fund
 // a raw transaction.
 type FundRawTransactionOptions struct {
@@ -325,6 +551,25 @@ func NewGetAddressesByAccountCmd(account string) *GetAddressesByAccountCmd {
 	}
 }
 
+// GetAuditLogCmd defines the getauditlog JSON-RPC command.
+type GetAuditLogCmd struct {
+	Operation *string
+	Caller    *string
+	Since     *int64
+	Count     *int
+}
+
+// NewGetAuditLogCmd returns a new instance which can be used to issue a
+// getauditlog JSON-RPC command.
+func NewGetAuditLogCmd(operation, caller *string, since *int64, count *int) *GetAuditLogCmd {
+	return &GetAuditLogCmd{
+		Operation: operation,
+		Caller:    caller,
+		Since:     since,
+		Count:     count,
+	}
+}
+
 // GetBalanceCmd defines the getbalance JSON-RPC command.
 type GetBalanceCmd struct {
 	Account *string
@@ -343,6 +588,24 @@ func NewGetBalanceCmd(account *string, minConf *int) *GetBalanceCmd {
 	}
 }
 
+// GetInvoiceCmd defines the getinvoice JSON-RPC command.
+type GetInvoiceCmd struct {
+	ID      uint64
+	MinConf *int `jsonrpcdefault:"1"`
+}
+
+// NewGetInvoiceCmd returns a new instance which can be used to issue a
+// getinvoice JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewGetInvoiceCmd(id uint64, minConf *int) *GetInvoiceCmd {
+	return &GetInvoiceCmd{
+		ID:      id,
+		MinConf: minConf,
+	}
+}
+
 // GetContractHashCmd defines the getcontracthash JSON-RPC command.
 type GetContractHashCmd struct {
 	FilePath []string
@@ -354,6 +617,17 @@ func NewGetContractHashCmd(filepaths []string) *GetContractHashCmd {
 	return &GetContractHashCmd{FilePath: filepaths}
 }
 
+// GetLabelCmd defines the getlabel JSON-RPC command.
+type GetLabelCmd struct {
+	Target string
+}
+
+// NewGetLabelCmd returns a new instance which can be used to issue a getlabel
+// JSON-RPC command.
+func NewGetLabelCmd(target string) *GetLabelCmd {
+	return &GetLabelCmd{Target: target}
+}
+
 // GetMasterPubkeyCmd is a type handling custom marshaling and unmarshaling of
 // getmasterpubkey JSON wallet extension commands.
 type GetMasterPubkeyCmd struct {
@@ -550,6 +824,22 @@ func NewGetWalletFeeCmd() *GetWalletFeeCmd {
 	return &GetWalletFeeCmd{}
 }
 
+// ImportDescriptorAccountCmd defines the importdescriptoraccount JSON-RPC
+// command.
+type ImportDescriptorAccountCmd struct {
+	Name       string `json:"name"`
+	Descriptor string `json:"descriptor"`
+}
+
+// NewImportDescriptorAccountCmd returns a new instance which can be used to
+// issue an importdescriptoraccount JSON-RPC command.
+func NewImportDescriptorAccountCmd(name, descriptor string) *ImportDescriptorAccountCmd {
+	return &ImportDescriptorAccountCmd{
+		Name:       name,
+		Descriptor: descriptor,
+	}
+}
+
 // ImportPrivKeyCmd defines the importprivkey JSON-RPC command.
 type ImportPrivKeyCmd struct {
 	PrivKey  string
@@ -585,6 +875,26 @@ func NewImportScriptCmd(hex string, rescan *bool, scanFrom *int) *ImportScriptCm
 	return &ImportScriptCmd{hex, rescan, scanFrom}
 }
 
+// ImportWalletCmd defines the importwallet JSON-RPC command.
+type ImportWalletCmd struct {
+	Filename string
+	Rescan   *bool `jsonrpcdefault:"true"`
+	ScanFrom *int
+}
+
+// NewImportWalletCmd returns a new instance which can be used to issue an
+// importwallet JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewImportWalletCmd(filename string, rescan *bool, scanFrom *int) *ImportWalletCmd {
+	return &ImportWalletCmd{
+		Filename: filename,
+		Rescan:   rescan,
+		ScanFrom: scanFrom,
+	}
+}
+
 // ImportXpubCmd is a type for handling custom marshaling and unmarshaling of
 // importxpub JSON-RPC commands.
 type ImportXpubCmd struct {
@@ -638,6 +948,57 @@ func NewListTicketsCmd() *ListTicketsCmd {
 	return &ListTicketsCmd{}
 }
 
+// ListVSPTicketsCmd defines the listvsptickets JSON-RPC command.
+type ListVSPTicketsCmd struct{}
+
+// NewListVSPTicketsCmd returns a new instance which can be used to issue a
+// listvsptickets JSON-RPC command.
+func NewListVSPTicketsCmd() *ListVSPTicketsCmd {
+	return &ListVSPTicketsCmd{}
+}
+
+// ListInvoicesCmd defines the listinvoices JSON-RPC command.
+type ListInvoicesCmd struct {
+	Status  *string
+	MinConf *int `jsonrpcdefault:"1"`
+}
+
+// NewListInvoicesCmd returns a new instance which can be used to issue a
+// listinvoices JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewListInvoicesCmd(status *string, minConf *int) *ListInvoicesCmd {
+	return &ListInvoicesCmd{
+		Status:  status,
+		MinConf: minConf,
+	}
+}
+
+// ListDescriptorAccountsCmd defines the listdescriptoraccounts JSON-RPC
+// command.
+type ListDescriptorAccountsCmd struct{}
+
+// NewListDescriptorAccountsCmd returns a new instance which can be used to
+// issue a listdescriptoraccounts JSON-RPC command.
+func NewListDescriptorAccountsCmd() *ListDescriptorAccountsCmd {
+	return &ListDescriptorAccountsCmd{}
+}
+
+// ListLabelsCmd defines the listlabels JSON-RPC command.
+type ListLabelsCmd struct {
+	Kind *string
+}
+
+// NewListLabelsCmd returns a new instance which can be used to issue a
+// listlabels JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewListLabelsCmd(kind *string) *ListLabelsCmd {
+	return &ListLabelsCmd{Kind: kind}
+}
+
 // ListLockUnspentCmd defines the listlockunspent JSON-RPC command.
 type ListLockUnspentCmd struct{}
 
@@ -810,6 +1171,17 @@ func NewLockUnspentCmd(unlock bool, transactions []dcrdtypes.TransactionInput) *
 	}
 }
 
+// ParsePaymentURICmd defines the parsepaymenturi JSON-RPC command.
+type ParsePaymentURICmd struct {
+	URI string
+}
+
+// NewParsePaymentURICmd returns a new instance which can be used to issue a
+// parsepaymenturi JSON-RPC command.
+func NewParsePaymentURICmd(uri string) *ParsePaymentURICmd {
+	return &ParsePaymentURICmd{URI: uri}
+}
+
 // PurchaseTicketCmd is a type handling custom marshaling and
 // unmarshaling of purchaseticket JSON RPC commands.
 type PurchaseTicketCmd struct {
@@ -843,6 +1215,28 @@ func NewPurchaseTicketCmd(fromAccount string, spendLimit float64, minConf *int,
 	}
 }
 
+// PurchaseVSPTicketCmd defines the purchasevspticket JSON-RPC command.
+type PurchaseVSPTicketCmd struct {
+	FromAccount string
+	NumTickets  *int `jsonrpcdefault:"1"`
+	MinConf     *int `jsonrpcdefault:"1"`
+	Expiry      *int
+}
+
+// NewPurchaseVSPTicketCmd returns a new instance which can be used to issue a
+// purchasevspticket JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewPurchaseVSPTicketCmd(fromAccount string, numTickets, minConf, expiry *int) *PurchaseVSPTicketCmd {
+	return &PurchaseVSPTicketCmd{
+		FromAccount: fromAccount,
+		NumTickets:  numTickets,
+		MinConf:     minConf,
+		Expiry:      expiry,
+	}
+}
+
 // RecoverAddressesCmd defines the recoveraddresses JSON-RPC command.
 //
 // Deprecated: This method is not implemented by the RPC server.
@@ -957,10 +1351,11 @@ func NewSendFromCmd(fromAccount, toAddress string, amount float64, minConf *int,
 
 // SendManyCmd defines the sendmany JSON-RPC command.
 type SendManyCmd struct {
-	FromAccount string
-	Amounts     map[string]float64 `jsonrpcusage:"{\"address\":amount,...}"` // In DCR
-	MinConf     *int               `jsonrpcdefault:"1"`
-	Comment     *string
+	FromAccount        string
+	Amounts            map[string]float64 `jsonrpcusage:"{\"address\":amount,...}"` // In DCR
+	MinConf            *int               `jsonrpcdefault:"1"`
+	Comment            *string
+	SelectionAlgorithm *string
 }
 
 // NewSendManyCmd returns a new instance which can be used to issue a sendmany
@@ -1023,6 +1418,30 @@ func NewSendToMultiSigCmd(fromaccount string, amount float64, pubkeys []string,
 	}
 }
 
+// SetAccountPassphraseCmd defines the setaccountpassphrase JSON-RPC command.
+type SetAccountPassphraseCmd struct {
+	Account    string
+	Passphrase string
+}
+
+// NewSetAccountPassphraseCmd returns a new instance which can be used to issue a
+// setaccountpassphrase JSON-RPC command.
+func NewSetAccountPassphraseCmd(account, passphrase string) *SetAccountPassphraseCmd {
+	return &SetAccountPassphraseCmd{Account: account, Passphrase: passphrase}
+}
+
+// SetLabelCmd defines the setlabel JSON-RPC command.
+type SetLabelCmd struct {
+	Target string
+	Label  string
+}
+
+// NewSetLabelCmd returns a new instance which can be used to issue a setlabel
+// JSON-RPC command.
+func NewSetLabelCmd(target, label string) *SetLabelCmd {
+	return &SetLabelCmd{Target: target, Label: label}
+}
+
 // SetTxFeeCmd defines the settxfee JSON-RPC command.
 type SetTxFeeCmd struct {
 	Amount float64 // In DCR
@@ -1138,6 +1557,15 @@ func NewStakePoolUserInfoCmd(user string) *StakePoolUserInfoCmd {
 	}
 }
 
+// StakeRewardEstimateCmd defines the stakerewardestimate JSON-RPC command.
+type StakeRewardEstimateCmd struct{}
+
+// NewStakeRewardEstimateCmd returns a new instance which can be used to issue
+// a stakerewardestimate JSON-RPC command.
+func NewStakeRewardEstimateCmd() *StakeRewardEstimateCmd {
+	return &StakeRewardEstimateCmd{}
+}
+
 // StartAutoBuyerCmd is a type handling custom marshaling and
 // unmarshaling of startautobuyer JSON RPC commands.
 //
@@ -1206,6 +1634,28 @@ func NewSweepAccountCmd(sourceAccount string, destinationAddress string, require
 	}
 }
 
+// TicketReportCmd defines the ticketreport JSON-RPC command.
+type TicketReportCmd struct {
+	Report  *string `jsonrpcdefault:"\"tickets\""`
+	Format  *string `jsonrpcdefault:"\"json\""`
+	Account *string
+	Period  *string `jsonrpcdefault:"\"month\""`
+}
+
+// NewTicketReportCmd returns a new instance which can be used to issue a
+// ticketreport JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewTicketReportCmd(report, format, account, period *string) *TicketReportCmd {
+	return &TicketReportCmd{
+		Report:  report,
+		Format:  format,
+		Account: account,
+		Period:  period,
+	}
+}
+
 // VerifySeedCmd defines the verifyseed JSON-RPC command.
 type VerifySeedCmd struct {
 	Seed    string
@@ -1234,6 +1684,26 @@ func NewWalletInfoCmd() *WalletInfoCmd {
 	return &WalletInfoCmd{}
 }
 
+// WalletProcessPSBTCmd defines the walletprocesspsbt JSON-RPC command.
+type WalletProcessPSBTCmd struct {
+	PSBT        string
+	Sign        *bool   `jsonrpcdefault:"true"`
+	SigHashType *string `jsonrpcdefault:"\"ALL\""`
+}
+
+// NewWalletProcessPSBTCmd returns a new instance which can be used to issue a
+// walletprocesspsbt JSON-RPC command.
+//
+// The parameters which are pointers indicate they are optional.  Passing nil
+// for optional parameters will use the default value.
+func NewWalletProcessPSBTCmd(psbt string, sign *bool, sigHashType *string) *WalletProcessPSBTCmd {
+	return &WalletProcessPSBTCmd{
+		PSBT:        psbt,
+		Sign:        sign,
+		SigHashType: sigHashType,
+	}
+}
+
 // WalletIsLockedCmd defines the walletislocked JSON-RPC command.
 type WalletIsLockedCmd struct{}
 
@@ -1243,8 +1713,11 @@ func NewWalletIsLockedCmd() *WalletIsLockedCmd {
 	return &WalletIsLockedCmd{}
 }
 
-// WalletLockCmd defines the walletlock JSON-RPC command.
-type WalletLockCmd struct{}
+// WalletLockCmd defines the walletlock JSON-RPC command.  The optional
+// account limits locking to an account encrypted by its own passphrase.
+type WalletLockCmd struct {
+	Account *string
+}
 
 // NewWalletLockCmd returns a new instance which can be used to issue a
 // walletlock JSON-RPC command.
@@ -1252,10 +1725,13 @@ func NewWalletLockCmd() *WalletLockCmd {
 	return &WalletLockCmd{}
 }
 
-// WalletPassphraseCmd defines the walletpassphrase JSON-RPC command.
+// WalletPassphraseCmd defines the walletpassphrase JSON-RPC command.  The
+// optional account selects an account encrypted by its own passphrase to
+// unlock instead of the wallet.
 type WalletPassphraseCmd struct {
 	Passphrase string
 	Timeout    int64
+	Account    *string
 }
 
 // NewWalletPassphraseCmd returns a new instance which can be used to issue a
@@ -1306,19 +1782,34 @@ func init() {
 		{"addmultisigaddress", (*AddMultisigAddressCmd)(nil)},
 		{"addticket", (*AddTicketCmd)(nil)},
 		{"auditreuse", (*AuditReuseCmd)(nil)},
+		{"backupwallet", (*BackupWalletCmd)(nil)},
+		{"bakemacaroon", (*BakeMacaroonCmd)(nil)},
+		{"bumpfee", (*BumpFeeCmd)(nil)},
+		{"combinepsbt", (*CombinePSBTCmd)(nil)},
 		{"consolidate", (*ConsolidateCmd)(nil)},
+		{"cpfp", (*CPFPCmd)(nil)},
+		{"createinvoice", (*CreateInvoiceCmd)(nil)},
 		{"createmultisig", (*CreateMultisigCmd)(nil)},
+		{"createmultisigaccount", (*CreateMultisigAccountCmd)(nil)},
+		{"createmultisigaccounttx", (*CreateMultisigAccountTxCmd)(nil)},
 		{"createnewaccount", (*CreateNewAccountCmd)(nil)},
+		{"createpsbt", (*CreatePSBTCmd)(nil)},
 		{"createvotingaccount", (*CreateVotingAccountCmd)(nil)},
 		{"dropvotingaccount", (*DropVotingAccountCmd)(nil)},
 		{"dumpprivkey", (*DumpPrivKeyCmd)(nil)},
+		{"dumpwallet", (*DumpWalletCmd)(nil)},
+		{"exporthistory", (*ExportHistoryCmd)(nil)},
+		{"finalizepsbt", (*FinalizePSBTCmd)(nil)},
 		{"fundrawtransaction", (*FundRawTransactionCmd)(nil)},
 		{"generatevote", (*GenerateVoteCmd)(nil)},
 		{"getaccount", (*GetAccountCmd)(nil)},
 		{"getaccountaddress", (*GetAccountAddressCmd)(nil)},
 		{"getaddressesbyaccount", (*GetAddressesByAccountCmd)(nil)},
+		{"getauditlog", (*GetAuditLogCmd)(nil)},
 		{"getbalance", (*GetBalanceCmd)(nil)},
 		{"getcontracthash", (*GetContractHashCmd)(nil)},
+		{"getinvoice", (*GetInvoiceCmd)(nil)},
+		{"getlabel", (*GetLabelCmd)(nil)},
 		{"getmasterpubkey", (*GetMasterPubkeyCmd)(nil)},
 		{"getmultisigoutinfo", (*GetMultisigOutInfoCmd)(nil)},
 		{"getnewaddress", (*GetNewAddressCmd)(nil)},
@@ -1333,12 +1824,17 @@ func init() {
 		{"getunconfirmedbalance", (*GetUnconfirmedBalanceCmd)(nil)},
 		{"getvotechoices", (*GetVoteChoicesCmd)(nil)},
 		{"getwalletfee", (*GetWalletFeeCmd)(nil)},
+		{"importdescriptoraccount", (*ImportDescriptorAccountCmd)(nil)},
 		{"importprivkey", (*ImportPrivKeyCmd)(nil)},
 		{"importscript", (*ImportScriptCmd)(nil)},
+		{"importwallet", (*ImportWalletCmd)(nil)},
 		{"importxpub", (*ImportXpubCmd)(nil)},
 		{"listaccounts", (*ListAccountsCmd)(nil)},
 		{"listaddresstransactions", (*ListAddressTransactionsCmd)(nil)},
 		{"listalltransactions", (*ListAllTransactionsCmd)(nil)},
+		{"listdescriptoraccounts", (*ListDescriptorAccountsCmd)(nil)},
+		{"listinvoices", (*ListInvoicesCmd)(nil)},
+		{"listlabels", (*ListLabelsCmd)(nil)},
 		{"listlockunspent", (*ListLockUnspentCmd)(nil)},
 		{"listreceivedbyaccount", (*ListReceivedByAccountCmd)(nil)},
 		{"listreceivedbyaddress", (*ListReceivedByAddressCmd)(nil)},
@@ -1347,10 +1843,13 @@ func init() {
 		{"listtickets", (*ListTicketsCmd)(nil)},
 		{"listtransactions", (*ListTransactionsCmd)(nil)},
 		{"listunspent", (*ListUnspentCmd)(nil)},
+		{"listvsptickets", (*ListVSPTicketsCmd)(nil)},
 		{"lockunspent", (*LockUnspentCmd)(nil)},
 		{"mixoutput", (*MixOutputCmd)(nil)},
 		{"mixaccount", (*MixAccountCmd)(nil)},
+		{"parsepaymenturi", (*ParsePaymentURICmd)(nil)},
 		{"purchaseticket", (*PurchaseTicketCmd)(nil)},
+		{"purchasevspticket", (*PurchaseVSPTicketCmd)(nil)},
 		{"redeemmultisigout", (*RedeemMultiSigOutCmd)(nil)},
 		{"redeemmultisigouts", (*RedeemMultiSigOutsCmd)(nil)},
 		{"renameaccount", (*RenameAccountCmd)(nil)},
@@ -1360,6 +1859,8 @@ func init() {
 		{"sendmany", (*SendManyCmd)(nil)},
 		{"sendtoaddress", (*SendToAddressCmd)(nil)},
 		{"sendtomultisig", (*SendToMultiSigCmd)(nil)},
+		{"setaccountpassphrase", (*SetAccountPassphraseCmd)(nil)},
+		{"setlabel", (*SetLabelCmd)(nil)},
 		{"settxfee", (*SetTxFeeCmd)(nil)},
 		{"setticketfee", (*SetTicketFeeCmd)(nil)},
 		{"setvotechoice", (*SetVoteChoiceCmd)(nil)},
@@ -1367,13 +1868,16 @@ func init() {
 		{"signrawtransaction", (*SignRawTransactionCmd)(nil)},
 		{"signrawtransactions", (*SignRawTransactionsCmd)(nil)},
 		{"stakepooluserinfo", (*StakePoolUserInfoCmd)(nil)},
+		{"stakerewardestimate", (*StakeRewardEstimateCmd)(nil)},
 		{"sweepaccount", (*SweepAccountCmd)(nil)},
+		{"ticketreport", (*TicketReportCmd)(nil)},
 		{"verifyseed", (*VerifySeedCmd)(nil)},
 		{"walletinfo", (*WalletInfoCmd)(nil)},
 		{"walletislocked", (*WalletIsLockedCmd)(nil)},
 		{"walletlock", (*WalletLockCmd)(nil)},
 		{"walletpassphrase", (*WalletPassphraseCmd)(nil)},
 		{"walletpassphrasechange", (*WalletPassphraseChangeCmd)(nil)},
+		{"walletprocesspsbt", (*WalletProcessPSBTCmd)(nil)},
 	}
 	for i := range register {
 		dcrjson.MustRegister(register[i].method, register[i].cmd, dcrjsonv2WalletOnly)
@@ -1384,6 +1888,8 @@ func init() {
 	register = []registeredMethod{
 		{"authenticate", (*dcrdtypes.AuthenticateCmd)(nil)},
 		{"createrawtransaction", (*dcrdtypes.CreateRawTransactionCmd)(nil)},
+		{"estimatesmartfee", (*dcrdtypes.EstimateSmartFeeCmd)(nil)},
+		{"estimatestakediff", (*dcrdtypes.EstimateStakeDiffCmd)(nil)},
 		{"getbestblock", (*dcrdtypes.GetBestBlockCmd)(nil)},
 		{"getbestblockhash", (*dcrdtypes.GetBestBlockHashCmd)(nil)},
 		{"getblockcount", (*dcrdtypes.GetBlockCountCmd)(nil)},
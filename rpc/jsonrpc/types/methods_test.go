@@ -63,6 +63,119 @@ func TestWalletSvrCmds(t *testing.T) {
 				Account:   dcrjson.String("test"),
 			},
 		},
+		{
+			name: "backupwallet",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("backupwallet", "/backups/wallet.db")
+			},
+			staticCmd: func() interface{} {
+				return NewBackupWalletCmd("/backups/wallet.db")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"backupwallet","params":["/backups/wallet.db"],"id":1}`,
+			unmarshalled: &BackupWalletCmd{
+				Destination: "/backups/wallet.db",
+			},
+		},
+		{
+			name: "bakemacaroon",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("bakemacaroon")
+			},
+			staticCmd: func() interface{} {
+				return NewBakeMacaroonCmd(nil, nil, nil, nil, nil)
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"bakemacaroon","params":[],"id":1}`,
+			unmarshalled: &BakeMacaroonCmd{},
+		},
+		{
+			name: "bakemacaroon optional",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("bakemacaroon", []string{"getnewaddress"}, "invoice", 3600, "payments", 0.5)
+			},
+			staticCmd: func() interface{} {
+				return NewBakeMacaroonCmd(&[]string{"getnewaddress"}, dcrjson.String("invoice"),
+					dcrjson.Int64(3600), dcrjson.String("payments"), dcrjson.Float64(0.5))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"bakemacaroon","params":[["getnewaddress"],"invoice",3600,"payments",0.5],"id":1}`,
+			unmarshalled: &BakeMacaroonCmd{
+				Methods:  &[]string{"getnewaddress"},
+				Role:     dcrjson.String("invoice"),
+				Expiry:   dcrjson.Int64(3600),
+				Account:  dcrjson.String("payments"),
+				MaxSpend: dcrjson.Float64(0.5),
+			},
+		},
+		{
+			name: "bumpfee",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("bumpfee", "123")
+			},
+			staticCmd: func() interface{} {
+				return NewBumpFeeCmd("123", nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"bumpfee","params":["123"],"id":1}`,
+			unmarshalled: &BumpFeeCmd{
+				TxHash: "123",
+			},
+		},
+		{
+			name: "bumpfee optional",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("bumpfee", "123", 0.0002)
+			},
+			staticCmd: func() interface{} {
+				return NewBumpFeeCmd("123", dcrjson.Float64(0.0002))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"bumpfee","params":["123",0.0002],"id":1}`,
+			unmarshalled: &BumpFeeCmd{
+				TxHash:  "123",
+				FeeRate: dcrjson.Float64(0.0002),
+			},
+		},
+		{
+			name: "cpfp",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("cpfp", "123")
+			},
+			staticCmd: func() interface{} {
+				return NewCPFPCmd("123", nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"cpfp","params":["123"],"id":1}`,
+			unmarshalled: &CPFPCmd{
+				TxHash: "123",
+			},
+		},
+		{
+			name: "cpfp optional",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("cpfp", "123", 0.0002, "acct")
+			},
+			staticCmd: func() interface{} {
+				return NewCPFPCmd("123", dcrjson.Float64(0.0002), dcrjson.String("acct"))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"cpfp","params":["123",0.0002,"acct"],"id":1}`,
+			unmarshalled: &CPFPCmd{
+				TxHash:  "123",
+				FeeRate: dcrjson.Float64(0.0002),
+				Account: dcrjson.String("acct"),
+			},
+		},
+		{
+			name: "createinvoice",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("createinvoice", "default", 1.5, "order 7", 3600)
+			},
+			staticCmd: func() interface{} {
+				return NewCreateInvoiceCmd("default", 1.5, dcrjson.String("order 7"), dcrjson.Int64(3600), nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"createinvoice","params":["default",1.5,"order 7",3600],"id":1}`,
+			unmarshalled: &CreateInvoiceCmd{
+				Account: "default",
+				Amount:  1.5,
+				Memo:    dcrjson.String("order 7"),
+				Expiry:  dcrjson.Int64(3600),
+			},
+		},
 		{
 			name: "createmultisig",
 			newCmd: func() (interface{}, error) {
@@ -78,6 +191,37 @@ func TestWalletSvrCmds(t *testing.T) {
 				Keys:      []string{"031234", "035678"},
 			},
 		},
+		{
+			name: "createmultisigaccount",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("createmultisigaccount", "vault", 2, []string{"xpubA", "xpubB"})
+			},
+			staticCmd: func() interface{} {
+				return NewCreateMultisigAccountCmd("vault", 2, []string{"xpubA", "xpubB"})
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"createmultisigaccount","params":["vault",2,["xpubA","xpubB"]],"id":1}`,
+			unmarshalled: &CreateMultisigAccountCmd{
+				Name:      "vault",
+				NRequired: 2,
+				XPubs:     []string{"xpubA", "xpubB"},
+			},
+		},
+		{
+			name: "createmultisigaccounttx",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("createmultisigaccounttx", "vault", `{"1Address":0.5}`)
+			},
+			staticCmd: func() interface{} {
+				amounts := map[string]float64{"1Address": 0.5}
+				return NewCreateMultisigAccountTxCmd("vault", amounts, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"createmultisigaccounttx","params":["vault",{"1Address":0.5}],"id":1}`,
+			unmarshalled: &CreateMultisigAccountTxCmd{
+				Account: "vault",
+				Amounts: map[string]float64{"1Address": 0.5},
+				MinConf: dcrjson.Int(1),
+			},
+		},
 		{
 			name: "createnewaccount",
 			newCmd: func() (interface{}, error) {
@@ -104,6 +248,19 @@ func TestWalletSvrCmds(t *testing.T) {
 				Address: "1Address",
 			},
 		},
+		{
+			name: "dumpwallet",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("dumpwallet", "export.json")
+			},
+			staticCmd: func() interface{} {
+				return NewDumpWalletCmd("export.json")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"dumpwallet","params":["export.json"],"id":1}`,
+			unmarshalled: &DumpWalletCmd{
+				Filename: "export.json",
+			},
+		},
 		{
 			name: "estimatepriority",
 			newCmd: func() (interface{}, error) {
@@ -117,6 +274,42 @@ func TestWalletSvrCmds(t *testing.T) {
 				NumBlocks: 6,
 			},
 		},
+		{
+			name: "exporthistory",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("exporthistory")
+			},
+			staticCmd: func() interface{} {
+				return NewExportHistoryCmd(nil, nil, nil, nil, nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"exporthistory","params":[],"id":1}`,
+			unmarshalled: &ExportHistoryCmd{
+				Format:      dcrjson.String("csv"),
+				StartHeight: dcrjson.Int(0),
+				EndHeight:   dcrjson.Int(-1),
+				Labels:      dcrjson.Bool(false),
+				Balances:    dcrjson.Bool(false),
+			},
+		},
+		{
+			name: "exporthistory optional",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("exporthistory", "json", 100, 200, "default", true, true)
+			},
+			staticCmd: func() interface{} {
+				return NewExportHistoryCmd(dcrjson.String("json"), dcrjson.Int(100), dcrjson.Int(200),
+					dcrjson.String("default"), dcrjson.Bool(true), dcrjson.Bool(true))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"exporthistory","params":["json",100,200,"default",true,true],"id":1}`,
+			unmarshalled: &ExportHistoryCmd{
+				Format:      dcrjson.String("json"),
+				StartHeight: dcrjson.Int(100),
+				EndHeight:   dcrjson.Int(200),
+				Account:     dcrjson.String("default"),
+				Labels:      dcrjson.Bool(true),
+				Balances:    dcrjson.Bool(true),
+			},
+		},
 		{
 			name: "getaccount",
 			newCmd: func() (interface{}, error) {
@@ -156,6 +349,34 @@ func TestWalletSvrCmds(t *testing.T) {
 				Account: "acct",
 			},
 		},
+		{
+			name: "getauditlog",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("getauditlog")
+			},
+			staticCmd: func() interface{} {
+				return NewGetAuditLogCmd(nil, nil, nil, nil)
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"getauditlog","params":[],"id":1}`,
+			unmarshalled: &GetAuditLogCmd{},
+		},
+		{
+			name: "getauditlog optional",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("getauditlog", "dumpprivkey", "jsonrpc:user@127.0.0.1:5000", 1560000000, 10)
+			},
+			staticCmd: func() interface{} {
+				return NewGetAuditLogCmd(dcrjson.String("dumpprivkey"), dcrjson.String("jsonrpc:user@127.0.0.1:5000"),
+					dcrjson.Int64(1560000000), dcrjson.Int(10))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getauditlog","params":["dumpprivkey","jsonrpc:user@127.0.0.1:5000",1560000000,10],"id":1}`,
+			unmarshalled: &GetAuditLogCmd{
+				Operation: dcrjson.String("dumpprivkey"),
+				Caller:    dcrjson.String("jsonrpc:user@127.0.0.1:5000"),
+				Since:     dcrjson.Int64(1560000000),
+				Count:     dcrjson.Int(10),
+			},
+		},
 		{
 			name: "getbalance",
 			newCmd: func() (interface{}, error) {
@@ -198,6 +419,33 @@ func TestWalletSvrCmds(t *testing.T) {
 				MinConf: dcrjson.Int(6),
 			},
 		},
+		{
+			name: "getinvoice",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("getinvoice", 3)
+			},
+			staticCmd: func() interface{} {
+				return NewGetInvoiceCmd(3, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getinvoice","params":[3],"id":1}`,
+			unmarshalled: &GetInvoiceCmd{
+				ID:      3,
+				MinConf: dcrjson.Int(1),
+			},
+		},
+		{
+			name: "getlabel",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("getlabel", "123:1")
+			},
+			staticCmd: func() interface{} {
+				return NewGetLabelCmd("123:1")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"getlabel","params":["123:1"],"id":1}`,
+			unmarshalled: &GetLabelCmd{
+				Target: "123:1",
+			},
+		},
 		{
 			name: "getnewaddress",
 			newCmd: func() (interface{}, error) {
@@ -336,6 +584,20 @@ func TestWalletSvrCmds(t *testing.T) {
 				IncludeWatchOnly: dcrjson.Bool(true),
 			},
 		},
+		{
+			name: "importdescriptoraccount",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("importdescriptoraccount", "vault", "pkh(xpub/*)")
+			},
+			staticCmd: func() interface{} {
+				return NewImportDescriptorAccountCmd("vault", "pkh(xpub/*)")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"importdescriptoraccount","params":["vault","pkh(xpub/*)"],"id":1}`,
+			unmarshalled: &ImportDescriptorAccountCmd{
+				Name:       "vault",
+				Descriptor: "pkh(xpub/*)",
+			},
+		},
 		{
 			name: "importprivkey",
 			newCmd: func() (interface{}, error) {
@@ -397,6 +659,35 @@ func TestWalletSvrCmds(t *testing.T) {
 				ScanFrom: dcrjson.Int(12345),
 			},
 		},
+		{
+			name: "importwallet",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("importwallet", "export.json")
+			},
+			staticCmd: func() interface{} {
+				return NewImportWalletCmd("export.json", nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"importwallet","params":["export.json"],"id":1}`,
+			unmarshalled: &ImportWalletCmd{
+				Filename: "export.json",
+				Rescan:   dcrjson.Bool(true),
+			},
+		},
+		{
+			name: "importwallet optional",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("importwallet", "export.json", false, 1000)
+			},
+			staticCmd: func() interface{} {
+				return NewImportWalletCmd("export.json", dcrjson.Bool(false), dcrjson.Int(1000))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"importwallet","params":["export.json",false,1000],"id":1}`,
+			unmarshalled: &ImportWalletCmd{
+				Filename: "export.json",
+				Rescan:   dcrjson.Bool(false),
+				ScanFrom: dcrjson.Int(1000),
+			},
+		},
 		{
 			name: "keypoolrefill",
 			newCmd: func() (interface{}, error) {
@@ -449,6 +740,66 @@ func TestWalletSvrCmds(t *testing.T) {
 				MinConf: dcrjson.Int(6),
 			},
 		},
+		{
+			name: "listdescriptoraccounts",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("listdescriptoraccounts")
+			},
+			staticCmd: func() interface{} {
+				return NewListDescriptorAccountsCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"listdescriptoraccounts","params":[],"id":1}`,
+			unmarshalled: &ListDescriptorAccountsCmd{},
+		},
+		{
+			name: "listinvoices",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("listinvoices", "paid", 6)
+			},
+			staticCmd: func() interface{} {
+				return NewListInvoicesCmd(dcrjson.String("paid"), dcrjson.Int(6))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"listinvoices","params":["paid",6],"id":1}`,
+			unmarshalled: &ListInvoicesCmd{
+				Status:  dcrjson.String("paid"),
+				MinConf: dcrjson.Int(6),
+			},
+		},
+		{
+			name: "listvsptickets",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("listvsptickets")
+			},
+			staticCmd: func() interface{} {
+				return NewListVSPTicketsCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"listvsptickets","params":[],"id":1}`,
+			unmarshalled: &ListVSPTicketsCmd{},
+		},
+		{
+			name: "listlabels",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("listlabels")
+			},
+			staticCmd: func() interface{} {
+				return NewListLabelsCmd(nil)
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"listlabels","params":[],"id":1}`,
+			unmarshalled: &ListLabelsCmd{},
+		},
+		{
+			name: "listlabels optional",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("listlabels", "address")
+			},
+			staticCmd: func() interface{} {
+				return NewListLabelsCmd(dcrjson.String("address"))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"listlabels","params":["address"],"id":1}`,
+			unmarshalled: &ListLabelsCmd{
+				Kind: dcrjson.String("address"),
+			},
+		},
 		{
 			name: "listlockunspent",
 			newCmd: func() (interface{}, error) {
@@ -802,6 +1153,50 @@ func TestWalletSvrCmds(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "parsepaymenturi",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("parsepaymenturi", "decred:DsAddress?amount=1")
+			},
+			staticCmd: func() interface{} {
+				return NewParsePaymentURICmd("decred:DsAddress?amount=1")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"parsepaymenturi","params":["decred:DsAddress?amount=1"],"id":1}`,
+			unmarshalled: &ParsePaymentURICmd{
+				URI: "decred:DsAddress?amount=1",
+			},
+		},
+		{
+			name: "purchasevspticket",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("purchasevspticket", "default")
+			},
+			staticCmd: func() interface{} {
+				return NewPurchaseVSPTicketCmd("default", nil, nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"purchasevspticket","params":["default"],"id":1}`,
+			unmarshalled: &PurchaseVSPTicketCmd{
+				FromAccount: "default",
+				NumTickets:  dcrjson.Int(1),
+				MinConf:     dcrjson.Int(1),
+			},
+		},
+		{
+			name: "purchasevspticket optional",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("purchasevspticket", "default", 5, 2, 100)
+			},
+			staticCmd: func() interface{} {
+				return NewPurchaseVSPTicketCmd("default", dcrjson.Int(5), dcrjson.Int(2), dcrjson.Int(100))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"purchasevspticket","params":["default",5,2,100],"id":1}`,
+			unmarshalled: &PurchaseVSPTicketCmd{
+				FromAccount: "default",
+				NumTickets:  dcrjson.Int(5),
+				MinConf:     dcrjson.Int(2),
+				Expiry:      dcrjson.Int(100),
+			},
+		},
 		{
 			name: "renameaccount",
 			newCmd: func() (interface{}, error) {
@@ -941,6 +1336,26 @@ func TestWalletSvrCmds(t *testing.T) {
 				Comment:     dcrjson.String("comment"),
 			},
 		},
+		{
+			name: "sendmany optional3",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("sendmany", "from", `{"1Address":0.5}`, 6, "", "branchandbound")
+			},
+			staticCmd: func() interface{} {
+				amounts := map[string]float64{"1Address": 0.5}
+				cmd := NewSendManyCmd("from", amounts, dcrjson.Int(6), dcrjson.String(""))
+				cmd.SelectionAlgorithm = dcrjson.String("branchandbound")
+				return cmd
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"sendmany","params":["from",{"1Address":0.5},6,"","branchandbound"],"id":1}`,
+			unmarshalled: &SendManyCmd{
+				FromAccount:        "from",
+				Amounts:            map[string]float64{"1Address": 0.5},
+				MinConf:            dcrjson.Int(6),
+				Comment:            dcrjson.String(""),
+				SelectionAlgorithm: dcrjson.String("branchandbound"),
+			},
+		},
 		{
 			name: "sendtoaddress",
 			newCmd: func() (interface{}, error) {
@@ -974,6 +1389,34 @@ func TestWalletSvrCmds(t *testing.T) {
 				CommentTo: dcrjson.String("commentto"),
 			},
 		},
+		{
+			name: "setaccountpassphrase",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("setaccountpassphrase", "treasury", "pass")
+			},
+			staticCmd: func() interface{} {
+				return NewSetAccountPassphraseCmd("treasury", "pass")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"setaccountpassphrase","params":["treasury","pass"],"id":1}`,
+			unmarshalled: &SetAccountPassphraseCmd{
+				Account:    "treasury",
+				Passphrase: "pass",
+			},
+		},
+		{
+			name: "setlabel",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("setlabel", "123", "rent")
+			},
+			staticCmd: func() interface{} {
+				return NewSetLabelCmd("123", "rent")
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"setlabel","params":["123","rent"],"id":1}`,
+			unmarshalled: &SetLabelCmd{
+				Target: "123",
+				Label:  "rent",
+			},
+		},
 		{
 			name: "settxfee",
 			newCmd: func() (interface{}, error) {
@@ -1086,6 +1529,17 @@ func TestWalletSvrCmds(t *testing.T) {
 				Flags:    dcrjson.String("ALL"),
 			},
 		},
+		{
+			name: "stakerewardestimate",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("stakerewardestimate")
+			},
+			staticCmd: func() interface{} {
+				return NewStakeRewardEstimateCmd()
+			},
+			marshalled:   `{"jsonrpc":"1.0","method":"stakerewardestimate","params":[],"id":1}`,
+			unmarshalled: &StakeRewardEstimateCmd{},
+		},
 		{
 			name: "sweepaccount - optionals provided",
 			newCmd: func() (interface{}, error) {
@@ -1118,6 +1572,38 @@ func TestWalletSvrCmds(t *testing.T) {
 				DestinationAddress: "DsUZxxoHJSty8DCfwfartwTYbuhmVct7tJu",
 			},
 		},
+		{
+			name: "ticketreport",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("ticketreport")
+			},
+			staticCmd: func() interface{} {
+				return NewTicketReportCmd(nil, nil, nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"ticketreport","params":[],"id":1}`,
+			unmarshalled: &TicketReportCmd{
+				Report: dcrjson.String("tickets"),
+				Format: dcrjson.String("json"),
+				Period: dcrjson.String("month"),
+			},
+		},
+		{
+			name: "ticketreport optional",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("ticketreport", "summary", "csv", "default", "week")
+			},
+			staticCmd: func() interface{} {
+				return NewTicketReportCmd(dcrjson.String("summary"), dcrjson.String("csv"),
+					dcrjson.String("default"), dcrjson.String("week"))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"ticketreport","params":["summary","csv","default","week"],"id":1}`,
+			unmarshalled: &TicketReportCmd{
+				Report:  dcrjson.String("summary"),
+				Format:  dcrjson.String("csv"),
+				Account: dcrjson.String("default"),
+				Period:  dcrjson.String("week"),
+			},
+		},
 		{
 			name: "verifyseed",
 			newCmd: func() (interface{}, error) {
@@ -1158,6 +1644,19 @@ func TestWalletSvrCmds(t *testing.T) {
 			marshalled:   `{"jsonrpc":"1.0","method":"walletlock","params":[],"id":1}`,
 			unmarshalled: &WalletLockCmd{},
 		},
+		{
+			name: "walletlock optional",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("walletlock", "treasury")
+			},
+			staticCmd: func() interface{} {
+				return &WalletLockCmd{Account: dcrjson.String("treasury")}
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"walletlock","params":["treasury"],"id":1}`,
+			unmarshalled: &WalletLockCmd{
+				Account: dcrjson.String("treasury"),
+			},
+		},
 		{
 			name: "walletpassphrase",
 			newCmd: func() (interface{}, error) {
@@ -1172,6 +1671,23 @@ func TestWalletSvrCmds(t *testing.T) {
 				Timeout:    60,
 			},
 		},
+		{
+			name: "walletpassphrase optional",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("walletpassphrase", "pass", 60, "treasury")
+			},
+			staticCmd: func() interface{} {
+				cmd := NewWalletPassphraseCmd("pass", 60)
+				cmd.Account = dcrjson.String("treasury")
+				return cmd
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"walletpassphrase","params":["pass",60,"treasury"],"id":1}`,
+			unmarshalled: &WalletPassphraseCmd{
+				Passphrase: "pass",
+				Timeout:    60,
+				Account:    dcrjson.String("treasury"),
+			},
+		},
 		{
 			name: "walletpassphrasechange",
 			newCmd: func() (interface{}, error) {
@@ -1186,6 +1702,37 @@ func TestWalletSvrCmds(t *testing.T) {
 				NewPassphrase: "new",
 			},
 		},
+		{
+			name: "walletprocesspsbt",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("walletprocesspsbt", "cHNidP8=")
+			},
+			staticCmd: func() interface{} {
+				return NewWalletProcessPSBTCmd("cHNidP8=", nil, nil)
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"walletprocesspsbt","params":["cHNidP8="],"id":1}`,
+			unmarshalled: &WalletProcessPSBTCmd{
+				PSBT:        "cHNidP8=",
+				Sign:        dcrjson.Bool(true),
+				SigHashType: dcrjson.String("ALL"),
+			},
+		},
+		{
+			name: "walletprocesspsbt optional",
+			newCmd: func() (interface{}, error) {
+				return dcrjson.NewCmd("walletprocesspsbt", "cHNidP8=", false, "SINGLE")
+			},
+			staticCmd: func() interface{} {
+				return NewWalletProcessPSBTCmd("cHNidP8=", dcrjson.Bool(false),
+					dcrjson.String("SINGLE"))
+			},
+			marshalled: `{"jsonrpc":"1.0","method":"walletprocesspsbt","params":["cHNidP8=",false,"SINGLE"],"id":1}`,
+			unmarshalled: &WalletProcessPSBTCmd{
+				PSBT:        "cHNidP8=",
+				Sign:        dcrjson.Bool(false),
+				SigHashType: dcrjson.String("SINGLE"),
+			},
+		},
 	}
 
 	t.Logf("Running %d tests", len(tests))
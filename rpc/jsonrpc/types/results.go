@@ -5,6 +5,73 @@
 
 package types
 
+// BakeMacaroonResult models the data returned from the bakemacaroon command.
+type BakeMacaroonResult struct {
+	Macaroon string   `json:"macaroon"`
+	ID       string   `json:"id"`
+	Caveats  []string `json:"caveats"`
+}
+
+// BumpFeeResult models the data returned from the bumpfee command.
+type BumpFeeResult struct {
+	TxID    string  `json:"txid"`
+	OrigFee float64 `json:"origfee"`
+	Fee     float64 `json:"fee"`
+}
+
+// CPFPResult models the data returned from the cpfp command.
+type CPFPResult struct {
+	TxID      string  `json:"txid"`
+	ParentFee float64 `json:"parentfee"`
+	Fee       float64 `json:"fee"`
+	Warning   string  `json:"warning,omitempty"`
+}
+
+// DumpWalletResult models the data returned from the dumpwallet command.
+type DumpWalletResult struct {
+	Filename string `json:"filename"`
+}
+
+// ExportHistoryResult models an entry of the exporthistory result in the json
+// format.
+type ExportHistoryResult struct {
+	Time        int64   `json:"time"`
+	BlockHeight int32   `json:"blockheight"`
+	BlockHash   string  `json:"blockhash"`
+	TxID        string  `json:"txid"`
+	Type        string  `json:"type"`
+	Category    string  `json:"category"`
+	Account     string  `json:"account"`
+	Debit       float64 `json:"debit"`
+	Credit      float64 `json:"credit"`
+	Fee         float64 `json:"fee"`
+	Net         float64 `json:"net"`
+	Balance     float64 `json:"balance,omitempty"`
+	Label       string  `json:"label,omitempty"`
+}
+
+// FinalizePSBTResult models the data returned from the finalizepsbt command.
+type FinalizePSBTResult struct {
+	PSBT     string `json:"psbt,omitempty"`
+	Hex      string `json:"hex,omitempty"`
+	Complete bool   `json:"complete"`
+}
+
+// CreateMultisigAccountResult models the data returned from the
+// createmultisigaccount command.
+type CreateMultisigAccountResult struct {
+	Account    uint32 `json:"account"`
+	Descriptor string `json:"descriptor"`
+}
+
+// CreateMultisigAccountTxResult models the data returned from the
+// createmultisigaccounttx command.
+type CreateMultisigAccountTxResult struct {
+	Hex  string  `json:"hex"`
+	PSBT string  `json:"psbt"`
+	Fee  float64 `json:"fee"`
+}
+
 // FundRawTransactionResult models the data from the fundrawtransaction command.
 type FundRawTransactionResult struct {
 	Hex string  `json:"hex"`
@@ -28,6 +95,19 @@ type GetAccountBalanceResult struct {
 	VotingAuthority         float64 `json:"votingauthority"`
 }
 
+// GetAuditLogResult models an audit log entry returned by the getauditlog
+// command.
+type GetAuditLogResult struct {
+	Seq       uint64                 `json:"seq"`
+	Time      int64                  `json:"time"`
+	Caller    string                 `json:"caller"`
+	Operation string                 `json:"operation"`
+	Params    map[string]interface{} `json:"params,omitempty"`
+	Error     string                 `json:"error,omitempty"`
+	PrevHash  string                 `json:"prevhash"`
+	Hash      string                 `json:"hash"`
+}
+
 // GetBalanceResult models the data from the getbalance command.
 type GetBalanceResult struct {
 	Balances                     []GetAccountBalanceResult `json:"balances"`
@@ -170,6 +250,49 @@ type InfoResult struct {
 // InfoWalletResult aliases InfoResult.
 type InfoWalletResult = InfoResult
 
+// InvoicePaymentResult models a transaction output paying an invoice.
+type InvoicePaymentResult struct {
+	TxID          string  `json:"txid"`
+	Vout          uint32  `json:"vout"`
+	Amount        float64 `json:"amount"`
+	Confirmations int32   `json:"confirmations"`
+	BlockHeight   int32   `json:"blockheight"`
+}
+
+// InvoiceResult models the data returned from the createinvoice and getinvoice
+// commands and the elements of the listinvoices result.
+type InvoiceResult struct {
+	ID       uint64                 `json:"id"`
+	Account  string                 `json:"account"`
+	Address  string                 `json:"address"`
+	Amount   float64                `json:"amount"`
+	Memo     string                 `json:"memo,omitempty"`
+	Created  int64                  `json:"created"`
+	Expires  int64                  `json:"expires,omitempty"`
+	Received float64                `json:"received"`
+	Pending  float64                `json:"pending"`
+	Status   string                 `json:"status"`
+	Payments []InvoicePaymentResult `json:"payments"`
+	URI      string                 `json:"uri"`
+}
+
+// ListDescriptorAccountsResult models the data returned from the
+// listdescriptoraccounts command.
+type ListDescriptorAccountsResult struct {
+	Account       uint32 `json:"account"`
+	Name          string `json:"name"`
+	Descriptor    string `json:"descriptor"`
+	UsedCount     uint32 `json:"usedcount"`
+	ReturnedCount uint32 `json:"returnedcount"`
+}
+
+// ListLabelsResult models the data returned from the listlabels command.
+type ListLabelsResult struct {
+	Kind   string `json:"kind"`
+	Target string `json:"target"`
+	Label  string `json:"label"`
+}
+
 // ScriptInfo is the structure representing a redeem script, its hash,
 // and its address.
 type ScriptInfo struct {
@@ -261,6 +384,7 @@ type ListTransactionsResult struct {
 	WalletConflicts   []string                `json:"walletconflicts"`
 	Comment           string                  `json:"comment,omitempty"`
 	OtherAccount      string                  `json:"otheraccount,omitempty"`
+	Label             string                  `json:"label,omitempty"`
 }
 
 // ListReceivedByAccountResult models the data from the listreceivedbyaccount
@@ -302,6 +426,32 @@ type ListUnspentResult struct {
 	Amount        float64 `json:"amount"`
 	Confirmations int64   `json:"confirmations"`
 	Spendable     bool    `json:"spendable"`
+	Label         string  `json:"label,omitempty"`
+}
+
+// ListVSPTicketsResult models the fee payment state of a ticket purchased
+// through a VSP returned by the listvsptickets command.
+type ListVSPTicketsResult struct {
+	TicketHash string  `json:"tickethash"`
+	VSP        string  `json:"vsp"`
+	FeeAccount string  `json:"feeaccount"`
+	State      string  `json:"state"`
+	FeeAddress string  `json:"feeaddress,omitempty"`
+	FeeAmount  float64 `json:"feeamount,omitempty"`
+	Expiration int64   `json:"expiration,omitempty"`
+	FeeTxHash  string  `json:"feetxhash,omitempty"`
+	Attempts   uint32  `json:"attempts,omitempty"`
+	LastError  string  `json:"lasterror,omitempty"`
+}
+
+// ParsePaymentURIResult models the data returned from the parsepaymenturi
+// command.
+type ParsePaymentURIResult struct {
+	Address string  `json:"address"`
+	Amount  float64 `json:"amount,omitempty"`
+	Label   string  `json:"label,omitempty"`
+	Message string  `json:"message,omitempty"`
+	Invoice string  `json:"invoice,omitempty"`
 }
 
 // RedeemMultiSigOutResult models the data returned from the redeemmultisigout
@@ -375,6 +525,20 @@ type StakePoolUserInfoResult struct {
 	InvalidTickets []string         `json:"invalid"`
 }
 
+// StakeRewardEstimateResult models the data returned from the
+// stakerewardestimate command.
+type StakeRewardEstimateResult struct {
+	TicketPrice       float64 `json:"ticketprice"`
+	PoolSize          uint32  `json:"poolsize"`
+	VoteProbability   float64 `json:"voteprobability"`
+	ExpiryProbability float64 `json:"expiryprobability"`
+	AverageVoteBlocks float64 `json:"averagevoteblocks"`
+	AverageVoteTime   int64   `json:"averagevotetime"`
+	VoteReward        float64 `json:"votereward"`
+	ROI               float64 `json:"roi"`
+	AnnualROI         float64 `json:"annualroi"`
+}
+
 // SweepAccountResult models the data returned from the sweepaccount
 // command.
 type SweepAccountResult struct {
@@ -384,6 +548,46 @@ type SweepAccountResult struct {
 	EstimatedSignedSize       uint32  `json:"estimatedsignedsize"`
 }
 
+// TicketReportTicketResult models the lifecycle of a ticket returned by the
+// ticketreport command in the json format.
+type TicketReportTicketResult struct {
+	TicketHash     string  `json:"tickethash"`
+	Account        string  `json:"account"`
+	Status         string  `json:"status"`
+	Price          float64 `json:"price"`
+	PurchaseFee    float64 `json:"purchasefee"`
+	Commitment     float64 `json:"commitment"`
+	VSPFee         float64 `json:"vspfee"`
+	PurchaseHeight int32   `json:"purchaseheight"`
+	PurchaseTime   int64   `json:"purchasetime"`
+	MaturityHeight int32   `json:"maturityheight"`
+	SpenderHash    string  `json:"spenderhash,omitempty"`
+	SpenderHeight  int32   `json:"spenderheight,omitempty"`
+	SpenderTime    int64   `json:"spendertime,omitempty"`
+	DaysToVote     float64 `json:"daystovote,omitempty"`
+	Subsidy        float64 `json:"subsidy"`
+	Reward         float64 `json:"reward"`
+	Profit         float64 `json:"profit"`
+}
+
+// TicketReportSummaryResult models the profitability summary of an account
+// in a period returned by the ticketreport command in the json format.
+type TicketReportSummaryResult struct {
+	Account           string  `json:"account"`
+	PeriodStart       int64   `json:"periodstart,omitempty"`
+	Tickets           int     `json:"tickets"`
+	Voted             int     `json:"voted"`
+	Revoked           int     `json:"revoked"`
+	Unspent           int     `json:"unspent"`
+	Invested          float64 `json:"invested"`
+	PurchaseFees      float64 `json:"purchasefees"`
+	VSPFees           float64 `json:"vspfees"`
+	Subsidy           float64 `json:"subsidy"`
+	Rewards           float64 `json:"rewards"`
+	Profit            float64 `json:"profit"`
+	AverageDaysToVote float64 `json:"averagedaystovote"`
+}
+
 // ValidateAddressResult models the data returned by the wallet server
 // validateaddress command.
 type ValidateAddressResult struct {
@@ -425,3 +629,10 @@ type WalletInfoResult struct {
 	VoteVersion      uint32  `json:"voteversion"`
 	Voting           bool    `json:"voting"`
 }
+
+// WalletProcessPSBTResult models the data returned from the walletprocesspsbt
+// command.
+type WalletProcessPSBTResult struct {
+	PSBT     string `json:"psbt"`
+	Complete bool   `json:"complete"`
+}
@@ -249,18 +249,33 @@ func (ChangePassphraseRequest_Key) EnumDescriptor() ([]byte, []int) {
 type ConstructTransactionRequest_OutputSelectionAlgorithm int32
 
 const (
-	ConstructTransactionRequest_UNSPECIFIED ConstructTransactionRequest_OutputSelectionAlgorithm = 0
-	ConstructTransactionRequest_ALL         ConstructTransactionRequest_OutputSelectionAlgorithm = 1
+	ConstructTransactionRequest_UNSPECIFIED      ConstructTransactionRequest_OutputSelectionAlgorithm = 0
+	ConstructTransactionRequest_ALL              ConstructTransactionRequest_OutputSelectionAlgorithm = 1
+	ConstructTransactionRequest_LARGEST_FIRST    ConstructTransactionRequest_OutputSelectionAlgorithm = 2
+	ConstructTransactionRequest_SMALLEST_FIRST   ConstructTransactionRequest_OutputSelectionAlgorithm = 3
+	ConstructTransactionRequest_OLDEST_FIRST     ConstructTransactionRequest_OutputSelectionAlgorithm = 4
+	ConstructTransactionRequest_BRANCH_AND_BOUND ConstructTransactionRequest_OutputSelectionAlgorithm = 5
+	ConstructTransactionRequest_AVOID_MIXING     ConstructTransactionRequest_OutputSelectionAlgorithm = 6
 )
 
 var ConstructTransactionRequest_OutputSelectionAlgorithm_name = map[int32]string{
 	0: "UNSPECIFIED",
 	1: "ALL",
+	2: "LARGEST_FIRST",
+	3: "SMALLEST_FIRST",
+	4: "OLDEST_FIRST",
+	5: "BRANCH_AND_BOUND",
+	6: "AVOID_MIXING",
 }
 
 var ConstructTransactionRequest_OutputSelectionAlgorithm_value = map[string]int32{
-	"UNSPECIFIED": 0,
-	"ALL":         1,
+	"UNSPECIFIED":      0,
+	"ALL":              1,
+	"LARGEST_FIRST":    2,
+	"SMALLEST_FIRST":   3,
+	"OLDEST_FIRST":     4,
+	"BRANCH_AND_BOUND": 5,
+	"AVOID_MIXING":     6,
 }
 
 func (x ConstructTransactionRequest_OutputSelectionAlgorithm) String() string {
@@ -446,6 +461,34 @@ func (ValidateAddressResponse_ScriptType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_00212fb1f9d3bf1c, []int{148, 0}
 }
 
+type LabelTarget_Kind int32
+
+const (
+	LabelTarget_ADDRESS     LabelTarget_Kind = 0
+	LabelTarget_TRANSACTION LabelTarget_Kind = 1
+	LabelTarget_OUTPUT      LabelTarget_Kind = 2
+)
+
+var LabelTarget_Kind_name = map[int32]string{
+	0: "ADDRESS",
+	1: "TRANSACTION",
+	2: "OUTPUT",
+}
+
+var LabelTarget_Kind_value = map[string]int32{
+	"ADDRESS":     0,
+	"TRANSACTION": 1,
+	"OUTPUT":      2,
+}
+
+func (x LabelTarget_Kind) String() string {
+	return proto.EnumName(LabelTarget_Kind_name, int32(x))
+}
+
+func (LabelTarget_Kind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{171, 0}
+}
+
 type VersionRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -564,6 +607,7 @@ type TransactionDetails struct {
 	Fee                  int64                              `protobuf:"varint,5,opt,name=fee,proto3" json:"fee,omitempty"`
 	Timestamp            int64                              `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	TransactionType      TransactionDetails_TransactionType `protobuf:"varint,7,opt,name=transaction_type,json=transactionType,proto3,enum=walletrpc.TransactionDetails_TransactionType" json:"transaction_type,omitempty"`
+	Label                string                             `protobuf:"bytes,8,opt,name=label,proto3" json:"label,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
 	XXX_unrecognized     []byte                             `json:"-"`
 	XXX_sizecache        int32                              `json:"-"`
@@ -643,6 +687,13 @@ func (m *TransactionDetails) GetTransactionType() TransactionDetails_Transaction
 	return TransactionDetails_REGULAR
 }
 
+func (m *TransactionDetails) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
 type TransactionDetails_Input struct {
 	Index                uint32   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
 	PreviousAccount      uint32   `protobuf:"varint,2,opt,name=previous_account,json=previousAccount,proto3" json:"previous_account,omitempty"`
@@ -705,6 +756,7 @@ type TransactionDetails_Output struct {
 	Amount               int64    `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
 	Address              string   `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
 	OutputScript         []byte   `protobuf:"bytes,6,opt,name=output_script,json=outputScript,proto3" json:"output_script,omitempty"`
+	Label                string   `protobuf:"bytes,7,opt,name=label,proto3" json:"label,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -777,6 +829,13 @@ func (m *TransactionDetails_Output) GetOutputScript() []byte {
 	return nil
 }
 
+func (m *TransactionDetails_Output) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
 type BlockDetails struct {
 	Hash                 []byte                `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 	Height               int32                 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
@@ -2998,14 +3057,15 @@ func (m *ChangePassphraseResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_ChangePassphraseResponse proto.InternalMessageInfo
 
 type FundTransactionRequest struct {
-	Account                  uint32   `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
-	TargetAmount             int64    `protobuf:"varint,2,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
-	RequiredConfirmations    int32    `protobuf:"varint,3,opt,name=required_confirmations,json=requiredConfirmations,proto3" json:"required_confirmations,omitempty"`
-	IncludeImmatureCoinbases bool     `protobuf:"varint,4,opt,name=include_immature_coinbases,json=includeImmatureCoinbases,proto3" json:"include_immature_coinbases,omitempty"`
-	IncludeChangeScript      bool     `protobuf:"varint,5,opt,name=include_change_script,json=includeChangeScript,proto3" json:"include_change_script,omitempty"`
-	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
-	XXX_unrecognized         []byte   `json:"-"`
-	XXX_sizecache            int32    `json:"-"`
+	Account                  uint32                                               `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
+	TargetAmount             int64                                                `protobuf:"varint,2,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	RequiredConfirmations    int32                                                `protobuf:"varint,3,opt,name=required_confirmations,json=requiredConfirmations,proto3" json:"required_confirmations,omitempty"`
+	IncludeImmatureCoinbases bool                                                 `protobuf:"varint,4,opt,name=include_immature_coinbases,json=includeImmatureCoinbases,proto3" json:"include_immature_coinbases,omitempty"`
+	IncludeChangeScript      bool                                                 `protobuf:"varint,5,opt,name=include_change_script,json=includeChangeScript,proto3" json:"include_change_script,omitempty"`
+	OutputSelectionAlgorithm ConstructTransactionRequest_OutputSelectionAlgorithm `protobuf:"varint,6,opt,name=output_selection_algorithm,json=outputSelectionAlgorithm,proto3,enum=walletrpc.ConstructTransactionRequest_OutputSelectionAlgorithm" json:"output_selection_algorithm,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{}                                             `json:"-"`
+	XXX_unrecognized         []byte                                               `json:"-"`
+	XXX_sizecache            int32                                                `json:"-"`
 }
 
 func (m *FundTransactionRequest) Reset()         { *m = FundTransactionRequest{} }
@@ -3068,6 +3128,13 @@ func (m *FundTransactionRequest) GetIncludeChangeScript() bool {
 	return false
 }
 
+func (m *FundTransactionRequest) GetOutputSelectionAlgorithm() ConstructTransactionRequest_OutputSelectionAlgorithm {
+	if m != nil {
+		return m.OutputSelectionAlgorithm
+	}
+	return ConstructTransactionRequest_UNSPECIFIED
+}
+
 type FundTransactionResponse struct {
 	SelectedOutputs      []*FundTransactionResponse_PreviousOutput `protobuf:"bytes,1,rep,name=selected_outputs,json=selectedOutputs,proto3" json:"selected_outputs,omitempty"`
 	TotalAmount          int64                                     `protobuf:"varint,2,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
@@ -3377,6 +3444,7 @@ type ConstructTransactionRequest struct {
 	OutputSelectionAlgorithm ConstructTransactionRequest_OutputSelectionAlgorithm `protobuf:"varint,4,opt,name=output_selection_algorithm,json=outputSelectionAlgorithm,proto3,enum=walletrpc.ConstructTransactionRequest_OutputSelectionAlgorithm" json:"output_selection_algorithm,omitempty"`
 	NonChangeOutputs         []*ConstructTransactionRequest_Output                `protobuf:"bytes,5,rep,name=non_change_outputs,json=nonChangeOutputs,proto3" json:"non_change_outputs,omitempty"`
 	ChangeDestination        *ConstructTransactionRequest_OutputDestination       `protobuf:"bytes,6,opt,name=change_destination,json=changeDestination,proto3" json:"change_destination,omitempty"`
+	ConfirmationTarget       int32                                                `protobuf:"varint,7,opt,name=confirmation_target,json=confirmationTarget,proto3" json:"confirmation_target,omitempty"`
 	XXX_NoUnkeyedLiteral     struct{}                                             `json:"-"`
 	XXX_unrecognized         []byte                                               `json:"-"`
 	XXX_sizecache            int32                                                `json:"-"`
@@ -3449,6 +3517,13 @@ func (m *ConstructTransactionRequest) GetChangeDestination() *ConstructTransacti
 	return nil
 }
 
+func (m *ConstructTransactionRequest) GetConfirmationTarget() int32 {
+	if m != nil {
+		return m.ConfirmationTarget
+	}
+	return 0
+}
+
 type ConstructTransactionRequest_OutputDestination struct {
 	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Script               []byte   `protobuf:"bytes,2,opt,name=script,proto3" json:"script,omitempty"`
@@ -6745,6 +6820,11 @@ type RunTicketBuyerRequest struct {
 	VotingAddress        string   `protobuf:"bytes,5,opt,name=voting_address,json=votingAddress,proto3" json:"voting_address,omitempty"`
 	PoolAddress          string   `protobuf:"bytes,6,opt,name=pool_address,json=poolAddress,proto3" json:"pool_address,omitempty"`
 	PoolFees             float64  `protobuf:"fixed64,7,opt,name=pool_fees,json=poolFees,proto3" json:"pool_fees,omitempty"`
+	MaxPrice             int64    `protobuf:"varint,8,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	AverageWindows       int32    `protobuf:"varint,9,opt,name=average_windows,json=averageWindows,proto3" json:"average_windows,omitempty"`
+	Spread               bool     `protobuf:"varint,10,opt,name=spread,proto3" json:"spread,omitempty"`
+	WindowBudget         int64    `protobuf:"varint,11,opt,name=window_budget,json=windowBudget,proto3" json:"window_budget,omitempty"`
+	TargetPoolSize       uint32   `protobuf:"varint,12,opt,name=target_pool_size,json=targetPoolSize,proto3" json:"target_pool_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -6824,6 +6904,41 @@ func (m *RunTicketBuyerRequest) GetPoolFees() float64 {
 	return 0
 }
 
+func (m *RunTicketBuyerRequest) GetMaxPrice() int64 {
+	if m != nil {
+		return m.MaxPrice
+	}
+	return 0
+}
+
+func (m *RunTicketBuyerRequest) GetAverageWindows() int32 {
+	if m != nil {
+		return m.AverageWindows
+	}
+	return 0
+}
+
+func (m *RunTicketBuyerRequest) GetSpread() bool {
+	if m != nil {
+		return m.Spread
+	}
+	return false
+}
+
+func (m *RunTicketBuyerRequest) GetWindowBudget() int64 {
+	if m != nil {
+		return m.WindowBudget
+	}
+	return 0
+}
+
+func (m *RunTicketBuyerRequest) GetTargetPoolSize() uint32 {
+	if m != nil {
+		return m.TargetPoolSize
+	}
+	return 0
+}
+
 type RunTicketBuyerResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -9539,730 +9654,1750 @@ func (m *SweepAccountResponse) GetEstimatedSignedSize() uint32 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("walletrpc.SyncNotificationType", SyncNotificationType_name, SyncNotificationType_value)
-	proto.RegisterEnum("walletrpc.TransactionDetails_TransactionType", TransactionDetails_TransactionType_name, TransactionDetails_TransactionType_value)
-	proto.RegisterEnum("walletrpc.NextAddressRequest_Kind", NextAddressRequest_Kind_name, NextAddressRequest_Kind_value)
-	proto.RegisterEnum("walletrpc.NextAddressRequest_GapPolicy", NextAddressRequest_GapPolicy_name, NextAddressRequest_GapPolicy_value)
-	proto.RegisterEnum("walletrpc.GetTicketsResponse_TicketDetails_TicketStatus", GetTicketsResponse_TicketDetails_TicketStatus_name, GetTicketsResponse_TicketDetails_TicketStatus_value)
-	proto.RegisterEnum("walletrpc.ChangePassphraseRequest_Key", ChangePassphraseRequest_Key_name, ChangePassphraseRequest_Key_value)
-	proto.RegisterEnum("walletrpc.ConstructTransactionRequest_OutputSelectionAlgorithm", ConstructTransactionRequest_OutputSelectionAlgorithm_name, ConstructTransactionRequest_OutputSelectionAlgorithm_value)
-	proto.RegisterEnum("walletrpc.CreateSignatureRequest_SigHashType", CreateSignatureRequest_SigHashType_name, CreateSignatureRequest_SigHashType_value)
-	proto.RegisterEnum("walletrpc.DecodedTransaction_Input_TreeType", DecodedTransaction_Input_TreeType_name, DecodedTransaction_Input_TreeType_value)
-	proto.RegisterEnum("walletrpc.DecodedTransaction_Output_ScriptClass", DecodedTransaction_Output_ScriptClass_name, DecodedTransaction_Output_ScriptClass_value)
-	proto.RegisterEnum("walletrpc.ValidateAddressResponse_ScriptType", ValidateAddressResponse_ScriptType_name, ValidateAddressResponse_ScriptType_value)
-	proto.RegisterType((*VersionRequest)(nil), "walletrpc.VersionRequest")
-	proto.RegisterType((*VersionResponse)(nil), "walletrpc.VersionResponse")
-	proto.RegisterType((*TransactionDetails)(nil), "walletrpc.TransactionDetails")
-	proto.RegisterType((*TransactionDetails_Input)(nil), "walletrpc.TransactionDetails.Input")
-	proto.RegisterType((*TransactionDetails_Output)(nil), "walletrpc.TransactionDetails.Output")
-	proto.RegisterType((*BlockDetails)(nil), "walletrpc.BlockDetails")
-	proto.RegisterType((*AccountBalance)(nil), "walletrpc.AccountBalance")
-	proto.RegisterType((*PingRequest)(nil), "walletrpc.PingRequest")
-	proto.RegisterType((*PingResponse)(nil), "walletrpc.PingResponse")
-	proto.RegisterType((*NetworkRequest)(nil), "walletrpc.NetworkRequest")
-	proto.RegisterType((*NetworkResponse)(nil), "walletrpc.NetworkResponse")
-	proto.RegisterType((*CoinTypeRequest)(nil), "walletrpc.CoinTypeRequest")
-	proto.RegisterType((*CoinTypeResponse)(nil), "walletrpc.CoinTypeResponse")
-	proto.RegisterType((*AccountNumberRequest)(nil), "walletrpc.AccountNumberRequest")
-	proto.RegisterType((*AccountNumberResponse)(nil), "walletrpc.AccountNumberResponse")
-	proto.RegisterType((*AccountsRequest)(nil), "walletrpc.AccountsRequest")
-	proto.RegisterType((*AccountsResponse)(nil), "walletrpc.AccountsResponse")
-	proto.RegisterType((*AccountsResponse_Account)(nil), "walletrpc.AccountsResponse.Account")
-	proto.RegisterType((*RenameAccountRequest)(nil), "walletrpc.RenameAccountRequest")
-	proto.RegisterType((*RenameAccountResponse)(nil), "walletrpc.RenameAccountResponse")
-	proto.RegisterType((*RescanRequest)(nil), "walletrpc.RescanRequest")
-	proto.RegisterType((*RescanResponse)(nil), "walletrpc.RescanResponse")
-	proto.RegisterType((*NextAccountRequest)(nil), "walletrpc.NextAccountRequest")
-	proto.RegisterType((*NextAccountResponse)(nil), "walletrpc.NextAccountResponse")
-	proto.RegisterType((*NextAddressRequest)(nil), "walletrpc.NextAddressRequest")
-	proto.RegisterType((*NextAddressResponse)(nil), "walletrpc.NextAddressResponse")
-	proto.RegisterType((*ImportPrivateKeyRequest)(nil), "walletrpc.ImportPrivateKeyRequest")
-	proto.RegisterType((*ImportPrivateKeyResponse)(nil), "walletrpc.ImportPrivateKeyResponse")
-	proto.RegisterType((*ImportScriptRequest)(nil), "walletrpc.ImportScriptRequest")
-	proto.RegisterType((*ImportScriptResponse)(nil), "walletrpc.ImportScriptResponse")
-	proto.RegisterType((*BalanceRequest)(nil), "walletrpc.BalanceRequest")
-	proto.RegisterType((*BalanceResponse)(nil), "walletrpc.BalanceResponse")
-	proto.RegisterType((*GetTransactionRequest)(nil), "walletrpc.GetTransactionRequest")
-	proto.RegisterType((*GetTransactionResponse)(nil), "walletrpc.GetTransactionResponse")
-	proto.RegisterType((*GetTransactionsRequest)(nil), "walletrpc.GetTransactionsRequest")
-	proto.RegisterType((*GetTransactionsResponse)(nil), "walletrpc.GetTransactionsResponse")
-	proto.RegisterType((*GetTicketRequest)(nil), "walletrpc.GetTicketRequest")
-	proto.RegisterType((*GetTicketsRequest)(nil), "walletrpc.GetTicketsRequest")
-	proto.RegisterType((*GetTicketsResponse)(nil), "walletrpc.GetTicketsResponse")
-	proto.RegisterType((*GetTicketsResponse_TicketDetails)(nil), "walletrpc.GetTicketsResponse.TicketDetails")
-	proto.RegisterType((*GetTicketsResponse_BlockDetails)(nil), "walletrpc.GetTicketsResponse.BlockDetails")
-	proto.RegisterType((*TicketPriceRequest)(nil), "walletrpc.TicketPriceRequest")
-	proto.RegisterType((*TicketPriceResponse)(nil), "walletrpc.TicketPriceResponse")
-	proto.RegisterType((*StakeInfoRequest)(nil), "walletrpc.StakeInfoRequest")
-	proto.RegisterType((*StakeInfoResponse)(nil), "walletrpc.StakeInfoResponse")
-	proto.RegisterType((*BlockInfoRequest)(nil), "walletrpc.BlockInfoRequest")
-	proto.RegisterType((*BlockInfoResponse)(nil), "walletrpc.BlockInfoResponse")
-	proto.RegisterType((*ChangePassphraseRequest)(nil), "walletrpc.ChangePassphraseRequest")
-	proto.RegisterType((*ChangePassphraseResponse)(nil), "walletrpc.ChangePassphraseResponse")
-	proto.RegisterType((*FundTransactionRequest)(nil), "walletrpc.FundTransactionRequest")
-	proto.RegisterType((*FundTransactionResponse)(nil), "walletrpc.FundTransactionResponse")
-	proto.RegisterType((*FundTransactionResponse_PreviousOutput)(nil), "walletrpc.FundTransactionResponse.PreviousOutput")
-	proto.RegisterType((*UnspentOutputsRequest)(nil), "walletrpc.UnspentOutputsRequest")
-	proto.RegisterType((*UnspentOutputResponse)(nil), "walletrpc.UnspentOutputResponse")
-	proto.RegisterType((*ConstructTransactionRequest)(nil), "walletrpc.ConstructTransactionRequest")
-	proto.RegisterType((*ConstructTransactionRequest_OutputDestination)(nil), "walletrpc.ConstructTransactionRequest.OutputDestination")
-	proto.RegisterType((*ConstructTransactionRequest_Output)(nil), "walletrpc.ConstructTransactionRequest.Output")
-	proto.RegisterType((*ConstructTransactionResponse)(nil), "walletrpc.ConstructTransactionResponse")
-	proto.RegisterType((*SignTransactionRequest)(nil), "walletrpc.SignTransactionRequest")
-	proto.RegisterType((*SignTransactionRequest_AdditionalScript)(nil), "walletrpc.SignTransactionRequest.AdditionalScript")
-	proto.RegisterType((*SignTransactionResponse)(nil), "walletrpc.SignTransactionResponse")
-	proto.RegisterType((*SignTransactionsRequest)(nil), "walletrpc.SignTransactionsRequest")
-	proto.RegisterType((*SignTransactionsRequest_AdditionalScript)(nil), "walletrpc.SignTransactionsRequest.AdditionalScript")
-	proto.RegisterType((*SignTransactionsRequest_UnsignedTransaction)(nil), "walletrpc.SignTransactionsRequest.UnsignedTransaction")
-	proto.RegisterType((*SignTransactionsResponse)(nil), "walletrpc.SignTransactionsResponse")
-	proto.RegisterType((*SignTransactionsResponse_SignedTransaction)(nil), "walletrpc.SignTransactionsResponse.SignedTransaction")
-	proto.RegisterType((*CreateSignatureRequest)(nil), "walletrpc.CreateSignatureRequest")
-	proto.RegisterType((*CreateSignatureResponse)(nil), "walletrpc.CreateSignatureResponse")
-	proto.RegisterType((*PublishTransactionRequest)(nil), "walletrpc.PublishTransactionRequest")
-	proto.RegisterType((*PublishTransactionResponse)(nil), "walletrpc.PublishTransactionResponse")
-	proto.RegisterType((*PublishUnminedTransactionsRequest)(nil), "walletrpc.PublishUnminedTransactionsRequest")
-	proto.RegisterType((*PublishUnminedTransactionsResponse)(nil), "walletrpc.PublishUnminedTransactionsResponse")
-	proto.RegisterType((*PurchaseTicketsRequest)(nil), "walletrpc.PurchaseTicketsRequest")
-	proto.RegisterType((*PurchaseTicketsResponse)(nil), "walletrpc.PurchaseTicketsResponse")
-	proto.RegisterType((*RevokeTicketsRequest)(nil), "walletrpc.RevokeTicketsRequest")
-	proto.RegisterType((*RevokeTicketsResponse)(nil), "walletrpc.RevokeTicketsResponse")
-	proto.RegisterType((*LoadActiveDataFiltersRequest)(nil), "walletrpc.LoadActiveDataFiltersRequest")
-	proto.RegisterType((*LoadActiveDataFiltersResponse)(nil), "walletrpc.LoadActiveDataFiltersResponse")
-	proto.RegisterType((*SignMessageRequest)(nil), "walletrpc.SignMessageRequest")
-	proto.RegisterType((*SignMessageResponse)(nil), "walletrpc.SignMessageResponse")
-	proto.RegisterType((*SignMessagesRequest)(nil), "walletrpc.SignMessagesRequest")
-	proto.RegisterType((*SignMessagesRequest_Message)(nil), "walletrpc.SignMessagesRequest.Message")
-	proto.RegisterType((*SignMessagesResponse)(nil), "walletrpc.SignMessagesResponse")
-	proto.RegisterType((*SignMessagesResponse_SignReply)(nil), "walletrpc.SignMessagesResponse.SignReply")
-	proto.RegisterType((*TransactionNotificationsRequest)(nil), "walletrpc.TransactionNotificationsRequest")
-	proto.RegisterType((*TransactionNotificationsResponse)(nil), "walletrpc.TransactionNotificationsResponse")
-	proto.RegisterType((*AccountNotificationsRequest)(nil), "walletrpc.AccountNotificationsRequest")
-	proto.RegisterType((*AccountNotificationsResponse)(nil), "walletrpc.AccountNotificationsResponse")
-	proto.RegisterType((*ConfirmationNotificationsRequest)(nil), "walletrpc.ConfirmationNotificationsRequest")
-	proto.RegisterType((*ConfirmationNotificationsResponse)(nil), "walletrpc.ConfirmationNotificationsResponse")
-	proto.RegisterType((*ConfirmationNotificationsResponse_TransactionConfirmations)(nil), "walletrpc.ConfirmationNotificationsResponse.TransactionConfirmations")
-	proto.RegisterType((*CreateWalletRequest)(nil), "walletrpc.CreateWalletRequest")
-	proto.RegisterType((*CreateWalletResponse)(nil), "walletrpc.CreateWalletResponse")
-	proto.RegisterType((*CreateWatchingOnlyWalletRequest)(nil), "walletrpc.CreateWatchingOnlyWalletRequest")
-	proto.RegisterType((*CreateWatchingOnlyWalletResponse)(nil), "walletrpc.CreateWatchingOnlyWalletResponse")
-	proto.RegisterType((*OpenWalletRequest)(nil), "walletrpc.OpenWalletRequest")
-	proto.RegisterType((*OpenWalletResponse)(nil), "walletrpc.OpenWalletResponse")
-	proto.RegisterType((*CloseWalletRequest)(nil), "walletrpc.CloseWalletRequest")
-	proto.RegisterType((*CloseWalletResponse)(nil), "walletrpc.CloseWalletResponse")
-	proto.RegisterType((*WalletExistsRequest)(nil), "walletrpc.WalletExistsRequest")
-	proto.RegisterType((*WalletExistsResponse)(nil), "walletrpc.WalletExistsResponse")
-	proto.RegisterType((*StartConsensusRpcRequest)(nil), "walletrpc.StartConsensusRpcRequest")
-	proto.RegisterType((*StartConsensusRpcResponse)(nil), "walletrpc.StartConsensusRpcResponse")
-	proto.RegisterType((*DiscoverAddressesRequest)(nil), "walletrpc.DiscoverAddressesRequest")
-	proto.RegisterType((*DiscoverAddressesResponse)(nil), "walletrpc.DiscoverAddressesResponse")
-	proto.RegisterType((*FetchMissingCFiltersRequest)(nil), "walletrpc.FetchMissingCFiltersRequest")
-	proto.RegisterType((*FetchMissingCFiltersResponse)(nil), "walletrpc.FetchMissingCFiltersResponse")
-	proto.RegisterType((*SubscribeToBlockNotificationsRequest)(nil), "walletrpc.SubscribeToBlockNotificationsRequest")
-	proto.RegisterType((*SubscribeToBlockNotificationsResponse)(nil), "walletrpc.SubscribeToBlockNotificationsResponse")
-	proto.RegisterType((*FetchHeadersRequest)(nil), "walletrpc.FetchHeadersRequest")
-	proto.RegisterType((*FetchHeadersResponse)(nil), "walletrpc.FetchHeadersResponse")
-	proto.RegisterType((*FetchHeadersNotification)(nil), "walletrpc.FetchHeadersNotification")
-	proto.RegisterType((*FetchMissingCFiltersNotification)(nil), "walletrpc.FetchMissingCFiltersNotification")
-	proto.RegisterType((*RescanProgressNotification)(nil), "walletrpc.RescanProgressNotification")
-	proto.RegisterType((*PeerNotification)(nil), "walletrpc.PeerNotification")
-	proto.RegisterType((*RpcSyncRequest)(nil), "walletrpc.RpcSyncRequest")
-	proto.RegisterType((*RpcSyncResponse)(nil), "walletrpc.RpcSyncResponse")
-	proto.RegisterType((*SpvSyncRequest)(nil), "walletrpc.SpvSyncRequest")
-	proto.RegisterType((*SpvSyncResponse)(nil), "walletrpc.SpvSyncResponse")
-	proto.RegisterType((*RescanPointRequest)(nil), "walletrpc.RescanPointRequest")
-	proto.RegisterType((*RescanPointResponse)(nil), "walletrpc.RescanPointResponse")
-	proto.RegisterType((*GenerateRandomSeedRequest)(nil), "walletrpc.GenerateRandomSeedRequest")
-	proto.RegisterType((*GenerateRandomSeedResponse)(nil), "walletrpc.GenerateRandomSeedResponse")
-	proto.RegisterType((*DecodeSeedRequest)(nil), "walletrpc.DecodeSeedRequest")
-	proto.RegisterType((*DecodeSeedResponse)(nil), "walletrpc.DecodeSeedResponse")
-	proto.RegisterType((*RunTicketBuyerRequest)(nil), "walletrpc.RunTicketBuyerRequest")
-	proto.RegisterType((*RunTicketBuyerResponse)(nil), "walletrpc.RunTicketBuyerResponse")
-	proto.RegisterType((*StartAutoBuyerRequest)(nil), "walletrpc.StartAutoBuyerRequest")
-	proto.RegisterType((*StartAutoBuyerResponse)(nil), "walletrpc.StartAutoBuyerResponse")
-	proto.RegisterType((*StopAutoBuyerRequest)(nil), "walletrpc.StopAutoBuyerRequest")
-	proto.RegisterType((*StopAutoBuyerResponse)(nil), "walletrpc.StopAutoBuyerResponse")
-	proto.RegisterType((*TicketBuyerConfigRequest)(nil), "walletrpc.TicketBuyerConfigRequest")
-	proto.RegisterType((*TicketBuyerConfigResponse)(nil), "walletrpc.TicketBuyerConfigResponse")
-	proto.RegisterType((*SetAccountRequest)(nil), "walletrpc.SetAccountRequest")
-	proto.RegisterType((*SetAccountResponse)(nil), "walletrpc.SetAccountResponse")
-	proto.RegisterType((*SetBalanceToMaintainRequest)(nil), "walletrpc.SetBalanceToMaintainRequest")
-	proto.RegisterType((*SetBalanceToMaintainResponse)(nil), "walletrpc.SetBalanceToMaintainResponse")
-	proto.RegisterType((*SetMaxFeeRequest)(nil), "walletrpc.SetMaxFeeRequest")
-	proto.RegisterType((*SetMaxFeeResponse)(nil), "walletrpc.SetMaxFeeResponse")
-	proto.RegisterType((*SetMaxPriceRelativeRequest)(nil), "walletrpc.SetMaxPriceRelativeRequest")
-	proto.RegisterType((*SetMaxPriceRelativeResponse)(nil), "walletrpc.SetMaxPriceRelativeResponse")
-	proto.RegisterType((*SetMaxPriceAbsoluteRequest)(nil), "walletrpc.SetMaxPriceAbsoluteRequest")
-	proto.RegisterType((*SetMaxPriceAbsoluteResponse)(nil), "walletrpc.SetMaxPriceAbsoluteResponse")
-	proto.RegisterType((*SetVotingAddressRequest)(nil), "walletrpc.SetVotingAddressRequest")
-	proto.RegisterType((*SetVotingAddressResponse)(nil), "walletrpc.SetVotingAddressResponse")
-	proto.RegisterType((*SetPoolAddressRequest)(nil), "walletrpc.SetPoolAddressRequest")
-	proto.RegisterType((*SetPoolAddressResponse)(nil), "walletrpc.SetPoolAddressResponse")
-	proto.RegisterType((*SetPoolFeesRequest)(nil), "walletrpc.SetPoolFeesRequest")
-	proto.RegisterType((*SetPoolFeesResponse)(nil), "walletrpc.SetPoolFeesResponse")
-	proto.RegisterType((*SetMaxPerBlockRequest)(nil), "walletrpc.SetMaxPerBlockRequest")
-	proto.RegisterType((*SetMaxPerBlockResponse)(nil), "walletrpc.SetMaxPerBlockResponse")
-	proto.RegisterType((*AgendasRequest)(nil), "walletrpc.AgendasRequest")
-	proto.RegisterType((*AgendasResponse)(nil), "walletrpc.AgendasResponse")
-	proto.RegisterType((*AgendasResponse_Agenda)(nil), "walletrpc.AgendasResponse.Agenda")
-	proto.RegisterType((*AgendasResponse_Choice)(nil), "walletrpc.AgendasResponse.Choice")
-	proto.RegisterType((*VoteChoicesRequest)(nil), "walletrpc.VoteChoicesRequest")
-	proto.RegisterType((*VoteChoicesResponse)(nil), "walletrpc.VoteChoicesResponse")
-	proto.RegisterType((*VoteChoicesResponse_Choice)(nil), "walletrpc.VoteChoicesResponse.Choice")
-	proto.RegisterType((*SetVoteChoicesRequest)(nil), "walletrpc.SetVoteChoicesRequest")
-	proto.RegisterType((*SetVoteChoicesRequest_Choice)(nil), "walletrpc.SetVoteChoicesRequest.Choice")
-	proto.RegisterType((*SetVoteChoicesResponse)(nil), "walletrpc.SetVoteChoicesResponse")
-	proto.RegisterType((*VerifyMessageRequest)(nil), "walletrpc.VerifyMessageRequest")
-	proto.RegisterType((*VerifyMessageResponse)(nil), "walletrpc.VerifyMessageResponse")
-	proto.RegisterType((*DecodedTransaction)(nil), "walletrpc.DecodedTransaction")
-	proto.RegisterType((*DecodedTransaction_Input)(nil), "walletrpc.DecodedTransaction.Input")
-	proto.RegisterType((*DecodedTransaction_Output)(nil), "walletrpc.DecodedTransaction.Output")
-	proto.RegisterType((*DecodeRawTransactionRequest)(nil), "walletrpc.DecodeRawTransactionRequest")
-	proto.RegisterType((*DecodeRawTransactionResponse)(nil), "walletrpc.DecodeRawTransactionResponse")
-	proto.RegisterType((*ValidateAddressRequest)(nil), "walletrpc.ValidateAddressRequest")
-	proto.RegisterType((*ValidateAddressResponse)(nil), "walletrpc.ValidateAddressResponse")
-	proto.RegisterType((*CommittedTicketsRequest)(nil), "walletrpc.CommittedTicketsRequest")
-	proto.RegisterType((*GetAccountExtendedPubKeyRequest)(nil), "walletrpc.GetAccountExtendedPubKeyRequest")
-	proto.RegisterType((*GetAccountExtendedPubKeyResponse)(nil), "walletrpc.GetAccountExtendedPubKeyResponse")
-	proto.RegisterType((*GetAccountExtendedPrivKeyRequest)(nil), "walletrpc.GetAccountExtendedPrivKeyRequest")
-	proto.RegisterType((*GetAccountExtendedPrivKeyResponse)(nil), "walletrpc.GetAccountExtendedPrivKeyResponse")
-	proto.RegisterType((*CommittedTicketsResponse)(nil), "walletrpc.CommittedTicketsResponse")
+type CreatePsbtRequest struct {
+	UnsignedTransaction  []byte   `protobuf:"bytes,1,opt,name=unsigned_transaction,json=unsignedTransaction,proto3" json:"unsigned_transaction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreatePsbtRequest) Reset()         { *m = CreatePsbtRequest{} }
+func (m *CreatePsbtRequest) String() string { return proto.CompactTextString(m) }
+func (*CreatePsbtRequest) ProtoMessage()    {}
+func (*CreatePsbtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{159}
+}
+
+func (m *CreatePsbtRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreatePsbtRequest.Unmarshal(m, b)
+}
+func (m *CreatePsbtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreatePsbtRequest.Marshal(b, m, deterministic)
+}
+func (m *CreatePsbtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatePsbtRequest.Merge(m, src)
+}
+func (m *CreatePsbtRequest) XXX_Size() int {
+	return xxx_messageInfo_CreatePsbtRequest.Size(m)
+}
+func (m *CreatePsbtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatePsbtRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreatePsbtRequest proto.InternalMessageInfo
+
+func (m *CreatePsbtRequest) GetUnsignedTransaction() []byte {
+	if m != nil {
+		return m.UnsignedTransaction
+	}
+	return nil
+}
+
+type CreatePsbtResponse struct {
+	Psbt                 []byte   `protobuf:"bytes,1,opt,name=psbt,proto3" json:"psbt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreatePsbtResponse) Reset()         { *m = CreatePsbtResponse{} }
+func (m *CreatePsbtResponse) String() string { return proto.CompactTextString(m) }
+func (*CreatePsbtResponse) ProtoMessage()    {}
+func (*CreatePsbtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{160}
+}
+
+func (m *CreatePsbtResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreatePsbtResponse.Unmarshal(m, b)
+}
+func (m *CreatePsbtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreatePsbtResponse.Marshal(b, m, deterministic)
+}
+func (m *CreatePsbtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatePsbtResponse.Merge(m, src)
+}
+func (m *CreatePsbtResponse) XXX_Size() int {
+	return xxx_messageInfo_CreatePsbtResponse.Size(m)
+}
+func (m *CreatePsbtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatePsbtResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreatePsbtResponse proto.InternalMessageInfo
+
+func (m *CreatePsbtResponse) GetPsbt() []byte {
+	if m != nil {
+		return m.Psbt
+	}
+	return nil
+}
+
+type ProcessPsbtRequest struct {
+	Passphrase           []byte                             `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	Psbt                 []byte                             `protobuf:"bytes,2,opt,name=psbt,proto3" json:"psbt,omitempty"`
+	Sign                 bool                               `protobuf:"varint,3,opt,name=sign,proto3" json:"sign,omitempty"`
+	HashType             CreateSignatureRequest_SigHashType `protobuf:"varint,4,opt,name=hash_type,json=hashType,proto3,enum=walletrpc.CreateSignatureRequest_SigHashType" json:"hash_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
+}
+
+func (m *ProcessPsbtRequest) Reset()         { *m = ProcessPsbtRequest{} }
+func (m *ProcessPsbtRequest) String() string { return proto.CompactTextString(m) }
+func (*ProcessPsbtRequest) ProtoMessage()    {}
+func (*ProcessPsbtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{161}
+}
+
+func (m *ProcessPsbtRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProcessPsbtRequest.Unmarshal(m, b)
+}
+func (m *ProcessPsbtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProcessPsbtRequest.Marshal(b, m, deterministic)
+}
+func (m *ProcessPsbtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcessPsbtRequest.Merge(m, src)
+}
+func (m *ProcessPsbtRequest) XXX_Size() int {
+	return xxx_messageInfo_ProcessPsbtRequest.Size(m)
+}
+func (m *ProcessPsbtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProcessPsbtRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProcessPsbtRequest proto.InternalMessageInfo
+
+func (m *ProcessPsbtRequest) GetPassphrase() []byte {
+	if m != nil {
+		return m.Passphrase
+	}
+	return nil
+}
+
+func (m *ProcessPsbtRequest) GetPsbt() []byte {
+	if m != nil {
+		return m.Psbt
+	}
+	return nil
+}
+
+func (m *ProcessPsbtRequest) GetSign() bool {
+	if m != nil {
+		return m.Sign
+	}
+	return false
+}
+
+func (m *ProcessPsbtRequest) GetHashType() CreateSignatureRequest_SigHashType {
+	if m != nil {
+		return m.HashType
+	}
+	return CreateSignatureRequest_SIGHASH_OLD
+}
+
+type ProcessPsbtResponse struct {
+	Psbt                 []byte   `protobuf:"bytes,1,opt,name=psbt,proto3" json:"psbt,omitempty"`
+	Complete             bool     `protobuf:"varint,2,opt,name=complete,proto3" json:"complete,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProcessPsbtResponse) Reset()         { *m = ProcessPsbtResponse{} }
+func (m *ProcessPsbtResponse) String() string { return proto.CompactTextString(m) }
+func (*ProcessPsbtResponse) ProtoMessage()    {}
+func (*ProcessPsbtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{162}
+}
+
+func (m *ProcessPsbtResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProcessPsbtResponse.Unmarshal(m, b)
+}
+func (m *ProcessPsbtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProcessPsbtResponse.Marshal(b, m, deterministic)
+}
+func (m *ProcessPsbtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProcessPsbtResponse.Merge(m, src)
+}
+func (m *ProcessPsbtResponse) XXX_Size() int {
+	return xxx_messageInfo_ProcessPsbtResponse.Size(m)
+}
+func (m *ProcessPsbtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProcessPsbtResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProcessPsbtResponse proto.InternalMessageInfo
+
+func (m *ProcessPsbtResponse) GetPsbt() []byte {
+	if m != nil {
+		return m.Psbt
+	}
+	return nil
+}
+
+func (m *ProcessPsbtResponse) GetComplete() bool {
+	if m != nil {
+		return m.Complete
+	}
+	return false
+}
+
+type CombinePsbtsRequest struct {
+	Psbts                [][]byte `protobuf:"bytes,1,rep,name=psbts,proto3" json:"psbts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CombinePsbtsRequest) Reset()         { *m = CombinePsbtsRequest{} }
+func (m *CombinePsbtsRequest) String() string { return proto.CompactTextString(m) }
+func (*CombinePsbtsRequest) ProtoMessage()    {}
+func (*CombinePsbtsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{163}
+}
+
+func (m *CombinePsbtsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CombinePsbtsRequest.Unmarshal(m, b)
+}
+func (m *CombinePsbtsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CombinePsbtsRequest.Marshal(b, m, deterministic)
+}
+func (m *CombinePsbtsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CombinePsbtsRequest.Merge(m, src)
+}
+func (m *CombinePsbtsRequest) XXX_Size() int {
+	return xxx_messageInfo_CombinePsbtsRequest.Size(m)
+}
+func (m *CombinePsbtsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CombinePsbtsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CombinePsbtsRequest proto.InternalMessageInfo
+
+func (m *CombinePsbtsRequest) GetPsbts() [][]byte {
+	if m != nil {
+		return m.Psbts
+	}
+	return nil
+}
+
+type CombinePsbtsResponse struct {
+	Psbt                 []byte   `protobuf:"bytes,1,opt,name=psbt,proto3" json:"psbt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CombinePsbtsResponse) Reset()         { *m = CombinePsbtsResponse{} }
+func (m *CombinePsbtsResponse) String() string { return proto.CompactTextString(m) }
+func (*CombinePsbtsResponse) ProtoMessage()    {}
+func (*CombinePsbtsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{164}
+}
+
+func (m *CombinePsbtsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CombinePsbtsResponse.Unmarshal(m, b)
+}
+func (m *CombinePsbtsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CombinePsbtsResponse.Marshal(b, m, deterministic)
+}
+func (m *CombinePsbtsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CombinePsbtsResponse.Merge(m, src)
+}
+func (m *CombinePsbtsResponse) XXX_Size() int {
+	return xxx_messageInfo_CombinePsbtsResponse.Size(m)
+}
+func (m *CombinePsbtsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CombinePsbtsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CombinePsbtsResponse proto.InternalMessageInfo
+
+func (m *CombinePsbtsResponse) GetPsbt() []byte {
+	if m != nil {
+		return m.Psbt
+	}
+	return nil
+}
+
+type FinalizePsbtRequest struct {
+	Psbt                 []byte   `protobuf:"bytes,1,opt,name=psbt,proto3" json:"psbt,omitempty"`
+	Extract              bool     `protobuf:"varint,2,opt,name=extract,proto3" json:"extract,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FinalizePsbtRequest) Reset()         { *m = FinalizePsbtRequest{} }
+func (m *FinalizePsbtRequest) String() string { return proto.CompactTextString(m) }
+func (*FinalizePsbtRequest) ProtoMessage()    {}
+func (*FinalizePsbtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{165}
+}
+
+func (m *FinalizePsbtRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FinalizePsbtRequest.Unmarshal(m, b)
+}
+func (m *FinalizePsbtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FinalizePsbtRequest.Marshal(b, m, deterministic)
+}
+func (m *FinalizePsbtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizePsbtRequest.Merge(m, src)
+}
+func (m *FinalizePsbtRequest) XXX_Size() int {
+	return xxx_messageInfo_FinalizePsbtRequest.Size(m)
+}
+func (m *FinalizePsbtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizePsbtRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalizePsbtRequest proto.InternalMessageInfo
+
+func (m *FinalizePsbtRequest) GetPsbt() []byte {
+	if m != nil {
+		return m.Psbt
+	}
+	return nil
+}
+
+func (m *FinalizePsbtRequest) GetExtract() bool {
+	if m != nil {
+		return m.Extract
+	}
+	return false
+}
+
+type FinalizePsbtResponse struct {
+	Psbt                 []byte   `protobuf:"bytes,1,opt,name=psbt,proto3" json:"psbt,omitempty"`
+	Complete             bool     `protobuf:"varint,2,opt,name=complete,proto3" json:"complete,omitempty"`
+	Transaction          []byte   `protobuf:"bytes,3,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FinalizePsbtResponse) Reset()         { *m = FinalizePsbtResponse{} }
+func (m *FinalizePsbtResponse) String() string { return proto.CompactTextString(m) }
+func (*FinalizePsbtResponse) ProtoMessage()    {}
+func (*FinalizePsbtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{166}
+}
+
+func (m *FinalizePsbtResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FinalizePsbtResponse.Unmarshal(m, b)
+}
+func (m *FinalizePsbtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FinalizePsbtResponse.Marshal(b, m, deterministic)
+}
+func (m *FinalizePsbtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizePsbtResponse.Merge(m, src)
+}
+func (m *FinalizePsbtResponse) XXX_Size() int {
+	return xxx_messageInfo_FinalizePsbtResponse.Size(m)
+}
+func (m *FinalizePsbtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizePsbtResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalizePsbtResponse proto.InternalMessageInfo
+
+func (m *FinalizePsbtResponse) GetPsbt() []byte {
+	if m != nil {
+		return m.Psbt
+	}
+	return nil
+}
+
+func (m *FinalizePsbtResponse) GetComplete() bool {
+	if m != nil {
+		return m.Complete
+	}
+	return false
+}
+
+func (m *FinalizePsbtResponse) GetTransaction() []byte {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+type BumpFeeRequest struct {
+	Passphrase           []byte   `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	TransactionHash      []byte   `protobuf:"bytes,2,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	FeePerKb             int32    `protobuf:"varint,3,opt,name=fee_per_kb,json=feePerKb,proto3" json:"fee_per_kb,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BumpFeeRequest) Reset()         { *m = BumpFeeRequest{} }
+func (m *BumpFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*BumpFeeRequest) ProtoMessage()    {}
+func (*BumpFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{167}
+}
+
+func (m *BumpFeeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BumpFeeRequest.Unmarshal(m, b)
+}
+func (m *BumpFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BumpFeeRequest.Marshal(b, m, deterministic)
+}
+func (m *BumpFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BumpFeeRequest.Merge(m, src)
+}
+func (m *BumpFeeRequest) XXX_Size() int {
+	return xxx_messageInfo_BumpFeeRequest.Size(m)
+}
+func (m *BumpFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BumpFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BumpFeeRequest proto.InternalMessageInfo
+
+func (m *BumpFeeRequest) GetPassphrase() []byte {
+	if m != nil {
+		return m.Passphrase
+	}
+	return nil
+}
+
+func (m *BumpFeeRequest) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+func (m *BumpFeeRequest) GetFeePerKb() int32 {
+	if m != nil {
+		return m.FeePerKb
+	}
+	return 0
+}
+
+type BumpFeeResponse struct {
+	Transaction          []byte   `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	TransactionHash      []byte   `protobuf:"bytes,2,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	OriginalFee          int64    `protobuf:"varint,3,opt,name=original_fee,json=originalFee,proto3" json:"original_fee,omitempty"`
+	Fee                  int64    `protobuf:"varint,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BumpFeeResponse) Reset()         { *m = BumpFeeResponse{} }
+func (m *BumpFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*BumpFeeResponse) ProtoMessage()    {}
+func (*BumpFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{168}
+}
+
+func (m *BumpFeeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BumpFeeResponse.Unmarshal(m, b)
+}
+func (m *BumpFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BumpFeeResponse.Marshal(b, m, deterministic)
+}
+func (m *BumpFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BumpFeeResponse.Merge(m, src)
+}
+func (m *BumpFeeResponse) XXX_Size() int {
+	return xxx_messageInfo_BumpFeeResponse.Size(m)
+}
+func (m *BumpFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BumpFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BumpFeeResponse proto.InternalMessageInfo
+
+func (m *BumpFeeResponse) GetTransaction() []byte {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+func (m *BumpFeeResponse) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+func (m *BumpFeeResponse) GetOriginalFee() int64 {
+	if m != nil {
+		return m.OriginalFee
+	}
+	return 0
+}
+
+func (m *BumpFeeResponse) GetFee() int64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+type ChildPaysForParentRequest struct {
+	Passphrase           []byte   `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	TransactionHash      []byte   `protobuf:"bytes,2,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	Account              uint32   `protobuf:"varint,3,opt,name=account,proto3" json:"account,omitempty"`
+	FeePerKb             int32    `protobuf:"varint,4,opt,name=fee_per_kb,json=feePerKb,proto3" json:"fee_per_kb,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChildPaysForParentRequest) Reset()         { *m = ChildPaysForParentRequest{} }
+func (m *ChildPaysForParentRequest) String() string { return proto.CompactTextString(m) }
+func (*ChildPaysForParentRequest) ProtoMessage()    {}
+func (*ChildPaysForParentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{169}
+}
+
+func (m *ChildPaysForParentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChildPaysForParentRequest.Unmarshal(m, b)
+}
+func (m *ChildPaysForParentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChildPaysForParentRequest.Marshal(b, m, deterministic)
+}
+func (m *ChildPaysForParentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChildPaysForParentRequest.Merge(m, src)
+}
+func (m *ChildPaysForParentRequest) XXX_Size() int {
+	return xxx_messageInfo_ChildPaysForParentRequest.Size(m)
+}
+func (m *ChildPaysForParentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChildPaysForParentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChildPaysForParentRequest proto.InternalMessageInfo
+
+func (m *ChildPaysForParentRequest) GetPassphrase() []byte {
+	if m != nil {
+		return m.Passphrase
+	}
+	return nil
+}
+
+func (m *ChildPaysForParentRequest) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+func (m *ChildPaysForParentRequest) GetAccount() uint32 {
+	if m != nil {
+		return m.Account
+	}
+	return 0
+}
+
+func (m *ChildPaysForParentRequest) GetFeePerKb() int32 {
+	if m != nil {
+		return m.FeePerKb
+	}
+	return 0
+}
+
+type ChildPaysForParentResponse struct {
+	Transaction          []byte   `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	TransactionHash      []byte   `protobuf:"bytes,2,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	ParentFee            int64    `protobuf:"varint,3,opt,name=parent_fee,json=parentFee,proto3" json:"parent_fee,omitempty"`
+	Fee                  int64    `protobuf:"varint,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	UnminedAncestors     bool     `protobuf:"varint,5,opt,name=unmined_ancestors,json=unminedAncestors,proto3" json:"unmined_ancestors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChildPaysForParentResponse) Reset()         { *m = ChildPaysForParentResponse{} }
+func (m *ChildPaysForParentResponse) String() string { return proto.CompactTextString(m) }
+func (*ChildPaysForParentResponse) ProtoMessage()    {}
+func (*ChildPaysForParentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{170}
+}
+
+func (m *ChildPaysForParentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChildPaysForParentResponse.Unmarshal(m, b)
+}
+func (m *ChildPaysForParentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChildPaysForParentResponse.Marshal(b, m, deterministic)
+}
+func (m *ChildPaysForParentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChildPaysForParentResponse.Merge(m, src)
+}
+func (m *ChildPaysForParentResponse) XXX_Size() int {
+	return xxx_messageInfo_ChildPaysForParentResponse.Size(m)
+}
+func (m *ChildPaysForParentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChildPaysForParentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChildPaysForParentResponse proto.InternalMessageInfo
+
+func (m *ChildPaysForParentResponse) GetTransaction() []byte {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+func (m *ChildPaysForParentResponse) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+func (m *ChildPaysForParentResponse) GetParentFee() int64 {
+	if m != nil {
+		return m.ParentFee
+	}
+	return 0
+}
+
+func (m *ChildPaysForParentResponse) GetFee() int64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *ChildPaysForParentResponse) GetUnminedAncestors() bool {
+	if m != nil {
+		return m.UnminedAncestors
+	}
+	return false
+}
+
+type LabelTarget struct {
+	Kind                 LabelTarget_Kind `protobuf:"varint,1,opt,name=kind,proto3,enum=walletrpc.LabelTarget_Kind" json:"kind,omitempty"`
+	Address              string           `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	TransactionHash      []byte           `protobuf:"bytes,3,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	OutputIndex          uint32           `protobuf:"varint,4,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *LabelTarget) Reset()         { *m = LabelTarget{} }
+func (m *LabelTarget) String() string { return proto.CompactTextString(m) }
+func (*LabelTarget) ProtoMessage()    {}
+func (*LabelTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{171}
+}
+
+func (m *LabelTarget) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LabelTarget.Unmarshal(m, b)
+}
+func (m *LabelTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LabelTarget.Marshal(b, m, deterministic)
+}
+func (m *LabelTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LabelTarget.Merge(m, src)
+}
+func (m *LabelTarget) XXX_Size() int {
+	return xxx_messageInfo_LabelTarget.Size(m)
+}
+func (m *LabelTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_LabelTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LabelTarget proto.InternalMessageInfo
+
+func (m *LabelTarget) GetKind() LabelTarget_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return LabelTarget_ADDRESS
+}
+
+func (m *LabelTarget) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *LabelTarget) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+func (m *LabelTarget) GetOutputIndex() uint32 {
+	if m != nil {
+		return m.OutputIndex
+	}
+	return 0
+}
+
+type SetLabelRequest struct {
+	Target               *LabelTarget `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Label                string       `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *SetLabelRequest) Reset()         { *m = SetLabelRequest{} }
+func (m *SetLabelRequest) String() string { return proto.CompactTextString(m) }
+func (*SetLabelRequest) ProtoMessage()    {}
+func (*SetLabelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{172}
+}
+
+func (m *SetLabelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetLabelRequest.Unmarshal(m, b)
+}
+func (m *SetLabelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetLabelRequest.Marshal(b, m, deterministic)
+}
+func (m *SetLabelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLabelRequest.Merge(m, src)
+}
+func (m *SetLabelRequest) XXX_Size() int {
+	return xxx_messageInfo_SetLabelRequest.Size(m)
+}
+func (m *SetLabelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLabelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetLabelRequest proto.InternalMessageInfo
+
+func (m *SetLabelRequest) GetTarget() *LabelTarget {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *SetLabelRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+type SetLabelResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetLabelResponse) Reset()         { *m = SetLabelResponse{} }
+func (m *SetLabelResponse) String() string { return proto.CompactTextString(m) }
+func (*SetLabelResponse) ProtoMessage()    {}
+func (*SetLabelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{173}
+}
+
+func (m *SetLabelResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetLabelResponse.Unmarshal(m, b)
+}
+func (m *SetLabelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetLabelResponse.Marshal(b, m, deterministic)
+}
+func (m *SetLabelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLabelResponse.Merge(m, src)
+}
+func (m *SetLabelResponse) XXX_Size() int {
+	return xxx_messageInfo_SetLabelResponse.Size(m)
+}
+func (m *SetLabelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLabelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetLabelResponse proto.InternalMessageInfo
+
+type GetLabelRequest struct {
+	Target               *LabelTarget `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GetLabelRequest) Reset()         { *m = GetLabelRequest{} }
+func (m *GetLabelRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLabelRequest) ProtoMessage()    {}
+func (*GetLabelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{174}
+}
+
+func (m *GetLabelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLabelRequest.Unmarshal(m, b)
+}
+func (m *GetLabelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLabelRequest.Marshal(b, m, deterministic)
+}
+func (m *GetLabelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLabelRequest.Merge(m, src)
+}
+func (m *GetLabelRequest) XXX_Size() int {
+	return xxx_messageInfo_GetLabelRequest.Size(m)
+}
+func (m *GetLabelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLabelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLabelRequest proto.InternalMessageInfo
+
+func (m *GetLabelRequest) GetTarget() *LabelTarget {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+type GetLabelResponse struct {
+	Label                string   `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetLabelResponse) Reset()         { *m = GetLabelResponse{} }
+func (m *GetLabelResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLabelResponse) ProtoMessage()    {}
+func (*GetLabelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{175}
+}
+
+func (m *GetLabelResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLabelResponse.Unmarshal(m, b)
+}
+func (m *GetLabelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLabelResponse.Marshal(b, m, deterministic)
+}
+func (m *GetLabelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLabelResponse.Merge(m, src)
+}
+func (m *GetLabelResponse) XXX_Size() int {
+	return xxx_messageInfo_GetLabelResponse.Size(m)
+}
+func (m *GetLabelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLabelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLabelResponse proto.InternalMessageInfo
+
+func (m *GetLabelResponse) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+type ListLabelsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListLabelsRequest) Reset()         { *m = ListLabelsRequest{} }
+func (m *ListLabelsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListLabelsRequest) ProtoMessage()    {}
+func (*ListLabelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{176}
+}
+
+func (m *ListLabelsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListLabelsRequest.Unmarshal(m, b)
+}
+func (m *ListLabelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListLabelsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListLabelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListLabelsRequest.Merge(m, src)
+}
+func (m *ListLabelsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListLabelsRequest.Size(m)
+}
+func (m *ListLabelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListLabelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListLabelsRequest proto.InternalMessageInfo
+
+type ListLabelsResponse struct {
+	Labels               []*ListLabelsResponse_Label `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *ListLabelsResponse) Reset()         { *m = ListLabelsResponse{} }
+func (m *ListLabelsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListLabelsResponse) ProtoMessage()    {}
+func (*ListLabelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{177}
+}
+
+func (m *ListLabelsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListLabelsResponse.Unmarshal(m, b)
+}
+func (m *ListLabelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListLabelsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListLabelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListLabelsResponse.Merge(m, src)
+}
+func (m *ListLabelsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListLabelsResponse.Size(m)
+}
+func (m *ListLabelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListLabelsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListLabelsResponse proto.InternalMessageInfo
+
+func (m *ListLabelsResponse) GetLabels() []*ListLabelsResponse_Label {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+type ListLabelsResponse_Label struct {
+	Target               *LabelTarget `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Label                string       `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListLabelsResponse_Label) Reset()         { *m = ListLabelsResponse_Label{} }
+func (m *ListLabelsResponse_Label) String() string { return proto.CompactTextString(m) }
+func (*ListLabelsResponse_Label) ProtoMessage()    {}
+func (*ListLabelsResponse_Label) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{177, 0}
+}
+
+func (m *ListLabelsResponse_Label) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListLabelsResponse_Label.Unmarshal(m, b)
+}
+func (m *ListLabelsResponse_Label) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListLabelsResponse_Label.Marshal(b, m, deterministic)
+}
+func (m *ListLabelsResponse_Label) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListLabelsResponse_Label.Merge(m, src)
+}
+func (m *ListLabelsResponse_Label) XXX_Size() int {
+	return xxx_messageInfo_ListLabelsResponse_Label.Size(m)
+}
+func (m *ListLabelsResponse_Label) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListLabelsResponse_Label.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListLabelsResponse_Label proto.InternalMessageInfo
+
+func (m *ListLabelsResponse_Label) GetTarget() *LabelTarget {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *ListLabelsResponse_Label) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("walletrpc.SyncNotificationType", SyncNotificationType_name, SyncNotificationType_value)
+	proto.RegisterEnum("walletrpc.TransactionDetails_TransactionType", TransactionDetails_TransactionType_name, TransactionDetails_TransactionType_value)
+	proto.RegisterEnum("walletrpc.NextAddressRequest_Kind", NextAddressRequest_Kind_name, NextAddressRequest_Kind_value)
+	proto.RegisterEnum("walletrpc.NextAddressRequest_GapPolicy", NextAddressRequest_GapPolicy_name, NextAddressRequest_GapPolicy_value)
+	proto.RegisterEnum("walletrpc.GetTicketsResponse_TicketDetails_TicketStatus", GetTicketsResponse_TicketDetails_TicketStatus_name, GetTicketsResponse_TicketDetails_TicketStatus_value)
+	proto.RegisterEnum("walletrpc.ChangePassphraseRequest_Key", ChangePassphraseRequest_Key_name, ChangePassphraseRequest_Key_value)
+	proto.RegisterEnum("walletrpc.ConstructTransactionRequest_OutputSelectionAlgorithm", ConstructTransactionRequest_OutputSelectionAlgorithm_name, ConstructTransactionRequest_OutputSelectionAlgorithm_value)
+	proto.RegisterEnum("walletrpc.CreateSignatureRequest_SigHashType", CreateSignatureRequest_SigHashType_name, CreateSignatureRequest_SigHashType_value)
+	proto.RegisterEnum("walletrpc.DecodedTransaction_Input_TreeType", DecodedTransaction_Input_TreeType_name, DecodedTransaction_Input_TreeType_value)
+	proto.RegisterEnum("walletrpc.DecodedTransaction_Output_ScriptClass", DecodedTransaction_Output_ScriptClass_name, DecodedTransaction_Output_ScriptClass_value)
+	proto.RegisterEnum("walletrpc.ValidateAddressResponse_ScriptType", ValidateAddressResponse_ScriptType_name, ValidateAddressResponse_ScriptType_value)
+	proto.RegisterEnum("walletrpc.LabelTarget_Kind", LabelTarget_Kind_name, LabelTarget_Kind_value)
+	proto.RegisterType((*VersionRequest)(nil), "walletrpc.VersionRequest")
+	proto.RegisterType((*VersionResponse)(nil), "walletrpc.VersionResponse")
+	proto.RegisterType((*TransactionDetails)(nil), "walletrpc.TransactionDetails")
+	proto.RegisterType((*TransactionDetails_Input)(nil), "walletrpc.TransactionDetails.Input")
+	proto.RegisterType((*TransactionDetails_Output)(nil), "walletrpc.TransactionDetails.Output")
+	proto.RegisterType((*BlockDetails)(nil), "walletrpc.BlockDetails")
+	proto.RegisterType((*AccountBalance)(nil), "walletrpc.AccountBalance")
+	proto.RegisterType((*PingRequest)(nil), "walletrpc.PingRequest")
+	proto.RegisterType((*PingResponse)(nil), "walletrpc.PingResponse")
+	proto.RegisterType((*NetworkRequest)(nil), "walletrpc.NetworkRequest")
+	proto.RegisterType((*NetworkResponse)(nil), "walletrpc.NetworkResponse")
+	proto.RegisterType((*CoinTypeRequest)(nil), "walletrpc.CoinTypeRequest")
+	proto.RegisterType((*CoinTypeResponse)(nil), "walletrpc.CoinTypeResponse")
+	proto.RegisterType((*AccountNumberRequest)(nil), "walletrpc.AccountNumberRequest")
+	proto.RegisterType((*AccountNumberResponse)(nil), "walletrpc.AccountNumberResponse")
+	proto.RegisterType((*AccountsRequest)(nil), "walletrpc.AccountsRequest")
+	proto.RegisterType((*AccountsResponse)(nil), "walletrpc.AccountsResponse")
+	proto.RegisterType((*AccountsResponse_Account)(nil), "walletrpc.AccountsResponse.Account")
+	proto.RegisterType((*RenameAccountRequest)(nil), "walletrpc.RenameAccountRequest")
+	proto.RegisterType((*RenameAccountResponse)(nil), "walletrpc.RenameAccountResponse")
+	proto.RegisterType((*RescanRequest)(nil), "walletrpc.RescanRequest")
+	proto.RegisterType((*RescanResponse)(nil), "walletrpc.RescanResponse")
+	proto.RegisterType((*NextAccountRequest)(nil), "walletrpc.NextAccountRequest")
+	proto.RegisterType((*NextAccountResponse)(nil), "walletrpc.NextAccountResponse")
+	proto.RegisterType((*NextAddressRequest)(nil), "walletrpc.NextAddressRequest")
+	proto.RegisterType((*NextAddressResponse)(nil), "walletrpc.NextAddressResponse")
+	proto.RegisterType((*ImportPrivateKeyRequest)(nil), "walletrpc.ImportPrivateKeyRequest")
+	proto.RegisterType((*ImportPrivateKeyResponse)(nil), "walletrpc.ImportPrivateKeyResponse")
+	proto.RegisterType((*ImportScriptRequest)(nil), "walletrpc.ImportScriptRequest")
+	proto.RegisterType((*ImportScriptResponse)(nil), "walletrpc.ImportScriptResponse")
+	proto.RegisterType((*BalanceRequest)(nil), "walletrpc.BalanceRequest")
+	proto.RegisterType((*BalanceResponse)(nil), "walletrpc.BalanceResponse")
+	proto.RegisterType((*GetTransactionRequest)(nil), "walletrpc.GetTransactionRequest")
+	proto.RegisterType((*GetTransactionResponse)(nil), "walletrpc.GetTransactionResponse")
+	proto.RegisterType((*GetTransactionsRequest)(nil), "walletrpc.GetTransactionsRequest")
+	proto.RegisterType((*GetTransactionsResponse)(nil), "walletrpc.GetTransactionsResponse")
+	proto.RegisterType((*GetTicketRequest)(nil), "walletrpc.GetTicketRequest")
+	proto.RegisterType((*GetTicketsRequest)(nil), "walletrpc.GetTicketsRequest")
+	proto.RegisterType((*GetTicketsResponse)(nil), "walletrpc.GetTicketsResponse")
+	proto.RegisterType((*GetTicketsResponse_TicketDetails)(nil), "walletrpc.GetTicketsResponse.TicketDetails")
+	proto.RegisterType((*GetTicketsResponse_BlockDetails)(nil), "walletrpc.GetTicketsResponse.BlockDetails")
+	proto.RegisterType((*TicketPriceRequest)(nil), "walletrpc.TicketPriceRequest")
+	proto.RegisterType((*TicketPriceResponse)(nil), "walletrpc.TicketPriceResponse")
+	proto.RegisterType((*StakeInfoRequest)(nil), "walletrpc.StakeInfoRequest")
+	proto.RegisterType((*StakeInfoResponse)(nil), "walletrpc.StakeInfoResponse")
+	proto.RegisterType((*BlockInfoRequest)(nil), "walletrpc.BlockInfoRequest")
+	proto.RegisterType((*BlockInfoResponse)(nil), "walletrpc.BlockInfoResponse")
+	proto.RegisterType((*ChangePassphraseRequest)(nil), "walletrpc.ChangePassphraseRequest")
+	proto.RegisterType((*ChangePassphraseResponse)(nil), "walletrpc.ChangePassphraseResponse")
+	proto.RegisterType((*FundTransactionRequest)(nil), "walletrpc.FundTransactionRequest")
+	proto.RegisterType((*FundTransactionResponse)(nil), "walletrpc.FundTransactionResponse")
+	proto.RegisterType((*FundTransactionResponse_PreviousOutput)(nil), "walletrpc.FundTransactionResponse.PreviousOutput")
+	proto.RegisterType((*UnspentOutputsRequest)(nil), "walletrpc.UnspentOutputsRequest")
+	proto.RegisterType((*UnspentOutputResponse)(nil), "walletrpc.UnspentOutputResponse")
+	proto.RegisterType((*ConstructTransactionRequest)(nil), "walletrpc.ConstructTransactionRequest")
+	proto.RegisterType((*ConstructTransactionRequest_OutputDestination)(nil), "walletrpc.ConstructTransactionRequest.OutputDestination")
+	proto.RegisterType((*ConstructTransactionRequest_Output)(nil), "walletrpc.ConstructTransactionRequest.Output")
+	proto.RegisterType((*ConstructTransactionResponse)(nil), "walletrpc.ConstructTransactionResponse")
+	proto.RegisterType((*SignTransactionRequest)(nil), "walletrpc.SignTransactionRequest")
+	proto.RegisterType((*SignTransactionRequest_AdditionalScript)(nil), "walletrpc.SignTransactionRequest.AdditionalScript")
+	proto.RegisterType((*SignTransactionResponse)(nil), "walletrpc.SignTransactionResponse")
+	proto.RegisterType((*SignTransactionsRequest)(nil), "walletrpc.SignTransactionsRequest")
+	proto.RegisterType((*SignTransactionsRequest_AdditionalScript)(nil), "walletrpc.SignTransactionsRequest.AdditionalScript")
+	proto.RegisterType((*SignTransactionsRequest_UnsignedTransaction)(nil), "walletrpc.SignTransactionsRequest.UnsignedTransaction")
+	proto.RegisterType((*SignTransactionsResponse)(nil), "walletrpc.SignTransactionsResponse")
+	proto.RegisterType((*SignTransactionsResponse_SignedTransaction)(nil), "walletrpc.SignTransactionsResponse.SignedTransaction")
+	proto.RegisterType((*CreateSignatureRequest)(nil), "walletrpc.CreateSignatureRequest")
+	proto.RegisterType((*CreateSignatureResponse)(nil), "walletrpc.CreateSignatureResponse")
+	proto.RegisterType((*PublishTransactionRequest)(nil), "walletrpc.PublishTransactionRequest")
+	proto.RegisterType((*PublishTransactionResponse)(nil), "walletrpc.PublishTransactionResponse")
+	proto.RegisterType((*PublishUnminedTransactionsRequest)(nil), "walletrpc.PublishUnminedTransactionsRequest")
+	proto.RegisterType((*PublishUnminedTransactionsResponse)(nil), "walletrpc.PublishUnminedTransactionsResponse")
+	proto.RegisterType((*PurchaseTicketsRequest)(nil), "walletrpc.PurchaseTicketsRequest")
+	proto.RegisterType((*PurchaseTicketsResponse)(nil), "walletrpc.PurchaseTicketsResponse")
+	proto.RegisterType((*RevokeTicketsRequest)(nil), "walletrpc.RevokeTicketsRequest")
+	proto.RegisterType((*RevokeTicketsResponse)(nil), "walletrpc.RevokeTicketsResponse")
+	proto.RegisterType((*LoadActiveDataFiltersRequest)(nil), "walletrpc.LoadActiveDataFiltersRequest")
+	proto.RegisterType((*LoadActiveDataFiltersResponse)(nil), "walletrpc.LoadActiveDataFiltersResponse")
+	proto.RegisterType((*SignMessageRequest)(nil), "walletrpc.SignMessageRequest")
+	proto.RegisterType((*SignMessageResponse)(nil), "walletrpc.SignMessageResponse")
+	proto.RegisterType((*SignMessagesRequest)(nil), "walletrpc.SignMessagesRequest")
+	proto.RegisterType((*SignMessagesRequest_Message)(nil), "walletrpc.SignMessagesRequest.Message")
+	proto.RegisterType((*SignMessagesResponse)(nil), "walletrpc.SignMessagesResponse")
+	proto.RegisterType((*SignMessagesResponse_SignReply)(nil), "walletrpc.SignMessagesResponse.SignReply")
+	proto.RegisterType((*TransactionNotificationsRequest)(nil), "walletrpc.TransactionNotificationsRequest")
+	proto.RegisterType((*TransactionNotificationsResponse)(nil), "walletrpc.TransactionNotificationsResponse")
+	proto.RegisterType((*AccountNotificationsRequest)(nil), "walletrpc.AccountNotificationsRequest")
+	proto.RegisterType((*AccountNotificationsResponse)(nil), "walletrpc.AccountNotificationsResponse")
+	proto.RegisterType((*ConfirmationNotificationsRequest)(nil), "walletrpc.ConfirmationNotificationsRequest")
+	proto.RegisterType((*ConfirmationNotificationsResponse)(nil), "walletrpc.ConfirmationNotificationsResponse")
+	proto.RegisterType((*ConfirmationNotificationsResponse_TransactionConfirmations)(nil), "walletrpc.ConfirmationNotificationsResponse.TransactionConfirmations")
+	proto.RegisterType((*CreateWalletRequest)(nil), "walletrpc.CreateWalletRequest")
+	proto.RegisterType((*CreateWalletResponse)(nil), "walletrpc.CreateWalletResponse")
+	proto.RegisterType((*CreateWatchingOnlyWalletRequest)(nil), "walletrpc.CreateWatchingOnlyWalletRequest")
+	proto.RegisterType((*CreateWatchingOnlyWalletResponse)(nil), "walletrpc.CreateWatchingOnlyWalletResponse")
+	proto.RegisterType((*OpenWalletRequest)(nil), "walletrpc.OpenWalletRequest")
+	proto.RegisterType((*OpenWalletResponse)(nil), "walletrpc.OpenWalletResponse")
+	proto.RegisterType((*CloseWalletRequest)(nil), "walletrpc.CloseWalletRequest")
+	proto.RegisterType((*CloseWalletResponse)(nil), "walletrpc.CloseWalletResponse")
+	proto.RegisterType((*WalletExistsRequest)(nil), "walletrpc.WalletExistsRequest")
+	proto.RegisterType((*WalletExistsResponse)(nil), "walletrpc.WalletExistsResponse")
+	proto.RegisterType((*StartConsensusRpcRequest)(nil), "walletrpc.StartConsensusRpcRequest")
+	proto.RegisterType((*StartConsensusRpcResponse)(nil), "walletrpc.StartConsensusRpcResponse")
+	proto.RegisterType((*DiscoverAddressesRequest)(nil), "walletrpc.DiscoverAddressesRequest")
+	proto.RegisterType((*DiscoverAddressesResponse)(nil), "walletrpc.DiscoverAddressesResponse")
+	proto.RegisterType((*FetchMissingCFiltersRequest)(nil), "walletrpc.FetchMissingCFiltersRequest")
+	proto.RegisterType((*FetchMissingCFiltersResponse)(nil), "walletrpc.FetchMissingCFiltersResponse")
+	proto.RegisterType((*SubscribeToBlockNotificationsRequest)(nil), "walletrpc.SubscribeToBlockNotificationsRequest")
+	proto.RegisterType((*SubscribeToBlockNotificationsResponse)(nil), "walletrpc.SubscribeToBlockNotificationsResponse")
+	proto.RegisterType((*FetchHeadersRequest)(nil), "walletrpc.FetchHeadersRequest")
+	proto.RegisterType((*FetchHeadersResponse)(nil), "walletrpc.FetchHeadersResponse")
+	proto.RegisterType((*FetchHeadersNotification)(nil), "walletrpc.FetchHeadersNotification")
+	proto.RegisterType((*FetchMissingCFiltersNotification)(nil), "walletrpc.FetchMissingCFiltersNotification")
+	proto.RegisterType((*RescanProgressNotification)(nil), "walletrpc.RescanProgressNotification")
+	proto.RegisterType((*PeerNotification)(nil), "walletrpc.PeerNotification")
+	proto.RegisterType((*RpcSyncRequest)(nil), "walletrpc.RpcSyncRequest")
+	proto.RegisterType((*RpcSyncResponse)(nil), "walletrpc.RpcSyncResponse")
+	proto.RegisterType((*SpvSyncRequest)(nil), "walletrpc.SpvSyncRequest")
+	proto.RegisterType((*SpvSyncResponse)(nil), "walletrpc.SpvSyncResponse")
+	proto.RegisterType((*RescanPointRequest)(nil), "walletrpc.RescanPointRequest")
+	proto.RegisterType((*RescanPointResponse)(nil), "walletrpc.RescanPointResponse")
+	proto.RegisterType((*GenerateRandomSeedRequest)(nil), "walletrpc.GenerateRandomSeedRequest")
+	proto.RegisterType((*GenerateRandomSeedResponse)(nil), "walletrpc.GenerateRandomSeedResponse")
+	proto.RegisterType((*DecodeSeedRequest)(nil), "walletrpc.DecodeSeedRequest")
+	proto.RegisterType((*DecodeSeedResponse)(nil), "walletrpc.DecodeSeedResponse")
+	proto.RegisterType((*RunTicketBuyerRequest)(nil), "walletrpc.RunTicketBuyerRequest")
+	proto.RegisterType((*RunTicketBuyerResponse)(nil), "walletrpc.RunTicketBuyerResponse")
+	proto.RegisterType((*StartAutoBuyerRequest)(nil), "walletrpc.StartAutoBuyerRequest")
+	proto.RegisterType((*StartAutoBuyerResponse)(nil), "walletrpc.StartAutoBuyerResponse")
+	proto.RegisterType((*StopAutoBuyerRequest)(nil), "walletrpc.StopAutoBuyerRequest")
+	proto.RegisterType((*StopAutoBuyerResponse)(nil), "walletrpc.StopAutoBuyerResponse")
+	proto.RegisterType((*TicketBuyerConfigRequest)(nil), "walletrpc.TicketBuyerConfigRequest")
+	proto.RegisterType((*TicketBuyerConfigResponse)(nil), "walletrpc.TicketBuyerConfigResponse")
+	proto.RegisterType((*SetAccountRequest)(nil), "walletrpc.SetAccountRequest")
+	proto.RegisterType((*SetAccountResponse)(nil), "walletrpc.SetAccountResponse")
+	proto.RegisterType((*SetBalanceToMaintainRequest)(nil), "walletrpc.SetBalanceToMaintainRequest")
+	proto.RegisterType((*SetBalanceToMaintainResponse)(nil), "walletrpc.SetBalanceToMaintainResponse")
+	proto.RegisterType((*SetMaxFeeRequest)(nil), "walletrpc.SetMaxFeeRequest")
+	proto.RegisterType((*SetMaxFeeResponse)(nil), "walletrpc.SetMaxFeeResponse")
+	proto.RegisterType((*SetMaxPriceRelativeRequest)(nil), "walletrpc.SetMaxPriceRelativeRequest")
+	proto.RegisterType((*SetMaxPriceRelativeResponse)(nil), "walletrpc.SetMaxPriceRelativeResponse")
+	proto.RegisterType((*SetMaxPriceAbsoluteRequest)(nil), "walletrpc.SetMaxPriceAbsoluteRequest")
+	proto.RegisterType((*SetMaxPriceAbsoluteResponse)(nil), "walletrpc.SetMaxPriceAbsoluteResponse")
+	proto.RegisterType((*SetVotingAddressRequest)(nil), "walletrpc.SetVotingAddressRequest")
+	proto.RegisterType((*SetVotingAddressResponse)(nil), "walletrpc.SetVotingAddressResponse")
+	proto.RegisterType((*SetPoolAddressRequest)(nil), "walletrpc.SetPoolAddressRequest")
+	proto.RegisterType((*SetPoolAddressResponse)(nil), "walletrpc.SetPoolAddressResponse")
+	proto.RegisterType((*SetPoolFeesRequest)(nil), "walletrpc.SetPoolFeesRequest")
+	proto.RegisterType((*SetPoolFeesResponse)(nil), "walletrpc.SetPoolFeesResponse")
+	proto.RegisterType((*SetMaxPerBlockRequest)(nil), "walletrpc.SetMaxPerBlockRequest")
+	proto.RegisterType((*SetMaxPerBlockResponse)(nil), "walletrpc.SetMaxPerBlockResponse")
+	proto.RegisterType((*AgendasRequest)(nil), "walletrpc.AgendasRequest")
+	proto.RegisterType((*AgendasResponse)(nil), "walletrpc.AgendasResponse")
+	proto.RegisterType((*AgendasResponse_Agenda)(nil), "walletrpc.AgendasResponse.Agenda")
+	proto.RegisterType((*AgendasResponse_Choice)(nil), "walletrpc.AgendasResponse.Choice")
+	proto.RegisterType((*VoteChoicesRequest)(nil), "walletrpc.VoteChoicesRequest")
+	proto.RegisterType((*VoteChoicesResponse)(nil), "walletrpc.VoteChoicesResponse")
+	proto.RegisterType((*VoteChoicesResponse_Choice)(nil), "walletrpc.VoteChoicesResponse.Choice")
+	proto.RegisterType((*SetVoteChoicesRequest)(nil), "walletrpc.SetVoteChoicesRequest")
+	proto.RegisterType((*SetVoteChoicesRequest_Choice)(nil), "walletrpc.SetVoteChoicesRequest.Choice")
+	proto.RegisterType((*SetVoteChoicesResponse)(nil), "walletrpc.SetVoteChoicesResponse")
+	proto.RegisterType((*VerifyMessageRequest)(nil), "walletrpc.VerifyMessageRequest")
+	proto.RegisterType((*VerifyMessageResponse)(nil), "walletrpc.VerifyMessageResponse")
+	proto.RegisterType((*DecodedTransaction)(nil), "walletrpc.DecodedTransaction")
+	proto.RegisterType((*DecodedTransaction_Input)(nil), "walletrpc.DecodedTransaction.Input")
+	proto.RegisterType((*DecodedTransaction_Output)(nil), "walletrpc.DecodedTransaction.Output")
+	proto.RegisterType((*DecodeRawTransactionRequest)(nil), "walletrpc.DecodeRawTransactionRequest")
+	proto.RegisterType((*DecodeRawTransactionResponse)(nil), "walletrpc.DecodeRawTransactionResponse")
+	proto.RegisterType((*ValidateAddressRequest)(nil), "walletrpc.ValidateAddressRequest")
+	proto.RegisterType((*ValidateAddressResponse)(nil), "walletrpc.ValidateAddressResponse")
+	proto.RegisterType((*CommittedTicketsRequest)(nil), "walletrpc.CommittedTicketsRequest")
+	proto.RegisterType((*GetAccountExtendedPubKeyRequest)(nil), "walletrpc.GetAccountExtendedPubKeyRequest")
+	proto.RegisterType((*GetAccountExtendedPubKeyResponse)(nil), "walletrpc.GetAccountExtendedPubKeyResponse")
+	proto.RegisterType((*GetAccountExtendedPrivKeyRequest)(nil), "walletrpc.GetAccountExtendedPrivKeyRequest")
+	proto.RegisterType((*GetAccountExtendedPrivKeyResponse)(nil), "walletrpc.GetAccountExtendedPrivKeyResponse")
+	proto.RegisterType((*CommittedTicketsResponse)(nil), "walletrpc.CommittedTicketsResponse")
 	proto.RegisterType((*CommittedTicketsResponse_TicketAddress)(nil), "walletrpc.CommittedTicketsResponse.TicketAddress")
 	proto.RegisterType((*BestBlockRequest)(nil), "walletrpc.BestBlockRequest")
 	proto.RegisterType((*BestBlockResponse)(nil), "walletrpc.BestBlockResponse")
 	proto.RegisterType((*SweepAccountRequest)(nil), "walletrpc.SweepAccountRequest")
 	proto.RegisterType((*SweepAccountResponse)(nil), "walletrpc.SweepAccountResponse")
+	proto.RegisterType((*CreatePsbtRequest)(nil), "walletrpc.CreatePsbtRequest")
+	proto.RegisterType((*CreatePsbtResponse)(nil), "walletrpc.CreatePsbtResponse")
+	proto.RegisterType((*ProcessPsbtRequest)(nil), "walletrpc.ProcessPsbtRequest")
+	proto.RegisterType((*ProcessPsbtResponse)(nil), "walletrpc.ProcessPsbtResponse")
+	proto.RegisterType((*CombinePsbtsRequest)(nil), "walletrpc.CombinePsbtsRequest")
+	proto.RegisterType((*CombinePsbtsResponse)(nil), "walletrpc.CombinePsbtsResponse")
+	proto.RegisterType((*FinalizePsbtRequest)(nil), "walletrpc.FinalizePsbtRequest")
+	proto.RegisterType((*FinalizePsbtResponse)(nil), "walletrpc.FinalizePsbtResponse")
+	proto.RegisterType((*BumpFeeRequest)(nil), "walletrpc.BumpFeeRequest")
+	proto.RegisterType((*BumpFeeResponse)(nil), "walletrpc.BumpFeeResponse")
+	proto.RegisterType((*ChildPaysForParentRequest)(nil), "walletrpc.ChildPaysForParentRequest")
+	proto.RegisterType((*ChildPaysForParentResponse)(nil), "walletrpc.ChildPaysForParentResponse")
+	proto.RegisterType((*LabelTarget)(nil), "walletrpc.LabelTarget")
+	proto.RegisterType((*SetLabelRequest)(nil), "walletrpc.SetLabelRequest")
+	proto.RegisterType((*SetLabelResponse)(nil), "walletrpc.SetLabelResponse")
+	proto.RegisterType((*GetLabelRequest)(nil), "walletrpc.GetLabelRequest")
+	proto.RegisterType((*GetLabelResponse)(nil), "walletrpc.GetLabelResponse")
+	proto.RegisterType((*ListLabelsRequest)(nil), "walletrpc.ListLabelsRequest")
+	proto.RegisterType((*ListLabelsResponse)(nil), "walletrpc.ListLabelsResponse")
+	proto.RegisterType((*ListLabelsResponse_Label)(nil), "walletrpc.ListLabelsResponse.Label")
 }
 
 func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
 
 var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 8399 bytes of a gzipped FileDescriptorProto
+	// 9078 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5b, 0x6c, 0x23, 0x49,
-	0x92, 0xd8, 0x16, 0xa9, 0x07, 0x19, 0x22, 0xa9, 0x62, 0xea, 0xc5, 0xa6, 0xfa, 0x59, 0x3d, 0x3d,
-	0xd3, 0x3b, 0x0f, 0x4d, 0xaf, 0x66, 0x76, 0x77, 0x6e, 0x77, 0x76, 0x66, 0xd8, 0x12, 0xbb, 0x9b,
-	0xdb, 0x12, 0xc5, 0x2b, 0xb2, 0x35, 0x3d, 0x3b, 0xf6, 0x15, 0x4a, 0x64, 0x4a, 0xaa, 0x6b, 0xb2,
-	0x8a, 0x5b, 0x55, 0x94, 0x5a, 0x6b, 0x1b, 0x1e, 0x9c, 0x61, 0xff, 0x1d, 0xfc, 0x00, 0xfc, 0x61,
-	0x9c, 0xef, 0x60, 0xc0, 0x86, 0x6d, 0xc0, 0xf0, 0x0b, 0x36, 0x8c, 0x83, 0xd7, 0x30, 0x7c, 0x86,
-	0x7f, 0x8c, 0x83, 0x61, 0x9c, 0x7f, 0xfc, 0xe1, 0x3f, 0x03, 0xfe, 0x3a, 0xc0, 0x07, 0xf8, 0xd7,
-	0x1f, 0x36, 0xf2, 0x55, 0x95, 0x59, 0x55, 0xa4, 0xa4, 0xb9, 0x59, 0xc0, 0xbb, 0x70, 0xff, 0x34,
-	0x2b, 0x32, 0x32, 0xf2, 0x15, 0x19, 0x19, 0x19, 0x19, 0x11, 0x82, 0xa2, 0x3d, 0x76, 0xb6, 0xc6,
-	0xbe, 0x17, 0x7a, 0xa8, 0x78, 0x6e, 0x0f, 0x87, 0x38, 0xf4, 0xc7, 0x7d, 0x43, 0x87, 0xca, 0x21,
-	0xf6, 0x03, 0xc7, 0x73, 0x4d, 0xfc, 0xd3, 0x09, 0x0e, 0x42, 0xe3, 0x0f, 0x34, 0x58, 0x8e, 0x40,
-	0xc1, 0xd8, 0x73, 0x03, 0x8c, 0x1e, 0x40, 0xe5, 0x8c, 0x81, 0xac, 0x20, 0xf4, 0x1d, 0xf7, 0xa4,
-	0xa6, 0xdd, 0xd5, 0x1e, 0x16, 0xcd, 0x32, 0x87, 0x76, 0x29, 0x10, 0xad, 0xc2, 0xfc, 0xc8, 0xfe,
-	0x4d, 0xcf, 0xaf, 0xe5, 0xee, 0x6a, 0x0f, 0xcb, 0x26, 0xfb, 0xa0, 0x50, 0xc7, 0xf5, 0xfc, 0x5a,
-	0x9e, 0x43, 0xc9, 0x07, 0x81, 0x8e, 0xed, 0xb0, 0x7f, 0x5a, 0x9b, 0x63, 0x50, 0xfa, 0x81, 0x6e,
-	0x03, 0x8c, 0x7d, 0xec, 0xe3, 0x21, 0xb6, 0x03, 0x5c, 0x9b, 0xa7, 0x8d, 0x48, 0x10, 0xd2, 0x91,
-	0xa3, 0x89, 0x33, 0x1c, 0x58, 0x23, 0x1c, 0xda, 0x03, 0x3b, 0xb4, 0x6b, 0x0b, 0xac, 0x23, 0x14,
-	0xba, 0xcf, 0x81, 0xc6, 0x7f, 0x9e, 0x07, 0xd4, 0xf3, 0x6d, 0x37, 0xb0, 0xfb, 0xa1, 0xe3, 0xb9,
-	0xbb, 0x38, 0xb4, 0x9d, 0x61, 0x80, 0x10, 0xcc, 0x9d, 0xda, 0xc1, 0x29, 0xed, 0x7c, 0xc9, 0xa4,
-	0xbf, 0xd1, 0x5d, 0x58, 0x0a, 0x63, 0x4c, 0xda, 0xf3, 0x92, 0x29, 0x83, 0xd0, 0x0f, 0x61, 0x61,
-	0x80, 0x8f, 0x9c, 0x30, 0xa8, 0xe5, 0xef, 0xe6, 0x1f, 0x2e, 0x6d, 0xdf, 0xdf, 0x8a, 0xa6, 0x6f,
-	0x2b, 0xdd, 0xc8, 0x56, 0xcb, 0x1d, 0x4f, 0x42, 0x93, 0x57, 0x41, 0x9f, 0xc0, 0x62, 0xdf, 0xc7,
-	0x03, 0x52, 0x7b, 0x8e, 0xd6, 0x7e, 0x63, 0x76, 0xed, 0x83, 0x49, 0x48, 0xaa, 0x8b, 0x4a, 0x48,
-	0x87, 0xfc, 0x31, 0x66, 0x33, 0x91, 0x37, 0xc9, 0x4f, 0x74, 0x13, 0x8a, 0xa1, 0x33, 0xc2, 0x41,
-	0x68, 0x8f, 0xc6, 0x74, 0xf4, 0x79, 0x33, 0x06, 0xa0, 0x97, 0xa0, 0x4b, 0x7d, 0xb7, 0xc2, 0x8b,
-	0x31, 0xae, 0x2d, 0xde, 0xd5, 0x1e, 0x56, 0xb6, 0xdf, 0x9b, 0xdd, 0xb0, 0x04, 0xea, 0x5d, 0x8c,
-	0xb1, 0xb9, 0x1c, 0xaa, 0x80, 0xfa, 0x4f, 0x61, 0x9e, 0x0e, 0x8d, 0xac, 0x9c, 0xe3, 0x0e, 0xf0,
-	0x6b, 0x3a, 0x8d, 0x65, 0x93, 0x7d, 0xa0, 0x6f, 0x83, 0x3e, 0xf6, 0xf1, 0x99, 0xe3, 0x4d, 0x02,
-	0xcb, 0xee, 0xf7, 0xbd, 0x89, 0x1b, 0x72, 0x36, 0x58, 0x16, 0xf0, 0x06, 0x03, 0xa3, 0xb7, 0x60,
-	0x39, 0x46, 0x1d, 0x51, 0xcc, 0x3c, 0x1d, 0x47, 0x25, 0xc2, 0xa4, 0xd0, 0xfa, 0x3f, 0xd2, 0x60,
-	0x81, 0x4d, 0xc8, 0x94, 0x46, 0x6b, 0xb0, 0xa8, 0xb6, 0x25, 0x3e, 0x51, 0x1d, 0x0a, 0x8e, 0x1b,
-	0x62, 0xdf, 0xb5, 0x87, 0x94, 0x78, 0xc1, 0x8c, 0xbe, 0xd1, 0x3a, 0x2c, 0xf0, 0x66, 0xe7, 0x68,
-	0xb3, 0xfc, 0x8b, 0x52, 0x1b, 0x0c, 0x7c, 0x1c, 0x04, 0x9c, 0xf3, 0xc4, 0x27, 0xba, 0x0f, 0x65,
-	0x8f, 0xf6, 0xc3, 0x0a, 0xfa, 0xbe, 0x33, 0x0e, 0xe9, 0xbc, 0x97, 0xcc, 0x12, 0x03, 0x76, 0x29,
-	0xcc, 0xf8, 0x12, 0x96, 0x13, 0x93, 0x88, 0x96, 0x60, 0xd1, 0x6c, 0x3e, 0x7d, 0xb1, 0xd7, 0x30,
-	0xf5, 0x6f, 0xa1, 0x12, 0x14, 0x76, 0x0e, 0x5a, 0xed, 0xc7, 0x8d, 0x6e, 0x53, 0x9f, 0x43, 0x2b,
-	0xb0, 0xdc, 0x6b, 0xed, 0x3c, 0x6f, 0xf6, 0xac, 0xce, 0x0b, 0x73, 0xe7, 0x19, 0x01, 0x6a, 0xa8,
-	0x00, 0x73, 0x87, 0x07, 0xbd, 0xa6, 0x9e, 0x43, 0x15, 0x00, 0xb3, 0x79, 0x78, 0xb0, 0xd3, 0xe8,
-	0xb5, 0x0e, 0xda, 0x7a, 0xde, 0xf8, 0x0f, 0x1a, 0x94, 0x1e, 0x0f, 0xbd, 0xfe, 0xab, 0x59, 0xbc,
-	0xbc, 0x0e, 0x0b, 0xa7, 0xd8, 0x39, 0x39, 0x65, 0xb3, 0x31, 0x6f, 0xf2, 0x2f, 0x95, 0x65, 0xf2,
-	0x49, 0x96, 0x79, 0x0b, 0x96, 0xed, 0xf1, 0xd8, 0xf7, 0xce, 0x70, 0x60, 0x8d, 0x6d, 0x1f, 0xbb,
-	0x21, 0x1d, 0x7e, 0xc1, 0xac, 0x08, 0x70, 0x87, 0x42, 0x51, 0x03, 0x4a, 0x12, 0x53, 0x08, 0x86,
-	0xbe, 0x35, 0x93, 0xaf, 0x4c, 0xa5, 0x8a, 0x71, 0x00, 0x15, 0xce, 0x05, 0x8f, 0xed, 0xa1, 0xed,
-	0xf6, 0xb1, 0xbc, 0x84, 0x9a, 0xba, 0x84, 0xf7, 0xa1, 0x1c, 0x7a, 0xa1, 0x3d, 0xb4, 0x8e, 0x18,
-	0x2a, 0x1d, 0x54, 0xde, 0x2c, 0x51, 0x20, 0xaf, 0x6e, 0x94, 0x61, 0xa9, 0xe3, 0xb8, 0x27, 0x42,
-	0x78, 0x55, 0xa0, 0xc4, 0x3e, 0x99, 0xe0, 0x22, 0xe2, 0xad, 0x8d, 0xc3, 0x73, 0xcf, 0x7f, 0x25,
-	0x30, 0x3e, 0x82, 0xe5, 0x08, 0x12, 0x4b, 0x37, 0xd2, 0xbf, 0x33, 0x6c, 0xb9, 0xac, 0x84, 0xf7,
-	0xa4, 0xcc, 0xa0, 0x1c, 0xdd, 0xa8, 0xc2, 0xf2, 0x8e, 0xe7, 0xb0, 0xdd, 0xc1, 0x89, 0xbd, 0x0f,
-	0x7a, 0x0c, 0xe2, 0xd4, 0x36, 0xa1, 0xd8, 0xf7, 0x1c, 0xbe, 0xf5, 0x18, 0xa1, 0x42, 0x9f, 0x23,
-	0x19, 0xbf, 0x06, 0xab, 0x7c, 0xfc, 0xed, 0xc9, 0xe8, 0x08, 0xfb, 0x9c, 0x10, 0xba, 0x07, 0x25,
-	0x3e, 0x6c, 0xcb, 0xb5, 0x47, 0x98, 0x8b, 0xd7, 0x25, 0x0e, 0x6b, 0xdb, 0x23, 0x6c, 0x7c, 0x02,
-	0x6b, 0x89, 0xaa, 0x72, 0xf7, 0x79, 0x5d, 0x5a, 0x12, 0x77, 0x5f, 0x42, 0x27, 0xdd, 0xe7, 0xf5,
-	0x03, 0xd1, 0xfd, 0xdf, 0xcf, 0x83, 0x1e, 0xc3, 0x38, 0xb9, 0x4f, 0xa1, 0xc0, 0x2b, 0x06, 0x35,
-	0x2d, 0x25, 0xf0, 0x92, 0xe8, 0x02, 0x60, 0x46, 0x95, 0xd0, 0xbb, 0x80, 0xfa, 0x13, 0x9f, 0x70,
-	0x8c, 0x75, 0x44, 0x38, 0xd6, 0xa2, 0x7c, 0xca, 0x04, 0xab, 0xce, 0x4b, 0x28, 0x2b, 0x3f, 0x23,
-	0x3c, 0xfb, 0x08, 0x56, 0x13, 0xd8, 0x8c, 0x83, 0xf3, 0x94, 0x83, 0x91, 0x82, 0x4f, 0x4b, 0xea,
-	0xbf, 0x95, 0x83, 0x45, 0x21, 0x4a, 0xae, 0x36, 0xf6, 0xd4, 0xf4, 0xe6, 0x52, 0xd3, 0x9b, 0xe6,
-	0xb6, 0x7c, 0x9a, 0xdb, 0xc8, 0xd0, 0xf0, 0x6b, 0x26, 0x45, 0xac, 0x57, 0xf8, 0xc2, 0xea, 0x47,
-	0x52, 0xa4, 0x6c, 0xea, 0xa2, 0xe4, 0x39, 0xbe, 0xd8, 0xa1, 0x9d, 0x7b, 0x17, 0x90, 0x90, 0x39,
-	0x12, 0xf6, 0x3c, 0xc3, 0x16, 0x25, 0x0a, 0xf6, 0x68, 0xec, 0xf9, 0x21, 0x1e, 0x48, 0xd8, 0x0b,
-	0x1c, 0x9b, 0x97, 0x08, 0x6c, 0xe3, 0x25, 0xac, 0x9a, 0x98, 0x8c, 0x45, 0xcc, 0x3f, 0x67, 0xa4,
-	0x2b, 0x4e, 0xc8, 0x0d, 0x28, 0xb8, 0xf8, 0x5c, 0x9e, 0x8c, 0x45, 0x17, 0x9f, 0x53, 0x3e, 0xdb,
-	0x80, 0xb5, 0x04, 0x65, 0xbe, 0x97, 0x7e, 0x1d, 0xca, 0x26, 0x0e, 0xfa, 0xb6, 0x2b, 0x31, 0xed,
-	0x11, 0x3e, 0x71, 0x5c, 0xb1, 0x64, 0x1a, 0x5d, 0xb2, 0x25, 0x0a, 0x63, 0x6b, 0x85, 0x6e, 0x01,
-	0x70, 0x94, 0x98, 0x07, 0x8a, 0x0c, 0xc1, 0x0e, 0x4e, 0x8d, 0x1f, 0x41, 0x45, 0x90, 0xe4, 0xdc,
-	0xf7, 0x0e, 0x54, 0x7d, 0x0a, 0x71, 0xf1, 0xc0, 0x0a, 0x4f, 0x7d, 0x6f, 0x72, 0x72, 0xca, 0x09,
-	0xeb, 0x51, 0x41, 0x8f, 0xc1, 0x8d, 0xcf, 0x01, 0xb5, 0xf1, 0xeb, 0x30, 0x31, 0x05, 0x44, 0x87,
-	0xb0, 0x83, 0x60, 0x7c, 0xea, 0x13, 0x1d, 0x82, 0xc9, 0x47, 0x09, 0x72, 0x05, 0x66, 0x30, 0x3e,
-	0x86, 0x15, 0x85, 0xf0, 0xf5, 0x76, 0xda, 0x7f, 0xca, 0xf1, 0x7e, 0xb1, 0xd3, 0x43, 0xf4, 0x6b,
-	0xba, 0xa4, 0xfb, 0x1e, 0xcc, 0xbd, 0x72, 0xdc, 0x01, 0xed, 0x49, 0x65, 0xdb, 0x90, 0xb6, 0x5b,
-	0x9a, 0xcc, 0xd6, 0x73, 0xc7, 0x1d, 0x98, 0x14, 0x1f, 0x3d, 0x01, 0x38, 0xb1, 0xc7, 0xd6, 0xd8,
-	0x1b, 0x3a, 0xfd, 0x0b, 0xca, 0xb0, 0x95, 0xed, 0xb7, 0x66, 0xd7, 0x7e, 0x6a, 0x8f, 0x3b, 0x14,
-	0xdd, 0x2c, 0x9e, 0x88, 0x9f, 0xc6, 0x36, 0xcc, 0x11, 0xaa, 0x68, 0x15, 0xf4, 0xc7, 0xad, 0xce,
-	0xa3, 0x47, 0x1f, 0x7e, 0x68, 0x35, 0x5f, 0xf6, 0x9a, 0x66, 0xbb, 0xb1, 0xa7, 0x7f, 0x4b, 0x86,
-	0xb6, 0xda, 0x1c, 0xaa, 0x19, 0x0e, 0x14, 0x23, 0x5a, 0xa8, 0x0e, 0xeb, 0x4f, 0x1b, 0x1d, 0xab,
-	0x73, 0xb0, 0xd7, 0xda, 0xf9, 0xc2, 0x7a, 0xd1, 0xee, 0x76, 0x9a, 0x3b, 0xad, 0x27, 0xad, 0xe6,
-	0x2e, 0xab, 0x2e, 0x95, 0x35, 0x4d, 0xf3, 0xc0, 0xd4, 0x35, 0xb4, 0x06, 0x55, 0x09, 0xda, 0x7a,
-	0xda, 0x3e, 0x30, 0xc9, 0xb1, 0xb7, 0x02, 0xcb, 0x12, 0xf8, 0x73, 0xb3, 0xd1, 0xd1, 0xf3, 0x46,
-	0x9b, 0xaf, 0x86, 0x18, 0x09, 0x5f, 0x0d, 0xe9, 0xb8, 0xd6, 0xd4, 0xe3, 0xfa, 0x16, 0xc0, 0x78,
-	0x72, 0x34, 0x74, 0xfa, 0x64, 0x23, 0xf1, 0xf5, 0x2d, 0x32, 0xc8, 0x73, 0x7c, 0x61, 0xfc, 0x33,
-	0x0d, 0x36, 0x5a, 0x74, 0x43, 0x75, 0x7c, 0xe7, 0xcc, 0x0e, 0xf1, 0x73, 0x7c, 0x71, 0x55, 0xe6,
-	0x99, 0xae, 0x71, 0xbc, 0x49, 0xb4, 0x1a, 0x4a, 0x8e, 0x6e, 0xdf, 0x73, 0xe7, 0x98, 0xae, 0x48,
-	0xd1, 0x2c, 0x8f, 0xa3, 0x56, 0x3e, 0x77, 0x8e, 0xc9, 0x21, 0xcd, 0x18, 0x99, 0xca, 0x8d, 0x82,
-	0xc9, 0xbf, 0xc8, 0xb9, 0x41, 0xfe, 0xb7, 0x8e, 0x7d, 0x6f, 0x44, 0x85, 0xc4, 0xbc, 0x59, 0x20,
-	0x80, 0x27, 0xbe, 0x37, 0x32, 0xea, 0x50, 0x4b, 0xf7, 0x98, 0xef, 0xcb, 0x7f, 0xae, 0xc1, 0x0a,
-	0x2b, 0x64, 0x8a, 0xc8, 0x55, 0x87, 0xb2, 0x0e, 0x0b, 0x5c, 0x9b, 0x61, 0xfb, 0x92, 0x7f, 0x49,
-	0x1d, 0xcc, 0x4f, 0xef, 0xe0, 0x9c, 0xda, 0x41, 0xf4, 0x1e, 0x20, 0x1f, 0xff, 0x74, 0xe2, 0xf8,
-	0xd8, 0xf2, 0xf1, 0x00, 0xe3, 0x91, 0x7d, 0x34, 0xc4, 0x5c, 0x8f, 0xa8, 0xf2, 0x12, 0x33, 0x2a,
-	0x30, 0xbe, 0x80, 0x55, 0xb5, 0xcb, 0x7c, 0x4d, 0xef, 0x41, 0x69, 0xbc, 0x1d, 0x9c, 0x5a, 0xea,
-	0xc2, 0x2e, 0x11, 0x18, 0x5f, 0x7e, 0x32, 0x2c, 0xa9, 0x85, 0x1c, 0x6d, 0x41, 0x82, 0x18, 0x2e,
-	0x54, 0xb8, 0xb8, 0xbe, 0xa6, 0x4c, 0xfc, 0x2e, 0xac, 0xf3, 0x8e, 0x0e, 0xac, 0xbe, 0xe7, 0x1e,
-	0x3b, 0xfe, 0xc8, 0x66, 0x8a, 0x0e, 0xd3, 0xa6, 0xd6, 0x44, 0xe9, 0x8e, 0x5c, 0x68, 0xfc, 0xdd,
-	0x1c, 0x2c, 0x47, 0x0d, 0xf2, 0x61, 0xac, 0xc2, 0x3c, 0x3d, 0x37, 0x68, 0x43, 0x79, 0x93, 0x7d,
-	0x10, 0x35, 0x2c, 0x18, 0x63, 0x77, 0x10, 0x75, 0x3c, 0x6f, 0xc6, 0x00, 0xa2, 0x86, 0x39, 0xa3,
-	0x91, 0x1d, 0x4e, 0xe8, 0x14, 0x9e, 0xdb, 0xfe, 0x40, 0x68, 0xc5, 0x02, 0x6c, 0x52, 0x28, 0xfa,
-	0x01, 0xdc, 0x88, 0x10, 0x83, 0xd0, 0x7e, 0x85, 0xad, 0x13, 0xec, 0x62, 0x9f, 0x76, 0x87, 0x6b,
-	0xb4, 0x1b, 0x02, 0xa1, 0x4b, 0xca, 0x9f, 0x46, 0xc5, 0xe8, 0x6d, 0xa8, 0x92, 0x93, 0x14, 0x0f,
-	0xac, 0xa3, 0x0b, 0x2b, 0x74, 0xfa, 0xaf, 0x70, 0x18, 0xf0, 0xcb, 0xc5, 0x32, 0x2b, 0x78, 0x7c,
-	0xd1, 0x63, 0x60, 0xa2, 0xd1, 0x9f, 0x79, 0xa1, 0xe3, 0x9e, 0x58, 0xf6, 0x24, 0x3c, 0xf5, 0x7c,
-	0x27, 0xbc, 0xe0, 0xf7, 0x8d, 0x65, 0x06, 0x6f, 0x08, 0x30, 0xb9, 0x44, 0x4d, 0x5c, 0x3e, 0x67,
-	0x78, 0x40, 0x2f, 0x1c, 0x79, 0x53, 0x06, 0x19, 0x8f, 0x61, 0xed, 0x29, 0x0e, 0x25, 0xfd, 0x50,
-	0x2c, 0xce, 0xb7, 0xd5, 0x0b, 0x8b, 0xa4, 0xd3, 0xca, 0x37, 0x10, 0x7a, 0x5a, 0xfc, 0x9e, 0x06,
-	0xeb, 0x49, 0x22, 0x91, 0xd2, 0xa2, 0xdc, 0xe2, 0x08, 0x81, 0x4b, 0x35, 0x53, 0xe5, 0x92, 0xf7,
-	0x06, 0x94, 0xb3, 0xd6, 0x5c, 0x05, 0xd2, 0xe3, 0x2c, 0x56, 0x69, 0xf2, 0xfc, 0x38, 0x13, 0xba,
-	0x8c, 0xf1, 0x5f, 0x72, 0xc9, 0x0e, 0x46, 0xc2, 0x7f, 0x0b, 0x56, 0x82, 0xd0, 0xf6, 0xe9, 0x74,
-	0x4a, 0x24, 0xd8, 0x48, 0xab, 0xa2, 0x28, 0x56, 0x8b, 0xb6, 0x61, 0x2d, 0x89, 0x1f, 0x6b, 0xf6,
-	0x55, 0x73, 0x45, 0xad, 0xc1, 0x0e, 0xdb, 0xb7, 0xa1, 0x8a, 0xdd, 0x41, 0xa2, 0x05, 0xd6, 0xc9,
-	0x65, 0x56, 0x10, 0xd3, 0xdf, 0x82, 0x15, 0x15, 0x97, 0x51, 0x67, 0xdb, 0xba, 0x2a, 0x63, 0x33,
-	0xda, 0x9f, 0xc0, 0xe6, 0xc8, 0x71, 0x9d, 0xd1, 0x64, 0x64, 0xf9, 0xb8, 0x4f, 0xb4, 0x35, 0xe5,
-	0x2a, 0xc0, 0xe4, 0xd5, 0x0d, 0x8e, 0x62, 0x52, 0x0c, 0x79, 0x1a, 0xd0, 0x47, 0x50, 0x0b, 0x6d,
-	0xff, 0x04, 0x2b, 0xf5, 0x24, 0x1d, 0x67, 0xde, 0x5c, 0x67, 0xe5, 0x52, 0x2d, 0xa6, 0xe9, 0xfc,
-	0x0b, 0x0d, 0x36, 0x52, 0x93, 0xca, 0x97, 0xfd, 0x09, 0xa0, 0x91, 0x43, 0x35, 0x05, 0xb9, 0x33,
-	0x6c, 0xf5, 0x37, 0xa4, 0xd5, 0x97, 0x6f, 0x4e, 0x66, 0x95, 0x56, 0x51, 0x7a, 0xd7, 0x81, 0xd5,
-	0x89, 0x9b, 0x41, 0x29, 0x77, 0x95, 0x1b, 0xce, 0x0a, 0xaf, 0x2a, 0x53, 0x34, 0x3e, 0x00, 0x9d,
-	0x74, 0x9a, 0x6e, 0x25, 0xc1, 0x03, 0x77, 0x60, 0x89, 0x6d, 0x39, 0x79, 0xed, 0x81, 0x81, 0x28,
-	0xff, 0xfc, 0xa5, 0x1c, 0x54, 0xa3, 0x5a, 0xbf, 0x32, 0xac, 0xb3, 0x05, 0x2b, 0x62, 0xe9, 0xd9,
-	0xe8, 0x63, 0x3d, 0x78, 0xde, 0xac, 0xf2, 0x55, 0xa7, 0x25, 0x6c, 0xc1, 0xff, 0xe3, 0x1c, 0x20,
-	0x79, 0x16, 0xf8, 0x5a, 0xef, 0xc0, 0x02, 0xab, 0xcf, 0xd7, 0xf7, 0x1d, 0x69, 0x55, 0xd2, 0xe8,
-	0x5b, 0xec, 0x5b, 0xac, 0x11, 0xaf, 0x8a, 0x3e, 0x83, 0x79, 0xda, 0x69, 0x3a, 0x17, 0x4b, 0xdb,
-	0x6f, 0xcf, 0xa6, 0xa1, 0xb0, 0x0d, 0xab, 0x58, 0xff, 0xa3, 0x1c, 0x94, 0x15, 0xda, 0xe8, 0xbb,
-	0x89, 0x8e, 0x5d, 0xc2, 0x2e, 0xa2, 0x2b, 0xdf, 0x87, 0x45, 0x2a, 0xfc, 0xb1, 0xcf, 0x3b, 0x73,
-	0x49, 0x3d, 0x81, 0x8d, 0xfe, 0x2c, 0x94, 0xf9, 0x44, 0x06, 0xa1, 0x1d, 0x4e, 0x02, 0xae, 0xf8,
-	0x7d, 0x74, 0x8d, 0xf9, 0xe0, 0x5f, 0x5d, 0x5a, 0xdf, 0x2c, 0x85, 0xd2, 0x97, 0xf1, 0x53, 0x28,
-	0xc9, 0xa5, 0x68, 0x09, 0x16, 0x5f, 0xb4, 0x9f, 0xb7, 0x0f, 0x3e, 0x6f, 0xeb, 0xdf, 0x62, 0x1f,
-	0xfb, 0xad, 0x76, 0x73, 0x57, 0xd7, 0x50, 0x09, 0x0a, 0xad, 0xfd, 0xfd, 0x46, 0xef, 0x05, 0x55,
-	0xdd, 0x0a, 0x30, 0xb7, 0xd7, 0x3a, 0x6c, 0xea, 0x79, 0x54, 0x84, 0xf9, 0xc3, 0x83, 0x5e, 0x73,
-	0x57, 0x9f, 0x43, 0x00, 0x0b, 0xfb, 0xad, 0x6e, 0xb7, 0xb9, 0xab, 0xcf, 0x93, 0xba, 0xcd, 0x97,
-	0x9d, 0x96, 0xd9, 0xdc, 0xd5, 0x17, 0x98, 0x65, 0xe4, 0xf0, 0xe0, 0x79, 0x73, 0x57, 0x5f, 0xac,
-	0xbf, 0xfc, 0x45, 0xd9, 0x36, 0x8c, 0x55, 0x40, 0x6c, 0x30, 0x1d, 0xdf, 0x89, 0x14, 0x02, 0xa3,
-	0x03, 0x2b, 0x0a, 0x34, 0x56, 0x3e, 0xf8, 0xc4, 0x8e, 0x09, 0x9c, 0x1f, 0xde, 0x7c, 0xcf, 0x52,
-	0xd4, 0x69, 0xbd, 0x30, 0x10, 0xe8, 0xf4, 0xa8, 0x6d, 0xb9, 0xc7, 0x9e, 0x68, 0xe5, 0x8f, 0x72,
-	0x50, 0x95, 0x80, 0xb1, 0x79, 0x60, 0xec, 0x79, 0x43, 0x2b, 0x70, 0x7e, 0x16, 0x99, 0x07, 0x08,
-	0xa0, 0xeb, 0xfc, 0x0c, 0x13, 0x1d, 0xd2, 0x1e, 0x0e, 0xad, 0x11, 0x1e, 0x51, 0x9c, 0xd0, 0x79,
-	0xcd, 0xb5, 0xcc, 0xb2, 0x3d, 0x1c, 0xee, 0x33, 0x68, 0xcf, 0x79, 0x4d, 0xf0, 0xbc, 0x73, 0x57,
-	0xc1, 0x63, 0xc6, 0xd5, 0xb2, 0x77, 0xee, 0x4a, 0x78, 0x75, 0x28, 0x08, 0x4d, 0x80, 0xdf, 0x52,
-	0xa3, 0x6f, 0x32, 0xc9, 0x43, 0xe7, 0x0c, 0xf3, 0xfb, 0x28, 0xfd, 0x4d, 0xf4, 0x96, 0x33, 0x2f,
-	0xc4, 0x03, 0x7e, 0xed, 0x64, 0x1f, 0x64, 0xd0, 0x23, 0x27, 0x08, 0xf8, 0xc1, 0x5e, 0x36, 0xf9,
-	0x17, 0xd1, 0x85, 0x7d, 0x7c, 0xe6, 0xbd, 0xc2, 0x83, 0x5a, 0x81, 0xe9, 0xc2, 0xfc, 0x93, 0x94,
-	0xe0, 0xd7, 0x63, 0xa2, 0x2b, 0xd5, 0x8a, 0xac, 0x84, 0x7f, 0xc6, 0xd7, 0xec, 0x60, 0x72, 0x14,
-	0x38, 0x83, 0x8b, 0x1a, 0x48, 0xd7, 0xec, 0x2e, 0x83, 0x91, 0xea, 0x13, 0x97, 0xb0, 0x7b, 0x58,
-	0x5b, 0x62, 0xd5, 0xf9, 0xa7, 0xd1, 0x03, 0x9d, 0x72, 0x8a, 0x34, 0xcf, 0x89, 0x43, 0x59, 0x4b,
-	0x1c, 0xca, 0xf4, 0x96, 0x9a, 0x94, 0x82, 0xe4, 0x96, 0x1a, 0x4b, 0x28, 0xe3, 0x6f, 0xe4, 0xa0,
-	0x2a, 0x91, 0xe5, 0x2b, 0xf5, 0xa7, 0xa6, 0x9b, 0x56, 0x2a, 0xf2, 0x59, 0x4a, 0x85, 0xc2, 0xc1,
-	0x73, 0x49, 0xeb, 0x9c, 0xd4, 0x8c, 0x4d, 0x64, 0xc5, 0x3c, 0x33, 0x50, 0xf3, 0x66, 0x08, 0x88,
-	0xdc, 0x99, 0x99, 0x1e, 0xe8, 0xb8, 0x67, 0xf6, 0xd0, 0x19, 0xd8, 0x62, 0x05, 0x0b, 0xa6, 0x1e,
-	0x30, 0x06, 0x8c, 0xe0, 0x59, 0xd6, 0xbe, 0xc5, 0x2c, 0x6b, 0x9f, 0xf1, 0x07, 0x1a, 0x6c, 0xec,
-	0x9c, 0xda, 0xee, 0x09, 0xee, 0x44, 0x77, 0x06, 0x31, 0xe5, 0x1f, 0x41, 0x9e, 0xdc, 0xac, 0x34,
-	0x2a, 0x78, 0xde, 0x94, 0x04, 0xcf, 0x94, 0x0a, 0x5b, 0xe4, 0xbe, 0x42, 0xaa, 0x10, 0x5d, 0xdc,
-	0x1b, 0x0e, 0x2c, 0xe9, 0x62, 0xc2, 0x2e, 0x1f, 0x65, 0x6f, 0x38, 0x88, 0xab, 0x11, 0x34, 0x17,
-	0x9f, 0xcb, 0x68, 0xec, 0x30, 0x2a, 0xbb, 0xf8, 0x3c, 0x46, 0x33, 0x6e, 0x43, 0xfe, 0x39, 0xbe,
-	0x20, 0xc2, 0xa4, 0x63, 0xb6, 0x0e, 0x1b, 0xbd, 0xa6, 0xfe, 0x2d, 0x22, 0x72, 0x3a, 0x2f, 0x1e,
-	0xef, 0xb5, 0x76, 0x74, 0x8d, 0x5c, 0x9b, 0xd2, 0x3d, 0xe2, 0xd7, 0xa6, 0xaf, 0x72, 0xb0, 0xfe,
-	0x64, 0xe2, 0x0e, 0x32, 0x74, 0xd2, 0xd9, 0x36, 0x49, 0x76, 0x96, 0x71, 0x0b, 0xb2, 0xb0, 0x49,
-	0x52, 0x20, 0x33, 0x5b, 0xcf, 0xb8, 0x48, 0xe4, 0x67, 0x5c, 0x24, 0xd0, 0xc7, 0x50, 0x77, 0xdc,
-	0xfe, 0x70, 0x32, 0xc0, 0x56, 0xa4, 0xdf, 0xf7, 0x3d, 0xc7, 0x3d, 0xb2, 0x03, 0x1c, 0xf0, 0xcb,
-	0x62, 0x8d, 0x63, 0xb4, 0x38, 0xc2, 0x8e, 0x28, 0x27, 0xa7, 0xbe, 0xa8, 0xdd, 0xa7, 0x43, 0x16,
-	0xa6, 0x6a, 0x76, 0x07, 0x5b, 0xe1, 0x85, 0x6c, 0x3a, 0xb8, 0xc5, 0xfa, 0x5f, 0xe5, 0x61, 0x23,
-	0x35, 0x05, 0x9c, 0xfb, 0xff, 0x0c, 0xe8, 0x01, 0x1e, 0xe2, 0x7e, 0x88, 0x07, 0x16, 0x33, 0x73,
-	0x0b, 0x73, 0xe0, 0x77, 0xa4, 0xf5, 0x9e, 0x52, 0x7b, 0xab, 0xc3, 0x0d, 0xf9, 0xfc, 0x39, 0x63,
-	0x59, 0x90, 0x62, 0xdf, 0x01, 0x15, 0xb5, 0x54, 0x0c, 0x28, 0xd3, 0xb8, 0x44, 0x61, 0x7c, 0x16,
-	0x1f, 0x82, 0xce, 0x07, 0x32, 0x7e, 0x25, 0xc6, 0xc2, 0x98, 0xa0, 0xc2, 0xe0, 0x9d, 0x57, 0x6c,
-	0x18, 0xf5, 0x3f, 0xd1, 0xa0, 0xa2, 0x36, 0x78, 0x8d, 0x5b, 0x05, 0xe9, 0x0a, 0xb7, 0xed, 0xb3,
-	0x07, 0x06, 0x26, 0x70, 0x97, 0x18, 0xac, 0x45, 0x9f, 0x19, 0xe2, 0x07, 0x83, 0xbc, 0xf2, 0x60,
-	0x40, 0x64, 0x79, 0xd4, 0xb7, 0x39, 0x4a, 0xbe, 0x30, 0xe6, 0xbd, 0x22, 0x74, 0x89, 0xa6, 0xec,
-	0x9c, 0x61, 0x8b, 0xec, 0x66, 0x7e, 0xcb, 0x5a, 0xe2, 0xb0, 0x9e, 0xc3, 0x6c, 0x8e, 0xe4, 0x32,
-	0x1d, 0xad, 0x32, 0xdf, 0xb4, 0x25, 0x02, 0x14, 0x2b, 0x4b, 0xe4, 0x74, 0xe8, 0x63, 0xf6, 0x8a,
-	0x33, 0x6f, 0xd2, 0xdf, 0xc6, 0x1f, 0x6a, 0xb0, 0xf6, 0x82, 0x89, 0x44, 0x3e, 0xa3, 0xbf, 0xc4,
-	0xac, 0x6b, 0xfc, 0xcd, 0x5c, 0x62, 0x34, 0x11, 0x13, 0xfe, 0x6a, 0x2f, 0x23, 0x39, 0x61, 0x58,
-	0x17, 0xac, 0x60, 0x32, 0xa2, 0x67, 0x68, 0xde, 0x2c, 0x32, 0x48, 0x77, 0x32, 0x32, 0xbe, 0x5a,
-	0x80, 0xcd, 0x1d, 0xcf, 0x0d, 0x42, 0x7f, 0xd2, 0xcf, 0xba, 0x3a, 0x3f, 0x80, 0x4a, 0xe0, 0x4d,
-	0xfc, 0x3e, 0xb6, 0xd4, 0x25, 0x2f, 0x33, 0xa8, 0xb0, 0x91, 0x7f, 0x3d, 0xbb, 0x06, 0xba, 0x09,
-	0x70, 0x8c, 0xb1, 0x35, 0xc6, 0xbe, 0xf5, 0xea, 0x88, 0x2f, 0x7f, 0xe1, 0x18, 0xe3, 0x0e, 0xf6,
-	0x9f, 0x1f, 0xa1, 0xbf, 0x00, 0x75, 0xf1, 0x22, 0x46, 0xb7, 0x36, 0x59, 0x1e, 0x7b, 0x78, 0xe2,
-	0xf9, 0x4e, 0x78, 0xca, 0xac, 0x43, 0x95, 0xed, 0x4f, 0xe5, 0x83, 0x61, 0xfa, 0x38, 0xf8, 0x9b,
-	0x67, 0x57, 0xd0, 0x69, 0x08, 0x32, 0x66, 0xcd, 0x9b, 0x52, 0x82, 0xbe, 0x04, 0xe4, 0x92, 0xfb,
-	0x23, 0x13, 0x10, 0x42, 0x3e, 0xcd, 0x53, 0xf9, 0xf4, 0xde, 0xb5, 0x9a, 0x35, 0x75, 0xd7, 0x73,
-	0x99, 0x54, 0x14, 0xc2, 0xe9, 0x04, 0x10, 0x27, 0x3c, 0xc0, 0x41, 0xe8, 0xb8, 0xcc, 0xb2, 0xb2,
-	0x40, 0x95, 0xf4, 0x8f, 0xae, 0x45, 0x7c, 0x37, 0xae, 0x6f, 0x56, 0x19, 0x4d, 0x09, 0x54, 0x1f,
-	0x42, 0x35, 0x85, 0x37, 0xc3, 0xac, 0x39, 0xcd, 0x60, 0x47, 0xf8, 0x80, 0xfe, 0xb2, 0xf8, 0x73,
-	0xbc, 0x50, 0x06, 0x19, 0x94, 0x3f, 0xe6, 0xd7, 0xff, 0x7c, 0xf4, 0x98, 0xfa, 0x13, 0x58, 0x92,
-	0x47, 0xa6, 0xfd, 0x29, 0x47, 0x26, 0x13, 0x93, 0x36, 0x59, 0x4e, 0xde, 0x64, 0xc6, 0x87, 0x50,
-	0x9b, 0xb6, 0xce, 0x68, 0x19, 0x96, 0x54, 0x9b, 0xf1, 0x22, 0xe4, 0x1b, 0x7b, 0x7b, 0xba, 0x66,
-	0xfc, 0xad, 0x1c, 0xdc, 0xcc, 0xee, 0x0c, 0x97, 0x10, 0xdf, 0x21, 0x37, 0xf7, 0xc0, 0x39, 0x49,
-	0x5c, 0xdd, 0xb9, 0x94, 0x58, 0x11, 0x65, 0x52, 0x55, 0xf4, 0x29, 0xdc, 0x64, 0x67, 0x4f, 0xf4,
-	0x08, 0xcd, 0x39, 0x59, 0xe9, 0xf7, 0x0d, 0x8a, 0xa3, 0x1e, 0x2b, 0x5c, 0x48, 0x92, 0x0b, 0x2d,
-	0x25, 0xa0, 0xd6, 0x63, 0x42, 0xa5, 0x4a, 0x8b, 0x14, 0xfc, 0x6d, 0x58, 0x23, 0x13, 0x34, 0x22,
-	0xfa, 0x97, 0xc5, 0xfb, 0x4a, 0xd5, 0x7f, 0xa6, 0x92, 0xaf, 0x44, 0x85, 0x5d, 0x5a, 0x46, 0x6f,
-	0x02, 0xf7, 0xa0, 0xc4, 0x79, 0x90, 0x89, 0x33, 0x76, 0x5b, 0x5e, 0x62, 0x30, 0x2a, 0xce, 0x8c,
-	0xff, 0x9d, 0x83, 0x75, 0x52, 0x23, 0x43, 0x32, 0x5c, 0x66, 0xfa, 0xfd, 0x2e, 0xac, 0x07, 0xd8,
-	0x77, 0xec, 0xa1, 0xf3, 0xb3, 0xc4, 0xbc, 0x31, 0xce, 0x5a, 0x8b, 0x4b, 0xe5, 0x99, 0xb3, 0x01,
-	0xd9, 0x83, 0x81, 0x43, 0x7e, 0x13, 0x0d, 0x9e, 0x72, 0x97, 0x78, 0x06, 0xde, 0x96, 0xd8, 0x27,
-	0xbb, 0x57, 0x5b, 0x8d, 0xa8, 0x2e, 0xb7, 0xfa, 0x56, 0xed, 0x04, 0x24, 0xa8, 0xff, 0x75, 0x0d,
-	0xf4, 0x24, 0xde, 0x37, 0x7c, 0x0c, 0x08, 0x49, 0x9c, 0x97, 0x24, 0xf1, 0xac, 0x23, 0xe0, 0xc7,
-	0x73, 0x85, 0xbc, 0x3e, 0x67, 0x96, 0x1d, 0x37, 0x22, 0x8b, 0xc9, 0x35, 0x79, 0x23, 0x35, 0x4c,
-	0xce, 0x93, 0x77, 0xd3, 0xc6, 0xc8, 0x84, 0x4b, 0xc9, 0x87, 0xb0, 0x1e, 0x71, 0xad, 0x42, 0x96,
-	0x5a, 0x9c, 0xca, 0x66, 0xc4, 0xd3, 0xd4, 0xe3, 0xa2, 0xc5, 0x9b, 0xfc, 0x6f, 0xf9, 0x54, 0x9b,
-	0xc1, 0x55, 0x57, 0xfc, 0x27, 0x89, 0xb7, 0x7b, 0x66, 0xd9, 0xfa, 0xde, 0xf4, 0x45, 0x8b, 0x5e,
-	0x8c, 0x5e, 0xa4, 0xb7, 0x90, 0xfa, 0xa8, 0x8f, 0x8e, 0x32, 0xd9, 0x82, 0x39, 0xcb, 0x7c, 0x70,
-	0x85, 0x16, 0x7e, 0x49, 0xf9, 0xa2, 0xbe, 0x07, 0x2b, 0x19, 0x93, 0x33, 0x63, 0x73, 0x69, 0x33,
-	0x36, 0x97, 0xf1, 0xdf, 0x35, 0xa8, 0xa5, 0x67, 0x88, 0xb3, 0xd4, 0x17, 0x89, 0xe5, 0x63, 0x9a,
-	0xf8, 0x77, 0x67, 0x4e, 0x2e, 0x57, 0xc5, 0xbb, 0xb3, 0x57, 0xaf, 0xfe, 0x0a, 0xaa, 0x29, 0x94,
-	0x5f, 0x18, 0x0b, 0xff, 0xe3, 0x3c, 0xac, 0xef, 0xf8, 0xd8, 0x0e, 0x31, 0x69, 0x93, 0xbf, 0x6a,
-	0x5c, 0xfd, 0xe5, 0x8d, 0x9f, 0x8b, 0x39, 0xf5, 0x5c, 0x9c, 0x3e, 0xe1, 0xf9, 0x59, 0xd2, 0xec,
-	0x0e, 0x2c, 0x49, 0x1d, 0xe7, 0xc2, 0x18, 0x9c, 0xa8, 0xbb, 0xe8, 0xc7, 0x50, 0x24, 0x2c, 0xc5,
-	0x3c, 0x39, 0xe6, 0x53, 0x4e, 0x54, 0xd9, 0xe3, 0x20, 0xf3, 0x4d, 0x38, 0x8e, 0xfa, 0x84, 0x14,
-	0x4e, 0xf9, 0x2f, 0xf4, 0x2e, 0xa0, 0xe8, 0xb8, 0x89, 0x39, 0x8a, 0xb9, 0x11, 0x45, 0x8e, 0x53,
-	0xe2, 0x46, 0x63, 0xfc, 0x15, 0x0d, 0x96, 0x24, 0x3a, 0xe4, 0x80, 0xec, 0xb6, 0x9e, 0x3e, 0x6b,
-	0x74, 0x9f, 0x59, 0x07, 0x7b, 0xe4, 0x80, 0x94, 0x00, 0xf4, 0xa0, 0x44, 0x3a, 0x94, 0x04, 0xa0,
-	0x7d, 0xd0, 0x6e, 0xea, 0x39, 0x84, 0xa0, 0x22, 0x20, 0xdd, 0x56, 0xfb, 0xe9, 0x5e, 0x53, 0xcf,
-	0xa3, 0x55, 0xd0, 0xa5, 0x6a, 0x87, 0x8d, 0xbd, 0x17, 0x4d, 0x7d, 0x0e, 0xdd, 0x80, 0xd5, 0x08,
-	0xda, 0xfe, 0xe2, 0xa0, 0xdd, 0xdc, 0x69, 0xb4, 0x3b, 0x8d, 0x2f, 0xf4, 0xaf, 0x34, 0xe3, 0x10,
-	0x36, 0x52, 0xc3, 0xe4, 0x2c, 0x79, 0x13, 0x8a, 0x81, 0x00, 0x0a, 0xeb, 0x48, 0x04, 0xc8, 0x78,
-	0x82, 0x2d, 0xc9, 0x4f, 0xb0, 0x3f, 0x86, 0x1b, 0x1d, 0xf2, 0x11, 0x9c, 0x66, 0x9c, 0x5e, 0xef,
-	0x01, 0x9a, 0x7a, 0xa2, 0x57, 0x53, 0xfb, 0xcd, 0x78, 0x0a, 0xf5, 0x2c, 0x5a, 0xd7, 0xbe, 0x42,
-	0x18, 0xf7, 0xe1, 0x1e, 0x27, 0xf4, 0x22, 0x6d, 0xd1, 0x17, 0x56, 0xbd, 0x37, 0xc0, 0x98, 0x85,
-	0x24, 0x8c, 0x0b, 0x79, 0x58, 0xef, 0x4c, 0xfc, 0xfe, 0xa9, 0x1d, 0xe0, 0x84, 0x39, 0xff, 0xeb,
-	0xbf, 0x30, 0xdf, 0x81, 0x25, 0x6a, 0x03, 0xb6, 0x86, 0xce, 0xc8, 0x11, 0xfa, 0x06, 0x50, 0xd0,
-	0x1e, 0x81, 0xcc, 0xd0, 0xf4, 0x19, 0x73, 0x4f, 0xd1, 0xf4, 0x1f, 0x40, 0x85, 0xdb, 0x3d, 0x55,
-	0xf7, 0x37, 0x6e, 0x66, 0x16, 0x0f, 0xaf, 0x77, 0x60, 0xc9, 0x9d, 0x8c, 0xa2, 0x57, 0x43, 0x66,
-	0x22, 0x04, 0x77, 0x32, 0x12, 0x0f, 0x86, 0xf7, 0xa0, 0x44, 0xcd, 0x91, 0x82, 0xca, 0x22, 0x7f,
-	0xbc, 0xf5, 0xbc, 0xa1, 0xa0, 0x21, 0xac, 0x9f, 0xc7, 0x18, 0x07, 0xf4, 0xc2, 0xa3, 0x31, 0xeb,
-	0xe7, 0x13, 0x8c, 0xa9, 0x7e, 0x4b, 0xcd, 0x84, 0x17, 0xdc, 0x68, 0xc8, 0xbf, 0xd0, 0x1a, 0x2c,
-	0x84, 0xaf, 0x49, 0x15, 0x6e, 0x2c, 0x9c, 0x0f, 0x5f, 0x3f, 0x61, 0xb7, 0x27, 0xde, 0x6d, 0x52,
-	0xb4, 0x24, 0x0c, 0x67, 0x04, 0xf2, 0x04, 0x63, 0xe3, 0x13, 0xd8, 0x48, 0xad, 0x00, 0xe7, 0x89,
-	0xfb, 0x91, 0x05, 0x9d, 0xb0, 0x03, 0x66, 0xe2, 0xb4, 0x24, 0xec, 0xe0, 0xcf, 0x28, 0xcc, 0xf8,
-	0x1e, 0xac, 0x9a, 0xd4, 0x9c, 0x79, 0xbd, 0xf5, 0x63, 0xfe, 0x33, 0x4a, 0x3d, 0xce, 0x13, 0xb7,
-	0xe1, 0xe6, 0x9e, 0x67, 0x0f, 0x1a, 0xd4, 0xa9, 0x6c, 0xd7, 0x0e, 0xed, 0x27, 0xce, 0x30, 0xc4,
-	0x7e, 0xc4, 0x59, 0x77, 0xe0, 0xd6, 0x94, 0x72, 0x4e, 0xe0, 0x14, 0x10, 0xd9, 0x86, 0xfb, 0x38,
-	0x08, 0xec, 0x13, 0x2c, 0xdf, 0xf8, 0xb3, 0xef, 0x0b, 0x35, 0x58, 0x1c, 0x31, 0x5c, 0x21, 0x31,
-	0xf9, 0x67, 0x62, 0x0c, 0xf9, 0xd4, 0x18, 0x3e, 0x80, 0x15, 0xa5, 0xa5, 0xab, 0x6c, 0x79, 0xe3,
-	0xf7, 0x35, 0xa5, 0xd6, 0x95, 0x19, 0xfe, 0x31, 0x14, 0x78, 0xbf, 0x84, 0x5a, 0xf2, 0x66, 0xe2,
-	0x5c, 0x4b, 0x50, 0xdc, 0x12, 0xfd, 0x8a, 0xea, 0xd5, 0x7f, 0x04, 0x8b, 0x1c, 0xf8, 0x75, 0xe6,
-	0xc3, 0xf8, 0xdb, 0x1a, 0xac, 0xaa, 0x0d, 0x45, 0x8f, 0x4e, 0x8b, 0x3e, 0x1e, 0x0f, 0x1d, 0x2c,
-	0x8e, 0xdc, 0x6f, 0x4f, 0xed, 0x9a, 0x74, 0xdc, 0x9a, 0x78, 0x3c, 0xbc, 0x30, 0x45, 0xcd, 0xfa,
-	0xa7, 0x50, 0x8c, 0xa0, 0x97, 0x88, 0xcd, 0x55, 0x98, 0xc7, 0xbe, 0xcf, 0x3d, 0xa8, 0x8b, 0x26,
-	0xfb, 0x30, 0xee, 0xc1, 0x1d, 0x49, 0xca, 0xb4, 0xbd, 0xd0, 0x39, 0x76, 0xfa, 0xb6, 0x22, 0x96,
-	0x7e, 0x37, 0x07, 0x77, 0xa7, 0xe3, 0xf0, 0xd1, 0x7c, 0x06, 0xcb, 0x76, 0x18, 0xda, 0xfd, 0x53,
-	0x3c, 0x60, 0x6f, 0x77, 0x62, 0x54, 0x53, 0xdf, 0x4a, 0x2b, 0x02, 0x9f, 0x42, 0x03, 0xf4, 0x16,
-	0x2c, 0x0f, 0xb0, 0x4a, 0x21, 0x47, 0xf7, 0x4e, 0x45, 0x80, 0x39, 0xe2, 0xb4, 0x17, 0xd5, 0xfc,
-	0xd7, 0x7d, 0x51, 0x45, 0x1f, 0x43, 0x3d, 0x83, 0xa2, 0xd8, 0xc1, 0x73, 0xb4, 0x17, 0xb5, 0x74,
-	0x45, 0xbe, 0x9b, 0x6f, 0xc1, 0xa6, 0xf0, 0x9e, 0xcc, 0x9a, 0xbe, 0xff, 0xa5, 0xc1, 0xcd, 0xec,
-	0xf2, 0x6b, 0xb9, 0x7e, 0x5d, 0xc5, 0xd1, 0x30, 0xdb, 0x87, 0x30, 0x7f, 0x2d, 0x1f, 0xc2, 0xb9,
-	0x6b, 0xf9, 0x10, 0xce, 0x4f, 0xf1, 0x21, 0xfc, 0x0d, 0xb8, 0x2b, 0x1f, 0x04, 0x59, 0x13, 0x43,
-	0x04, 0x76, 0xf8, 0x5a, 0x15, 0x93, 0x85, 0xf0, 0x35, 0x9b, 0x54, 0x22, 0x81, 0x83, 0xd0, 0x1b,
-	0x5b, 0xf6, 0x71, 0xc8, 0x5f, 0x31, 0xe7, 0xcd, 0x22, 0x81, 0x34, 0x08, 0xc0, 0xf8, 0x27, 0x39,
-	0xb8, 0x37, 0xa3, 0x01, 0x3e, 0xb3, 0xaf, 0x92, 0x8f, 0x24, 0x8c, 0x25, 0x9b, 0xaa, 0x39, 0x62,
-	0x36, 0x91, 0x2d, 0xc5, 0x6b, 0x40, 0x22, 0x96, 0x78, 0x6b, 0xa9, 0xff, 0x8e, 0x06, 0xb5, 0x69,
-	0xb8, 0x68, 0x03, 0x16, 0xf9, 0x58, 0xf9, 0xc6, 0x5c, 0x60, 0x23, 0xfd, 0x46, 0x9c, 0x43, 0x52,
-	0xef, 0x45, 0x73, 0xe9, 0x77, 0xa8, 0xbf, 0xac, 0xc1, 0x0a, 0x53, 0xb7, 0x3e, 0xa7, 0x63, 0x17,
-	0x8b, 0xf0, 0x0e, 0x54, 0xb9, 0x32, 0x95, 0x12, 0xa4, 0x3a, 0x2b, 0x90, 0x9e, 0x4e, 0xde, 0x23,
-	0x9a, 0x26, 0xf3, 0x43, 0x4b, 0xbd, 0xb2, 0x54, 0x79, 0x89, 0x84, 0x8e, 0x60, 0x2e, 0xc0, 0x78,
-	0xc0, 0xfb, 0x4b, 0x7f, 0x1b, 0xeb, 0xb0, 0xaa, 0x76, 0x83, 0x1f, 0x40, 0xaf, 0xe1, 0x8e, 0x80,
-	0x87, 0xfd, 0x53, 0xc7, 0x3d, 0x39, 0x70, 0x87, 0x17, 0x6a, 0x57, 0x1f, 0x02, 0xe5, 0x61, 0x77,
-	0x80, 0x07, 0xd6, 0x78, 0x72, 0x64, 0x89, 0x67, 0xa2, 0xa2, 0x59, 0x11, 0xf0, 0xce, 0xe4, 0xe8,
-	0x39, 0xbe, 0xc8, 0x1e, 0x54, 0x2e, 0x7b, 0x50, 0x86, 0x01, 0x77, 0xa7, 0xb7, 0xcc, 0x7b, 0xf7,
-	0x19, 0x54, 0x0f, 0xc6, 0xd8, 0xfd, 0xfa, 0x53, 0x67, 0xfc, 0x1a, 0x20, 0x99, 0x42, 0xac, 0x2d,
-	0x9c, 0xf3, 0x56, 0x2d, 0xcf, 0x1d, 0xb2, 0xf1, 0x14, 0xcc, 0xd2, 0xb9, 0xd4, 0x15, 0x63, 0x15,
-	0xd0, 0xce, 0xd0, 0x0b, 0xd4, 0x85, 0x33, 0xd6, 0x60, 0x45, 0x81, 0xf2, 0x9e, 0xae, 0xc1, 0x0a,
-	0x83, 0x34, 0x5f, 0x3b, 0x41, 0xec, 0x8e, 0xbd, 0x05, 0xab, 0x2a, 0x98, 0x77, 0x80, 0xea, 0x45,
-	0x04, 0xc2, 0x5b, 0xe6, 0x5f, 0xc6, 0xef, 0x92, 0x1b, 0x63, 0x68, 0xfb, 0xe1, 0x0e, 0x41, 0x73,
-	0x83, 0x49, 0x60, 0x8e, 0xfb, 0x62, 0xe0, 0x6f, 0xc1, 0x32, 0xf7, 0x66, 0x4f, 0x38, 0xd3, 0x55,
-	0x38, 0x58, 0xa8, 0x64, 0x75, 0x28, 0x4c, 0x02, 0x22, 0x46, 0x22, 0x71, 0x15, 0x7d, 0x93, 0x32,
-	0x32, 0x6d, 0xe7, 0x9e, 0x2f, 0x18, 0x24, 0xfa, 0x26, 0x57, 0xc4, 0x3e, 0xf6, 0xf9, 0x66, 0xc4,
-	0xfc, 0x72, 0x2c, 0x83, 0x8c, 0x4d, 0xb8, 0x91, 0xd1, 0x3d, 0x3e, 0x07, 0x7f, 0x5f, 0x83, 0xda,
-	0xae, 0x13, 0xf4, 0xbd, 0x33, 0xec, 0xf3, 0xae, 0xc4, 0x2a, 0xc3, 0x3b, 0x50, 0x1d, 0xf0, 0x32,
-	0x4b, 0x72, 0x46, 0xa7, 0x2f, 0x9a, 0xa2, 0x40, 0x78, 0xa2, 0x5f, 0x97, 0xe1, 0xa7, 0xb8, 0xd3,
-	0xe4, 0xa7, 0xb8, 0xd3, 0x90, 0x51, 0x64, 0xf4, 0x93, 0x8f, 0xe2, 0x16, 0x6c, 0x3e, 0xc1, 0x61,
-	0xff, 0x74, 0xdf, 0x09, 0x02, 0xc7, 0x3d, 0xd9, 0x49, 0xa8, 0x74, 0xb7, 0xe1, 0x66, 0x76, 0x31,
-	0xaf, 0xfe, 0x26, 0xbc, 0xd1, 0x9d, 0x1c, 0x91, 0xcb, 0xe0, 0x11, 0xee, 0x79, 0xb4, 0xcd, 0xcc,
-	0xe3, 0xe9, 0x2d, 0x78, 0x70, 0x09, 0x5e, 0xcc, 0x59, 0xb4, 0x41, 0xf6, 0x32, 0x1c, 0xd5, 0xff,
-	0x87, 0x39, 0x58, 0x55, 0xe1, 0x9c, 0xb5, 0xb6, 0x61, 0xed, 0x98, 0xc0, 0xf1, 0x80, 0xbf, 0x2f,
-	0x07, 0x96, 0xfc, 0x92, 0xb0, 0xc2, 0x0b, 0x79, 0x35, 0x76, 0xc8, 0xbc, 0x0f, 0xab, 0xc7, 0x8e,
-	0x1f, 0x84, 0x96, 0x8b, 0xcf, 0xd3, 0x1e, 0xfe, 0x55, 0x5a, 0xd6, 0xc6, 0xe7, 0xb1, 0xc3, 0xd0,
-	0x07, 0xb0, 0x9e, 0xaa, 0x20, 0x3b, 0xf9, 0xaf, 0xa8, 0x55, 0xd8, 0xdb, 0xf9, 0x47, 0x70, 0x63,
-	0x64, 0x3b, 0xd4, 0xc4, 0xef, 0xb8, 0x56, 0xe8, 0x8c, 0xe5, 0xa6, 0x18, 0xb3, 0xad, 0x11, 0x84,
-	0x1d, 0x52, 0xde, 0x73, 0xc6, 0x71, 0x73, 0x1f, 0xc3, 0x66, 0x76, 0x4d, 0xd6, 0x26, 0xb3, 0xa4,
-	0x6e, 0xa4, 0xeb, 0x32, 0x19, 0xfc, 0x1a, 0x6a, 0xf2, 0x4c, 0xc9, 0xd3, 0x3c, 0x7b, 0xb6, 0xe6,
-	0xb3, 0x67, 0xeb, 0x21, 0xe8, 0x43, 0x3b, 0x08, 0x79, 0x05, 0xf6, 0x86, 0xc4, 0x2c, 0xcc, 0x15,
-	0x02, 0x67, 0xb8, 0x3d, 0x67, 0x84, 0x8d, 0xbf, 0xa7, 0xc1, 0xdd, 0x2c, 0x6e, 0x51, 0xba, 0xd0,
-	0x80, 0x5b, 0xa2, 0x0b, 0xfd, 0x63, 0x56, 0x6e, 0x51, 0x9e, 0x55, 0x9d, 0xf0, 0xeb, 0x1c, 0x69,
-	0x87, 0xe3, 0xd0, 0x7d, 0xc8, 0x67, 0xf6, 0x47, 0xb0, 0x99, 0x22, 0x41, 0x6e, 0x95, 0x8a, 0x1f,
-	0x43, 0x2d, 0x41, 0xa0, 0xe9, 0x0e, 0xf8, 0x04, 0xb5, 0xa0, 0xce, 0x7c, 0xf6, 0x3b, 0xbe, 0x77,
-	0x42, 0xb6, 0x83, 0xd2, 0xbf, 0x6b, 0xf9, 0xef, 0x3f, 0x07, 0xbd, 0x83, 0xb1, 0xaf, 0x10, 0xb8,
-	0x05, 0x30, 0xc6, 0xd8, 0x57, 0x26, 0xb6, 0x48, 0x20, 0x3b, 0xc9, 0x18, 0x2d, 0xd5, 0x0a, 0x64,
-	0xfc, 0x89, 0x06, 0x15, 0x73, 0xdc, 0xef, 0x5e, 0xb8, 0xff, 0x0f, 0xc9, 0xc0, 0x6c, 0x49, 0x36,
-	0x7f, 0x2d, 0x49, 0xb6, 0x30, 0x45, 0x92, 0x19, 0xff, 0x26, 0x0f, 0xcb, 0xd1, 0x88, 0xe3, 0xb3,
-	0x22, 0xb8, 0x70, 0xfb, 0x78, 0x20, 0xce, 0x0a, 0xf6, 0x85, 0xf6, 0xa0, 0xea, 0x4a, 0xd3, 0xcc,
-	0x6c, 0x5a, 0x2c, 0xde, 0xe0, 0x8e, 0x7c, 0xa5, 0xb9, 0x70, 0xfb, 0xf2, 0x72, 0x50, 0x2b, 0x96,
-	0xee, 0x26, 0x20, 0xe8, 0x19, 0x94, 0x29, 0x7f, 0x88, 0x6d, 0x40, 0x27, 0x46, 0x0d, 0x14, 0x9a,
-	0xb6, 0x89, 0xcc, 0xd2, 0xb1, 0x54, 0x82, 0x6c, 0x58, 0x67, 0x94, 0x46, 0x8c, 0xe9, 0x23, 0x96,
-	0xa4, 0x93, 0xa9, 0x7a, 0xf9, 0x5d, 0xb6, 0x39, 0xcc, 0xd5, 0x63, 0x19, 0x83, 0x13, 0x42, 0x6d,
-	0x58, 0x66, 0x9c, 0x67, 0x8d, 0x39, 0xc7, 0xd2, 0x05, 0x58, 0xda, 0x7e, 0x20, 0xd1, 0x9e, 0xce,
-	0xd2, 0x66, 0xc5, 0x57, 0xca, 0xd0, 0x13, 0xd0, 0x29, 0x87, 0x3a, 0xee, 0xb1, 0xc7, 0x95, 0x3f,
-	0xfe, 0x38, 0xb8, 0x29, 0x11, 0x4c, 0x32, 0xb6, 0xb9, 0x4c, 0x2a, 0xb5, 0xe2, 0x3a, 0xc6, 0x6f,
-	0x6b, 0x50, 0xe9, 0x8e, 0xcf, 0x64, 0x86, 0xfd, 0x45, 0x9e, 0x7b, 0xd4, 0x7a, 0x74, 0x66, 0xf5,
-	0x3d, 0xd7, 0xc5, 0xfd, 0x90, 0x5e, 0xc4, 0x8a, 0x26, 0x04, 0xe3, 0xb3, 0x1d, 0x06, 0xa1, 0xec,
-	0x14, 0xf5, 0xe7, 0xff, 0xb3, 0xd3, 0x2f, 0x1b, 0x3b, 0xad, 0x02, 0xe2, 0xad, 0x7a, 0x4e, 0x14,
-	0x0c, 0x65, 0x34, 0x60, 0x45, 0x81, 0xf2, 0x75, 0x7d, 0x5b, 0x88, 0x69, 0x6b, 0x4c, 0xe0, 0x8a,
-	0x59, 0xd4, 0x8f, 0xf1, 0xa9, 0x02, 0xf4, 0x31, 0xdc, 0xe0, 0x11, 0x04, 0xd8, 0xb4, 0xdd, 0x81,
-	0x37, 0xea, 0x62, 0x3c, 0x90, 0x7c, 0x9a, 0xc9, 0x95, 0xc1, 0x1a, 0x62, 0xf7, 0x24, 0x3c, 0xe5,
-	0x6a, 0x03, 0x10, 0xd0, 0x1e, 0x85, 0x18, 0x7f, 0x0e, 0xea, 0x59, 0xb5, 0x63, 0x1f, 0x3b, 0x5a,
-	0xfd, 0xe8, 0x22, 0xc4, 0x41, 0x64, 0x0e, 0xc1, 0x78, 0xf0, 0x98, 0x00, 0xd0, 0x0d, 0x28, 0xd0,
-	0xe2, 0x53, 0xfe, 0x62, 0x53, 0x34, 0x17, 0xc9, 0xf7, 0x33, 0xfc, 0x9a, 0x68, 0xe5, 0xb4, 0x68,
-	0xe4, 0xe2, 0x91, 0xe7, 0x3a, 0x7d, 0x1e, 0x6c, 0x53, 0x22, 0xc0, 0x7d, 0x0e, 0x33, 0xb6, 0xa1,
-	0xba, 0x8b, 0xfb, 0xde, 0x00, 0xcb, 0x5d, 0xbe, 0x05, 0x40, 0x84, 0x3b, 0x7b, 0xb5, 0xe0, 0x07,
-	0x42, 0x91, 0x40, 0xe8, 0x4b, 0x85, 0xf1, 0x7d, 0x40, 0x72, 0x9d, 0xd8, 0x37, 0x74, 0x40, 0xa1,
-	0x03, 0x8b, 0x5e, 0x97, 0xf8, 0x8b, 0x08, 0x87, 0x11, 0x54, 0xe3, 0xaf, 0xe6, 0x60, 0xcd, 0x9c,
-	0xb8, 0xcc, 0xec, 0xf7, 0x78, 0x72, 0x11, 0x47, 0x77, 0x7e, 0x7d, 0x93, 0xef, 0x03, 0xa8, 0x88,
-	0x18, 0x8c, 0xbe, 0x6c, 0x28, 0x28, 0xf3, 0x08, 0x0c, 0x8e, 0xb6, 0x05, 0x2b, 0x3c, 0x6c, 0xd1,
-	0x0a, 0x3d, 0x8b, 0xa8, 0x36, 0xa1, 0xed, 0x88, 0x60, 0x90, 0x2a, 0x2f, 0xea, 0x79, 0xfb, 0xbc,
-	0x40, 0x26, 0xab, 0x5a, 0x7c, 0x39, 0x59, 0x7e, 0x2c, 0x26, 0x0d, 0xba, 0x0b, 0x97, 0x18, 0x74,
-	0x17, 0x55, 0x83, 0xae, 0x51, 0x83, 0xf5, 0xe4, 0x84, 0x70, 0x3d, 0xf5, 0xb7, 0xf3, 0xb0, 0x46,
-	0x75, 0x92, 0xc6, 0x24, 0xf4, 0xbe, 0xa1, 0xb9, 0x9a, 0x32, 0x09, 0xf9, 0x69, 0x93, 0x70, 0x1f,
-	0x2a, 0x23, 0x9b, 0xda, 0x95, 0x85, 0x93, 0x0b, 0x9b, 0xaf, 0xa5, 0x91, 0xfd, 0xfa, 0x89, 0xf0,
-	0x73, 0x79, 0x17, 0x10, 0x41, 0xa2, 0x0e, 0xc1, 0x96, 0x8f, 0x87, 0x76, 0x28, 0x7c, 0x66, 0x35,
-	0x53, 0x1f, 0xd9, 0xaf, 0xb9, 0x07, 0x31, 0x83, 0xab, 0xd8, 0xf6, 0x51, 0xe0, 0x0d, 0x27, 0x21,
-	0xe6, 0x41, 0x33, 0x11, 0x76, 0x83, 0xc3, 0x33, 0x56, 0x61, 0xf1, 0x2a, 0xab, 0x50, 0xb8, 0x64,
-	0x15, 0x8a, 0x09, 0xb3, 0xba, 0x01, 0x65, 0xda, 0x29, 0xec, 0x33, 0x45, 0x98, 0x5b, 0xd1, 0xc9,
-	0x30, 0x3b, 0xd8, 0xa7, 0xba, 0x2f, 0x59, 0xa9, 0xe4, 0x72, 0xf0, 0x95, 0x5a, 0x87, 0xd5, 0x6e,
-	0xe8, 0x8d, 0x93, 0xeb, 0x64, 0x6c, 0x90, 0x05, 0x54, 0xe0, 0xbc, 0x42, 0x1d, 0x6a, 0xd2, 0x8a,
-	0x53, 0x0b, 0x4b, 0x14, 0x9e, 0xfd, 0xd7, 0x16, 0xe0, 0x46, 0x46, 0xa1, 0x14, 0xd0, 0x97, 0xed,
-	0xbb, 0xf6, 0x06, 0x54, 0xec, 0xb3, 0x13, 0x3e, 0xaf, 0x23, 0x6f, 0x20, 0xb4, 0xb4, 0x92, 0x7d,
-	0x76, 0x42, 0xe7, 0x74, 0xdf, 0x1b, 0xd0, 0x9b, 0x5d, 0x84, 0x75, 0xf8, 0x79, 0xa3, 0x63, 0x0d,
-	0xf0, 0x30, 0xb4, 0x05, 0x03, 0x08, 0x54, 0x52, 0xb2, 0x4b, 0x0a, 0xae, 0xbd, 0x6b, 0x0c, 0x28,
-	0x33, 0x9b, 0x27, 0x41, 0xb7, 0xcf, 0x4e, 0x84, 0x2f, 0x18, 0x03, 0xf6, 0xbc, 0xc6, 0xd9, 0x09,
-	0xfa, 0x0e, 0xac, 0x0d, 0x3c, 0x37, 0xb4, 0xce, 0x6d, 0x27, 0xb4, 0x8e, 0x3d, 0x5f, 0x79, 0x2e,
-	0x29, 0x98, 0x88, 0x14, 0x7e, 0x6e, 0x3b, 0xe1, 0x13, 0xcf, 0x97, 0x9e, 0x4d, 0xd8, 0x43, 0x07,
-	0xef, 0x2f, 0x8f, 0x9e, 0x62, 0x30, 0xd6, 0xd3, 0x5b, 0xcc, 0x17, 0x8b, 0xf9, 0x75, 0x71, 0x06,
-	0x28, 0x1e, 0x63, 0xdc, 0xa5, 0x00, 0xc2, 0x76, 0xa4, 0x98, 0xbb, 0xf7, 0x05, 0x7d, 0x7b, 0xe8,
-	0xb8, 0x27, 0x9c, 0x0f, 0xf4, 0x63, 0x8c, 0x7b, 0xb4, 0xa0, 0xcb, 0xe0, 0x68, 0x03, 0x16, 0x47,
-	0x8e, 0x2b, 0xbd, 0xa7, 0x2c, 0x8c, 0x1c, 0xf7, 0x09, 0xc6, 0xb4, 0x80, 0x6d, 0x88, 0x5a, 0x89,
-	0x17, 0xd0, 0x9d, 0x90, 0xe6, 0xa0, 0x72, 0x8a, 0x83, 0xa6, 0xb0, 0x7e, 0x65, 0x0a, 0xeb, 0x67,
-	0x6f, 0xab, 0xe5, 0x29, 0xdb, 0xea, 0x0d, 0xb6, 0x53, 0x9d, 0xc8, 0xe3, 0xbd, 0x56, 0x65, 0xbe,
-	0x8b, 0x23, 0xfb, 0x75, 0x4b, 0xf8, 0xbb, 0xa7, 0xf6, 0x09, 0xba, 0x64, 0x9f, 0xac, 0x24, 0xf6,
-	0xc9, 0xf7, 0x60, 0x23, 0x18, 0xfb, 0xd8, 0x1e, 0x88, 0x38, 0x95, 0x31, 0x7f, 0x3e, 0x0a, 0x6a,
-	0xab, 0x74, 0xf1, 0xd6, 0x58, 0x31, 0x0f, 0x1d, 0x10, 0x85, 0x19, 0xdb, 0x78, 0x2d, 0x6b, 0x1b,
-	0xc7, 0xaf, 0x58, 0xeb, 0xd2, 0x2b, 0x96, 0xf1, 0x1e, 0x54, 0xbb, 0x38, 0x19, 0xc2, 0x3c, 0x75,
-	0x27, 0x90, 0x53, 0x5e, 0x46, 0xe7, 0x7b, 0x6e, 0x1f, 0x36, 0xbb, 0x58, 0xa4, 0x54, 0x88, 0x39,
-	0x56, 0x8a, 0x20, 0xca, 0x62, 0x74, 0x6d, 0x0a, 0xa3, 0x1b, 0xb7, 0xe1, 0x66, 0x36, 0x39, 0xde,
-	0xdc, 0xf7, 0x41, 0xef, 0xe2, 0x70, 0x9f, 0x32, 0x87, 0x68, 0x23, 0x2d, 0x4d, 0xb5, 0x94, 0x34,
-	0x35, 0x56, 0xe8, 0x60, 0x45, 0x45, 0x4e, 0xed, 0xc7, 0x50, 0x67, 0x40, 0x65, 0xd1, 0x05, 0xdd,
-	0x6c, 0x4e, 0xd1, 0xb2, 0x39, 0xc5, 0xb8, 0x45, 0x27, 0x22, 0x4d, 0x2b, 0xb3, 0x29, 0xc1, 0x8d,
-	0x99, 0x4d, 0x45, 0x2c, 0xac, 0x65, 0xb3, 0x70, 0xa2, 0xa9, 0x98, 0x56, 0x64, 0x8d, 0xdc, 0xe8,
-	0xe2, 0xf0, 0x50, 0x66, 0x01, 0xc9, 0x6f, 0x33, 0xc1, 0x30, 0x5a, 0x06, 0xc3, 0x10, 0x41, 0x9a,
-	0xa6, 0xc0, 0xa9, 0xff, 0x00, 0xd6, 0xba, 0x38, 0xec, 0xc4, 0xac, 0x2d, 0xc5, 0xe4, 0x2b, 0x9b,
-	0x40, 0x4b, 0x6d, 0x02, 0x2a, 0xeb, 0x13, 0x75, 0x39, 0xd5, 0xef, 0x50, 0xe6, 0xea, 0xf0, 0x0d,
-	0x21, 0x3d, 0x01, 0xc4, 0x9b, 0x46, 0x4b, 0x1c, 0xf1, 0x6b, 0xb0, 0xa2, 0x54, 0xe1, 0x94, 0x7e,
-	0x48, 0xfb, 0xb7, 0x1f, 0xcb, 0x07, 0x41, 0x2c, 0x25, 0x4a, 0xb4, 0xec, 0xc3, 0x28, 0x51, 0x39,
-	0x4e, 0xe7, 0xd1, 0x38, 0xc1, 0xee, 0xc0, 0x8e, 0x2c, 0x5b, 0x3f, 0xcf, 0xc3, 0x72, 0x04, 0x8a,
-	0xcf, 0x11, 0xe1, 0x08, 0xc9, 0x77, 0x0f, 0xff, 0x44, 0x3f, 0x84, 0x45, 0x9b, 0x21, 0xf3, 0x97,
-	0xc6, 0x7b, 0x72, 0x6a, 0x0b, 0x95, 0x0c, 0xff, 0x36, 0x45, 0x8d, 0xfa, 0x1f, 0x6a, 0xb0, 0xc0,
-	0x60, 0xa8, 0x02, 0x39, 0x67, 0xc0, 0xe7, 0x36, 0xe7, 0x50, 0x3b, 0xc0, 0x00, 0x33, 0x9f, 0x0e,
-	0xe1, 0x44, 0x57, 0x34, 0x65, 0x10, 0x42, 0x30, 0x37, 0xb2, 0x83, 0x57, 0x5c, 0x7d, 0xa3, 0xbf,
-	0x49, 0x6f, 0xfa, 0xa7, 0x9e, 0xd3, 0xc7, 0xc2, 0x87, 0x6e, 0x56, 0x6f, 0x76, 0x28, 0xa6, 0x29,
-	0x6a, 0xb0, 0xb7, 0x17, 0xdb, 0x0f, 0x65, 0xaf, 0xe4, 0x22, 0x85, 0x50, 0x9f, 0xe4, 0x3b, 0xc0,
-	0x0e, 0x10, 0xee, 0xb5, 0xcc, 0x54, 0x10, 0x60, 0x20, 0x82, 0x50, 0xff, 0x2d, 0x0d, 0x16, 0x18,
-	0xcd, 0xaf, 0x37, 0x1a, 0x9e, 0x10, 0x89, 0x8e, 0x86, 0x66, 0x3a, 0xba, 0x05, 0xe0, 0x04, 0x64,
-	0xdb, 0x44, 0x87, 0x68, 0xc1, 0x2c, 0x3a, 0x41, 0x83, 0x01, 0xd0, 0x0a, 0xcc, 0x3b, 0x81, 0xe5,
-	0x7a, 0xdc, 0xf8, 0x31, 0xe7, 0x04, 0x6d, 0x8f, 0x48, 0xb3, 0x43, 0x2f, 0xc4, 0xac, 0x1f, 0xd1,
-	0x9a, 0xfe, 0xd3, 0x1c, 0xac, 0x28, 0xe0, 0x4b, 0xd7, 0xf5, 0xd3, 0x78, 0x26, 0xd9, 0xba, 0xca,
-	0x77, 0xb1, 0x0c, 0x52, 0xa9, 0xd9, 0xac, 0x43, 0xe1, 0xcc, 0x0b, 0xb1, 0x34, 0xa8, 0xe8, 0xbb,
-	0xfe, 0x77, 0xe2, 0x99, 0xda, 0x84, 0x22, 0xe3, 0x06, 0x2b, 0x9a, 0xb0, 0x02, 0x03, 0xb4, 0x06,
-	0xe4, 0x3e, 0xce, 0x0b, 0xd3, 0xb3, 0x57, 0x65, 0x25, 0xbb, 0xd2, 0x1c, 0x6e, 0x42, 0x91, 0xb5,
-	0x4e, 0x68, 0xb1, 0xcb, 0x4b, 0x81, 0x01, 0x18, 0x2d, 0x5e, 0x28, 0xd3, 0x9a, 0x63, 0xb4, 0x58,
-	0x89, 0x44, 0xcb, 0xf8, 0x3d, 0x8d, 0xee, 0xb7, 0xf4, 0x5c, 0xa2, 0x46, 0x3c, 0x33, 0xec, 0x5d,
-	0x4d, 0xce, 0x0f, 0x91, 0x59, 0x25, 0x39, 0x37, 0xf5, 0xc7, 0x57, 0x1b, 0xbe, 0x32, 0x9e, 0x9c,
-	0x3a, 0x1e, 0xe3, 0x43, 0xba, 0xa5, 0xb3, 0x16, 0x55, 0x9e, 0x79, 0x4d, 0x9d, 0x79, 0xe3, 0x14,
-	0x56, 0x0f, 0xb1, 0xef, 0x1c, 0x5f, 0x7c, 0x03, 0x2e, 0x0f, 0xca, 0xbb, 0x7b, 0x3e, 0xe9, 0xbb,
-	0xf0, 0x1e, 0xac, 0x25, 0x5a, 0x8a, 0x23, 0xf9, 0x69, 0xec, 0x14, 0xb7, 0x7f, 0xb0, 0x0f, 0xe3,
-	0xab, 0x25, 0x71, 0x49, 0x54, 0x3c, 0xca, 0xae, 0xe1, 0x8f, 0x28, 0xf1, 0x32, 0x33, 0xb8, 0x46,
-	0xbc, 0xbc, 0x09, 0x45, 0x6a, 0xae, 0xa6, 0xfb, 0x96, 0xf3, 0x22, 0x01, 0xd0, 0x6d, 0x1d, 0xbb,
-	0xc8, 0xcc, 0x29, 0x2e, 0x32, 0x59, 0x69, 0xbf, 0xe6, 0xbf, 0x89, 0xb4, 0x5f, 0xe8, 0x87, 0xb0,
-	0x40, 0x2f, 0xca, 0x44, 0x83, 0x4d, 0x26, 0x03, 0x4a, 0x4f, 0x81, 0xc8, 0x7e, 0xc6, 0xaa, 0xa0,
-	0x4f, 0x60, 0x51, 0xf8, 0xe6, 0x2f, 0xa6, 0xb2, 0x9f, 0x65, 0xd4, 0x16, 0xd9, 0xcf, 0x78, 0xa5,
-	0xfa, 0x7f, 0xcd, 0x8b, 0xa4, 0x63, 0x3f, 0x80, 0x1b, 0x91, 0xff, 0xdc, 0x94, 0x39, 0xde, 0x10,
-	0x08, 0x89, 0xd7, 0x7f, 0xf4, 0x31, 0xd4, 0x33, 0xeb, 0xca, 0x9e, 0xa0, 0xb5, 0x8c, 0xca, 0xcc,
-	0x0b, 0xf0, 0x33, 0xc9, 0x2d, 0xb4, 0xb2, 0xfd, 0xee, 0x15, 0x86, 0xbf, 0xd5, 0xf3, 0x31, 0xa6,
-	0xb3, 0xc9, 0x9c, 0x48, 0xeb, 0x50, 0x08, 0x08, 0xe7, 0xba, 0xfd, 0x28, 0x0a, 0x53, 0x7c, 0xd3,
-	0x2d, 0xc5, 0x42, 0x40, 0x1c, 0x97, 0x4b, 0xf1, 0x02, 0x03, 0xb4, 0xdc, 0xd4, 0x93, 0x31, 0x73,
-	0xb9, 0x52, 0x42, 0x0c, 0xef, 0x00, 0xfb, 0xe4, 0x83, 0x61, 0x01, 0x9a, 0xec, 0x1d, 0xba, 0x25,
-	0xf2, 0xb2, 0x45, 0x6c, 0x2e, 0xdc, 0x0e, 0x0b, 0x8c, 0x27, 0x23, 0x38, 0x77, 0xa7, 0x7d, 0x04,
-	0xab, 0x49, 0x54, 0xcb, 0x0e, 0x46, 0xf4, 0x22, 0x51, 0x34, 0x51, 0x02, 0xbd, 0x11, 0x8c, 0x8c,
-	0x8f, 0xa0, 0x20, 0xc6, 0xaa, 0xe6, 0x3a, 0x5b, 0x8d, 0x83, 0x86, 0xff, 0x8f, 0xf8, 0xa7, 0xa1,
-	0x22, 0xcc, 0x77, 0x7b, 0x8d, 0xe7, 0x4d, 0x5d, 0xab, 0xff, 0xdb, 0x39, 0x39, 0xb5, 0xdb, 0x99,
-	0x3d, 0x9c, 0x08, 0x4d, 0x8b, 0x7d, 0xc4, 0x09, 0xdf, 0x72, 0x89, 0x84, 0x6f, 0x72, 0x8c, 0x83,
-	0xb4, 0x6d, 0xe2, 0xe0, 0x88, 0x39, 0x25, 0x38, 0x82, 0x9c, 0x93, 0xf1, 0x50, 0x98, 0x99, 0xa3,
-	0x18, 0x88, 0x11, 0xa0, 0xf7, 0x61, 0x25, 0x72, 0x99, 0x8b, 0x06, 0x18, 0xf0, 0x94, 0x04, 0x22,
-	0xa5, 0xc9, 0x20, 0xf2, 0x7e, 0x0c, 0x50, 0x17, 0x4a, 0x9c, 0x5e, 0x7f, 0x68, 0xf3, 0x2b, 0x7b,
-	0x65, 0xfb, 0xd1, 0x55, 0xf8, 0x7a, 0x8b, 0x4d, 0xdc, 0x0e, 0xa9, 0x67, 0x2e, 0x05, 0xf1, 0x07,
-	0x11, 0x4e, 0xb6, 0x78, 0x5b, 0xac, 0x15, 0xa8, 0x65, 0x36, 0x06, 0xa0, 0x77, 0xa0, 0xda, 0xf7,
-	0x46, 0x23, 0x27, 0x1c, 0x61, 0x37, 0x8a, 0x36, 0x28, 0x32, 0xb5, 0x34, 0x2e, 0x60, 0xc1, 0x06,
-	0xc6, 0xff, 0xd0, 0x60, 0x49, 0x6a, 0x07, 0xe9, 0x50, 0x6a, 0x1f, 0xb4, 0xad, 0x6e, 0xaf, 0xd1,
-	0xde, 0x6d, 0x98, 0xbb, 0x2c, 0x86, 0xbb, 0xf3, 0xe2, 0xb1, 0xf5, 0xbc, 0xf9, 0x05, 0xf3, 0x1b,
-	0xe5, 0x1f, 0xd6, 0xb3, 0x46, 0xf7, 0x99, 0x9e, 0xa3, 0xae, 0xa5, 0x3b, 0x66, 0xab, 0xd3, 0x63,
-	0x80, 0x3c, 0x2a, 0x43, 0x71, 0xff, 0xc5, 0x5e, 0xaf, 0x65, 0x75, 0x5b, 0x4f, 0xf5, 0x39, 0xf2,
-	0xd9, 0x7e, 0xb1, 0xb7, 0x67, 0xed, 0x36, 0x7a, 0x0d, 0x7d, 0x9e, 0xba, 0x94, 0x92, 0x35, 0xb5,
-	0xba, 0x2f, 0x1e, 0xef, 0xb7, 0xba, 0xdd, 0xd6, 0x41, 0x5b, 0x5f, 0x20, 0x48, 0x0c, 0xfa, 0xb4,
-	0xd9, 0xd6, 0x17, 0x63, 0x24, 0x29, 0xa7, 0x5d, 0x41, 0xa9, 0x6a, 0xed, 0x3c, 0x6b, 0xb4, 0x9f,
-	0x36, 0xf5, 0x22, 0x69, 0x5f, 0xf4, 0xa8, 0xb1, 0xd7, 0xd3, 0x81, 0xa0, 0xc9, 0x5d, 0xa4, 0xd0,
-	0x25, 0xa3, 0x07, 0x9b, 0x6c, 0xa2, 0x4d, 0xfb, 0x3c, 0xc3, 0x87, 0xf4, 0x6b, 0x3a, 0x61, 0x5b,
-	0x70, 0x33, 0x9b, 0xea, 0x55, 0xf3, 0x8c, 0xa4, 0x17, 0x5f, 0x71, 0x9b, 0x36, 0xb6, 0x61, 0xfd,
-	0x90, 0xc7, 0xe2, 0x66, 0xa4, 0x87, 0xca, 0x3c, 0xd4, 0x8c, 0x9f, 0xcf, 0xc3, 0x46, 0xaa, 0x12,
-	0xef, 0xd0, 0x0d, 0x28, 0x38, 0x81, 0x25, 0x1f, 0x51, 0x8b, 0x4e, 0x40, 0x91, 0xc9, 0x75, 0xde,
-	0x09, 0xac, 0x91, 0xe3, 0x8a, 0x2c, 0x39, 0x0b, 0x4e, 0xb0, 0xef, 0xb8, 0x59, 0xbe, 0x4c, 0xf9,
-	0x2c, 0x5f, 0xa6, 0xbb, 0x50, 0xe2, 0x1e, 0x1c, 0xf4, 0x36, 0xc1, 0xb5, 0x0f, 0x18, 0x53, 0xf7,
-	0x0d, 0xd2, 0x0f, 0xd2, 0x82, 0xf0, 0xf1, 0x60, 0x61, 0xc9, 0x0b, 0xac, 0x90, 0x48, 0x35, 0x27,
-	0x90, 0x9d, 0x9c, 0x0b, 0x66, 0xc1, 0x09, 0xb8, 0x98, 0x79, 0x13, 0x96, 0x23, 0x0f, 0x68, 0x4a,
-	0x99, 0x1d, 0x0e, 0x45, 0xb3, 0x2c, 0x3c, 0xeb, 0x09, 0xf1, 0x00, 0xb5, 0x81, 0xef, 0x11, 0x76,
-	0x9c, 0x15, 0x52, 0xc7, 0xd9, 0x94, 0x39, 0xe1, 0xdb, 0x8c, 0x0a, 0x60, 0xbe, 0xf7, 0xa9, 0x80,
-	0x7a, 0x07, 0xd0, 0xd8, 0xbe, 0xa0, 0xb6, 0x9b, 0xc1, 0xc0, 0x17, 0xbd, 0x2b, 0x32, 0x59, 0x38,
-	0xb6, 0x2f, 0x7a, 0x1e, 0x21, 0xc4, 0x3b, 0x79, 0x1f, 0xca, 0x81, 0x73, 0x12, 0x58, 0x42, 0x02,
-	0x50, 0x53, 0x49, 0xd9, 0x2c, 0x11, 0xa0, 0xc9, 0x61, 0xd4, 0x83, 0x3c, 0xb0, 0xa2, 0x3c, 0x93,
-	0x4b, 0x2c, 0x17, 0x91, 0x13, 0xb4, 0x44, 0xa6, 0xc9, 0x48, 0x88, 0x95, 0x24, 0x21, 0x66, 0xfc,
-	0xb1, 0x06, 0x10, 0xf7, 0x11, 0x55, 0xa1, 0xdc, 0xf6, 0xdc, 0x6e, 0x68, 0xbb, 0x03, 0xdb, 0x1f,
-	0xf4, 0x2e, 0x58, 0xaa, 0x48, 0xe6, 0x25, 0xd3, 0xbb, 0xe0, 0x7b, 0x94, 0x7e, 0x31, 0x7f, 0x70,
-	0x3d, 0x47, 0xbd, 0xbd, 0x29, 0x01, 0x0e, 0xc9, 0xa3, 0x0a, 0xc0, 0xfe, 0x64, 0x18, 0x3a, 0x5d,
-	0xe7, 0xa4, 0x77, 0xa1, 0xcf, 0x91, 0xef, 0xf6, 0x64, 0x38, 0xdc, 0xb5, 0x43, 0xbb, 0x77, 0xa1,
-	0xcf, 0xa3, 0x35, 0x9e, 0x8b, 0xa0, 0x3b, 0x39, 0xa2, 0x4f, 0x23, 0xe4, 0x74, 0xd7, 0x17, 0x08,
-	0x9a, 0x48, 0x11, 0xd4, 0xbb, 0xd0, 0x17, 0x23, 0x34, 0x13, 0x9f, 0x79, 0xe2, 0x81, 0x86, 0xef,
-	0x54, 0x5e, 0x9b, 0x85, 0xca, 0xf5, 0x2e, 0xf8, 0x4e, 0x9d, 0x1c, 0xbd, 0xc2, 0x17, 0x8d, 0x61,
-	0xd8, 0xbb, 0xd0, 0x01, 0xad, 0xc0, 0x32, 0x03, 0x90, 0x6e, 0x31, 0xe0, 0x92, 0xf1, 0x01, 0x6c,
-	0xec, 0x50, 0x21, 0x15, 0xe2, 0x41, 0xc2, 0x95, 0xb6, 0x06, 0x8b, 0xc2, 0x3c, 0xc6, 0x5c, 0xcb,
-	0xc4, 0xa7, 0xf1, 0x0c, 0xee, 0x3c, 0x8d, 0xcc, 0x1c, 0x4d, 0xc5, 0x71, 0xe8, 0x7a, 0x59, 0x9d,
-	0x8c, 0x2e, 0xdc, 0x9d, 0x4e, 0x89, 0x6f, 0xa2, 0xf7, 0x61, 0xd5, 0xee, 0xf7, 0xad, 0x29, 0x8e,
-	0x4b, 0x55, 0xbb, 0xdf, 0x57, 0x2b, 0x1a, 0x4e, 0x26, 0x51, 0xdf, 0x39, 0xbb, 0x76, 0xff, 0x12,
-	0xf6, 0xee, 0x5c, 0xca, 0x15, 0xf7, 0x10, 0xee, 0xcd, 0x68, 0x2a, 0x8a, 0x82, 0x5b, 0x53, 0x07,
-	0xe0, 0x3b, 0x67, 0xd2, 0x08, 0x90, 0x3c, 0x02, 0x56, 0xd5, 0xf8, 0xd7, 0x1a, 0xd4, 0xd2, 0xeb,
-	0xc2, 0xe9, 0x7d, 0x09, 0xcb, 0x8a, 0xef, 0x37, 0xce, 0x8a, 0xfd, 0x9e, 0x56, 0x9b, 0x27, 0x17,
-	0x11, 0xbb, 0x32, 0x49, 0xa9, 0xde, 0x10, 0x09, 0x54, 0x1a, 0x71, 0x5c, 0xa3, 0x94, 0x40, 0xa5,
-	0x14, 0x65, 0x48, 0x99, 0xfe, 0xd6, 0x8f, 0x40, 0x7f, 0x8c, 0x83, 0x50, 0xb6, 0x2c, 0x18, 0x9f,
-	0x42, 0x55, 0x82, 0xc5, 0xef, 0x97, 0x92, 0x5f, 0x44, 0x39, 0xca, 0x1a, 0x22, 0x32, 0x8c, 0xe4,
-	0xe2, 0x0c, 0x23, 0xc6, 0xbf, 0xd7, 0x60, 0xa5, 0x7b, 0x8e, 0xf1, 0x38, 0x9d, 0x52, 0x31, 0x23,
-	0xcc, 0xb6, 0x98, 0x0c, 0xb3, 0x7d, 0x1f, 0x56, 0xa4, 0x38, 0x48, 0x4b, 0xed, 0x39, 0x92, 0x8a,
-	0x1a, 0x71, 0xd8, 0xca, 0x8c, 0x58, 0xeb, 0xf2, 0xd5, 0xe2, 0x72, 0xe7, 0x98, 0x3d, 0x46, 0xc4,
-	0xe5, 0x1a, 0xff, 0x53, 0x83, 0x55, 0x75, 0x10, 0xbf, 0xda, 0x81, 0x92, 0x6f, 0xff, 0x83, 0x3c,
-	0xac, 0x66, 0x3d, 0x33, 0x23, 0x80, 0x85, 0xee, 0x17, 0xed, 0x1d, 0x1a, 0x4f, 0x5a, 0x82, 0xc2,
-	0x8b, 0x36, 0xff, 0xd2, 0x10, 0x82, 0x4a, 0xa7, 0xd9, 0x34, 0xad, 0x9d, 0x83, 0x76, 0xbb, 0xb9,
-	0xd3, 0x6b, 0xee, 0xea, 0x39, 0x22, 0xf8, 0x28, 0x6c, 0xb7, 0xd5, 0x8d, 0xc1, 0x79, 0xf4, 0x06,
-	0xdc, 0x7d, 0xd2, 0xec, 0xed, 0x3c, 0x6b, 0xee, 0x5a, 0x54, 0xb9, 0x69, 0x3f, 0xb5, 0x76, 0x9e,
-	0xb4, 0xf6, 0x7a, 0x4d, 0xb3, 0x4b, 0x54, 0x2a, 0x93, 0x65, 0xb9, 0x79, 0x00, 0xf7, 0xa6, 0x62,
-	0x75, 0xcc, 0x83, 0xa7, 0x66, 0xb3, 0xdb, 0xd5, 0xe7, 0x67, 0xa2, 0x3d, 0x69, 0xb5, 0x5b, 0xdd,
-	0x67, 0x34, 0x35, 0xce, 0x26, 0x6c, 0x08, 0xb4, 0x67, 0xcd, 0xc6, 0xae, 0xdc, 0xd4, 0x22, 0xba,
-	0x09, 0xb5, 0x64, 0x61, 0xd4, 0x42, 0x21, 0xab, 0x34, 0x22, 0x5c, 0x44, 0xb7, 0xa1, 0x4e, 0x87,
-	0x77, 0xd8, 0x34, 0xad, 0xc6, 0xee, 0x2e, 0xa9, 0xd3, 0x8c, 0x69, 0x03, 0xba, 0x03, 0x9b, 0x19,
-	0xe5, 0x11, 0x81, 0x25, 0x32, 0x71, 0x66, 0xb3, 0xbb, 0xd3, 0x68, 0x47, 0x95, 0x4a, 0x44, 0xe6,
-	0x73, 0x58, 0xd4, 0x8f, 0xb2, 0x04, 0x8c, 0x6a, 0x57, 0xb6, 0xcd, 0x28, 0xd5, 0x78, 0x17, 0xfb,
-	0x67, 0x4e, 0x1f, 0xa3, 0xcf, 0x60, 0x91, 0x43, 0xd0, 0x0d, 0xf9, 0x5c, 0x57, 0x12, 0x92, 0xd7,
-	0xeb, 0x59, 0x45, 0x8c, 0xab, 0xb7, 0xff, 0xf8, 0x26, 0x94, 0x99, 0xcf, 0xa4, 0xa0, 0xf9, 0x7d,
-	0x98, 0xeb, 0x38, 0xee, 0x09, 0x5a, 0x97, 0x1f, 0xcf, 0xe3, 0x0c, 0xc1, 0xf5, 0x8d, 0x14, 0x3c,
-	0x72, 0x8e, 0x5f, 0xe4, 0x99, 0x7e, 0x95, 0xce, 0xa8, 0xe9, 0x83, 0x95, 0xce, 0x24, 0xf3, 0x08,
-	0xef, 0x40, 0x41, 0x64, 0x03, 0x46, 0x75, 0x45, 0x50, 0x2a, 0x59, 0x83, 0xeb, 0x9b, 0x99, 0x65,
-	0x9c, 0x88, 0x09, 0x65, 0x25, 0xcd, 0x2f, 0xba, 0x93, 0xce, 0xbe, 0xab, 0xe4, 0x0e, 0xae, 0xdf,
-	0x9d, 0x8e, 0x10, 0x77, 0x2c, 0xf2, 0x1a, 0xa9, 0x67, 0x26, 0xf3, 0x4d, 0x77, 0x2c, 0x95, 0x17,
-	0xf8, 0x33, 0x58, 0x14, 0x69, 0x70, 0xe5, 0xf9, 0x51, 0x73, 0x2d, 0x2a, 0xf3, 0x93, 0xcc, 0x8a,
-	0x18, 0x40, 0x6d, 0xda, 0x51, 0x8c, 0x12, 0x99, 0xb8, 0x66, 0x9d, 0xfc, 0xf5, 0x77, 0xae, 0x84,
-	0xcb, 0x1b, 0x3d, 0x83, 0x1b, 0x53, 0xcf, 0x4f, 0x74, 0x09, 0x25, 0xe5, 0x40, 0xaf, 0xbf, 0x7b,
-	0x35, 0x64, 0xde, 0xee, 0x0b, 0xa8, 0xa8, 0x59, 0xeb, 0xd0, 0xdd, 0x44, 0x82, 0xae, 0xd4, 0xa5,
-	0xa5, 0x7e, 0x6f, 0x06, 0x06, 0x27, 0xfb, 0x13, 0x58, 0x4e, 0x24, 0xc3, 0x43, 0xd3, 0x6b, 0x45,
-	0x0b, 0x6b, 0xcc, 0x42, 0x61, 0x94, 0x1f, 0x69, 0xe8, 0x29, 0x14, 0xa3, 0xc4, 0x61, 0x68, 0x33,
-	0x2b, 0x9d, 0x98, 0xa0, 0x77, 0x6b, 0x66, 0xae, 0x31, 0xf4, 0x1c, 0x20, 0x86, 0xa2, 0x9b, 0x53,
-	0x90, 0xaf, 0x42, 0xea, 0x91, 0x86, 0xf6, 0x60, 0x49, 0x4a, 0xd6, 0x85, 0x94, 0xd0, 0x91, 0x54,
-	0x6a, 0xaf, 0xfa, 0xed, 0x69, 0xc5, 0x51, 0xc6, 0xc0, 0x62, 0x94, 0x93, 0x4b, 0x19, 0x63, 0x32,
-	0x7d, 0x57, 0xfd, 0x66, 0x76, 0x61, 0x4c, 0x27, 0xca, 0x18, 0xa5, 0xd0, 0x49, 0xa6, 0xa7, 0x52,
-	0xe8, 0xa4, 0x93, 0x4c, 0x11, 0x3a, 0x42, 0x6b, 0x51, 0xe9, 0x24, 0xf4, 0x1b, 0x95, 0x4e, 0x4a,
-	0xd1, 0x99, 0x28, 0x71, 0x0d, 0x8a, 0x73, 0xb0, 0xb2, 0xb7, 0x2e, 0x89, 0x23, 0x52, 0xf6, 0xd6,
-	0x65, 0xf1, 0x44, 0x8f, 0x34, 0xe4, 0xc4, 0xf9, 0xcc, 0x95, 0x26, 0xdf, 0xcc, 0x90, 0x49, 0x59,
-	0xcd, 0xbd, 0x75, 0x29, 0x5e, 0xd4, 0xd4, 0xcf, 0xe0, 0xc6, 0xd4, 0x38, 0x10, 0x65, 0x23, 0x5f,
-	0x16, 0xd3, 0xa2, 0x6c, 0xe4, 0x4b, 0x43, 0x4b, 0x1e, 0x6a, 0x8f, 0x34, 0xf4, 0x25, 0xe8, 0xc9,
-	0x3c, 0x52, 0xc8, 0xb8, 0x3c, 0xed, 0x55, 0xfd, 0xfe, 0x4c, 0x9c, 0x58, 0xe2, 0x2b, 0x09, 0xb7,
-	0x15, 0x89, 0x9f, 0x95, 0xe4, 0x5b, 0x91, 0xf8, 0x99, 0xb9, 0xba, 0xd1, 0xa7, 0xb0, 0xc0, 0xdc,
-	0xbe, 0x50, 0x2d, 0xe5, 0x95, 0x26, 0xa8, 0xdc, 0xc8, 0x28, 0x91, 0x77, 0x9d, 0x94, 0x01, 0x5b,
-	0xd9, 0x75, 0xe9, 0x94, 0xdb, 0xca, 0xae, 0xcb, 0x4a, 0x9c, 0x2d, 0xa8, 0x89, 0xfc, 0xcc, 0x33,
-	0x73, 0x54, 0xa7, 0xa9, 0x25, 0x6c, 0x1e, 0x5f, 0x82, 0x9e, 0x4c, 0x86, 0xac, 0xac, 0xc6, 0x94,
-	0xdc, 0xce, 0xca, 0x6a, 0x4c, 0xcb, 0xa6, 0x8c, 0x0e, 0xa0, 0x24, 0x67, 0x26, 0x46, 0xb7, 0x53,
-	0x95, 0x94, 0x2c, 0xcb, 0xf5, 0x3b, 0x53, 0xcb, 0x39, 0xc1, 0x97, 0xb0, 0x9c, 0xc8, 0x92, 0xa5,
-	0x48, 0xec, 0xec, 0x14, 0x64, 0x8a, 0xc4, 0x9e, 0x96, 0xa2, 0xeb, 0x10, 0x2a, 0x6a, 0x12, 0x28,
-	0xe5, 0x88, 0xc9, 0xcc, 0x0f, 0x55, 0x9f, 0x8a, 0x21, 0xad, 0xfd, 0x09, 0xac, 0x66, 0xe5, 0x5c,
-	0x51, 0x36, 0xf5, 0x8c, 0x0c, 0x31, 0xca, 0xa6, 0x9e, 0x99, 0xbc, 0xe5, 0x25, 0x2c, 0x27, 0xd2,
-	0x16, 0x28, 0x53, 0x93, 0x9d, 0x46, 0x44, 0x99, 0x9a, 0x69, 0x29, 0x38, 0xbe, 0x04, 0x3d, 0x99,
-	0x10, 0x01, 0x19, 0x97, 0xa7, 0xa2, 0x50, 0x58, 0x64, 0x6a, 0x32, 0x86, 0x97, 0xb0, 0x9c, 0x08,
-	0x8a, 0x57, 0xba, 0x9d, 0x9d, 0x17, 0x40, 0xe9, 0xf6, 0xb4, 0x98, 0x7a, 0x1b, 0x50, 0x3a, 0x94,
-	0x1d, 0xc9, 0x8f, 0x26, 0x53, 0xa3, 0xe6, 0xeb, 0x0f, 0x2e, 0xc1, 0xe2, 0x4d, 0x5c, 0x44, 0xd1,
-	0xf2, 0x19, 0xf1, 0xeb, 0xe8, 0xdd, 0x34, 0x91, 0xe9, 0xb1, 0xf0, 0xf5, 0xf7, 0xae, 0x88, 0x1d,
-	0xcf, 0x5b, 0x22, 0x22, 0x5b, 0x99, 0xb7, 0xec, 0x78, 0x79, 0x65, 0xde, 0xa6, 0x05, 0x74, 0x53,
-	0x11, 0x2a, 0xc5, 0x5c, 0x27, 0x44, 0x68, 0x3a, 0x8a, 0x3b, 0x21, 0x42, 0x33, 0xc2, 0xb5, 0xd1,
-	0x6f, 0xc2, 0x5a, 0x66, 0x38, 0x36, 0x92, 0xd9, 0x7b, 0x56, 0x40, 0x77, 0xfd, 0xe1, 0xe5, 0x88,
-	0xb1, 0x7c, 0x94, 0x82, 0x89, 0x15, 0xf9, 0x98, 0x8e, 0xf8, 0x56, 0xe4, 0x63, 0x56, 0x98, 0xf6,
-	0x01, 0x94, 0xe4, 0xd0, 0x64, 0x74, 0x7b, 0x76, 0x38, 0xb5, 0x22, 0xc2, 0x32, 0xa3, 0xa0, 0x5f,
-	0xc2, 0x72, 0xc2, 0xd6, 0xaa, 0x2c, 0x5c, 0xb6, 0x41, 0x5b, 0x59, 0xb8, 0x69, 0xe6, 0x6b, 0x72,
-	0xb0, 0x26, 0xcc, 0x48, 0xea, 0xc1, 0x9a, 0x6d, 0x39, 0x54, 0x0f, 0xd6, 0x69, 0x56, 0x2c, 0x32,
-	0x0f, 0x92, 0x29, 0x44, 0x9d, 0x87, 0xb4, 0xa1, 0x47, 0x9d, 0x87, 0x0c, 0x1b, 0xca, 0xf6, 0xef,
-	0xcc, 0x8b, 0xc0, 0x3d, 0xb2, 0x9c, 0xd8, 0x17, 0x77, 0xce, 0x03, 0x28, 0xc9, 0x81, 0x7b, 0x4a,
-	0x43, 0x19, 0x81, 0x7e, 0x4a, 0x43, 0x99, 0x11, 0x7f, 0x07, 0x50, 0x92, 0x03, 0x30, 0x15, 0x82,
-	0x19, 0x01, 0xa2, 0x0a, 0xc1, 0xac, 0xc8, 0x4d, 0x72, 0xf5, 0x9a, 0x16, 0x3f, 0xa9, 0xa8, 0x87,
-	0x97, 0x84, 0x77, 0x2a, 0xea, 0xe1, 0x65, 0x01, 0x99, 0xa8, 0x05, 0x10, 0x87, 0x53, 0x2a, 0xd7,
-	0x80, 0x54, 0x9c, 0xa6, 0x72, 0x0d, 0xc8, 0x88, 0xc1, 0xdc, 0x83, 0x25, 0x29, 0x90, 0x52, 0xd9,
-	0x20, 0xe9, 0xb0, 0x4b, 0x65, 0x83, 0x64, 0xc4, 0x5f, 0xa2, 0xc7, 0xb0, 0xc8, 0x03, 0x1d, 0x94,
-	0xab, 0xac, 0x1a, 0x8c, 0xa1, 0x5c, 0x65, 0x13, 0x71, 0x11, 0x8f, 0x34, 0x42, 0x83, 0xc7, 0xde,
-	0x28, 0x34, 0xd4, 0x08, 0x24, 0x85, 0x46, 0x22, 0x54, 0x87, 0x29, 0x59, 0x92, 0x73, 0xbe, 0x32,
-	0xaa, 0xb4, 0x2b, 0xbf, 0x32, 0xaa, 0x0c, 0x9f, 0xfe, 0x6d, 0x0f, 0x56, 0x25, 0xdf, 0xda, 0xc3,
-	0x6d, 0xc1, 0x9d, 0x9f, 0x43, 0x45, 0xf5, 0xc2, 0x56, 0xd4, 0x84, 0x4c, 0x8f, 0x75, 0xe5, 0x26,
-	0x9a, 0xed, 0xc2, 0xfd, 0x48, 0xdb, 0xfe, 0x77, 0x05, 0x91, 0x5d, 0x99, 0x96, 0x88, 0xf6, 0x5e,
-	0x40, 0x45, 0xf5, 0x25, 0x56, 0xda, 0xcb, 0xf4, 0xfa, 0x56, 0xda, 0xcb, 0x76, 0x44, 0x26, 0x32,
-	0x5e, 0x71, 0x38, 0x56, 0x64, 0x7c, 0x96, 0x8b, 0x72, 0xfd, 0xee, 0x74, 0x04, 0x4e, 0xf3, 0x37,
-	0xa0, 0x9a, 0x72, 0x47, 0x46, 0xf7, 0x53, 0x57, 0xc8, 0xb4, 0x27, 0x73, 0xfd, 0x8d, 0xd9, 0x48,
-	0xf1, 0x0e, 0x88, 0xbd, 0x35, 0x95, 0x1d, 0x90, 0xf2, 0xf9, 0x54, 0x76, 0x40, 0xda, 0xc5, 0x93,
-	0x28, 0x65, 0x59, 0x3e, 0x99, 0x8a, 0x52, 0x36, 0xc3, 0x07, 0xb4, 0xfe, 0xd6, 0xa5, 0x78, 0xd2,
-	0x0d, 0x59, 0xf8, 0x68, 0xaa, 0x37, 0xe4, 0x84, 0xcb, 0x67, 0xfd, 0x66, 0x76, 0x21, 0xa7, 0x33,
-	0xa0, 0x9e, 0x81, 0x49, 0x57, 0x4c, 0xf4, 0x20, 0x55, 0x29, 0xcb, 0xed, 0xb3, 0xfe, 0xe6, 0x65,
-	0x68, 0x99, 0xad, 0xc4, 0xae, 0xf5, 0xd9, 0xd5, 0x13, 0x1e, 0x9f, 0xd3, 0x5a, 0x49, 0x3a, 0x73,
-	0x52, 0x75, 0x32, 0xe1, 0x8a, 0xa9, 0xaa, 0x93, 0xd9, 0x9e, 0x9e, 0xaa, 0x3a, 0x39, 0xc5, 0x97,
-	0x93, 0xee, 0x17, 0xc5, 0x1f, 0x53, 0xdd, 0x2f, 0x59, 0x6e, 0x9e, 0xea, 0x7e, 0xc9, 0x74, 0xe6,
-	0xa4, 0x3a, 0x45, 0xec, 0x99, 0x89, 0x6e, 0xa5, 0x6b, 0x48, 0x4e, 0x9e, 0xaa, 0x4e, 0x91, 0x76,
-	0xe8, 0xe4, 0x9d, 0x94, 0x7c, 0x32, 0x93, 0x9d, 0x4c, 0xfb, 0x7a, 0x26, 0x3b, 0x99, 0xe1, 0xd0,
-	0xb9, 0xfd, 0x73, 0x8d, 0xf4, 0x12, 0x0f, 0x84, 0xec, 0xb0, 0x01, 0xa5, 0xa3, 0x85, 0x14, 0x05,
-	0x78, 0x6a, 0x28, 0x92, 0xa2, 0x00, 0xcf, 0x08, 0x39, 0x6a, 0x01, 0xc4, 0xf1, 0x3d, 0xca, 0x9e,
-	0x4c, 0x85, 0x0a, 0xd5, 0x6f, 0x4d, 0x29, 0xe5, 0xbd, 0xff, 0x75, 0x28, 0x33, 0x37, 0x4d, 0xc9,
-	0xa0, 0xcd, 0xfd, 0x36, 0x95, 0x43, 0x41, 0xf5, 0x59, 0x55, 0x0e, 0x85, 0x84, 0x9b, 0xe7, 0xf6,
-	0xbf, 0xd4, 0xa0, 0xcc, 0xd8, 0x44, 0xd0, 0xdc, 0x83, 0x25, 0xc9, 0x6f, 0x4e, 0x59, 0xc7, 0xb4,
-	0xf3, 0x9e, 0xb2, 0x8e, 0x59, 0xee, 0x76, 0x6c, 0x1d, 0x65, 0x82, 0x77, 0x2f, 0x73, 0x08, 0x4c,
-	0xae, 0x63, 0x06, 0xd9, 0xed, 0x31, 0xd4, 0xb9, 0xd6, 0x48, 0xdd, 0xe8, 0xb8, 0x11, 0x45, 0x0c,
-	0xc1, 0x84, 0xb2, 0xe2, 0x5d, 0xa7, 0x88, 0xee, 0x2c, 0x0f, 0x3f, 0x45, 0x74, 0x67, 0x3a, 0xe6,
-	0x6d, 0xff, 0x45, 0x58, 0x65, 0x2b, 0xc2, 0x0b, 0x44, 0x5b, 0x27, 0x02, 0xae, 0x7a, 0x70, 0x28,
-	0x72, 0x72, 0x86, 0xe3, 0x88, 0x22, 0x27, 0x67, 0xb9, 0x82, 0x1c, 0x2d, 0xd0, 0xbf, 0xa5, 0xfa,
-	0xc1, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x9a, 0x50, 0x0c, 0xce, 0x58, 0x75, 0x00, 0x00,
+	0x92, 0xd8, 0x92, 0xd4, 0x83, 0x0c, 0x89, 0x14, 0x55, 0x7a, 0x34, 0xbb, 0xfa, 0x21, 0x75, 0xf5,
+	0x3c, 0x7a, 0x77, 0x66, 0x34, 0xb3, 0x9a, 0x7d, 0xcc, 0xed, 0x6b, 0x96, 0x2d, 0x51, 0xdd, 0xdc,
+	0x96, 0x28, 0x5d, 0x91, 0xea, 0x9e, 0xd9, 0xb5, 0xaf, 0x50, 0x22, 0x53, 0x52, 0x5d, 0x93, 0x55,
+	0xdc, 0xaa, 0xa2, 0xba, 0xb5, 0xb6, 0xe1, 0xc5, 0x19, 0xf6, 0xdf, 0x01, 0xb6, 0x0f, 0xf7, 0x61,
+	0x9c, 0xcf, 0x58, 0xc0, 0x86, 0x7d, 0x3f, 0x7e, 0xc1, 0x86, 0x71, 0xf0, 0x1a, 0x86, 0xcf, 0x30,
+	0x60, 0x18, 0x07, 0x7f, 0x9c, 0x7f, 0x6c, 0xc0, 0x7f, 0x06, 0xfc, 0x65, 0xc0, 0x06, 0xfc, 0x7b,
+	0x1f, 0x67, 0x64, 0x66, 0x64, 0x55, 0x66, 0x3d, 0x28, 0x69, 0x76, 0x06, 0xf0, 0x2e, 0xae, 0x7f,
+	0x5a, 0x15, 0x11, 0x19, 0x99, 0x19, 0x19, 0xf9, 0x8a, 0x8c, 0x08, 0x42, 0xc5, 0x1e, 0x3b, 0x5b,
+	0x63, 0xdf, 0x0b, 0x3d, 0xad, 0xf2, 0xca, 0x1e, 0x0e, 0x49, 0xe8, 0x8f, 0xfb, 0x46, 0x1d, 0x6a,
+	0xcf, 0x89, 0x1f, 0x38, 0x9e, 0x6b, 0x92, 0x1f, 0x4f, 0x48, 0x10, 0x1a, 0x7f, 0x54, 0x80, 0xa5,
+	0x08, 0x14, 0x8c, 0x3d, 0x37, 0x20, 0xda, 0x9b, 0x50, 0xbb, 0xe0, 0x20, 0x2b, 0x08, 0x7d, 0xc7,
+	0x3d, 0x6b, 0x14, 0x36, 0x0b, 0x8f, 0x2a, 0x66, 0x15, 0xa1, 0x5d, 0x06, 0xd4, 0x56, 0x61, 0x76,
+	0x64, 0xff, 0xa6, 0xe7, 0x37, 0x8a, 0x9b, 0x85, 0x47, 0x55, 0x93, 0x7f, 0x30, 0xa8, 0xe3, 0x7a,
+	0x7e, 0xa3, 0x84, 0x50, 0xc7, 0xe5, 0xd0, 0xb1, 0x1d, 0xf6, 0xcf, 0x1b, 0x33, 0x1c, 0xca, 0x3e,
+	0xb4, 0xfb, 0x00, 0x63, 0x9f, 0xf8, 0x64, 0x48, 0xec, 0x80, 0x34, 0x66, 0x59, 0x25, 0x12, 0x84,
+	0x36, 0xe4, 0x64, 0xe2, 0x0c, 0x07, 0xd6, 0x88, 0x84, 0xf6, 0xc0, 0x0e, 0xed, 0xc6, 0x1c, 0x6f,
+	0x08, 0x83, 0x1e, 0x20, 0xd0, 0xf8, 0xd3, 0x59, 0xd0, 0x7a, 0xbe, 0xed, 0x06, 0x76, 0x3f, 0x74,
+	0x3c, 0x77, 0x97, 0x84, 0xb6, 0x33, 0x0c, 0x34, 0x0d, 0x66, 0xce, 0xed, 0xe0, 0x9c, 0x35, 0x7e,
+	0xd1, 0x64, 0x7f, 0x6b, 0x9b, 0xb0, 0x10, 0xc6, 0x94, 0xac, 0xe5, 0x8b, 0xa6, 0x0c, 0xd2, 0xbe,
+	0x0d, 0x73, 0x03, 0x72, 0xe2, 0x84, 0x41, 0xa3, 0xb4, 0x59, 0x7a, 0xb4, 0xb0, 0xfd, 0x70, 0x2b,
+	0x12, 0xdf, 0x56, 0xba, 0x92, 0xad, 0xb6, 0x3b, 0x9e, 0x84, 0x26, 0x16, 0xd1, 0xbe, 0x07, 0xf3,
+	0x7d, 0x9f, 0x0c, 0x68, 0xe9, 0x19, 0x56, 0xfa, 0x8d, 0xe9, 0xa5, 0x0f, 0x27, 0x21, 0x2d, 0x2e,
+	0x0a, 0x69, 0x75, 0x28, 0x9d, 0x12, 0x2e, 0x89, 0x92, 0x49, 0xff, 0xd4, 0xee, 0x42, 0x25, 0x74,
+	0x46, 0x24, 0x08, 0xed, 0xd1, 0x98, 0xf5, 0xbe, 0x64, 0xc6, 0x00, 0xed, 0x13, 0xa8, 0x4b, 0x6d,
+	0xb7, 0xc2, 0xcb, 0x31, 0x69, 0xcc, 0x6f, 0x16, 0x1e, 0xd5, 0xb6, 0xdf, 0x9b, 0x5e, 0xb1, 0x04,
+	0xea, 0x5d, 0x8e, 0x89, 0xb9, 0x14, 0xaa, 0x00, 0x3a, 0x60, 0x43, 0xfb, 0x84, 0x0c, 0x1b, 0x65,
+	0x26, 0x71, 0xfe, 0xa1, 0xff, 0x18, 0x66, 0x59, 0x87, 0x29, 0xda, 0x71, 0x07, 0xe4, 0x35, 0x13,
+	0x6e, 0xd5, 0xe4, 0x1f, 0xda, 0x97, 0xa1, 0x3e, 0xf6, 0xc9, 0x85, 0xe3, 0x4d, 0x02, 0xcb, 0xee,
+	0xf7, 0xbd, 0x89, 0x1b, 0xa2, 0x72, 0x2c, 0x09, 0x78, 0x93, 0x83, 0xb5, 0xb7, 0x61, 0x29, 0x26,
+	0x1d, 0x31, 0xca, 0x12, 0xeb, 0x5d, 0x2d, 0xa2, 0x64, 0x50, 0xfd, 0x8f, 0x0a, 0x30, 0xc7, 0xc5,
+	0x94, 0x53, 0x69, 0x03, 0xe6, 0xd5, 0xba, 0xc4, 0xa7, 0xa6, 0x43, 0xd9, 0x71, 0x43, 0xe2, 0xbb,
+	0xf6, 0x90, 0x31, 0x2f, 0x9b, 0xd1, 0xb7, 0xb6, 0x0e, 0x73, 0x58, 0xed, 0x0c, 0xab, 0x16, 0xbf,
+	0x18, 0xb7, 0xc1, 0xc0, 0x27, 0x41, 0x80, 0xfa, 0x28, 0x3e, 0xb5, 0x87, 0x50, 0xf5, 0x58, 0x3b,
+	0xac, 0xa0, 0xef, 0x3b, 0xe3, 0x90, 0x8d, 0xc6, 0xa2, 0xb9, 0xc8, 0x81, 0x5d, 0x06, 0x8b, 0xc5,
+	0x36, 0x2f, 0x89, 0xcd, 0xf8, 0x11, 0x2c, 0x25, 0x04, 0xae, 0x2d, 0xc0, 0xbc, 0xd9, 0x7a, 0x72,
+	0xbc, 0xdf, 0x34, 0xeb, 0x5f, 0xd2, 0x16, 0xa1, 0xbc, 0x73, 0xd8, 0xee, 0x3c, 0x6e, 0x76, 0x5b,
+	0xf5, 0x19, 0x6d, 0x05, 0x96, 0x7a, 0xed, 0x9d, 0x67, 0xad, 0x9e, 0x75, 0x74, 0x6c, 0xee, 0x3c,
+	0xa5, 0xc0, 0x82, 0x56, 0x86, 0x99, 0xe7, 0x87, 0xbd, 0x56, 0xbd, 0xa8, 0xd5, 0x00, 0xcc, 0xd6,
+	0xf3, 0xc3, 0x9d, 0x66, 0xaf, 0x7d, 0xd8, 0xa9, 0x97, 0x8c, 0xff, 0x50, 0x80, 0xc5, 0xc7, 0x43,
+	0xaf, 0xff, 0x72, 0x9a, 0xde, 0xaf, 0xc3, 0xdc, 0x39, 0x71, 0xce, 0xce, 0xb9, 0x8c, 0x66, 0x4d,
+	0xfc, 0x52, 0xd5, 0xab, 0x94, 0x54, 0xaf, 0xb7, 0x61, 0xc9, 0x1e, 0x8f, 0x7d, 0xef, 0x82, 0x04,
+	0xd6, 0xd8, 0xf6, 0x89, 0x1b, 0x32, 0xa1, 0x94, 0xcd, 0x9a, 0x00, 0x1f, 0x31, 0xa8, 0xd6, 0x84,
+	0x45, 0x49, 0x81, 0x84, 0xf2, 0xdf, 0x9b, 0xaa, 0x83, 0xa6, 0x52, 0xc4, 0x38, 0x84, 0x1a, 0xea,
+	0xc6, 0x63, 0x7b, 0x68, 0xbb, 0x7d, 0x22, 0x0f, 0x6c, 0x41, 0x1d, 0xd8, 0x87, 0x50, 0x0d, 0xbd,
+	0xd0, 0x1e, 0x5a, 0x27, 0x9c, 0x94, 0x75, 0xaa, 0x64, 0x2e, 0x32, 0x20, 0x16, 0x37, 0xaa, 0xb0,
+	0x70, 0xe4, 0xb8, 0x67, 0x62, 0xa1, 0xab, 0xc1, 0x22, 0xff, 0xe4, 0x8b, 0x1c, 0x5d, 0x0a, 0x3b,
+	0x24, 0x7c, 0xe5, 0xf9, 0x2f, 0x05, 0xc5, 0x47, 0xb0, 0x14, 0x41, 0xe2, 0x95, 0x90, 0xb6, 0xef,
+	0x82, 0x58, 0x2e, 0xc7, 0x60, 0x4b, 0xaa, 0x1c, 0x8a, 0xe4, 0xc6, 0x32, 0x2c, 0xed, 0x78, 0x0e,
+	0x9f, 0x49, 0xc8, 0xec, 0x7d, 0xa8, 0xc7, 0x20, 0xe4, 0x76, 0x07, 0x2a, 0x7d, 0xcf, 0xc1, 0x69,
+	0xca, 0x19, 0x95, 0xfb, 0x48, 0x64, 0xfc, 0x1a, 0xac, 0x62, 0xff, 0x3b, 0x93, 0xd1, 0x09, 0xf1,
+	0x91, 0x91, 0xf6, 0x00, 0x16, 0xb1, 0xdb, 0x96, 0x6b, 0x8f, 0x08, 0x2e, 0xc5, 0x0b, 0x08, 0xeb,
+	0xd8, 0x23, 0x62, 0x7c, 0x0f, 0xd6, 0x12, 0x45, 0xe5, 0xe6, 0x63, 0x59, 0x86, 0x89, 0x9b, 0x2f,
+	0x91, 0xd3, 0xe6, 0x63, 0xf9, 0x40, 0x34, 0xff, 0x0f, 0x4b, 0x50, 0x8f, 0x61, 0xc8, 0xee, 0x63,
+	0x28, 0x63, 0xc1, 0xa0, 0x51, 0x48, 0x2d, 0x8e, 0x49, 0x72, 0x01, 0x30, 0xa3, 0x42, 0xda, 0xbb,
+	0xa0, 0xf5, 0x27, 0x3e, 0xd5, 0x18, 0xeb, 0x84, 0x6a, 0xac, 0xc5, 0xf4, 0x94, 0x2f, 0xc2, 0x75,
+	0xc4, 0x30, 0x55, 0x7e, 0x4a, 0x75, 0xf6, 0x03, 0x58, 0x4d, 0x50, 0x73, 0x0d, 0x2e, 0x31, 0x0d,
+	0xd6, 0x14, 0x7a, 0x86, 0xd1, 0x7f, 0xab, 0x08, 0xf3, 0x62, 0x81, 0xb9, 0x5e, 0xdf, 0x53, 0xe2,
+	0x2d, 0xa6, 0xc4, 0x9b, 0xd6, 0xb6, 0x52, 0x5a, 0xdb, 0x68, 0xd7, 0xc8, 0x6b, 0xbe, 0xb6, 0x58,
+	0x2f, 0xc9, 0xa5, 0xd5, 0x8f, 0xd6, 0x96, 0xaa, 0x59, 0x17, 0x98, 0x67, 0xe4, 0x72, 0x87, 0x35,
+	0xee, 0x5d, 0xd0, 0x1c, 0x37, 0x45, 0x3d, 0xcb, 0xa9, 0x1d, 0x37, 0x83, 0x7a, 0x34, 0xf6, 0xfc,
+	0x90, 0x0c, 0x24, 0xea, 0x39, 0xa4, 0x46, 0x8c, 0xa0, 0x36, 0x3e, 0x81, 0x55, 0x93, 0xd0, 0xbe,
+	0x08, 0xf9, 0xa3, 0x22, 0x5d, 0x53, 0x20, 0xb7, 0xa1, 0xec, 0x92, 0x57, 0xb2, 0x30, 0xe6, 0x5d,
+	0xf2, 0x8a, 0xe9, 0xd9, 0x2d, 0x58, 0x4b, 0x70, 0xc6, 0xb9, 0xf4, 0xeb, 0x50, 0x35, 0x49, 0xd0,
+	0xb7, 0x5d, 0x49, 0x69, 0x4f, 0xc8, 0x99, 0xe3, 0x8a, 0x21, 0x2b, 0xb0, 0x21, 0x5b, 0x60, 0x30,
+	0x3e, 0x56, 0xda, 0x3d, 0x00, 0x24, 0x89, 0x75, 0xa0, 0xc2, 0x09, 0xec, 0xe0, 0xdc, 0xf8, 0x2e,
+	0xd4, 0x04, 0x4b, 0xd4, 0xbe, 0x77, 0x60, 0xd9, 0x67, 0x10, 0x97, 0x0c, 0xac, 0xf0, 0xdc, 0xf7,
+	0x26, 0x67, 0xe7, 0xc8, 0xb8, 0x1e, 0x21, 0x7a, 0x1c, 0x6e, 0xbc, 0x00, 0xad, 0x43, 0x5e, 0x87,
+	0x09, 0x11, 0xd0, 0xf3, 0x86, 0x1d, 0x04, 0xe3, 0x73, 0x9f, 0x9e, 0x37, 0xf8, 0xfa, 0x28, 0x41,
+	0xae, 0xa1, 0x0c, 0xc6, 0x77, 0x60, 0x45, 0x61, 0x7c, 0xb3, 0x99, 0xf6, 0x9f, 0x8b, 0xd8, 0x2e,
+	0xbe, 0xa7, 0x88, 0x76, 0xe5, 0xaf, 0x74, 0xdf, 0x80, 0x99, 0x97, 0x8e, 0x3b, 0x60, 0x2d, 0xa9,
+	0x6d, 0x1b, 0xd2, 0x74, 0x4b, 0xb3, 0xd9, 0x7a, 0xe6, 0xb8, 0x03, 0x93, 0xd1, 0x6b, 0x7b, 0x00,
+	0x67, 0xf6, 0xd8, 0x1a, 0x7b, 0x43, 0xa7, 0x7f, 0xc9, 0x14, 0xb6, 0xb6, 0xfd, 0xf6, 0xf4, 0xd2,
+	0x4f, 0xec, 0xf1, 0x11, 0x23, 0x37, 0x2b, 0x67, 0xe2, 0x4f, 0x63, 0x1b, 0x66, 0x28, 0x57, 0x6d,
+	0x15, 0xea, 0x8f, 0xdb, 0x47, 0x1f, 0x7c, 0xf0, 0xb5, 0xaf, 0x59, 0xad, 0x4f, 0x7a, 0x2d, 0xb3,
+	0xd3, 0xdc, 0xaf, 0x7f, 0x49, 0x86, 0xb6, 0x3b, 0x08, 0x2d, 0x18, 0x0e, 0x54, 0x22, 0x5e, 0x9a,
+	0x0e, 0xeb, 0x4f, 0x9a, 0x47, 0xd6, 0xd1, 0xe1, 0x7e, 0x7b, 0xe7, 0x53, 0xeb, 0xb8, 0xd3, 0x3d,
+	0x6a, 0xed, 0xb4, 0xf7, 0xda, 0xad, 0x5d, 0x5e, 0x5c, 0xc2, 0xb5, 0x4c, 0xf3, 0xd0, 0xac, 0x17,
+	0xb4, 0x35, 0x58, 0x96, 0xa0, 0xed, 0x27, 0x9d, 0x43, 0x93, 0x6e, 0x7b, 0x2b, 0xb0, 0x24, 0x81,
+	0x5f, 0x98, 0xcd, 0xa3, 0x7a, 0xc9, 0xe8, 0xc0, 0x8a, 0xd2, 0x13, 0x1c, 0x0d, 0x69, 0x13, 0x2f,
+	0xa8, 0x9b, 0xf8, 0x3d, 0x80, 0xf1, 0xe4, 0x64, 0xe8, 0xf4, 0xe9, 0x44, 0xc2, 0xf1, 0xad, 0x70,
+	0xc8, 0x33, 0x72, 0x69, 0xfc, 0xb3, 0x02, 0xdc, 0x6a, 0xb3, 0x09, 0x75, 0xe4, 0x3b, 0x17, 0x76,
+	0x48, 0x9e, 0x91, 0xcb, 0xeb, 0x2a, 0x4f, 0xfe, 0x39, 0xe4, 0x2d, 0x7a, 0xd6, 0x61, 0xec, 0xd8,
+	0xf4, 0x7d, 0xe5, 0x9c, 0xb2, 0x11, 0xa9, 0x98, 0xd5, 0x71, 0x54, 0xcb, 0x0b, 0xe7, 0x94, 0x6e,
+	0xd2, 0x5c, 0x91, 0xd9, 0xba, 0x51, 0x36, 0xf1, 0x8b, 0xee, 0x1b, 0xf4, 0x7f, 0xeb, 0xd4, 0xf7,
+	0x46, 0x6c, 0x91, 0x98, 0x35, 0xcb, 0x14, 0xb0, 0xe7, 0x7b, 0x23, 0x43, 0x87, 0x46, 0xba, 0xc5,
+	0x38, 0x2f, 0xff, 0x79, 0x01, 0x56, 0x38, 0x92, 0x1f, 0x4f, 0xae, 0xdb, 0x95, 0x75, 0x98, 0xc3,
+	0x33, 0x0e, 0x9f, 0x97, 0xf8, 0x25, 0x35, 0xb0, 0x94, 0xdf, 0xc0, 0x19, 0xb5, 0x81, 0xda, 0x7b,
+	0xa0, 0xf9, 0xe4, 0xc7, 0x13, 0xc7, 0x27, 0x96, 0x4f, 0x06, 0x84, 0x8c, 0xec, 0x93, 0x21, 0xc1,
+	0x73, 0xc4, 0x32, 0x62, 0xcc, 0x08, 0x61, 0x7c, 0x0a, 0xab, 0x6a, 0x93, 0x71, 0x4c, 0x1f, 0xc0,
+	0xe2, 0x78, 0x3b, 0x38, 0xb7, 0xd4, 0x81, 0x5d, 0xa0, 0x30, 0x1c, 0x7e, 0xda, 0x2d, 0xa9, 0x86,
+	0x22, 0xab, 0x41, 0x82, 0x18, 0x2e, 0xd4, 0x70, 0xb9, 0xbe, 0xe1, 0x9a, 0xf8, 0x75, 0x58, 0xc7,
+	0x86, 0x0e, 0xac, 0xbe, 0xe7, 0x9e, 0x3a, 0xfe, 0xc8, 0xe6, 0x07, 0x1d, 0x7e, 0x9a, 0x5a, 0x13,
+	0xd8, 0x1d, 0x19, 0x69, 0xfc, 0xfd, 0x22, 0x2c, 0x45, 0x15, 0x62, 0x37, 0x56, 0x61, 0x96, 0xed,
+	0x1b, 0xac, 0xa2, 0x92, 0xc9, 0x3f, 0xe8, 0x31, 0x2c, 0x18, 0x13, 0x77, 0x10, 0x35, 0xbc, 0x64,
+	0xc6, 0x00, 0x7a, 0x0c, 0x73, 0x46, 0x23, 0x3b, 0x9c, 0x30, 0x11, 0xbe, 0xb2, 0xfd, 0x81, 0x38,
+	0x2b, 0x0b, 0xb0, 0xc9, 0xa0, 0xda, 0xb7, 0xe0, 0x76, 0x44, 0x18, 0x84, 0xf6, 0x4b, 0x62, 0x9d,
+	0x11, 0x97, 0xf8, 0xac, 0x39, 0x78, 0xce, 0xbd, 0x25, 0x08, 0xba, 0x14, 0xff, 0x24, 0x42, 0x6b,
+	0x5f, 0x81, 0x65, 0xba, 0x93, 0x92, 0x81, 0x75, 0x72, 0x69, 0x85, 0x4e, 0xff, 0x25, 0x09, 0x03,
+	0xbc, 0x88, 0x2c, 0x71, 0xc4, 0xe3, 0xcb, 0x1e, 0x07, 0xd3, 0x73, 0xfe, 0x85, 0x17, 0x3a, 0xee,
+	0x99, 0x65, 0x4f, 0xc2, 0x73, 0xcf, 0x77, 0xc2, 0x4b, 0xbc, 0x9b, 0x2c, 0x71, 0x78, 0x53, 0x80,
+	0xe9, 0x85, 0x6b, 0xe2, 0xa2, 0xcc, 0xc8, 0x80, 0x1d, 0x8b, 0x4b, 0xa6, 0x0c, 0x32, 0x1e, 0xc3,
+	0xda, 0x13, 0x12, 0x4a, 0xe7, 0x43, 0x31, 0x38, 0x5f, 0x56, 0x2f, 0x37, 0xd2, 0x99, 0x56, 0xbe,
+	0xad, 0xb0, 0xdd, 0xe2, 0xef, 0x15, 0x60, 0x3d, 0xc9, 0x24, 0x3a, 0xb4, 0x28, 0x37, 0x3e, 0xca,
+	0xe0, 0xca, 0x93, 0xa9, 0x5c, 0x42, 0x7b, 0x03, 0xaa, 0x59, 0x63, 0xae, 0x02, 0xd9, 0x76, 0x16,
+	0x1f, 0x69, 0x4a, 0xb8, 0x9d, 0x89, 0xb3, 0x8c, 0xf1, 0x5f, 0x8a, 0xc9, 0x06, 0x46, 0x8b, 0xff,
+	0x16, 0xac, 0x04, 0xa1, 0xed, 0x33, 0x71, 0x4a, 0x2c, 0x78, 0x4f, 0x97, 0x05, 0x2a, 0x3e, 0x16,
+	0x6d, 0xc3, 0x5a, 0x92, 0x3e, 0x3e, 0xd9, 0x2f, 0x9b, 0x2b, 0x6a, 0x09, 0x86, 0xa2, 0x83, 0x4b,
+	0xdc, 0x41, 0xa2, 0x06, 0xde, 0xc8, 0x25, 0x8e, 0x88, 0xf9, 0x6f, 0xc1, 0x8a, 0x4a, 0xcb, 0xb9,
+	0xf3, 0x69, 0xbd, 0x2c, 0x53, 0x73, 0xde, 0xdf, 0x83, 0x3b, 0x23, 0xc7, 0x75, 0x46, 0x93, 0x91,
+	0xe5, 0x93, 0x3e, 0x3d, 0xad, 0x29, 0x57, 0x01, 0xbe, 0x5e, 0xdd, 0x46, 0x12, 0x93, 0x51, 0xc8,
+	0x62, 0xd0, 0x3e, 0x82, 0x46, 0x68, 0xfb, 0x67, 0x44, 0x29, 0x27, 0x9d, 0x71, 0x66, 0xcd, 0x75,
+	0x8e, 0x97, 0x4a, 0xf1, 0x93, 0xce, 0xbf, 0x28, 0xc0, 0xad, 0x94, 0x50, 0x71, 0xd8, 0xf7, 0x40,
+	0x1b, 0x39, 0xec, 0xa4, 0x20, 0x37, 0x86, 0x8f, 0xfe, 0x2d, 0x69, 0xf4, 0xe5, 0x9b, 0x93, 0xb9,
+	0xcc, 0x8a, 0x28, 0xad, 0x3b, 0x82, 0xd5, 0x89, 0x9b, 0xc1, 0xa9, 0x78, 0x9d, 0x1b, 0xce, 0x0a,
+	0x16, 0x95, 0x39, 0x1a, 0x1f, 0x42, 0x9d, 0x36, 0x9a, 0x4d, 0x25, 0xa1, 0x03, 0x1b, 0xb0, 0xc0,
+	0xa7, 0x9c, 0x3c, 0xf6, 0xc0, 0x41, 0x4c, 0x7f, 0xfe, 0x5a, 0x11, 0x96, 0xa3, 0x52, 0xbf, 0x32,
+	0xaa, 0xb3, 0x05, 0x2b, 0x62, 0xe8, 0x79, 0xef, 0xe3, 0x73, 0xf0, 0xac, 0xb9, 0x8c, 0xa3, 0xce,
+	0x30, 0x7c, 0xc0, 0xff, 0xd3, 0x0c, 0x68, 0xb2, 0x14, 0x70, 0xac, 0x77, 0x60, 0x8e, 0x97, 0xc7,
+	0xf1, 0x7d, 0x47, 0x1a, 0x95, 0x34, 0xf9, 0x16, 0xff, 0x16, 0x63, 0x84, 0x45, 0xb5, 0xef, 0xc3,
+	0x2c, 0x6b, 0x34, 0x93, 0xc5, 0xc2, 0xf6, 0x57, 0xa6, 0xf3, 0x50, 0xd4, 0x86, 0x17, 0xd4, 0xff,
+	0xa4, 0x08, 0x55, 0x85, 0xb7, 0xf6, 0xf5, 0x44, 0xc3, 0xae, 0x50, 0x17, 0xd1, 0x94, 0x6f, 0xc2,
+	0x3c, 0x5b, 0xfc, 0x89, 0xdf, 0x28, 0x5e, 0xa7, 0x9c, 0xa0, 0xd6, 0xfe, 0x22, 0x54, 0x51, 0x90,
+	0x41, 0x68, 0x87, 0x93, 0x00, 0x0f, 0x7e, 0x1f, 0xdd, 0x40, 0x1e, 0xf8, 0xd5, 0x65, 0xe5, 0xcd,
+	0xc5, 0x50, 0xfa, 0x32, 0x7e, 0x0c, 0x8b, 0x32, 0x96, 0xda, 0x30, 0x8e, 0x3b, 0xcf, 0x3a, 0x87,
+	0x2f, 0x3a, 0xf5, 0x2f, 0xf1, 0x8f, 0x83, 0x76, 0xa7, 0xb5, 0x5b, 0x2f, 0x50, 0x83, 0x46, 0xfb,
+	0xe0, 0xa0, 0xd9, 0x3b, 0x66, 0x47, 0xb7, 0x32, 0xcc, 0xec, 0xb7, 0x9f, 0xb7, 0xea, 0x25, 0xad,
+	0x02, 0xb3, 0xd4, 0x8a, 0xb1, 0x5b, 0x9f, 0xd1, 0x00, 0xe6, 0x0e, 0xda, 0xdd, 0x6e, 0x6b, 0xb7,
+	0x3e, 0x4b, 0xcb, 0xb6, 0x3e, 0x39, 0x6a, 0x9b, 0xad, 0xdd, 0xfa, 0x1c, 0xb7, 0x8c, 0x3c, 0x3f,
+	0x7c, 0xd6, 0xda, 0xad, 0xcf, 0xeb, 0x9f, 0x7c, 0x51, 0xb6, 0x0d, 0x63, 0x15, 0x34, 0xde, 0x99,
+	0x23, 0xdf, 0x89, 0x0e, 0x04, 0xc6, 0x11, 0xac, 0x28, 0xd0, 0xf8, 0xf0, 0x81, 0x82, 0x1d, 0x53,
+	0x38, 0x6e, 0xde, 0x0b, 0x61, 0x4c, 0x9a, 0xd7, 0x0a, 0x43, 0x83, 0x3a, 0xdb, 0x6a, 0xdb, 0xee,
+	0xa9, 0x27, 0x6a, 0xf9, 0x93, 0x22, 0x2c, 0x4b, 0xc0, 0xd8, 0x3c, 0x30, 0xf6, 0xbc, 0xa1, 0x15,
+	0x38, 0x3f, 0x89, 0xcc, 0x03, 0x14, 0xd0, 0x75, 0x7e, 0x42, 0xe8, 0x19, 0xd2, 0x1e, 0x0e, 0xad,
+	0x11, 0x19, 0x31, 0x9a, 0xd0, 0x79, 0x8d, 0xa7, 0xcc, 0xaa, 0x3d, 0x1c, 0x1e, 0x70, 0x68, 0xcf,
+	0x79, 0x4d, 0xe9, 0xbc, 0x57, 0xae, 0x42, 0xc7, 0x0d, 0xb1, 0x55, 0xef, 0x95, 0x2b, 0xd1, 0x51,
+	0xdb, 0x18, 0x9e, 0x04, 0xf0, 0x96, 0x1a, 0x7d, 0x53, 0x21, 0x0f, 0x9d, 0x0b, 0x82, 0xf7, 0x51,
+	0xf6, 0x37, 0x3d, 0xb7, 0x5c, 0x78, 0x21, 0x19, 0xe0, 0xb5, 0x93, 0x7f, 0xd0, 0x4e, 0x8f, 0x9c,
+	0x20, 0xc0, 0x8d, 0xbd, 0x6a, 0xe2, 0x17, 0x3d, 0x0b, 0xfb, 0xe4, 0xc2, 0x7b, 0x49, 0x06, 0xcc,
+	0x7e, 0x58, 0x35, 0xc5, 0x27, 0xc5, 0x90, 0xd7, 0x63, 0x7a, 0x56, 0x6a, 0x54, 0x38, 0x06, 0x3f,
+	0xe3, 0x6b, 0x76, 0x30, 0x39, 0x09, 0x9c, 0xc1, 0x65, 0x03, 0xa4, 0x6b, 0x76, 0x97, 0xc3, 0x68,
+	0xf1, 0x89, 0x4b, 0xd5, 0x3d, 0x6c, 0x2c, 0xf0, 0xe2, 0xf8, 0x69, 0xf4, 0xa0, 0xce, 0x34, 0x45,
+	0x92, 0x73, 0x62, 0x53, 0x2e, 0x24, 0x36, 0x65, 0x76, 0x4b, 0x4d, 0xae, 0x82, 0xf4, 0x96, 0x1a,
+	0xaf, 0x50, 0xc6, 0xdf, 0x2e, 0xc2, 0xb2, 0xc4, 0x16, 0x47, 0xea, 0x17, 0xe6, 0x9b, 0x3e, 0x54,
+	0x94, 0xb2, 0x0e, 0x15, 0x8a, 0x06, 0xcf, 0x24, 0xad, 0x73, 0x52, 0x35, 0x36, 0x5d, 0x2b, 0x66,
+	0xb9, 0x31, 0x1b, 0xab, 0xa1, 0x20, 0x7a, 0x67, 0xe6, 0xe7, 0x40, 0xc7, 0xbd, 0xb0, 0x87, 0xce,
+	0xc0, 0x16, 0x23, 0x58, 0x36, 0xeb, 0x01, 0x57, 0xc0, 0x08, 0x9e, 0x65, 0xed, 0x9b, 0xcf, 0xb2,
+	0xf6, 0xd1, 0x37, 0x83, 0x5b, 0x3b, 0xe7, 0xb6, 0x7b, 0x46, 0x8e, 0xa2, 0x3b, 0x83, 0x10, 0xf9,
+	0x47, 0x50, 0xa2, 0x37, 0xab, 0x02, 0x5b, 0x78, 0xde, 0x92, 0x16, 0x9e, 0x9c, 0x02, 0x5b, 0xf4,
+	0xbe, 0x42, 0x8b, 0xd0, 0xb3, 0xb8, 0x37, 0x1c, 0x58, 0xd2, 0xc5, 0x84, 0x5f, 0x3e, 0xaa, 0xde,
+	0x70, 0x10, 0x17, 0xa3, 0x64, 0xd4, 0x3e, 0x21, 0x91, 0xf1, 0xcd, 0xa8, 0xea, 0x92, 0x57, 0x31,
+	0x99, 0x71, 0x1f, 0x4a, 0xcf, 0xc8, 0x25, 0x5d, 0x4c, 0x8e, 0xcc, 0xf6, 0xf3, 0x66, 0xaf, 0x55,
+	0xff, 0x12, 0x5d, 0x72, 0x8e, 0x8e, 0x1f, 0xef, 0xb7, 0x77, 0xea, 0x05, 0x7a, 0x6d, 0x4a, 0xb7,
+	0x08, 0xaf, 0x4d, 0x7f, 0x56, 0x84, 0xf5, 0xbd, 0x89, 0x3b, 0xc8, 0x38, 0x93, 0x4e, 0xb7, 0x49,
+	0xf2, 0xbd, 0x0c, 0xed, 0xca, 0xc2, 0x26, 0xc9, 0x80, 0xdc, 0x98, 0x3d, 0xe5, 0x22, 0x51, 0x9a,
+	0x72, 0x91, 0xd0, 0xbe, 0x03, 0xba, 0xe3, 0xf6, 0x87, 0x93, 0x01, 0xb1, 0xa2, 0xf3, 0x3d, 0x35,
+	0x1c, 0x9e, 0xd8, 0x01, 0x09, 0xf0, 0xb2, 0xd8, 0x40, 0x8a, 0x36, 0x12, 0xec, 0x08, 0x3c, 0xdd,
+	0xf5, 0x45, 0xe9, 0x3e, 0xeb, 0xb2, 0x30, 0x60, 0xf3, 0x3b, 0xd8, 0x0a, 0x22, 0xb9, 0x38, 0xd0,
+	0x8e, 0xfd, 0x57, 0x40, 0x17, 0xc6, 0x6e, 0x32, 0x24, 0xfc, 0x48, 0x66, 0x0f, 0xcf, 0xe8, 0x99,
+	0xfe, 0x7c, 0xc4, 0x34, 0xa8, 0xb6, 0xfd, 0xb1, 0x3c, 0xba, 0x9e, 0x1b, 0x84, 0xfe, 0xa4, 0x9f,
+	0x71, 0x8e, 0xc7, 0x47, 0x8e, 0xae, 0xe0, 0xd3, 0x14, 0x6c, 0xcc, 0x86, 0x97, 0x83, 0x31, 0xfe,
+	0x55, 0x09, 0x6e, 0xa5, 0x46, 0x00, 0x27, 0xdf, 0x5f, 0x80, 0x3a, 0x6f, 0x13, 0x19, 0x58, 0x9c,
+	0x81, 0xb0, 0x46, 0x7e, 0x55, 0x6a, 0x50, 0x4e, 0xe9, 0xad, 0x23, 0x7c, 0x5d, 0xe0, 0x8d, 0x32,
+	0x97, 0x04, 0x2b, 0xfe, 0x1d, 0xb0, 0x95, 0x9e, 0xad, 0x42, 0xca, 0x28, 0x2e, 0x30, 0x18, 0x0e,
+	0xe2, 0x23, 0xa8, 0xa3, 0x1c, 0xc7, 0x2f, 0x85, 0x28, 0xb9, 0x0e, 0xd6, 0x38, 0xfc, 0xe8, 0x25,
+	0x97, 0xa2, 0xfe, 0x7f, 0x0a, 0x50, 0x53, 0x2b, 0xbc, 0xc1, 0xa5, 0x86, 0x36, 0x05, 0xc7, 0x80,
+	0xbf, 0x7a, 0xf0, 0xf5, 0x7e, 0x81, 0xc3, 0xda, 0x14, 0x24, 0xbd, 0x62, 0x94, 0x94, 0x57, 0x0c,
+	0xba, 0x95, 0x44, 0x6d, 0x9b, 0x61, 0xec, 0xcb, 0x63, 0x6c, 0x15, 0xe5, 0x4b, 0x0f, 0xea, 0xd4,
+	0xaa, 0x4d, 0x17, 0x13, 0xbc, 0xe4, 0x2d, 0x20, 0xac, 0xe7, 0x70, 0x93, 0x27, 0xbd, 0xcb, 0x47,
+	0x4a, 0x86, 0x6b, 0xc6, 0x22, 0x05, 0x0a, 0xc5, 0xa2, 0xdb, 0x44, 0xe8, 0x13, 0xfe, 0xe0, 0x34,
+	0x6b, 0xb2, 0xbf, 0x8d, 0x3f, 0x2e, 0xc0, 0xda, 0x31, 0x5f, 0x91, 0x51, 0xa2, 0xbf, 0xc4, 0x33,
+	0xc7, 0xf8, 0xdd, 0x62, 0xa2, 0x37, 0x91, 0x12, 0xfe, 0x6a, 0x0f, 0x23, 0xdd, 0xe0, 0x78, 0x13,
+	0xac, 0x60, 0x32, 0x62, 0x5b, 0x78, 0xc9, 0xac, 0x70, 0x48, 0x77, 0x32, 0x32, 0x7e, 0x67, 0x1e,
+	0xee, 0x4c, 0x99, 0xf1, 0x74, 0x8d, 0x0e, 0xbc, 0x89, 0xdf, 0x27, 0x96, 0x3a, 0xe4, 0x55, 0x0e,
+	0x15, 0x26, 0xfa, 0xcf, 0x66, 0x56, 0xd1, 0xee, 0x02, 0x9c, 0x12, 0x62, 0x8d, 0x89, 0x6f, 0xbd,
+	0x3c, 0xc1, 0xe1, 0x2f, 0x9f, 0x12, 0x72, 0x44, 0xfc, 0x67, 0x27, 0x57, 0xac, 0x5c, 0x33, 0x5f,
+	0xf0, 0xca, 0xa5, 0xfd, 0x08, 0x34, 0x97, 0x5e, 0x5f, 0xf9, 0x02, 0x21, 0xd6, 0xa7, 0x59, 0xb6,
+	0x3e, 0xbd, 0x77, 0xa3, 0x6a, 0xcd, 0xba, 0xeb, 0xb9, 0x7c, 0x51, 0x16, 0x8b, 0xd3, 0x19, 0x68,
+	0xc8, 0x78, 0x40, 0x82, 0xd0, 0x71, 0xb9, 0x61, 0x67, 0x8e, 0xdd, 0x11, 0x3e, 0xba, 0x11, 0xf3,
+	0xdd, 0xb8, 0xbc, 0xb9, 0xcc, 0x79, 0x4a, 0x20, 0xed, 0x7d, 0x58, 0x91, 0x07, 0xc4, 0xe2, 0x53,
+	0x11, 0x55, 0x44, 0x93, 0x51, 0x3d, 0x86, 0xd1, 0x87, 0xb0, 0x9c, 0x62, 0x3c, 0xc5, 0x0c, 0x9b,
+	0x67, 0x60, 0xa4, 0x8a, 0xc3, 0xfe, 0xb2, 0xd0, 0xd5, 0x40, 0x1c, 0x5e, 0x39, 0x14, 0x1d, 0x15,
+	0xf4, 0xbf, 0x1c, 0x3d, 0x09, 0xff, 0x10, 0x16, 0x64, 0x51, 0x14, 0x7e, 0x41, 0x51, 0xc8, 0xcc,
+	0xa4, 0x59, 0x59, 0x94, 0x67, 0xa5, 0xf1, 0xbb, 0x05, 0x68, 0xe4, 0x69, 0x86, 0xb6, 0x04, 0x0b,
+	0xaa, 0x91, 0x7b, 0x1e, 0x4a, 0xcd, 0xfd, 0xfd, 0x7a, 0x41, 0x5b, 0x86, 0xea, 0x7e, 0xd3, 0x7c,
+	0xd2, 0xea, 0xf6, 0xac, 0xbd, 0xb6, 0xd9, 0xed, 0xd5, 0x8b, 0x9a, 0x06, 0xb5, 0xee, 0x41, 0x73,
+	0x7f, 0x3f, 0x86, 0x95, 0xb4, 0x3a, 0x2c, 0x1e, 0xee, 0xef, 0xc6, 0x90, 0x19, 0x66, 0x65, 0x37,
+	0x9b, 0x9d, 0x9d, 0xa7, 0x56, 0xb3, 0xb3, 0x6b, 0x3d, 0x3e, 0x3c, 0xee, 0xd0, 0x3b, 0x53, 0x1d,
+	0x16, 0x9b, 0xcf, 0x0f, 0xdb, 0xbb, 0xd6, 0x41, 0xfb, 0x93, 0x76, 0xe7, 0x49, 0x7d, 0xce, 0xf8,
+	0x3b, 0x45, 0xb8, 0x9b, 0xdd, 0x5d, 0x5c, 0xb4, 0xbe, 0x4a, 0x6d, 0x19, 0x81, 0x73, 0x96, 0x30,
+	0x66, 0xe0, 0xc2, 0xb5, 0x22, 0x70, 0x52, 0x51, 0xed, 0x63, 0xb8, 0xcb, 0xb7, 0xc3, 0xe8, 0xb1,
+	0x1e, 0x27, 0x97, 0x22, 0x99, 0xdb, 0x8c, 0x46, 0xdd, 0xe9, 0x70, 0xdd, 0xa6, 0x57, 0x7c, 0xc6,
+	0x40, 0x2d, 0xc7, 0xd7, 0xb9, 0x65, 0x86, 0x52, 0xe8, 0xb7, 0x61, 0x8d, 0x0e, 0xc1, 0x88, 0x9e,
+	0x48, 0x2d, 0x6c, 0x2b, 0xbb, 0x10, 0xf1, 0x4b, 0xca, 0x4a, 0x84, 0xec, 0x32, 0x1c, 0xbb, 0x1b,
+	0x3d, 0x80, 0x45, 0x9c, 0x16, 0x7c, 0x85, 0xe5, 0xf6, 0x83, 0x05, 0x0e, 0x63, 0x2b, 0xac, 0xf1,
+	0xa7, 0x45, 0x58, 0xa7, 0x25, 0x32, 0x16, 0xab, 0xab, 0x8c, 0xe1, 0x5f, 0x87, 0xf5, 0x80, 0xf8,
+	0x8e, 0x3d, 0x74, 0x7e, 0x92, 0x90, 0x1b, 0xd7, 0xdd, 0xb5, 0x18, 0x2b, 0x4b, 0xce, 0x06, 0xcd,
+	0x1e, 0x0c, 0x1c, 0xfa, 0x37, 0xbd, 0xd3, 0x30, 0xfd, 0x15, 0x0f, 0xe3, 0xdb, 0x92, 0x82, 0x66,
+	0xb7, 0x6a, 0xab, 0x19, 0x95, 0x45, 0x3b, 0xf8, 0xb2, 0x9d, 0x80, 0x04, 0xfa, 0xdf, 0x2a, 0x40,
+	0x3d, 0x49, 0xf7, 0x39, 0xef, 0x4c, 0x62, 0x73, 0x28, 0x49, 0x9b, 0xc3, 0xb4, 0x5d, 0xe9, 0x07,
+	0x33, 0xe5, 0x52, 0x7d, 0xc6, 0xac, 0x3a, 0x6e, 0xc4, 0x96, 0x50, 0xc3, 0xc1, 0xad, 0x54, 0x37,
+	0x51, 0x27, 0x37, 0xd3, 0xe6, 0xd9, 0x84, 0x43, 0xce, 0xd7, 0x60, 0x3d, 0xd2, 0x5a, 0x85, 0x2d,
+	0xb3, 0xc1, 0x55, 0xcd, 0x48, 0xa7, 0xdb, 0xae, 0x68, 0x36, 0x09, 0x8c, 0xff, 0x5e, 0x4a, 0xd5,
+	0x19, 0x5c, 0x77, 0xc4, 0x7f, 0x98, 0xf0, 0x66, 0xe0, 0xb6, 0xbe, 0x6f, 0xe4, 0x0f, 0x9a, 0xe0,
+	0xbc, 0x75, 0x9c, 0x9e, 0x42, 0xaa, 0x9b, 0x83, 0x76, 0x92, 0xa9, 0x16, 0xdc, 0xd5, 0xe8, 0xc3,
+	0x6b, 0xd4, 0xf0, 0x4b, 0xaa, 0x17, 0xfa, 0x3e, 0xac, 0x64, 0x08, 0x67, 0xca, 0xe4, 0x2a, 0x4c,
+	0x99, 0x5c, 0xc6, 0xff, 0x28, 0x40, 0x23, 0x2d, 0x21, 0x54, 0xa9, 0x4f, 0x13, 0xc3, 0xc7, 0x2f,
+	0x07, 0x5f, 0x9f, 0x2a, 0x5c, 0x5e, 0x74, 0xab, 0x3b, 0x7d, 0xf4, 0xf4, 0x97, 0xb0, 0x9c, 0x22,
+	0xf9, 0xc2, 0x54, 0xf8, 0x1f, 0x97, 0x60, 0x7d, 0xc7, 0x27, 0x76, 0x48, 0x68, 0x9d, 0xf8, 0xce,
+	0x73, 0xfd, 0xb7, 0x48, 0xdc, 0x79, 0x8b, 0xea, 0xce, 0x9b, 0x2f, 0xf0, 0xd2, 0xb4, 0xd5, 0x6c,
+	0x03, 0x16, 0xa4, 0x86, 0xe3, 0x62, 0x0c, 0x4e, 0xd4, 0x5c, 0xed, 0x07, 0x50, 0xa1, 0x2a, 0xc5,
+	0x7d, 0x5b, 0x66, 0x53, 0x2e, 0x68, 0xd9, 0xfd, 0xa0, 0xf2, 0xa6, 0x1a, 0xc7, 0xbc, 0x64, 0xca,
+	0xe7, 0xf8, 0x17, 0xf5, 0x77, 0x88, 0xb6, 0x9b, 0x58, 0xa3, 0xb8, 0xbb, 0x55, 0xe4, 0x60, 0x26,
+	0x2e, 0x59, 0xc6, 0xdf, 0x28, 0xc0, 0x82, 0xc4, 0x87, 0xee, 0xc0, 0xdd, 0xf6, 0x93, 0xa7, 0xcd,
+	0xee, 0x53, 0xeb, 0x70, 0x9f, 0xee, 0xc0, 0x12, 0x80, 0xef, 0xc4, 0x75, 0x58, 0x14, 0x80, 0xce,
+	0x61, 0xa7, 0x85, 0x1b, 0x31, 0x42, 0xba, 0xed, 0xce, 0x93, 0x7d, 0x6a, 0xab, 0x5c, 0x85, 0xba,
+	0x54, 0xec, 0x79, 0x73, 0xff, 0x98, 0x3a, 0x67, 0xdd, 0x86, 0xd5, 0x08, 0xda, 0xf9, 0xf4, 0xb0,
+	0xd3, 0xda, 0x69, 0x76, 0x8e, 0x9a, 0x9f, 0xd6, 0x7f, 0x5a, 0x30, 0x9e, 0xc3, 0xad, 0x54, 0x37,
+	0x51, 0x25, 0xe9, 0xfb, 0x9e, 0x00, 0x0a, 0x7b, 0x51, 0x04, 0xc8, 0x78, 0x94, 0x5e, 0x94, 0x1f,
+	0xa5, 0x7f, 0x00, 0xb7, 0x8f, 0xe8, 0x47, 0x70, 0x9e, 0xb1, 0x7b, 0xbd, 0x07, 0x5a, 0xee, 0x8e,
+	0xbe, 0x9c, 0x9a, 0x6f, 0xc6, 0x13, 0xd0, 0xb3, 0x78, 0xdd, 0xf8, 0x56, 0x63, 0x3c, 0x84, 0x07,
+	0xc8, 0xe8, 0x38, 0xfd, 0xc6, 0x21, 0xec, 0x9c, 0x6f, 0x80, 0x31, 0x8d, 0x08, 0xcd, 0x2d, 0x3f,
+	0x2d, 0xc1, 0xfa, 0xd1, 0xc4, 0xef, 0x9f, 0xdb, 0x01, 0x49, 0x3c, 0x70, 0x7c, 0xf6, 0x37, 0xf7,
+	0x0d, 0x58, 0x60, 0x56, 0x71, 0x6b, 0xe8, 0x8c, 0x1c, 0x71, 0xde, 0x00, 0x06, 0xda, 0xa7, 0x90,
+	0x29, 0x97, 0x0f, 0xae, 0xdc, 0x39, 0x97, 0x8f, 0x37, 0xa1, 0x86, 0x96, 0x60, 0xd5, 0x4d, 0x10,
+	0x0d, 0xef, 0xe2, 0x29, 0x7a, 0x03, 0x16, 0xdc, 0xc9, 0x28, 0x7a, 0x47, 0xe5, 0x46, 0x53, 0x70,
+	0x27, 0x23, 0xec, 0x20, 0x7b, 0xce, 0xa6, 0x06, 0x5a, 0xc1, 0x65, 0x1e, 0x9f, 0xb3, 0x3d, 0x6f,
+	0x28, 0x78, 0x08, 0x7b, 0xf0, 0x29, 0x21, 0x01, 0xbb, 0x83, 0x15, 0xb8, 0x3d, 0x78, 0x8f, 0x10,
+	0x76, 0x82, 0x66, 0x86, 0xd3, 0x4b, 0x34, 0xa3, 0xe2, 0x97, 0xb6, 0x06, 0x73, 0xe1, 0x6b, 0x5a,
+	0x04, 0xcd, 0xa7, 0xb3, 0xe1, 0xeb, 0x3d, 0x7e, 0xa1, 0xc3, 0x66, 0x53, 0xd4, 0x82, 0x30, 0x25,
+	0x52, 0xc8, 0x1e, 0xa1, 0x1e, 0x64, 0xb7, 0x52, 0x23, 0x80, 0x3a, 0xf1, 0x30, 0x7a, 0x53, 0xa0,
+	0xea, 0x40, 0xf8, 0x72, 0xba, 0x28, 0x5e, 0x06, 0x9e, 0x32, 0x98, 0xf1, 0x0d, 0xea, 0x73, 0x44,
+	0x0d, 0xbc, 0x37, 0x1b, 0x3f, 0xee, 0x51, 0xa4, 0x94, 0x43, 0x9d, 0xb8, 0x0f, 0x77, 0xf7, 0x3d,
+	0x7b, 0xd0, 0x64, 0x6e, 0x76, 0xbb, 0x76, 0x68, 0xef, 0x39, 0xc3, 0x90, 0xf8, 0x91, 0x66, 0x6d,
+	0xc0, 0xbd, 0x1c, 0x3c, 0x32, 0x38, 0x07, 0x8d, 0x4e, 0xc3, 0x03, 0x12, 0x04, 0xf6, 0x19, 0x91,
+	0x8d, 0x10, 0xd9, 0x37, 0x92, 0x06, 0xcc, 0x8f, 0x38, 0xad, 0x58, 0x31, 0xf1, 0x33, 0xd1, 0x87,
+	0x52, 0xaa, 0x0f, 0x1f, 0xc2, 0x8a, 0x52, 0xd3, 0x75, 0xa6, 0xbc, 0xf1, 0x87, 0x05, 0xa5, 0xd4,
+	0xb5, 0x15, 0xfe, 0x31, 0x94, 0xb1, 0x5d, 0xe2, 0x58, 0xf2, 0x56, 0x62, 0x5f, 0x4b, 0x70, 0xdc,
+	0x12, 0xed, 0x8a, 0xca, 0xe9, 0xdf, 0x85, 0x79, 0x04, 0x7e, 0x16, 0x79, 0x18, 0x7f, 0xb7, 0x00,
+	0xab, 0x6a, 0x45, 0xd1, 0x33, 0xdc, 0xbc, 0x4f, 0xc6, 0x43, 0x87, 0x88, 0x2d, 0xf7, 0xcb, 0xb9,
+	0x4d, 0x93, 0xb6, 0x5b, 0x93, 0x8c, 0x87, 0x97, 0xa6, 0x28, 0xa9, 0x7f, 0x0c, 0x95, 0x08, 0x7a,
+	0xc5, 0xb2, 0xb9, 0x0a, 0xb3, 0xc4, 0xf7, 0xd1, 0xff, 0xbc, 0x62, 0xf2, 0x0f, 0xe3, 0x01, 0x6c,
+	0x48, 0xab, 0x4c, 0xc7, 0x0b, 0x9d, 0x53, 0xa7, 0x6f, 0x2b, 0xcb, 0xd2, 0xef, 0x17, 0x61, 0x33,
+	0x9f, 0x06, 0x7b, 0xf3, 0x7d, 0x58, 0xb2, 0xc3, 0xd0, 0xee, 0x9f, 0x53, 0x8f, 0x08, 0x6a, 0x52,
+	0x17, 0xbd, 0xca, 0x7d, 0x3d, 0xae, 0x09, 0x7a, 0x06, 0x0d, 0xa8, 0x3d, 0x7d, 0x40, 0x54, 0x0e,
+	0x45, 0x36, 0x77, 0x6a, 0x03, 0xa2, 0x10, 0xe6, 0xbd, 0x31, 0x97, 0x3e, 0xeb, 0x1b, 0x33, 0x35,
+	0x7b, 0x65, 0x70, 0x14, 0x33, 0x78, 0x86, 0xb5, 0xa2, 0x91, 0x2e, 0x88, 0xb3, 0xf9, 0x1e, 0xdc,
+	0x11, 0xfe, 0xa4, 0x59, 0xe2, 0xfb, 0xbf, 0x05, 0xb8, 0x9b, 0x8d, 0xbf, 0x91, 0x33, 0xdc, 0x75,
+	0x5c, 0x2f, 0xb3, 0xbd, 0x2a, 0x4b, 0x37, 0xf2, 0xaa, 0x9c, 0xb9, 0x91, 0x57, 0xe5, 0x6c, 0x8e,
+	0x57, 0xe5, 0x6f, 0xc0, 0xa6, 0xbc, 0x11, 0x64, 0x09, 0x86, 0x2e, 0xd8, 0xe1, 0x6b, 0x75, 0x99,
+	0x2c, 0x87, 0xaf, 0xb9, 0x50, 0xe9, 0x0a, 0x1c, 0x84, 0xde, 0xd8, 0xb2, 0x4f, 0x43, 0x7c, 0xd7,
+	0x9d, 0x35, 0x2b, 0x14, 0xd2, 0xa4, 0x00, 0xe3, 0x9f, 0x14, 0xe1, 0xc1, 0x94, 0x0a, 0x50, 0xb2,
+	0x2f, 0x93, 0xcf, 0x46, 0x5c, 0x25, 0x5b, 0xaa, 0xc1, 0x63, 0x3a, 0x93, 0x2d, 0xc5, 0x8f, 0x42,
+	0x62, 0x96, 0x78, 0x7d, 0xd2, 0x7f, 0xaf, 0x00, 0x8d, 0x3c, 0x5a, 0xed, 0x16, 0xcc, 0x63, 0x5f,
+	0x71, 0x62, 0xce, 0xf1, 0x9e, 0x7e, 0x2e, 0xee, 0x32, 0xa9, 0x17, 0xb4, 0x99, 0xf4, 0xcb, 0xdc,
+	0x5f, 0x2f, 0xc0, 0x0a, 0x3f, 0x6e, 0xbd, 0x60, 0x7d, 0x17, 0x83, 0xf0, 0x0e, 0x2c, 0xe3, 0x61,
+	0x2a, 0xb5, 0x90, 0xd6, 0x39, 0x42, 0x7a, 0x4c, 0x7a, 0x8f, 0x9e, 0x34, 0xb9, 0x67, 0x5e, 0xea,
+	0xdd, 0x69, 0x19, 0x31, 0x12, 0xb9, 0x06, 0x33, 0x01, 0x21, 0x03, 0x6c, 0x2f, 0xfb, 0xdb, 0x58,
+	0x87, 0x55, 0xb5, 0x19, 0xb8, 0x01, 0xbd, 0x86, 0x0d, 0x01, 0x0f, 0xfb, 0xe7, 0x8e, 0x7b, 0x76,
+	0xe8, 0x0e, 0x2f, 0xd5, 0xa6, 0x3e, 0x02, 0xa6, 0xc3, 0xee, 0x80, 0x0c, 0xac, 0xf1, 0xe4, 0xc4,
+	0x12, 0x0f, 0x67, 0x15, 0xb3, 0x26, 0xe0, 0x47, 0x93, 0x13, 0xfa, 0x8c, 0x95, 0xd9, 0xa9, 0x62,
+	0x76, 0xa7, 0x0c, 0x03, 0x36, 0xf3, 0x6b, 0xc6, 0xd6, 0x7d, 0x1f, 0x96, 0x0f, 0xc7, 0xc4, 0xfd,
+	0xec, 0xa2, 0x33, 0x7e, 0x0d, 0x34, 0x99, 0x43, 0x7c, 0x5a, 0x78, 0x85, 0xb5, 0x5a, 0x9e, 0x3b,
+	0xe4, 0xfd, 0x29, 0x9b, 0x8b, 0xaf, 0xa4, 0xa6, 0xd0, 0xa7, 0xf7, 0x9d, 0xa1, 0x17, 0xa8, 0x03,
+	0x67, 0xac, 0xc1, 0x8a, 0x02, 0xc5, 0x96, 0xae, 0xc1, 0x0a, 0x87, 0xb4, 0x5e, 0x3b, 0x41, 0xec,
+	0xa0, 0xbe, 0x05, 0xab, 0x2a, 0x18, 0x1b, 0xc0, 0xce, 0x45, 0x14, 0x82, 0x35, 0xe3, 0x97, 0xf1,
+	0xfb, 0xf4, 0xc6, 0x18, 0xda, 0x7e, 0x48, 0x2d, 0x64, 0xc4, 0x0d, 0x26, 0x81, 0x39, 0xee, 0x8b,
+	0x8e, 0xbf, 0x0d, 0x4b, 0xe8, 0xdf, 0x9f, 0x70, 0x2f, 0xac, 0x21, 0x58, 0x1c, 0xc9, 0x74, 0x28,
+	0x4f, 0x02, 0xe2, 0x4b, 0xcb, 0x55, 0xf4, 0x4d, 0x71, 0x54, 0x6c, 0xaf, 0x3c, 0x5f, 0x28, 0x48,
+	0xf4, 0x4d, 0xaf, 0x88, 0x7d, 0xe2, 0xe3, 0x64, 0x24, 0x78, 0x39, 0x96, 0x41, 0xc6, 0x1d, 0xb8,
+	0x9d, 0xd1, 0x3c, 0x94, 0xc1, 0x3f, 0x2c, 0x40, 0x63, 0xd7, 0x09, 0xfa, 0xde, 0x05, 0xf1, 0xb1,
+	0x29, 0xf1, 0x91, 0xe1, 0x1d, 0x58, 0x1e, 0x20, 0xce, 0x92, 0xdc, 0xf3, 0xd9, 0x1b, 0xaf, 0x40,
+	0x08, 0xdf, 0xfc, 0x9b, 0x2a, 0x7c, 0x8e, 0x83, 0x51, 0x29, 0xc7, 0xc1, 0x88, 0xf6, 0x22, 0xa3,
+	0x9d, 0xd8, 0x8b, 0x7b, 0x70, 0x67, 0x8f, 0x84, 0xfd, 0xf3, 0x03, 0x27, 0x08, 0x1c, 0xf7, 0x6c,
+	0x27, 0x71, 0xa4, 0xbb, 0x0f, 0x77, 0xb3, 0xd1, 0x58, 0xfc, 0x2d, 0x78, 0x83, 0x7a, 0x01, 0xf4,
+	0x7d, 0xe7, 0x84, 0xf4, 0x3c, 0x56, 0x67, 0xe6, 0xf6, 0xf4, 0x36, 0xbc, 0x79, 0x05, 0x5d, 0xac,
+	0x59, 0xac, 0x42, 0xfe, 0x56, 0x1e, 0x95, 0xff, 0x83, 0x22, 0xac, 0xaa, 0x70, 0x54, 0xad, 0x6d,
+	0x58, 0x3b, 0xa5, 0x70, 0x32, 0xc0, 0x17, 0xf7, 0xc0, 0x92, 0x1f, 0x37, 0x56, 0x10, 0x89, 0xc5,
+	0xf8, 0x26, 0xf3, 0x3e, 0xac, 0x9e, 0x3a, 0x7e, 0x10, 0x5a, 0xf4, 0xcd, 0x3a, 0x15, 0xf3, 0xb0,
+	0xcc, 0x70, 0x1d, 0xf2, 0x2a, 0x92, 0xa0, 0xf6, 0x21, 0xac, 0xa7, 0x0a, 0xc8, 0x61, 0x0f, 0x2b,
+	0x6a, 0x11, 0x86, 0xd2, 0x3e, 0x82, 0xdb, 0x23, 0xdb, 0x61, 0xaf, 0x0e, 0x8e, 0x6b, 0x85, 0xce,
+	0x58, 0xae, 0x8a, 0x2b, 0xdb, 0x1a, 0x25, 0xd8, 0xa1, 0xf8, 0x9e, 0x33, 0x8e, 0xab, 0xfb, 0x0e,
+	0xdc, 0xc9, 0x2e, 0xc9, 0xeb, 0xe4, 0x96, 0xd4, 0x5b, 0xe9, 0xb2, 0x7c, 0x0d, 0x7e, 0x0d, 0x0d,
+	0x59, 0x52, 0xb2, 0x98, 0xa7, 0x4b, 0x6b, 0x36, 0x5b, 0x5a, 0x8f, 0xa0, 0x3e, 0xb4, 0x83, 0x10,
+	0x0b, 0xf0, 0x67, 0x2d, 0x6e, 0x61, 0xae, 0x51, 0x38, 0xa7, 0xa5, 0x2f, 0x5b, 0xc6, 0x3f, 0x28,
+	0xc0, 0x66, 0x96, 0xb6, 0x28, 0x4d, 0x68, 0xc2, 0x3d, 0xd1, 0x84, 0xfe, 0x29, 0xc7, 0x5b, 0x4c,
+	0x67, 0xd5, 0xb0, 0x04, 0x1d, 0x89, 0x76, 0x90, 0x86, 0xcd, 0x43, 0x94, 0xec, 0x77, 0xe1, 0x4e,
+	0x8a, 0x05, 0xbd, 0x55, 0x2a, 0x9e, 0x1d, 0x8d, 0x04, 0x83, 0x96, 0x3b, 0x40, 0x01, 0xb5, 0x41,
+	0xe7, 0x51, 0x0c, 0x47, 0xbe, 0x77, 0x46, 0xa7, 0x83, 0xd2, 0xbe, 0x1b, 0x45, 0x34, 0x3c, 0x83,
+	0xfa, 0x11, 0x21, 0xbe, 0xc2, 0x80, 0x1a, 0x0e, 0x08, 0xf1, 0x15, 0xc1, 0x56, 0x28, 0x64, 0x27,
+	0x19, 0xcb, 0xa6, 0x5a, 0x81, 0x0c, 0xfa, 0x30, 0x6d, 0x8e, 0xfb, 0xdd, 0x4b, 0xf7, 0xff, 0xa3,
+	0x35, 0x30, 0x7b, 0x25, 0x9b, 0xbd, 0xd1, 0x4a, 0x36, 0x97, 0xb3, 0x92, 0x19, 0xff, 0xa6, 0x04,
+	0x4b, 0x51, 0x8f, 0xe3, 0xbd, 0x22, 0xb8, 0x74, 0xfb, 0x64, 0x20, 0xf6, 0x0a, 0xfe, 0xa5, 0xed,
+	0xc3, 0xb2, 0x2b, 0x89, 0x99, 0xdb, 0xb4, 0x78, 0x04, 0xc6, 0x86, 0x7c, 0xa5, 0xb9, 0x74, 0xfb,
+	0xf2, 0x70, 0x30, 0x2b, 0x56, 0xdd, 0x4d, 0x40, 0xb4, 0xa7, 0x50, 0x65, 0xfa, 0x21, 0xa6, 0x01,
+	0x13, 0x8c, 0x1a, 0x3a, 0x95, 0x37, 0x89, 0xcc, 0xc5, 0x53, 0x09, 0xa3, 0xd9, 0xb0, 0xce, 0x39,
+	0x8d, 0xb8, 0xd2, 0x47, 0x2a, 0xd9, 0x98, 0x49, 0xf9, 0x3d, 0x5e, 0x35, 0x39, 0xcc, 0xd5, 0x53,
+	0x99, 0x02, 0x19, 0x69, 0x1d, 0x58, 0xe2, 0x9a, 0x67, 0x8d, 0x51, 0x63, 0xd9, 0x00, 0x2c, 0x6c,
+	0xbf, 0x29, 0xf1, 0xce, 0x57, 0x69, 0xb3, 0xe6, 0x2b, 0x38, 0x6d, 0x0f, 0xea, 0x4c, 0x43, 0x1d,
+	0xf7, 0xd4, 0xc3, 0xc3, 0x1f, 0xbe, 0x57, 0xde, 0x91, 0x18, 0x26, 0x15, 0xdb, 0x5c, 0xa2, 0x85,
+	0xda, 0x71, 0x19, 0xe3, 0xb7, 0x0b, 0x50, 0xeb, 0x8e, 0x2f, 0x64, 0x85, 0xfd, 0x22, 0xf7, 0x3d,
+	0x66, 0x3d, 0xba, 0xa0, 0x76, 0x21, 0x97, 0xf4, 0x43, 0x76, 0x11, 0xab, 0x50, 0xeb, 0xd1, 0xc5,
+	0x0e, 0x87, 0x30, 0x75, 0x8a, 0xda, 0xf3, 0xe7, 0xea, 0xf4, 0xcb, 0xa6, 0x4e, 0xab, 0xa0, 0x61,
+	0xad, 0x9e, 0x13, 0x85, 0x87, 0x19, 0x4d, 0x58, 0x51, 0xa0, 0x38, 0xae, 0x5f, 0x11, 0xcb, 0xb4,
+	0x35, 0xa6, 0x70, 0xc5, 0x2c, 0xea, 0xc7, 0xf4, 0xec, 0x00, 0xf4, 0x1d, 0xb8, 0x8d, 0x31, 0x15,
+	0xc4, 0xb4, 0xdd, 0x81, 0x37, 0xea, 0x12, 0x32, 0x90, 0xbc, 0xbc, 0xe9, 0x95, 0xc1, 0x1a, 0x12,
+	0xf7, 0x2c, 0x3c, 0xc7, 0x63, 0x03, 0x50, 0xd0, 0x3e, 0x83, 0x18, 0x7f, 0x09, 0xf4, 0xac, 0xd2,
+	0xb1, 0xd7, 0x21, 0x2b, 0x7e, 0x72, 0x19, 0x92, 0x20, 0x32, 0x87, 0x10, 0x1a, 0x98, 0x11, 0x92,
+	0x80, 0x06, 0xee, 0x31, 0xf4, 0x39, 0xbe, 0xd8, 0x54, 0xcc, 0x79, 0xfa, 0xfd, 0x94, 0xbc, 0xa6,
+	0xa7, 0x72, 0x86, 0x1a, 0xb9, 0x64, 0xe4, 0xb9, 0x4e, 0x1f, 0xc3, 0x8f, 0x16, 0x29, 0xf0, 0x00,
+	0x61, 0xc6, 0x36, 0x2c, 0xef, 0x92, 0xbe, 0x37, 0x20, 0x72, 0x93, 0xef, 0x01, 0xd0, 0xc5, 0x9d,
+	0xbf, 0x5a, 0xe0, 0x86, 0x50, 0xa1, 0x10, 0xf6, 0x52, 0x61, 0x7c, 0x13, 0x34, 0xb9, 0x4c, 0xec,
+	0x2d, 0x3b, 0x60, 0xd0, 0x81, 0xc5, 0xae, 0x4b, 0xf8, 0x22, 0x82, 0x30, 0x4a, 0x4a, 0xe7, 0xcf,
+	0x9a, 0x39, 0x71, 0xb9, 0xd9, 0xef, 0xf1, 0xe4, 0x92, 0xf8, 0xd7, 0xb5, 0x80, 0xe5, 0x9b, 0x7c,
+	0x69, 0xda, 0x02, 0x8c, 0x4a, 0xe9, 0xcb, 0x86, 0x82, 0x2a, 0x87, 0x0a, 0xaf, 0x93, 0x2d, 0x58,
+	0xc1, 0x40, 0x4e, 0x2b, 0xf4, 0x2c, 0x7a, 0xb4, 0x09, 0x6d, 0x47, 0x84, 0xc7, 0x2c, 0x23, 0xaa,
+	0xe7, 0x1d, 0x20, 0x42, 0x66, 0xab, 0x5a, 0x7c, 0x91, 0x2d, 0x07, 0xa6, 0x0c, 0xba, 0x73, 0x57,
+	0x18, 0x74, 0xe7, 0x13, 0x06, 0xdd, 0x3b, 0x50, 0x19, 0xd9, 0xaf, 0xd1, 0xbf, 0x98, 0x7b, 0xdc,
+	0x94, 0x47, 0xf6, 0x6b, 0xee, 0x5c, 0x4c, 0x5d, 0x33, 0x2f, 0x88, 0x6f, 0x9f, 0x11, 0xeb, 0x95,
+	0xe3, 0x0e, 0xbc, 0x57, 0x01, 0x33, 0xfb, 0xce, 0x9a, 0x35, 0x04, 0xbf, 0xe0, 0x50, 0xb6, 0x06,
+	0x8d, 0x7d, 0x62, 0x0f, 0x1a, 0x80, 0x6b, 0x10, 0xfb, 0x62, 0xf7, 0x32, 0x46, 0x62, 0x9d, 0x4c,
+	0x06, 0xd4, 0x95, 0x83, 0x9b, 0x80, 0x17, 0x39, 0xf0, 0x31, 0x83, 0xd1, 0xe3, 0x17, 0x3a, 0x62,
+	0xc5, 0x7e, 0xc8, 0x8b, 0x4c, 0x84, 0x35, 0x0e, 0x3f, 0x42, 0x6f, 0x64, 0xa3, 0x01, 0xeb, 0xc9,
+	0xd1, 0xc3, 0x43, 0xf5, 0x6f, 0x97, 0x60, 0x8d, 0x1d, 0xa0, 0x9a, 0x93, 0xd0, 0xfb, 0x9c, 0x06,
+	0x36, 0x67, 0xc4, 0x4a, 0x79, 0x23, 0xf6, 0x10, 0x6a, 0x54, 0x94, 0x92, 0x93, 0x10, 0x1f, 0xdc,
+	0x85, 0x91, 0xfd, 0x7a, 0x4f, 0xf8, 0x09, 0xbd, 0x0b, 0x5a, 0x24, 0x6f, 0xcb, 0x27, 0x43, 0x3b,
+	0x14, 0x2e, 0xcf, 0x05, 0xb3, 0x2e, 0x04, 0x6f, 0x22, 0x5c, 0xa5, 0xb6, 0x4f, 0x02, 0x6f, 0x38,
+	0x09, 0x09, 0xc6, 0x3c, 0x45, 0xd4, 0x4d, 0x84, 0x67, 0xa8, 0xcc, 0xfc, 0x75, 0x54, 0xa6, 0x7c,
+	0x85, 0xca, 0x54, 0x12, 0x2a, 0x63, 0x40, 0x95, 0x35, 0x8a, 0xf8, 0xfc, 0xd4, 0xde, 0x80, 0xa8,
+	0x9b, 0x47, 0xc4, 0x67, 0x07, 0x75, 0x3a, 0x52, 0xc9, 0xe1, 0xc0, 0x91, 0x5a, 0x87, 0xd5, 0x2e,
+	0x35, 0x3f, 0x25, 0xc6, 0x89, 0xda, 0xe4, 0x13, 0x70, 0x2c, 0xa0, 0x43, 0x43, 0x1a, 0x71, 0x66,
+	0x0e, 0x8a, 0xa2, 0xeb, 0xff, 0xe6, 0x1c, 0xdc, 0xce, 0x40, 0x4a, 0xf1, 0x98, 0xd9, 0xbe, 0x7f,
+	0x6f, 0x40, 0xcd, 0xbe, 0x38, 0x43, 0xb9, 0x8e, 0xbc, 0x81, 0x38, 0x52, 0x2e, 0xda, 0x17, 0x67,
+	0x4c, 0xa6, 0x07, 0xde, 0x80, 0x5d, 0x43, 0x23, 0xaa, 0xe7, 0x2f, 0x9a, 0x47, 0xd6, 0x80, 0x0c,
+	0x43, 0x5b, 0x28, 0x80, 0x20, 0xa5, 0x98, 0x5d, 0x8a, 0xb8, 0xf1, 0x14, 0x37, 0xa0, 0xca, 0x04,
+	0x18, 0x50, 0x72, 0xfb, 0xe2, 0x4c, 0xf8, 0xd2, 0x71, 0x60, 0xcf, 0x6b, 0x5e, 0x9c, 0x69, 0x5f,
+	0x85, 0xb5, 0x81, 0xe7, 0x86, 0xd6, 0x2b, 0xdb, 0x09, 0xad, 0x53, 0xcf, 0x57, 0xde, 0x76, 0xca,
+	0xa6, 0x46, 0x91, 0x2f, 0x6c, 0x27, 0xdc, 0xf3, 0x7c, 0xe9, 0x8d, 0x87, 0xbf, 0xca, 0x60, 0x7b,
+	0x31, 0xf8, 0x8d, 0xc3, 0x78, 0x4b, 0xef, 0x71, 0x5f, 0x36, 0xee, 0x17, 0x87, 0x0a, 0x50, 0x39,
+	0x25, 0xa4, 0xcb, 0x00, 0x54, 0xed, 0x28, 0x1a, 0x67, 0x65, 0xd0, 0xb7, 0x87, 0x34, 0x1b, 0x0b,
+	0xd7, 0x83, 0xfa, 0x29, 0x21, 0xdc, 0xfb, 0xaa, 0xcb, 0xe1, 0xd4, 0x26, 0x37, 0x72, 0x5c, 0xe9,
+	0xf1, 0x67, 0x6e, 0xe4, 0xb8, 0xf4, 0xf5, 0x87, 0x22, 0xf8, 0x84, 0x68, 0x2c, 0x22, 0x82, 0xcd,
+	0x84, 0xb4, 0x06, 0x55, 0x53, 0x1a, 0x94, 0xa3, 0xfa, 0xb5, 0x1c, 0xd5, 0xcf, 0x9e, 0x56, 0x4b,
+	0x39, 0xd3, 0xea, 0x0d, 0x3e, 0x53, 0x9d, 0x28, 0x60, 0xa1, 0xb1, 0xcc, 0xd7, 0xa5, 0x91, 0xfd,
+	0xba, 0x2d, 0xc2, 0x15, 0x52, 0xf3, 0x44, 0xbb, 0x62, 0x9e, 0xac, 0x24, 0xe6, 0xc9, 0x37, 0xe0,
+	0x16, 0x5f, 0x06, 0x45, 0x98, 0xd1, 0x18, 0xdf, 0xba, 0x82, 0xc6, 0x2a, 0x1b, 0xbc, 0x35, 0x8e,
+	0xc6, 0xc8, 0x0f, 0x81, 0xcc, 0x98, 0xc6, 0x6b, 0x59, 0xd3, 0x38, 0x7e, 0x72, 0x5b, 0x97, 0x9e,
+	0xdc, 0x8c, 0xf7, 0x60, 0xb9, 0x4b, 0x92, 0x11, 0xe8, 0xb9, 0x33, 0x81, 0x1e, 0x49, 0x64, 0x72,
+	0x9c, 0x73, 0x07, 0x70, 0xa7, 0x4b, 0xc2, 0xc7, 0x49, 0x8d, 0x95, 0x02, 0xc0, 0xb2, 0x14, 0xbd,
+	0x90, 0xa3, 0xe8, 0xd4, 0xc6, 0x92, 0xcd, 0x0e, 0xab, 0xfb, 0x26, 0xd4, 0xbb, 0x24, 0x3c, 0x60,
+	0xca, 0x21, 0xea, 0x48, 0xaf, 0xa6, 0x85, 0xd4, 0x6a, 0x6a, 0xac, 0xc0, 0xb2, 0x54, 0x10, 0xb9,
+	0xfd, 0x00, 0x74, 0x0e, 0x54, 0x06, 0x5d, 0xf0, 0xcd, 0xd6, 0x94, 0x42, 0xb6, 0xa6, 0x50, 0xe3,
+	0x51, 0x26, 0xaf, 0xcc, 0xaa, 0x84, 0x36, 0x66, 0x56, 0x15, 0xa9, 0x70, 0x21, 0x5b, 0x85, 0x13,
+	0x55, 0xc5, 0xbc, 0x22, 0xd3, 0xe9, 0xad, 0x2e, 0x09, 0x9f, 0xcb, 0x2a, 0x20, 0xf9, 0xbd, 0x26,
+	0x14, 0xa6, 0x90, 0xa1, 0x30, 0x74, 0x21, 0x4d, 0x73, 0x40, 0xee, 0xdf, 0x82, 0xb5, 0x2e, 0xdf,
+	0x68, 0x13, 0xbc, 0x93, 0x93, 0xa0, 0x90, 0x9a, 0x04, 0x6c, 0xad, 0x4f, 0x94, 0x45, 0xae, 0x5f,
+	0x05, 0x0d, 0x31, 0x74, 0x42, 0x48, 0xef, 0x15, 0xf1, 0xa4, 0x29, 0xa8, 0x93, 0x86, 0x5a, 0xc7,
+	0x94, 0x22, 0xc8, 0xe9, 0xdb, 0xb0, 0x86, 0xc2, 0xc1, 0xf5, 0x41, 0x30, 0x4b, 0x2d, 0x25, 0x85,
+	0xec, 0xcd, 0x28, 0x51, 0x38, 0xce, 0xc6, 0xd2, 0x3c, 0x23, 0xee, 0xc0, 0x8e, 0xcc, 0x70, 0x3f,
+	0x2f, 0xc1, 0x52, 0x04, 0x8a, 0xf7, 0x11, 0xe1, 0x17, 0x8a, 0xb3, 0x07, 0x3f, 0xb5, 0x6f, 0xc3,
+	0xbc, 0xcd, 0x89, 0xf1, 0x59, 0xf4, 0x81, 0x9c, 0x99, 0x44, 0x65, 0x83, 0xdf, 0xa6, 0x28, 0xa1,
+	0xff, 0x71, 0x01, 0xe6, 0x38, 0x4c, 0xab, 0x41, 0xd1, 0x19, 0xa0, 0x6c, 0x8b, 0x0e, 0x33, 0x5a,
+	0x0c, 0x08, 0x77, 0x40, 0x11, 0x1e, 0x7f, 0x15, 0x53, 0x06, 0xd1, 0x37, 0x81, 0x91, 0x1d, 0xbc,
+	0xc4, 0xb3, 0x26, 0xfb, 0x9b, 0xb6, 0xa6, 0x7f, 0xee, 0x39, 0x7d, 0x22, 0x1c, 0xfe, 0xa6, 0xb5,
+	0x66, 0x87, 0x51, 0x9a, 0xa2, 0x04, 0x7f, 0x28, 0xa2, 0x46, 0x2a, 0xc9, 0xab, 0xbb, 0xc2, 0x20,
+	0xcc, 0xa7, 0x7b, 0x03, 0xf8, 0x06, 0x82, 0x5e, 0xdf, 0xfc, 0x08, 0x02, 0x1c, 0x44, 0x09, 0xf4,
+	0xdf, 0x2a, 0xc0, 0x1c, 0xe7, 0xf9, 0xd9, 0x7a, 0x83, 0xb9, 0xaf, 0x58, 0x6f, 0xe8, 0xdf, 0xb4,
+	0x41, 0x4e, 0x40, 0xa7, 0x4d, 0xb4, 0x89, 0x96, 0xcd, 0x8a, 0x13, 0x34, 0x39, 0x40, 0x5b, 0x81,
+	0x59, 0x27, 0xb0, 0x5c, 0x0f, 0x2d, 0x35, 0x33, 0x4e, 0xd0, 0xf1, 0xe8, 0x6a, 0xf6, 0xdc, 0x0b,
+	0x09, 0x6f, 0x47, 0x34, 0xa6, 0xff, 0xb4, 0x08, 0x2b, 0x0a, 0xf8, 0xca, 0x71, 0xfd, 0x38, 0x96,
+	0x24, 0x1f, 0x57, 0xf9, 0xe2, 0x98, 0xc1, 0x2a, 0x25, 0x4d, 0x1d, 0xca, 0x34, 0x54, 0x4d, 0xea,
+	0x54, 0xf4, 0xad, 0xff, 0x2c, 0x96, 0xd4, 0x1d, 0xa8, 0x70, 0x6d, 0xb0, 0x22, 0x81, 0x95, 0x39,
+	0xa0, 0x3d, 0xa0, 0xc6, 0x03, 0x44, 0xa6, 0xa5, 0xb7, 0xcc, 0x31, 0xbb, 0x31, 0x82, 0xf2, 0xe2,
+	0xb5, 0x53, 0x5e, 0xfc, 0xa6, 0x55, 0xe6, 0x00, 0xce, 0x0b, 0x91, 0x32, 0xaf, 0x19, 0xce, 0x8b,
+	0x63, 0x24, 0x5e, 0x34, 0xb0, 0x7d, 0x8d, 0xaf, 0x15, 0x09, 0x59, 0x6a, 0xcd, 0x58, 0x32, 0xfc,
+	0x11, 0x50, 0x4e, 0xef, 0x91, 0x59, 0x24, 0x29, 0x1b, 0xfd, 0xf1, 0xf5, 0xba, 0xaf, 0xf4, 0xa7,
+	0xa8, 0xf6, 0xc7, 0xf8, 0x1a, 0xac, 0x27, 0x2b, 0xc3, 0x41, 0x95, 0x25, 0x5f, 0x50, 0x25, 0x6f,
+	0x9c, 0xc3, 0xea, 0x73, 0xe2, 0x3b, 0xa7, 0x97, 0x9f, 0x83, 0x7f, 0x86, 0xe2, 0x24, 0x50, 0x4a,
+	0x3a, 0x5a, 0xbc, 0x07, 0x6b, 0x89, 0x9a, 0xe2, 0x44, 0x0c, 0x2c, 0xf4, 0x0d, 0x8d, 0x35, 0xfc,
+	0xc3, 0xf8, 0xe9, 0x82, 0xb8, 0xd1, 0x2a, 0xee, 0x6f, 0x37, 0x70, 0x9e, 0x94, 0x74, 0x99, 0x5b,
+	0x87, 0xc5, 0x27, 0x95, 0x23, 0xb3, 0xad, 0xb3, 0x79, 0x8b, 0xba, 0x48, 0x01, 0x6c, 0x5a, 0xc7,
+	0xfe, 0x3c, 0x33, 0x8a, 0x3f, 0x4f, 0x56, 0x86, 0xb7, 0xd9, 0xcf, 0x25, 0xc3, 0xdb, 0xb7, 0x61,
+	0x8e, 0xdd, 0xea, 0xe9, 0x09, 0x36, 0x99, 0xcb, 0x29, 0x2d, 0x02, 0x91, 0xe8, 0x8e, 0x17, 0xa1,
+	0x89, 0xee, 0x44, 0x6c, 0xc3, 0x7c, 0x2a, 0xd1, 0x5d, 0x46, 0x69, 0x91, 0xe8, 0x0e, 0x0b, 0xe9,
+	0xff, 0xb5, 0x24, 0x32, 0xc9, 0x7d, 0x0b, 0x6e, 0x47, 0xce, 0x7e, 0x39, 0x32, 0xbe, 0x25, 0x08,
+	0x12, 0xae, 0x0a, 0xd4, 0xcd, 0x21, 0xb3, 0xac, 0xec, 0xb6, 0xda, 0xc8, 0x28, 0xcc, 0x5d, 0x16,
+	0xbf, 0x2f, 0xf9, 0xb0, 0xd6, 0xb6, 0xdf, 0xbd, 0x46, 0xf7, 0xb7, 0x7a, 0x3e, 0x21, 0x4c, 0x9a,
+	0xac, 0x24, 0x55, 0xf1, 0x80, 0x6a, 0xae, 0xdb, 0x8f, 0x82, 0x68, 0xc5, 0x37, 0x9b, 0x52, 0x3c,
+	0x84, 0xc6, 0x71, 0x71, 0x15, 0x2f, 0x73, 0x40, 0xdb, 0x4d, 0xbd, 0x6f, 0x73, 0xff, 0x30, 0x25,
+	0x42, 0x74, 0x03, 0xf8, 0x27, 0x76, 0x86, 0xc7, 0xd7, 0xf2, 0x47, 0xf3, 0xb6, 0x48, 0xb6, 0x17,
+	0xa9, 0xb9, 0xf0, 0x91, 0x2c, 0x73, 0x9d, 0x8c, 0xe0, 0xe8, 0xfb, 0xfb, 0x01, 0xac, 0x26, 0x49,
+	0x2d, 0x3b, 0x18, 0xb1, 0x8b, 0x44, 0xc5, 0xd4, 0x12, 0xe4, 0xcd, 0x60, 0x64, 0x7c, 0x04, 0x65,
+	0xd1, 0x57, 0x35, 0x55, 0xdd, 0x6a, 0x1c, 0xf3, 0xfd, 0x67, 0xe2, 0x5f, 0x81, 0xc6, 0x75, 0x77,
+	0x7b, 0xcd, 0x67, 0xad, 0x7a, 0x41, 0xff, 0xb7, 0x33, 0x72, 0xbe, 0xbe, 0x0b, 0x7b, 0x38, 0x11,
+	0x27, 0x2d, 0xfe, 0x11, 0x67, 0xf1, 0x2b, 0x26, 0xb2, 0xf8, 0xc9, 0x21, 0x1f, 0xd2, 0xb4, 0x89,
+	0x63, 0x45, 0x66, 0x94, 0x58, 0x11, 0xba, 0x4f, 0xc6, 0x5d, 0xe1, 0x36, 0x99, 0x4a, 0x20, 0x7a,
+	0x40, 0x43, 0x58, 0x22, 0xff, 0xbe, 0xa8, 0x83, 0x01, 0x66, 0x94, 0x10, 0x19, 0x69, 0x06, 0x91,
+	0xab, 0x66, 0xa0, 0x75, 0x61, 0x11, 0xf9, 0xf5, 0x87, 0x36, 0x5e, 0xd9, 0x6b, 0xdb, 0x1f, 0x5c,
+	0x47, 0xaf, 0xb7, 0xb8, 0xe0, 0x76, 0x68, 0x39, 0x73, 0x21, 0x88, 0x3f, 0xe8, 0xe2, 0x64, 0x8b,
+	0x87, 0xd0, 0x46, 0x99, 0x99, 0x91, 0x63, 0x00, 0x35, 0x61, 0xf7, 0xbd, 0xd1, 0xc8, 0x09, 0x47,
+	0xc4, 0x8d, 0x42, 0x23, 0x2a, 0xfc, 0x58, 0x1a, 0x23, 0x78, 0x64, 0x84, 0xf1, 0x3f, 0xa9, 0x9f,
+	0xab, 0xc4, 0xba, 0x0e, 0x8b, 0x9d, 0xc3, 0x8e, 0xd5, 0xed, 0x35, 0x3b, 0xbb, 0x4d, 0x73, 0x97,
+	0x87, 0xe0, 0x1f, 0x1d, 0x3f, 0xb6, 0x9e, 0xb5, 0x3e, 0xe5, 0x4e, 0xae, 0xf8, 0x61, 0x51, 0x6f,
+	0xd5, 0x7a, 0x91, 0xf9, 0xc1, 0xee, 0x98, 0xed, 0xa3, 0x1e, 0x07, 0x94, 0xb4, 0x2a, 0x54, 0x0e,
+	0x8e, 0xf7, 0x7b, 0x6d, 0xab, 0xdb, 0x7e, 0x52, 0x9f, 0xa1, 0x9f, 0x9d, 0xe3, 0xfd, 0x7d, 0x6b,
+	0xb7, 0xd9, 0x6b, 0xd6, 0x67, 0x99, 0xff, 0x2b, 0x1d, 0x53, 0xab, 0x7b, 0xfc, 0x98, 0x46, 0xea,
+	0xd3, 0x6c, 0x83, 0x73, 0x94, 0x88, 0x43, 0x9f, 0xb4, 0x3a, 0xf5, 0xf9, 0x98, 0x48, 0x4a, 0x49,
+	0x58, 0x56, 0x8a, 0x5a, 0x3b, 0x4f, 0x9b, 0x9d, 0x27, 0xad, 0x7a, 0x85, 0xd6, 0x2f, 0x5a, 0xd4,
+	0xdc, 0xef, 0xd5, 0x81, 0x92, 0xc9, 0x4d, 0x64, 0xd0, 0x05, 0xa3, 0x07, 0x77, 0xb8, 0xa0, 0x4d,
+	0xfb, 0x55, 0x86, 0xc3, 0xeb, 0x67, 0xf4, 0x18, 0xb7, 0xe0, 0x6e, 0x36, 0xd7, 0xeb, 0xa6, 0x89,
+	0x49, 0x0f, 0xbe, 0xe2, 0xe3, 0x6d, 0x6c, 0xc3, 0xfa, 0x73, 0x0c, 0xa5, 0xce, 0xc8, 0xee, 0x95,
+	0xb9, 0xa9, 0x19, 0x3f, 0x9f, 0x85, 0x5b, 0xa9, 0x42, 0xd8, 0xa0, 0xdb, 0x50, 0x76, 0x02, 0x4b,
+	0xde, 0xa2, 0xe6, 0x9d, 0x80, 0x11, 0xd3, 0xeb, 0xbc, 0x13, 0x58, 0xd4, 0x79, 0x0b, 0x93, 0x1c,
+	0xcd, 0x39, 0xc1, 0x81, 0xe3, 0x66, 0x39, 0x5e, 0x95, 0xb2, 0x1c, 0xaf, 0x36, 0x61, 0x11, 0xdd,
+	0x4d, 0xd8, 0x6d, 0x02, 0x4f, 0x1f, 0xd4, 0x07, 0xf9, 0x19, 0xb9, 0xa4, 0xed, 0xa0, 0x35, 0x20,
+	0x05, 0x46, 0x95, 0xcf, 0x71, 0x24, 0x5d, 0xd5, 0x9c, 0x40, 0xf6, 0xc8, 0xa6, 0x39, 0x35, 0x03,
+	0x5c, 0x66, 0x68, 0x9e, 0xab, 0x97, 0xd1, 0xfa, 0x32, 0x18, 0xf8, 0x7c, 0x73, 0xa0, 0x79, 0xae,
+	0xd0, 0x59, 0x9b, 0x32, 0xa7, 0xaf, 0x02, 0x38, 0x47, 0xf8, 0x76, 0x56, 0x4e, 0x6d, 0x67, 0x39,
+	0x32, 0xc1, 0x69, 0xc6, 0x16, 0x60, 0x08, 0xa2, 0xbf, 0xb5, 0x77, 0x40, 0x1b, 0xdb, 0x97, 0xcc,
+	0x76, 0x33, 0x18, 0xf8, 0xa2, 0x75, 0x15, 0xbe, 0x16, 0x8e, 0xed, 0xcb, 0x9e, 0x47, 0x19, 0x61,
+	0x23, 0xa9, 0x2d, 0xdc, 0x39, 0x0b, 0x2c, 0xb1, 0x02, 0x30, 0x53, 0x49, 0xd5, 0x5c, 0xa4, 0x40,
+	0x13, 0x61, 0xcc, 0xdd, 0x3d, 0xb0, 0xa2, 0xe4, 0xa1, 0x0b, 0xac, 0xa3, 0xe0, 0x04, 0x6d, 0x84,
+	0xc4, 0x8b, 0xd8, 0xa2, 0xb4, 0x88, 0x19, 0xff, 0xab, 0x00, 0x10, 0xb7, 0x91, 0x46, 0x7c, 0x75,
+	0x3c, 0xb7, 0x1b, 0xda, 0xee, 0xc0, 0xf6, 0x07, 0xbd, 0x4b, 0x9e, 0xe9, 0x93, 0xbb, 0xf4, 0xf4,
+	0x2e, 0x71, 0x8e, 0xb2, 0x2f, 0xee, 0xbc, 0x5e, 0x2f, 0x52, 0x08, 0x67, 0x80, 0x90, 0x12, 0x4d,
+	0xf7, 0x79, 0x30, 0x19, 0x86, 0x4e, 0xd7, 0x39, 0xeb, 0x5d, 0xd6, 0x67, 0xe8, 0x77, 0x67, 0x32,
+	0x1c, 0x52, 0x9f, 0xd7, 0xde, 0x65, 0x7d, 0x56, 0x5b, 0xc3, 0x54, 0x12, 0xdd, 0xc9, 0x09, 0x7b,
+	0xc7, 0xa1, 0xbb, 0x7b, 0x7d, 0x8e, 0x92, 0x89, 0x0c, 0x4f, 0xbd, 0xcb, 0xfa, 0x7c, 0x44, 0x46,
+	0xdd, 0x6d, 0xc5, 0x6b, 0x12, 0xce, 0x54, 0x2c, 0xcd, 0x43, 0x0d, 0x7b, 0x97, 0x38, 0x53, 0x27,
+	0x27, 0x2f, 0xc9, 0x65, 0x73, 0x18, 0xf6, 0x2e, 0xeb, 0x40, 0x93, 0xaf, 0x71, 0x00, 0x6d, 0x16,
+	0x07, 0x2e, 0x18, 0x1f, 0xc2, 0xad, 0x1d, 0xb6, 0x48, 0x85, 0x64, 0x90, 0xf0, 0xfb, 0x6d, 0xc0,
+	0xbc, 0x30, 0x8f, 0x71, 0x3f, 0x38, 0xf1, 0x69, 0x3c, 0x85, 0x8d, 0x27, 0x91, 0x99, 0xa3, 0xa5,
+	0x78, 0x39, 0xdd, 0x2c, 0x29, 0x97, 0xd1, 0x85, 0xcd, 0x7c, 0x4e, 0x38, 0x89, 0xde, 0x87, 0x55,
+	0xbb, 0xdf, 0xb7, 0x72, 0xbc, 0xac, 0x96, 0xed, 0x7e, 0x5f, 0x2d, 0x68, 0x38, 0x99, 0x4c, 0x7d,
+	0xe7, 0xe2, 0xc6, 0xed, 0x4b, 0xd8, 0xbb, 0x8b, 0x29, 0xbf, 0xe1, 0xe7, 0xf0, 0x60, 0x4a, 0x55,
+	0x51, 0xc8, 0xde, 0x9a, 0xda, 0x01, 0xdf, 0xb9, 0x90, 0x7a, 0xa0, 0xc9, 0x3d, 0xe0, 0x45, 0x8d,
+	0x7f, 0x5d, 0x80, 0x46, 0x7a, 0x5c, 0x90, 0xdf, 0x8f, 0x60, 0x49, 0x71, 0x54, 0x27, 0x59, 0xb1,
+	0xf3, 0x79, 0xa5, 0xb7, 0x7a, 0x72, 0x51, 0x33, 0xc9, 0x49, 0x6f, 0x8a, 0xfc, 0x37, 0xcd, 0x38,
+	0xcc, 0x53, 0xca, 0x7f, 0xb3, 0x18, 0x25, 0xb8, 0xc9, 0x77, 0x4c, 0xd0, 0xa0, 0xfe, 0x98, 0x04,
+	0xa1, 0x6c, 0x59, 0x30, 0x3e, 0x86, 0x65, 0x09, 0x16, 0x3f, 0xb6, 0x4a, 0x4e, 0x1c, 0xd5, 0x28,
+	0xe9, 0x8b, 0x48, 0x10, 0x53, 0x8c, 0x13, 0xc4, 0x18, 0xff, 0x9e, 0x3a, 0x5b, 0xbf, 0x22, 0x64,
+	0x9c, 0xce, 0x88, 0x99, 0x11, 0xa6, 0x5c, 0x49, 0x86, 0x29, 0xbf, 0x0f, 0x2b, 0x52, 0x58, 0xa8,
+	0xa5, 0xb6, 0x5c, 0x93, 0x50, 0xcd, 0x38, 0xc6, 0x66, 0x4a, 0xac, 0x7a, 0xf5, 0x7a, 0x71, 0xcd,
+	0x33, 0xdc, 0x1e, 0x23, 0xe2, 0x9a, 0x8d, 0xff, 0x4d, 0xdd, 0xae, 0x95, 0x4e, 0xfc, 0x6a, 0x47,
+	0x75, 0x1a, 0x7b, 0xb0, 0xcc, 0xdd, 0x18, 0x8f, 0x82, 0x93, 0x68, 0xc8, 0x6e, 0xde, 0x59, 0xe3,
+	0x11, 0x68, 0x32, 0x1f, 0x94, 0x9a, 0x06, 0x33, 0xe3, 0xe0, 0x44, 0x28, 0x26, 0xfb, 0xdb, 0xf8,
+	0x83, 0x02, 0x68, 0x47, 0xbe, 0xd7, 0x27, 0x41, 0x20, 0xd7, 0x79, 0xd5, 0xc3, 0x95, 0x60, 0x55,
+	0x8c, 0x59, 0x51, 0x18, 0x6d, 0x09, 0xe6, 0x49, 0x64, 0x7f, 0xab, 0x21, 0x52, 0x33, 0xbf, 0x50,
+	0x88, 0x94, 0xd1, 0x82, 0x15, 0xa5, 0xa5, 0xf9, 0xbd, 0xa2, 0x97, 0x94, 0xbe, 0x37, 0x1a, 0x0f,
+	0x49, 0x28, 0x8e, 0x0b, 0xd1, 0xb7, 0xf1, 0x0e, 0xac, 0xec, 0x78, 0xa3, 0x13, 0xc7, 0x65, 0xc2,
+	0x89, 0x96, 0x6f, 0x9a, 0xad, 0x9d, 0x7e, 0xe3, 0xe2, 0xcd, 0x3f, 0x8c, 0xaf, 0xc0, 0xaa, 0x4a,
+	0x3c, 0x45, 0x94, 0x3b, 0xb0, 0xb2, 0xe7, 0xb8, 0xec, 0x18, 0x26, 0x8b, 0x32, 0xab, 0x7d, 0x2c,
+	0x23, 0x50, 0xe8, 0xdb, 0xfd, 0x10, 0x9b, 0x27, 0x3e, 0xa9, 0x95, 0x40, 0x65, 0xf2, 0xd9, 0x7a,
+	0x99, 0x0c, 0xd0, 0x2b, 0xa5, 0x02, 0xf4, 0x8c, 0x4b, 0xa8, 0x3d, 0x9e, 0x8c, 0xc6, 0x92, 0xd5,
+	0xfb, 0xaa, 0x41, 0xcf, 0xb2, 0x08, 0x14, 0xb3, 0x2d, 0x02, 0x53, 0xf3, 0x15, 0x18, 0xbf, 0x53,
+	0x80, 0xa5, 0xa8, 0xee, 0x6b, 0x07, 0xc5, 0xde, 0xa0, 0x7a, 0x1a, 0xcd, 0xe9, 0x3b, 0x67, 0x54,
+	0x90, 0xec, 0x91, 0x82, 0x4f, 0xd2, 0x05, 0x01, 0xa3, 0xcf, 0x40, 0x98, 0x76, 0x7e, 0x26, 0x4a,
+	0x3b, 0x4f, 0xed, 0x4e, 0xb7, 0x77, 0xce, 0x1d, 0x9a, 0x79, 0xe7, 0x32, 0xd8, 0xf3, 0x7c, 0x9e,
+	0xf9, 0xe7, 0x0b, 0x10, 0x8e, 0xf4, 0x20, 0x52, 0x52, 0x9f, 0x06, 0xd3, 0xcb, 0xa1, 0x2c, 0xb6,
+	0xff, 0x58, 0x00, 0x3d, 0xab, 0x81, 0x5f, 0x84, 0x04, 0xa9, 0x9b, 0x1d, 0x63, 0x2f, 0xc9, 0xaf,
+	0xc2, 0x21, 0x99, 0xd2, 0xa3, 0xf7, 0x3a, 0x11, 0x7e, 0x61, 0xbb, 0x7d, 0x12, 0x84, 0x9e, 0x1f,
+	0x39, 0xb2, 0x21, 0xa2, 0x29, 0xe0, 0xc6, 0x7f, 0x2b, 0xc0, 0xc2, 0xbe, 0x7d, 0x42, 0x86, 0xfc,
+	0x31, 0x4f, 0x7b, 0x1f, 0x53, 0xfe, 0xf2, 0x14, 0x4a, 0xb2, 0x5f, 0x8b, 0x44, 0x25, 0xe7, 0xfa,
+	0xcd, 0x0f, 0xf6, 0xcc, 0xea, 0x63, 0xe9, 0x7a, 0x31, 0xbf, 0x33, 0xa9, 0x98, 0x5f, 0xe3, 0x03,
+	0xcc, 0x05, 0xbc, 0x00, 0xf3, 0xcd, 0xdd, 0x5d, 0xb3, 0xd5, 0xed, 0xf2, 0xe0, 0xca, 0x9e, 0xd9,
+	0xec, 0x74, 0x9b, 0x3b, 0xec, 0xee, 0x57, 0xa0, 0x49, 0x96, 0x0e, 0x8f, 0x7b, 0x47, 0xc7, 0xbd,
+	0x7a, 0xd1, 0x78, 0x01, 0x4b, 0x5d, 0x12, 0xb2, 0x66, 0xc7, 0x2f, 0x56, 0x73, 0x98, 0x4c, 0x82,
+	0x5f, 0xb1, 0xd6, 0xb3, 0xfb, 0x67, 0x22, 0x55, 0x9c, 0x50, 0xbf, 0x28, 0x27, 0xd4, 0xd7, 0xd8,
+	0x3b, 0x15, 0x32, 0xc6, 0x27, 0x84, 0x26, 0x2c, 0x3d, 0xf9, 0xc5, 0x2a, 0x33, 0x1e, 0xb1, 0xd4,
+	0x8c, 0x0a, 0xdb, 0xb8, 0x01, 0x05, 0xb9, 0x01, 0x2b, 0xb0, 0xbc, 0xef, 0x04, 0x9c, 0x34, 0x32,
+	0x6f, 0xff, 0xac, 0x00, 0x9a, 0x0c, 0x45, 0x0e, 0xdf, 0x86, 0x39, 0x56, 0x28, 0x2b, 0x69, 0x7a,
+	0x9a, 0x9c, 0x37, 0xcc, 0xc4, 0x22, 0xfa, 0x01, 0xcc, 0x32, 0xc0, 0xe7, 0x23, 0xb8, 0xaf, 0xfc,
+	0xa3, 0x12, 0xac, 0x66, 0x39, 0x9a, 0xd1, 0x61, 0xeb, 0x7e, 0xda, 0xd9, 0x61, 0x29, 0x2b, 0x16,
+	0xa1, 0x7c, 0xdc, 0xc1, 0xaf, 0x02, 0x8d, 0x8d, 0x3d, 0x6a, 0xb5, 0x4c, 0x6b, 0xe7, 0xb0, 0xd3,
+	0x69, 0xed, 0xd0, 0xe4, 0x7d, 0x45, 0x7a, 0x9b, 0x60, 0xb0, 0xdd, 0x76, 0x37, 0x06, 0x97, 0xb4,
+	0x37, 0x60, 0x73, 0xaf, 0xd5, 0xdb, 0x79, 0xda, 0xa2, 0x59, 0x29, 0xba, 0x34, 0x92, 0xd6, 0xda,
+	0xd9, 0x6b, 0xef, 0xf7, 0x5a, 0x66, 0x97, 0xda, 0x29, 0x4c, 0x9e, 0xf9, 0xef, 0x4d, 0x78, 0x90,
+	0x4b, 0x75, 0x64, 0x1e, 0x3e, 0x61, 0x9a, 0x35, 0x3b, 0x95, 0x6c, 0xaf, 0xdd, 0x69, 0x77, 0x9f,
+	0xb2, 0x74, 0x81, 0x77, 0xe0, 0x96, 0x20, 0x7b, 0xda, 0x6a, 0xee, 0xca, 0x55, 0xcd, 0x6b, 0x77,
+	0xa1, 0x91, 0x44, 0x46, 0x35, 0x94, 0xb3, 0xb0, 0x11, 0xe3, 0x8a, 0x76, 0x1f, 0x74, 0xd6, 0xbd,
+	0xe7, 0x2d, 0xd3, 0x42, 0x7d, 0x6f, 0xc5, 0xbc, 0x41, 0xdb, 0x80, 0x3b, 0x19, 0xf8, 0x88, 0xc1,
+	0x02, 0x15, 0x9c, 0xd9, 0xea, 0xee, 0x34, 0x3b, 0x51, 0xa1, 0x45, 0x7a, 0x91, 0x42, 0x58, 0xd4,
+	0x8e, 0xaa, 0x04, 0x8c, 0x4a, 0xd7, 0xb6, 0xcd, 0xe8, 0xa7, 0x5a, 0xba, 0xc4, 0xbf, 0xa0, 0x46,
+	0xfa, 0xef, 0xc3, 0x3c, 0x42, 0xb4, 0xdb, 0xf2, 0x65, 0x59, 0xf9, 0x41, 0x17, 0x5d, 0xcf, 0x42,
+	0x71, 0xd5, 0xda, 0xfe, 0x99, 0x01, 0x55, 0x1e, 0x35, 0x21, 0x78, 0x7e, 0x13, 0x66, 0xe8, 0xaf,
+	0x22, 0x68, 0xb2, 0x36, 0x49, 0xbf, 0x9a, 0xa0, 0xdf, 0x4a, 0xc1, 0xa3, 0xf0, 0xb8, 0x79, 0xfc,
+	0xf5, 0x03, 0xa5, 0x31, 0xea, 0x4f, 0x2a, 0xe8, 0x7a, 0x16, 0x2a, 0x0a, 0x17, 0x2c, 0x8b, 0x5f,
+	0x48, 0xd0, 0x74, 0xe5, 0xf6, 0xa1, 0xfc, 0x92, 0x82, 0x7e, 0x27, 0x13, 0x87, 0x4c, 0x4c, 0xa8,
+	0x2a, 0x3f, 0x7d, 0xa0, 0x6d, 0xa4, 0x7f, 0x91, 0x40, 0xf9, 0x3d, 0x05, 0x7d, 0x33, 0x9f, 0x20,
+	0x6e, 0x18, 0x22, 0x02, 0xa5, 0x61, 0x89, 0xdf, 0x48, 0xd0, 0xef, 0x64, 0xe2, 0x62, 0xf9, 0x88,
+	0x9f, 0x06, 0x90, 0xe5, 0xa3, 0xe6, 0x9f, 0xd6, 0xf5, 0x2c, 0x14, 0x72, 0x08, 0xa0, 0x91, 0x77,
+	0xbf, 0xd5, 0x12, 0xd9, 0x49, 0xa7, 0x5d, 0xa7, 0xf5, 0x77, 0xae, 0x45, 0x8b, 0x95, 0x5e, 0xc0,
+	0xed, 0x0c, 0x1a, 0x7e, 0xb3, 0xd4, 0xae, 0xe0, 0xa4, 0xdc, 0x92, 0xf5, 0x77, 0xaf, 0x47, 0x8c,
+	0xf5, 0x1e, 0x43, 0x4d, 0xcd, 0xe4, 0xab, 0x6d, 0xaa, 0xe5, 0xd3, 0x96, 0x40, 0xfd, 0xc1, 0x14,
+	0x0a, 0x64, 0xfb, 0x43, 0x58, 0x52, 0x31, 0x81, 0x96, 0x5f, 0x2a, 0x1a, 0x58, 0x63, 0x1a, 0x09,
+	0xe7, 0xfc, 0x41, 0x41, 0x7b, 0x02, 0x95, 0x28, 0x99, 0xaa, 0x76, 0x27, 0x2b, 0xc5, 0xaa, 0xe0,
+	0x77, 0x6f, 0x6a, 0xfe, 0x55, 0xed, 0x19, 0x40, 0x0c, 0xd5, 0xee, 0xe6, 0x10, 0x5f, 0x87, 0xd5,
+	0x07, 0x05, 0x6d, 0x1f, 0x16, 0xa4, 0x04, 0xa6, 0x9a, 0x4c, 0x9f, 0x4e, 0x77, 0xaa, 0xdf, 0xcf,
+	0x43, 0x47, 0x59, 0x94, 0x2b, 0x51, 0x9e, 0x52, 0xa5, 0x8f, 0xc9, 0x94, 0xa6, 0xfa, 0xdd, 0x6c,
+	0x64, 0xcc, 0x27, 0xca, 0xa2, 0xa9, 0xf0, 0x49, 0xa6, 0xec, 0xd4, 0xef, 0x66, 0x23, 0x25, 0x3e,
+	0xc2, 0x14, 0xa0, 0xf2, 0x49, 0x18, 0x0d, 0xf4, 0xbb, 0xd9, 0xc8, 0x78, 0x8a, 0x8b, 0x8d, 0x5e,
+	0x99, 0xe2, 0x89, 0x03, 0x84, 0x7e, 0x27, 0x13, 0x87, 0x4c, 0xda, 0x00, 0xf1, 0xf6, 0xad, 0x8c,
+	0x5b, 0xea, 0x68, 0xa0, 0xdf, 0xcb, 0xc1, 0x22, 0xab, 0x89, 0x12, 0x69, 0xa9, 0x84, 0x2b, 0x29,
+	0x73, 0xfd, 0x8a, 0xc8, 0x66, 0xfd, 0x9d, 0x6b, 0xd1, 0x46, 0xca, 0xe2, 0xc4, 0xbf, 0x39, 0xa3,
+	0x54, 0xf9, 0x56, 0xc6, 0x1a, 0x99, 0x55, 0xdd, 0xdb, 0x57, 0xd2, 0x45, 0x55, 0xfd, 0x04, 0x6e,
+	0xe7, 0x46, 0xa6, 0x2a, 0x0b, 0xcb, 0x55, 0x51, 0xb6, 0xfa, 0xbb, 0xd7, 0x23, 0xe6, 0x35, 0x3f,
+	0x2a, 0x7c, 0x50, 0xd0, 0x7e, 0x04, 0xf5, 0x64, 0xae, 0x4f, 0xcd, 0xb8, 0x3a, 0x35, 0xa9, 0xfe,
+	0x70, 0x2a, 0x4d, 0xbc, 0x03, 0x29, 0x3f, 0x8a, 0xa2, 0xec, 0x40, 0x59, 0x3f, 0xc4, 0xa2, 0x6f,
+	0xe6, 0x13, 0x44, 0x8f, 0x11, 0x73, 0xdc, 0x11, 0x5d, 0x6b, 0xa4, 0xfc, 0xe4, 0x05, 0x97, 0xdb,
+	0x19, 0x18, 0x79, 0x15, 0x90, 0x7e, 0xa5, 0x44, 0x59, 0x05, 0xd2, 0x3f, 0x8b, 0xa2, 0xdf, 0xcf,
+	0x43, 0x63, 0x73, 0x04, 0x37, 0xf1, 0x1b, 0x1a, 0x53, 0x7f, 0x47, 0x44, 0xbf, 0x9f, 0x87, 0x8e,
+	0x4c, 0x90, 0xf5, 0xe4, 0x0f, 0x56, 0x28, 0xa3, 0x91, 0xf3, 0xfb, 0x1b, 0xfa, 0xc3, 0xa9, 0x34,
+	0xc8, 0xfc, 0x10, 0x16, 0xe5, 0x5f, 0x8f, 0xd0, 0xee, 0xa7, 0x0a, 0x29, 0xbf, 0x84, 0xa1, 0x6f,
+	0xe4, 0xe2, 0x91, 0xe1, 0x27, 0xb0, 0x94, 0x48, 0x25, 0xaa, 0xec, 0x20, 0xd9, 0x69, 0x62, 0x75,
+	0x63, 0x1a, 0x09, 0x72, 0x7e, 0x0e, 0x35, 0x35, 0x53, 0xa6, 0xb2, 0xe5, 0x65, 0x26, 0xd1, 0xd4,
+	0x73, 0x29, 0xa4, 0xb1, 0x3f, 0xa3, 0x66, 0x9a, 0x74, 0x16, 0x38, 0x65, 0x52, 0x4f, 0xc9, 0x8a,
+	0xa7, 0xbf, 0x7d, 0x25, 0x5d, 0x2c, 0x9a, 0x44, 0x22, 0x25, 0x45, 0x34, 0xd9, 0x89, 0xcd, 0x74,
+	0x63, 0x1a, 0x49, 0xac, 0x22, 0x09, 0x54, 0xa0, 0x19, 0x57, 0x27, 0xc7, 0xd2, 0x1f, 0x4e, 0xa5,
+	0x89, 0x9b, 0x9d, 0x30, 0xb5, 0x29, 0xcd, 0xce, 0x36, 0xc3, 0xe9, 0xc6, 0x34, 0x12, 0xe4, 0x6c,
+	0x83, 0x96, 0x4e, 0xae, 0xa3, 0xc9, 0x9e, 0x11, 0xb9, 0x79, 0x7c, 0xf4, 0x37, 0xaf, 0xa0, 0xc2,
+	0x2a, 0x2e, 0x41, 0xcf, 0xcf, 0xa8, 0xa3, 0xbd, 0x9b, 0x66, 0x92, 0x9f, 0x9d, 0x47, 0x7f, 0xef,
+	0x9a, 0xd4, 0xb1, 0xdc, 0x12, 0x39, 0x62, 0x14, 0xb9, 0x65, 0x67, 0xf0, 0xd1, 0x8d, 0x69, 0x24,
+	0xf2, 0x12, 0x2a, 0x65, 0x81, 0x49, 0x2c, 0xa1, 0xe9, 0xbc, 0x32, 0xfa, 0x66, 0x3e, 0x01, 0xf2,
+	0xfc, 0x4d, 0x58, 0xcb, 0x4c, 0x10, 0xa3, 0xc9, 0xea, 0x3d, 0x2d, 0xc5, 0x8c, 0xfe, 0xe8, 0x6a,
+	0xc2, 0x78, 0x7d, 0x94, 0xd2, 0x9b, 0x28, 0xeb, 0x63, 0x3a, 0x07, 0x8d, 0x7e, 0x3f, 0x0f, 0x1d,
+	0x2f, 0x61, 0x12, 0x38, 0xd0, 0xee, 0x4f, 0x4f, 0xf0, 0xa2, 0x6f, 0xe4, 0xe2, 0xe3, 0x81, 0x4b,
+	0x3c, 0xa8, 0x2a, 0x03, 0x97, 0xfd, 0x6a, 0xad, 0x1b, 0xd3, 0x48, 0xe2, 0x79, 0x9a, 0x7c, 0x2b,
+	0x52, 0x37, 0xd6, 0xec, 0xe7, 0x41, 0xfd, 0xe1, 0x54, 0x1a, 0x49, 0x0e, 0xd2, 0x7b, 0x87, 0x2a,
+	0x87, 0xf4, 0x6b, 0x8e, 0xbe, 0x91, 0x8b, 0x8f, 0xcf, 0x6b, 0xf1, 0x43, 0x80, 0x72, 0x5e, 0x4b,
+	0xbd, 0x33, 0xe8, 0xf7, 0x72, 0xb0, 0xf1, 0x88, 0x4b, 0xe6, 0x77, 0x65, 0xc4, 0xd3, 0x0f, 0x08,
+	0xfa, 0xfd, 0x3c, 0x74, 0xdc, 0x53, 0xd9, 0xb0, 0xae, 0xf4, 0x34, 0xc3, 0x3c, 0xaf, 0x6f, 0xe4,
+	0xe2, 0x63, 0x86, 0xb2, 0xe1, 0x5c, 0x61, 0x98, 0x61, 0x96, 0xd7, 0x37, 0x72, 0xf1, 0xd2, 0x6d,
+	0x96, 0xdb, 0xa8, 0xd5, 0xdb, 0xac, 0x62, 0x33, 0xd7, 0xf5, 0x2c, 0x54, 0xbc, 0x36, 0xa6, 0xcd,
+	0xb5, 0xca, 0xda, 0x98, 0x6b, 0x6e, 0xd6, 0xdf, 0xbc, 0x82, 0x2a, 0x3e, 0xd4, 0x77, 0xb3, 0x0e,
+	0xf5, 0xdd, 0x29, 0x87, 0xfa, 0xa4, 0x15, 0x71, 0xfb, 0xf7, 0x66, 0x45, 0xbe, 0x09, 0x3a, 0xe7,
+	0x89, 0x2f, 0x0c, 0x25, 0x87, 0xb0, 0x28, 0xe7, 0x9b, 0x50, 0x44, 0x9a, 0x91, 0x9f, 0x42, 0xdf,
+	0xc8, 0xc5, 0x4b, 0x83, 0x2e, 0xe5, 0x0d, 0x51, 0x07, 0x3d, 0x9d, 0xd7, 0x44, 0xdf, 0xc8, 0xc5,
+	0xc7, 0xf6, 0x82, 0xbc, 0xb4, 0x1f, 0xca, 0x1d, 0xe2, 0x8a, 0xac, 0x24, 0xfa, 0x3b, 0xd7, 0xa2,
+	0x8d, 0xe7, 0x54, 0x9c, 0x05, 0x44, 0x99, 0x53, 0xa9, 0xf4, 0x22, 0xfa, 0xbd, 0x1c, 0x6c, 0x3c,
+	0xa7, 0xa4, 0xfc, 0x1f, 0xca, 0x9c, 0x4a, 0x67, 0x0b, 0xd1, 0xef, 0xe7, 0xa1, 0x91, 0xdb, 0x63,
+	0x98, 0xc7, 0xf8, 0x5c, 0x45, 0x63, 0xd5, 0x18, 0x62, 0x5d, 0xcf, 0x42, 0x45, 0x27, 0xa9, 0xc7,
+	0x30, 0x8f, 0x21, 0xe3, 0x0a, 0x0f, 0x35, 0x70, 0x5e, 0xd7, 0xb3, 0x50, 0xf2, 0x49, 0x5c, 0x8a,
+	0x29, 0x55, 0x7a, 0x95, 0x8e, 0x40, 0xd5, 0xef, 0xe7, 0xa1, 0x51, 0x3b, 0x3d, 0x58, 0x95, 0xa2,
+	0xac, 0x9e, 0x6f, 0x0b, 0xed, 0x7c, 0x01, 0x35, 0x35, 0x1e, 0x4f, 0x39, 0x4b, 0x66, 0x06, 0x5a,
+	0xea, 0x0f, 0xa6, 0x50, 0x88, 0xe6, 0x6f, 0xff, 0xbb, 0x32, 0x68, 0x12, 0x46, 0xd4, 0x77, 0x0c,
+	0x35, 0x35, 0xaa, 0x4c, 0xa9, 0x2f, 0x33, 0xfe, 0x4f, 0x7f, 0x30, 0x85, 0x22, 0x3e, 0x08, 0x28,
+	0xa1, 0x67, 0xca, 0x41, 0x20, 0x2b, 0x58, 0x4d, 0xdf, 0xcc, 0x27, 0x40, 0x9e, 0xbf, 0x01, 0xcb,
+	0xa9, 0xc0, 0x34, 0xed, 0x61, 0xca, 0xee, 0x91, 0x8e, 0x69, 0xd3, 0xdf, 0x98, 0x4e, 0x14, 0xcf,
+	0x80, 0x38, 0x6e, 0x47, 0x99, 0x01, 0xa9, 0xe8, 0x1f, 0xfd, 0x5e, 0x0e, 0x16, 0x59, 0x9d, 0xc1,
+	0x6a, 0x56, 0x74, 0x8e, 0x72, 0x72, 0x9f, 0x12, 0x0d, 0xa4, 0xbf, 0x7d, 0x25, 0x9d, 0x64, 0xd6,
+	0x11, 0xd1, 0x3a, 0x5a, 0x62, 0x39, 0x54, 0x82, 0x7f, 0xf4, 0xbb, 0xd9, 0x48, 0xe4, 0x33, 0x80,
+	0x15, 0x8c, 0xe7, 0x50, 0xa2, 0xba, 0xde, 0x4c, 0x15, 0xca, 0x0a, 0x00, 0xd2, 0xdf, 0xba, 0x8a,
+	0x2c, 0xb3, 0x96, 0x38, 0xc8, 0x32, 0xbb, 0x78, 0x22, 0xf6, 0x47, 0x7f, 0xeb, 0x2a, 0x32, 0xe9,
+	0xce, 0x91, 0x08, 0xca, 0x51, 0xef, 0x1c, 0xd9, 0x31, 0x3f, 0xfa, 0xc3, 0xa9, 0x34, 0xb1, 0x79,
+	0x53, 0x8d, 0xcc, 0x51, 0xe7, 0x4b, 0x56, 0xc0, 0x8f, 0xfe, 0x60, 0x0a, 0x85, 0x74, 0xf0, 0x8c,
+	0x63, 0x74, 0xb4, 0x7b, 0xe9, 0x12, 0x52, 0xb8, 0x8f, 0x7e, 0x3f, 0x0f, 0xad, 0x34, 0x52, 0x8a,
+	0xce, 0x49, 0x36, 0x32, 0x1d, 0xf5, 0xa3, 0x3f, 0x98, 0x42, 0x81, 0x6b, 0xd6, 0xcf, 0xa9, 0xdf,
+	0x2a, 0x21, 0x03, 0xb1, 0x76, 0xd8, 0xf4, 0x37, 0xbc, 0x92, 0x41, 0xee, 0xca, 0x49, 0x20, 0x37,
+	0x82, 0x5e, 0x7f, 0xf3, 0x0a, 0xaa, 0x78, 0x4e, 0xc6, 0x61, 0xe9, 0xca, 0x9c, 0x4c, 0x45, 0xb8,
+	0xeb, 0xf7, 0x72, 0xb0, 0xd8, 0xfa, 0x5f, 0x87, 0x2a, 0x0f, 0xd8, 0x91, 0x5e, 0x61, 0x38, 0x20,
+	0x50, 0x36, 0x05, 0x35, 0x7a, 0x49, 0xd7, 0xb3, 0x50, 0xc8, 0xf2, 0x5f, 0x16, 0xa0, 0xca, 0xd5,
+	0x44, 0xf0, 0xdc, 0x87, 0x05, 0x29, 0x82, 0x42, 0x19, 0xc7, 0x74, 0x18, 0x87, 0x7e, 0x3f, 0x0f,
+	0xad, 0x8c, 0xa3, 0xcc, 0x70, 0xf3, 0xaa, 0xd0, 0x10, 0xfd, 0xc1, 0x14, 0x0a, 0x6c, 0xf6, 0x18,
+	0x74, 0xbc, 0x5a, 0xb0, 0x80, 0x0a, 0xb4, 0xb4, 0x89, 0x2e, 0x98, 0x50, 0x55, 0xe2, 0x2c, 0x94,
+	0xa5, 0x3b, 0x2b, 0xd6, 0x43, 0xdf, 0xcc, 0x27, 0xc0, 0x1a, 0xff, 0x2a, 0xac, 0xf2, 0x11, 0x41,
+	0x84, 0xa8, 0xeb, 0x0c, 0x56, 0xb3, 0x7c, 0x79, 0x95, 0x75, 0x72, 0x8a, 0x0b, 0xb1, 0xfe, 0xf6,
+	0x95, 0x74, 0xbc, 0x01, 0x27, 0x73, 0x63, 0xdf, 0x0b, 0xbd, 0x0f, 0xff, 0xdf, 0x00, 0x03, 0xbb,
+	0x9c, 0xb8, 0x4d, 0x81, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -10366,6 +11501,8 @@ type WalletServiceClient interface {
 	StakeInfo(ctx context.Context, in *StakeInfoRequest, opts ...grpc.CallOption) (*StakeInfoResponse, error)
 	BlockInfo(ctx context.Context, in *BlockInfoRequest, opts ...grpc.CallOption) (*BlockInfoResponse, error)
 	BestBlock(ctx context.Context, in *BestBlockRequest, opts ...grpc.CallOption) (*BestBlockResponse, error)
+	GetLabel(ctx context.Context, in *GetLabelRequest, opts ...grpc.CallOption) (*GetLabelResponse, error)
+	ListLabels(ctx context.Context, in *ListLabelsRequest, opts ...grpc.CallOption) (*ListLabelsResponse, error)
 	// Notifications
 	TransactionNotifications(ctx context.Context, in *TransactionNotificationsRequest, opts ...grpc.CallOption) (WalletService_TransactionNotificationsClient, error)
 	AccountNotifications(ctx context.Context, in *AccountNotificationsRequest, opts ...grpc.CallOption) (WalletService_AccountNotificationsClient, error)
@@ -10394,6 +11531,13 @@ type WalletServiceClient interface {
 	ValidateAddress(ctx context.Context, in *ValidateAddressRequest, opts ...grpc.CallOption) (*ValidateAddressResponse, error)
 	CommittedTickets(ctx context.Context, in *CommittedTicketsRequest, opts ...grpc.CallOption) (*CommittedTicketsResponse, error)
 	SweepAccount(ctx context.Context, in *SweepAccountRequest, opts ...grpc.CallOption) (*SweepAccountResponse, error)
+	CreatePsbt(ctx context.Context, in *CreatePsbtRequest, opts ...grpc.CallOption) (*CreatePsbtResponse, error)
+	ProcessPsbt(ctx context.Context, in *ProcessPsbtRequest, opts ...grpc.CallOption) (*ProcessPsbtResponse, error)
+	CombinePsbts(ctx context.Context, in *CombinePsbtsRequest, opts ...grpc.CallOption) (*CombinePsbtsResponse, error)
+	FinalizePsbt(ctx context.Context, in *FinalizePsbtRequest, opts ...grpc.CallOption) (*FinalizePsbtResponse, error)
+	BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error)
+	ChildPaysForParent(ctx context.Context, in *ChildPaysForParentRequest, opts ...grpc.CallOption) (*ChildPaysForParentResponse, error)
+	SetLabel(ctx context.Context, in *SetLabelRequest, opts ...grpc.CallOption) (*SetLabelResponse, error)
 }
 
 type walletServiceClient struct {
@@ -10594,6 +11738,24 @@ func (c *walletServiceClient) BestBlock(ctx context.Context, in *BestBlockReques
 	return out, nil
 }
 
+func (c *walletServiceClient) GetLabel(ctx context.Context, in *GetLabelRequest, opts ...grpc.CallOption) (*GetLabelResponse, error) {
+	out := new(GetLabelResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/GetLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ListLabels(ctx context.Context, in *ListLabelsRequest, opts ...grpc.CallOption) (*ListLabelsResponse, error) {
+	out := new(ListLabelsResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/ListLabels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *walletServiceClient) TransactionNotifications(ctx context.Context, in *TransactionNotificationsRequest, opts ...grpc.CallOption) (WalletService_TransactionNotificationsClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_WalletService_serviceDesc.Streams[2], "/walletrpc.WalletService/TransactionNotifications", opts...)
 	if err != nil {
@@ -10942,6 +12104,69 @@ func (c *walletServiceClient) SweepAccount(ctx context.Context, in *SweepAccount
 	return out, nil
 }
 
+func (c *walletServiceClient) CreatePsbt(ctx context.Context, in *CreatePsbtRequest, opts ...grpc.CallOption) (*CreatePsbtResponse, error) {
+	out := new(CreatePsbtResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/CreatePsbt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ProcessPsbt(ctx context.Context, in *ProcessPsbtRequest, opts ...grpc.CallOption) (*ProcessPsbtResponse, error) {
+	out := new(ProcessPsbtResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/ProcessPsbt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CombinePsbts(ctx context.Context, in *CombinePsbtsRequest, opts ...grpc.CallOption) (*CombinePsbtsResponse, error) {
+	out := new(CombinePsbtsResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/CombinePsbts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) FinalizePsbt(ctx context.Context, in *FinalizePsbtRequest, opts ...grpc.CallOption) (*FinalizePsbtResponse, error) {
+	out := new(FinalizePsbtResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/FinalizePsbt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error) {
+	out := new(BumpFeeResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/BumpFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ChildPaysForParent(ctx context.Context, in *ChildPaysForParentRequest, opts ...grpc.CallOption) (*ChildPaysForParentResponse, error) {
+	out := new(ChildPaysForParentResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/ChildPaysForParent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SetLabel(ctx context.Context, in *SetLabelRequest, opts ...grpc.CallOption) (*SetLabelResponse, error) {
+	out := new(SetLabelResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/SetLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WalletServiceServer is the server API for WalletService service.
 type WalletServiceServer interface {
 	// Queries
@@ -10961,6 +12186,8 @@ type WalletServiceServer interface {
 	StakeInfo(context.Context, *StakeInfoRequest) (*StakeInfoResponse, error)
 	BlockInfo(context.Context, *BlockInfoRequest) (*BlockInfoResponse, error)
 	BestBlock(context.Context, *BestBlockRequest) (*BestBlockResponse, error)
+	GetLabel(context.Context, *GetLabelRequest) (*GetLabelResponse, error)
+	ListLabels(context.Context, *ListLabelsRequest) (*ListLabelsResponse, error)
 	// Notifications
 	TransactionNotifications(*TransactionNotificationsRequest, WalletService_TransactionNotificationsServer) error
 	AccountNotifications(*AccountNotificationsRequest, WalletService_AccountNotificationsServer) error
@@ -10989,6 +12216,13 @@ type WalletServiceServer interface {
 	ValidateAddress(context.Context, *ValidateAddressRequest) (*ValidateAddressResponse, error)
 	CommittedTickets(context.Context, *CommittedTicketsRequest) (*CommittedTicketsResponse, error)
 	SweepAccount(context.Context, *SweepAccountRequest) (*SweepAccountResponse, error)
+	CreatePsbt(context.Context, *CreatePsbtRequest) (*CreatePsbtResponse, error)
+	ProcessPsbt(context.Context, *ProcessPsbtRequest) (*ProcessPsbtResponse, error)
+	CombinePsbts(context.Context, *CombinePsbtsRequest) (*CombinePsbtsResponse, error)
+	FinalizePsbt(context.Context, *FinalizePsbtRequest) (*FinalizePsbtResponse, error)
+	BumpFee(context.Context, *BumpFeeRequest) (*BumpFeeResponse, error)
+	ChildPaysForParent(context.Context, *ChildPaysForParentRequest) (*ChildPaysForParentResponse, error)
+	SetLabel(context.Context, *SetLabelRequest) (*SetLabelResponse, error)
 }
 
 // UnimplementedWalletServiceServer can be embedded to have forward compatible implementations.
@@ -11043,6 +12277,12 @@ func (*UnimplementedWalletServiceServer) BlockInfo(ctx context.Context, req *Blo
 func (*UnimplementedWalletServiceServer) BestBlock(ctx context.Context, req *BestBlockRequest) (*BestBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BestBlock not implemented")
 }
+func (*UnimplementedWalletServiceServer) GetLabel(ctx context.Context, req *GetLabelRequest) (*GetLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLabel not implemented")
+}
+func (*UnimplementedWalletServiceServer) ListLabels(ctx context.Context, req *ListLabelsRequest) (*ListLabelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLabels not implemented")
+}
 func (*UnimplementedWalletServiceServer) TransactionNotifications(req *TransactionNotificationsRequest, srv WalletService_TransactionNotificationsServer) error {
 	return status.Errorf(codes.Unimplemented, "method TransactionNotifications not implemented")
 }
@@ -11121,6 +12361,27 @@ func (*UnimplementedWalletServiceServer) CommittedTickets(ctx context.Context, r
 func (*UnimplementedWalletServiceServer) SweepAccount(ctx context.Context, req *SweepAccountRequest) (*SweepAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SweepAccount not implemented")
 }
+func (*UnimplementedWalletServiceServer) CreatePsbt(ctx context.Context, req *CreatePsbtRequest) (*CreatePsbtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePsbt not implemented")
+}
+func (*UnimplementedWalletServiceServer) ProcessPsbt(ctx context.Context, req *ProcessPsbtRequest) (*ProcessPsbtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessPsbt not implemented")
+}
+func (*UnimplementedWalletServiceServer) CombinePsbts(ctx context.Context, req *CombinePsbtsRequest) (*CombinePsbtsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CombinePsbts not implemented")
+}
+func (*UnimplementedWalletServiceServer) FinalizePsbt(ctx context.Context, req *FinalizePsbtRequest) (*FinalizePsbtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizePsbt not implemented")
+}
+func (*UnimplementedWalletServiceServer) BumpFee(ctx context.Context, req *BumpFeeRequest) (*BumpFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BumpFee not implemented")
+}
+func (*UnimplementedWalletServiceServer) ChildPaysForParent(ctx context.Context, req *ChildPaysForParentRequest) (*ChildPaysForParentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChildPaysForParent not implemented")
+}
+func (*UnimplementedWalletServiceServer) SetLabel(ctx context.Context, req *SetLabelRequest) (*SetLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLabel not implemented")
+}
 
 func RegisterWalletServiceServer(s *grpc.Server, srv WalletServiceServer) {
 	s.RegisterService(&_WalletService_serviceDesc, srv)
@@ -11420,6 +12681,42 @@ func _WalletService_BestBlock_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletService_GetLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/GetLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetLabel(ctx, req.(*GetLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ListLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ListLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/ListLabels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ListLabels(ctx, req.(*ListLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WalletService_TransactionNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(TransactionNotificationsRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -11908,6 +13205,132 @@ func _WalletService_SweepAccount_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletService_CreatePsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CreatePsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/CreatePsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CreatePsbt(ctx, req.(*CreatePsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ProcessPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessPsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ProcessPsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/ProcessPsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ProcessPsbt(ctx, req.(*ProcessPsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_CombinePsbts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CombinePsbtsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CombinePsbts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/CombinePsbts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CombinePsbts(ctx, req.(*CombinePsbtsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_FinalizePsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizePsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).FinalizePsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/FinalizePsbt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).FinalizePsbt(ctx, req.(*FinalizePsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_BumpFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).BumpFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/BumpFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).BumpFee(ctx, req.(*BumpFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ChildPaysForParent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChildPaysForParentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ChildPaysForParent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/ChildPaysForParent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ChildPaysForParent(ctx, req.(*ChildPaysForParentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_SetLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SetLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/SetLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SetLabel(ctx, req.(*SetLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _WalletService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "walletrpc.WalletService",
 	HandlerType: (*WalletServiceServer)(nil),
@@ -11968,6 +13391,14 @@ var _WalletService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BestBlock",
 			Handler:    _WalletService_BestBlock_Handler,
 		},
+		{
+			MethodName: "GetLabel",
+			Handler:    _WalletService_GetLabel_Handler,
+		},
+		{
+			MethodName: "ListLabels",
+			Handler:    _WalletService_ListLabels_Handler,
+		},
 		{
 			MethodName: "ChangePassphrase",
 			Handler:    _WalletService_ChangePassphrase_Handler,
@@ -12052,6 +13483,34 @@ var _WalletService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SweepAccount",
 			Handler:    _WalletService_SweepAccount_Handler,
 		},
+		{
+			MethodName: "CreatePsbt",
+			Handler:    _WalletService_CreatePsbt_Handler,
+		},
+		{
+			MethodName: "ProcessPsbt",
+			Handler:    _WalletService_ProcessPsbt_Handler,
+		},
+		{
+			MethodName: "CombinePsbts",
+			Handler:    _WalletService_CombinePsbts_Handler,
+		},
+		{
+			MethodName: "FinalizePsbt",
+			Handler:    _WalletService_FinalizePsbt_Handler,
+		},
+		{
+			MethodName: "BumpFee",
+			Handler:    _WalletService_BumpFee_Handler,
+		},
+		{
+			MethodName: "ChildPaysForParent",
+			Handler:    _WalletService_ChildPaysForParent_Handler,
+		},
+		{
+			MethodName: "SetLabel",
+			Handler:    _WalletService_SetLabel_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -6,24 +6,36 @@
 package main
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
+	"crypto/x509"
 	"io/ioutil"
 	"net"
 	"os"
 	"path/filepath"
+	"reflect"
 	"runtime"
 	"strings"
 	"time"
 
+	"decred.org/dcrwallet/internal/auditlog"
 	"decred.org/dcrwallet/internal/loader"
 	"decred.org/dcrwallet/internal/rpc/jsonrpc"
+	"decred.org/dcrwallet/internal/rpc/restgateway"
+	"decred.org/dcrwallet/internal/rpc/rpcauth"
 	"decred.org/dcrwallet/internal/rpc/rpcserver"
+	"decred.org/dcrwallet/internal/vsp"
 	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 )
 
 // openRPCKeyPair creates or loads the RPC TLS keypair specified by the
@@ -104,7 +116,7 @@ func generateRPCKeyPair(writeKey bool) (tls.Certificate, error) {
 	return keyPair, nil
 }
 
-func startRPCServers(walletLoader *loader.Loader) (*grpc.Server, *jsonrpc.Server, error) {
+func startRPCServers(walletLoader *loader.Loader) (*grpc.Server, *jsonrpc.Server, *restgateway.Server, error) {
 	var jsonrpcAddrNotifier jsonrpcListenerEventServer
 	var grpcAddrNotifier grpcListenerEventServer
 	if cfg.RPCListenerEvents {
@@ -115,6 +127,7 @@ func startRPCServers(walletLoader *loader.Loader) (*grpc.Server, *jsonrpc.Server
 	var (
 		server        *grpc.Server
 		jsonrpcServer *jsonrpc.Server
+		restServer    *restgateway.Server
 		jsonrpcListen = net.Listen
 		keyPair       tls.Certificate
 		err           error
@@ -124,7 +137,7 @@ func startRPCServers(walletLoader *loader.Loader) (*grpc.Server, *jsonrpc.Server
 	} else {
 		keyPair, err = openRPCKeyPair()
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 
 		// Change the standard net.Listen function to the tls one.
@@ -141,9 +154,23 @@ func startRPCServers(walletLoader *loader.Loader) (*grpc.Server, *jsonrpc.Server
 			listeners := makeListeners(cfg.GRPCListeners, net.Listen)
 			if len(listeners) == 0 {
 				err := errors.New("failed to create listeners for RPC server")
-				return nil, nil, err
+				return nil, nil, nil, err
 			}
-			creds := credentials.NewServerTLSFromCert(&keyPair)
+			grpcTLSConfig := &tls.Config{
+				Certificates: []tls.Certificate{keyPair},
+				MinVersion:   tls.VersionTLS12,
+			}
+			if cfg.grpcClientCerts != nil {
+				// Only clients presenting one of the configured
+				// certificates complete the handshake.  The REST
+				// gateway connects with the RPC certificate.
+				if len(cfg.RESTListeners) != 0 {
+					cfg.grpcClientCerts[sha256.Sum256(keyPair.Certificate[0])] = rpcauth.Full
+				}
+				grpcTLSConfig.ClientAuth = tls.RequireAnyClientCert
+				grpcTLSConfig.VerifyPeerCertificate = cfg.grpcClientCerts.VerifyPeerCertificate
+			}
+			creds := credentials.NewTLS(grpcTLSConfig)
 			server = grpc.NewServer(
 				grpc.Creds(creds),
 				grpc.StreamInterceptor(interceptStreaming),
@@ -165,16 +192,23 @@ func startRPCServers(walletLoader *loader.Loader) (*grpc.Server, *jsonrpc.Server
 					log.Tracef("Finished serving gRPC: %v", err)
 				}()
 			}
+
+			if len(cfg.RESTListeners) != 0 {
+				restServer, err = startRESTGateway(keyPair, listeners[0].Addr(), jsonrpcListen)
+				if err != nil {
+					return nil, nil, nil, err
+				}
+			}
 		}
 	}
 
-	if cfg.Username == "" || cfg.Password == "" {
+	if (cfg.Username == "" || cfg.Password == "") && len(cfg.rpcUsers) == 0 {
 		log.Info("JSON-RPC server disabled (requires username and password)")
 	} else if len(cfg.LegacyRPCListeners) != 0 {
 		listeners := makeListeners(cfg.LegacyRPCListeners, jsonrpcListen)
 		if len(listeners) == 0 {
 			err := errors.New("failed to create listeners for JSON-RPC server")
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 		opts := jsonrpc.Options{
 			Username:            cfg.Username,
@@ -186,6 +220,10 @@ func startRPCServers(walletLoader *loader.Loader) (*grpc.Server, *jsonrpc.Server
 			MixAccount:          cfg.mixedAccount,
 			MixBranch:           cfg.mixedBranch,
 			MixChangeAccount:    cfg.ChangeAccount,
+			Users:               cfg.rpcUsers,
+			AuditLog:            auditLog,
+			VSP:                 vspClient,
+			MacaroonRootKey:     macaroonRootKey,
 		}
 		jsonrpcServer = jsonrpc.NewServer(&opts, activeNet.Params, walletLoader, listeners)
 		for _, lis := range listeners {
@@ -195,10 +233,49 @@ func startRPCServers(walletLoader *loader.Loader) (*grpc.Server, *jsonrpc.Server
 
 	// Error when neither the GRPC nor JSON-RPC servers can be started.
 	if server == nil && jsonrpcServer == nil {
-		return nil, nil, errors.New("no suitable RPC services can be started")
+		return nil, nil, nil, errors.New("no suitable RPC services can be started")
 	}
 
-	return server, jsonrpcServer, nil
+	return server, jsonrpcServer, restServer, nil
+}
+
+// startRESTGateway starts the REST gateway to the gRPC server listening on
+// grpcAddr.  The gateway connects to the gRPC server with the RPC key pair,
+// which is authorized for the full role when gRPC client certificates are
+// required, and REST clients are authorized by the macaroons they present.
+func startRESTGateway(keyPair tls.Certificate, grpcAddr net.Addr, listen listenFunc) (*restgateway.Server, error) {
+	addr := *grpcAddr.(*net.TCPAddr)
+	if addr.IP.IsUnspecified() {
+		if addr.IP.To4() != nil {
+			addr.IP = net.IPv4(127, 0, 0, 1)
+		} else {
+			addr.IP = net.IPv6loopback
+		}
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{keyPair},
+		MinVersion:   tls.VersionTLS12,
+
+		// The gRPC server may listen on any address, so rather than
+		// verifying the host name, the RPC certificate is pinned.
+		InsecureSkipVerify: true,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 || !bytes.Equal(rawCerts[0], keyPair.Certificate[0]) {
+				return errors.New("gRPC server did not present the RPC certificate")
+			}
+			return nil
+		},
+	}
+	conn, err := grpc.Dial(addr.String(), grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	if err != nil {
+		return nil, err
+	}
+	listeners := makeListeners(cfg.RESTListeners, listen)
+	if len(listeners) == 0 {
+		conn.Close()
+		return nil, errors.New("failed to create listeners for REST gateway")
+	}
+	return restgateway.NewServer(conn, listeners)
 }
 
 // serviceName returns the package.service segment from the full gRPC method
@@ -210,16 +287,267 @@ func serviceName(method string) string {
 	return method[:strings.IndexRune(method, '/')]
 }
 
+// auditLog records privileged operations invoked by RPC clients.  It is nil
+// when the audit log is disabled.
+var auditLog *auditlog.Log
+
+// vspClient purchases tickets voted by the fee-based VSP configured by
+// --vspurl.  It is nil when no VSP is configured.
+var vspClient *vsp.Client
+
+// auditedGRPCMethods are the privileged unary gRPC methods recorded in the
+// audit log: unlocks, passphrase changes, key dumps, imports, signing and
+// publishing.  Each method maps to the fields of its request message which
+// hold secrets and are redacted from the log.
+var auditedGRPCMethods = map[string][]string{
+	"/walletrpc.WalletService/GetAccountExtendedPrivKey":  {"Passphrase"},
+	"/walletrpc.WalletService/ChangePassphrase":           {"OldPassphrase", "NewPassphrase"},
+	"/walletrpc.WalletService/ImportPrivateKey":           {"Passphrase", "PrivateKeyWif"},
+	"/walletrpc.WalletService/ImportScript":               {"Passphrase"},
+	"/walletrpc.WalletService/NextAccount":                {"Passphrase"},
+	"/walletrpc.WalletService/SignTransaction":            {"Passphrase"},
+	"/walletrpc.WalletService/SignTransactions":           {"Passphrase"},
+	"/walletrpc.WalletService/CreateSignature":            {"Passphrase"},
+	"/walletrpc.WalletService/PublishTransaction":         {},
+	"/walletrpc.WalletService/PublishUnminedTransactions": {},
+	"/walletrpc.WalletService/PurchaseTickets":            {"Passphrase"},
+	"/walletrpc.WalletService/RevokeTickets":              {"Passphrase"},
+	"/walletrpc.WalletService/SignMessage":                {"Passphrase"},
+	"/walletrpc.WalletService/SignMessages":               {"Passphrase"},
+	"/walletrpc.WalletService/SweepAccount":               {},
+	"/walletrpc.WalletService/ProcessPsbt":                {"Passphrase"},
+	"/walletrpc.WalletService/BumpFee":                    {"Passphrase"},
+	"/walletrpc.WalletService/ChildPaysForParent":         {"Passphrase"},
+	"/walletrpc.WalletLoaderService/CreateWallet":         {"PublicPassphrase", "PrivatePassphrase", "Seed"},
+	"/walletrpc.TicketBuyerService/StartAutoBuyer":        {"Passphrase"},
+}
+
+// auditedGRPCStreams are the privileged streaming gRPC methods recorded in the
+// audit log.  The synchronization methods receive the private passphrase to
+// discover addresses and accounts, and the ticket buyer purchases tickets.
+// These are recorded when the request is received, as the streams run until
+// the wallet is closed.
+var auditedGRPCStreams = map[string][]string{
+	"/walletrpc.WalletLoaderService/RpcSync":         {"Password", "PrivatePassphrase"},
+	"/walletrpc.WalletLoaderService/SpvSync":         {"PrivatePassphrase"},
+	"/walletrpc.TicketBuyerV2Service/RunTicketBuyer": {"Passphrase"},
+}
+
+// grpcCaller describes a gRPC client in the audit log by the subject common
+// name of its TLS client certificate, if it presented one, and its address.
+func grpcCaller(p *peer.Peer) string {
+	var name string
+	if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+		if certs := tlsInfo.State.PeerCertificates; len(certs) != 0 {
+			name = certs[0].Subject.CommonName
+		}
+	}
+	return "grpc:" + name + "@" + p.Addr.String()
+}
+
+// macaroonRootKey authenticates macaroons presented by RPC clients.  It is nil
+// when macaroon authentication is disabled.
+var macaroonRootKey []byte
+
+// checkGRPCPermission returns a PermissionDenied status error if the gRPC client
+// may not call a method, due to the role of its client certificate or the
+// caveats of a macaroon presented in the "macaroon" request metadata.  When no
+// client certificates are configured, every client has the full role.  The
+// role of the client and the restrictions of the presented macaroon, if any,
+// are returned.
+func checkGRPCPermission(ctx context.Context, p *peer.Peer, ok bool, method string) (rpcauth.Role, *rpcauth.Restrictions, error) {
+	role := rpcauth.Full
+	if cfg.grpcClientCerts != nil {
+		role = ""
+		if ok {
+			if tlsInfo, isTLS := p.AuthInfo.(credentials.TLSInfo); isTLS {
+				role, _ = cfg.grpcClientCerts.Role(tlsInfo.State.PeerCertificates)
+			}
+		}
+	}
+	r, err := grpcMacaroon(ctx)
+	if err != nil {
+		if ok {
+			grpcLog.Warnf("Method %s denied to %s: invalid macaroon: %v", method, p.Addr.String(), err)
+		}
+		return "", nil, status.Errorf(codes.Unauthenticated, "invalid macaroon: %v", err)
+	}
+	err = role.CheckMethod(method)
+	if err == nil && r != nil {
+		err = r.CheckMethod(method, time.Now())
+	}
+	if err != nil {
+		if ok {
+			grpcLog.Warnf("Method %s denied to %s: %v", method, p.Addr.String(), err)
+		}
+		return "", nil, status.Errorf(codes.PermissionDenied, "%v", err)
+	}
+	return role, r, nil
+}
+
+// checkGRPCRequest returns a PermissionDenied status error if the role of a
+// gRPC client, or the restrictions of its macaroon, do not allow calling a
+// method with the arguments of a request.
+func checkGRPCRequest(role rpcauth.Role, r *rpcauth.Restrictions, method string, req interface{}) error {
+	err := role.CheckRequest(method, req)
+	if err == nil && r != nil {
+		err = r.CheckRequest(method, req)
+	}
+	if err != nil {
+		return status.Errorf(codes.PermissionDenied, "%v", err)
+	}
+	return nil
+}
+
+// grpcMacaroon verifies the macaroon presented in the "macaroon" metadata of a
+// gRPC request and returns its restrictions, or nil if no macaroon was
+// presented.
+func grpcMacaroon(ctx context.Context) (*rpcauth.Restrictions, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("macaroon")
+	if len(values) == 0 {
+		return nil, nil
+	}
+	if macaroonRootKey == nil {
+		return nil, errors.New("macaroon authentication is disabled")
+	}
+	m, err := rpcauth.DecodeMacaroon(values[0])
+	if err != nil {
+		return nil, err
+	}
+	return m.Verify(macaroonRootKey)
+}
+
+// restrictGRPC applies the account and spend caveats of a macaroon to a gRPC
+// request.  The accounts of streaming requests (with a nil req) can not be
+// checked before calling the handler, so account scoped macaroons may only
+// call streaming methods with permission PermInfo.  The returned context limits
+// the amount spent by transactions created or published by the handler.
+func restrictGRPC(ctx context.Context, r *rpcauth.Restrictions, method string, req interface{}) (context.Context, error) {
+	if limit, ok := r.SpendLimit(); ok {
+		ctx = wallet.WithSpendLimit(ctx, limit)
+	}
+	if !r.AccountScoped() || rpcauth.MethodPermission(method) == rpcauth.PermInfo {
+		return ctx, nil
+	}
+	accounts, ok := grpcRequestAccounts(req)
+	if !ok || len(accounts) == 0 {
+		return ctx, status.Errorf(codes.PermissionDenied, "macaroon is restricted to an "+
+			"account and %s does not name an account", method)
+	}
+	for _, account := range accounts {
+		if err := r.CheckAccount(account); err != nil {
+			return ctx, status.Errorf(codes.PermissionDenied, "%v", err)
+		}
+	}
+	return ctx, nil
+}
+
+// grpcRequestAccounts returns the values of the account number fields of a
+// gRPC request message, which are the fields whose names end in Account or
+// AccountNumber.  ok is false when the message names an account by name,
+// which can not be checked.
+func grpcRequestAccounts(req interface{}) (accounts []uint32, ok bool) {
+	v := reflect.Indirect(reflect.ValueOf(req))
+	if v.Kind() != reflect.Struct {
+		return nil, true
+	}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name := t.Field(i).Name
+		if !strings.HasSuffix(name, "Account") && !strings.HasSuffix(name, "AccountNumber") &&
+			!strings.HasSuffix(name, "AccountName") {
+			continue
+		}
+		switch f := v.Field(i); f.Kind() {
+		case reflect.Uint32:
+			accounts = append(accounts, uint32(f.Uint()))
+		case reflect.String:
+			return nil, false
+		}
+	}
+	return accounts, true
+}
+
+// restrictedStream is a server stream whose handler runs with the context of a
+// restricted request.
+type restrictedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *restrictedStream) Context() context.Context { return s.ctx }
+
+// checkedStream is a server stream of a method whose permission depends on its
+// request, which checks the first request received from the client.
+type checkedStream struct {
+	grpc.ServerStream
+	method  string
+	role    rpcauth.Role
+	r       *rpcauth.Restrictions
+	checked bool
+}
+
+func (s *checkedStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err != nil || s.checked {
+		return err
+	}
+	s.checked = true
+	return checkGRPCRequest(s.role, s.r, s.method, m)
+}
+
+// auditedStream is a server stream which records the request of a privileged
+// streaming method in the audit log when it is received.
+type auditedStream struct {
+	grpc.ServerStream
+	method   string
+	p        *peer.Peer
+	recorded bool
+}
+
+func (s *auditedStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err != nil || s.recorded {
+		return err
+	}
+	s.recorded = true
+	auditErr := auditLog.Record(grpcCaller(s.p), s.method,
+		auditlog.Params(m, auditedGRPCStreams[s.method]...), nil)
+	if auditErr != nil {
+		grpcLog.Errorf("Failed to record streaming method %s invoked by %s in audit log: %v",
+			s.method, s.p.Addr.String(), auditErr)
+	}
+	return nil
+}
+
 func interceptStreaming(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
 	p, ok := peer.FromContext(ss.Context())
 	if ok {
 		grpcLog.Infof("Streaming method %s invoked by %s", info.FullMethod,
 			p.Addr.String())
 	}
-	err := rpcserver.ServiceReady(serviceName(info.FullMethod))
+	role, r, err := checkGRPCPermission(ss.Context(), p, ok, info.FullMethod)
 	if err != nil {
 		return err
 	}
+	if rpcauth.RequestChecked(info.FullMethod) {
+		ss = &checkedStream{ServerStream: ss, method: info.FullMethod, role: role, r: r}
+	}
+	if r != nil {
+		ctx, err := restrictGRPC(ss.Context(), r, info.FullMethod, nil)
+		if err != nil {
+			return err
+		}
+		ss = &restrictedStream{ServerStream: ss, ctx: ctx}
+	}
+	err = rpcserver.ServiceReady(serviceName(info.FullMethod))
+	if err != nil {
+		return err
+	}
+	if _, audited := auditedGRPCStreams[info.FullMethod]; audited && auditLog != nil && ok {
+		ss = &auditedStream{ServerStream: ss, method: info.FullMethod, p: p}
+	}
 	err = handler(srv, ss)
 	if err != nil && ok {
 		grpcLog.Errorf("Streaming method %s invoked by %s errored: %v",
@@ -234,6 +562,23 @@ func interceptUnary(ctx context.Context, req interface{}, info *grpc.UnaryServer
 		grpcLog.Infof("Unary method %s invoked by %s", info.FullMethod,
 			p.Addr.String())
 	}
+	role, r, err := checkGRPCPermission(ctx, p, ok, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	err = checkGRPCRequest(role, r, info.FullMethod, req)
+	if err != nil {
+		if ok {
+			grpcLog.Warnf("Method %s denied to %s: %v", info.FullMethod, p.Addr.String(), err)
+		}
+		return nil, err
+	}
+	if r != nil {
+		ctx, err = restrictGRPC(ctx, r, info.FullMethod, req)
+		if err != nil {
+			return nil, err
+		}
+	}
 	err = rpcserver.ServiceReady(serviceName(info.FullMethod))
 	if err != nil {
 		return nil, err
@@ -243,6 +588,13 @@ func interceptUnary(ctx context.Context, req interface{}, info *grpc.UnaryServer
 		grpcLog.Errorf("Unary method %s invoked by %s errored: %v",
 			info.FullMethod, p.Addr.String(), err)
 	}
+	if secrets, audited := auditedGRPCMethods[info.FullMethod]; audited && auditLog != nil && ok {
+		auditErr := auditLog.Record(grpcCaller(p), info.FullMethod, auditlog.Params(req, secrets...), err)
+		if auditErr != nil {
+			grpcLog.Errorf("Failed to record unary method %s invoked by %s in audit log: %v",
+				info.FullMethod, p.Addr.String(), auditErr)
+		}
+	}
 	return resp, err
 }
 
@@ -0,0 +1,74 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+
+	pb "github.com/decred/dcrwallet/rpc/walletrpc"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+)
+
+// grpcMethods returns the input message types of the methods of the walletrpc
+// services, keyed by full method name, and whether each method streams.
+func grpcMethods(t *testing.T) (inputs map[string]reflect.Type, streams map[string]bool) {
+	gz, _ := (*pb.BalanceRequest)(nil).Descriptor()
+	r, err := gzip.NewReader(bytes.NewReader(gz))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fd descriptor.FileDescriptorProto
+	err = proto.Unmarshal(b, &fd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inputs = make(map[string]reflect.Type)
+	streams = make(map[string]bool)
+	for _, s := range fd.Service {
+		for _, m := range s.Method {
+			name := "/" + fd.GetPackage() + "." + s.GetName() + "/" + m.GetName()
+			inputs[name] = proto.MessageType(strings.TrimPrefix(m.GetInputType(), "."))
+			streams[name] = m.GetClientStreaming() || m.GetServerStreaming()
+		}
+	}
+	return inputs, streams
+}
+
+func TestAuditedGRPCMethods(t *testing.T) {
+	inputs, streams := grpcMethods(t)
+	check := func(audited map[string][]string, streaming bool) {
+		for method, secrets := range audited {
+			input, ok := inputs[method]
+			if !ok {
+				t.Errorf("audited method %s does not exist", method)
+				continue
+			}
+			if streams[method] != streaming {
+				t.Errorf("audited method %s streaming=%v", method, streams[method])
+			}
+			if input == nil {
+				t.Errorf("%s: unknown request type", method)
+				continue
+			}
+			for _, field := range secrets {
+				if _, ok := input.Elem().FieldByName(field); !ok {
+					t.Errorf("%s: secret field %s does not exist", method, field)
+				}
+			}
+		}
+	}
+	check(auditedGRPCMethods, false)
+	check(auditedGRPCStreams, true)
+}
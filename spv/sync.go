@@ -719,6 +719,9 @@ func (s *Syncer) handleTxInvs(ctx context.Context, rp *p2p.RemotePeer, hashes []
 		s.seenTxs.Add(*h)
 	}
 
+	// Record the fee rates of all relayed transactions for fee estimation.
+	s.wallet.ObserveMempoolTxs(txs)
+
 	// Save any relevant transaction.
 	relevant := s.filterRelevant(txs)
 	for _, tx := range relevant {
@@ -3,6 +3,7 @@ module github.com/decred/dcrwallet/ticketbuyer/v4
 go 1.12
 
 require (
+	github.com/decred/dcrd/chaincfg/v2 v2.3.0
 	github.com/decred/dcrd/dcrutil/v2 v2.0.1
 	github.com/decred/dcrd/wire v1.3.0
 	github.com/decred/dcrwallet/errors/v2 v2.0.0
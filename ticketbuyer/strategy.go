@@ -0,0 +1,212 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package ticketbuyer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/wire"
+)
+
+// Strategy decides how many tickets to buy at a block.  Buy is called with the
+// number of tickets allowed by the available balance and previously applied
+// strategies, and returns the number of tickets to buy, which must not be
+// greater.  Strategies are applied before the per-block limits of the network
+// and Config.Limit.
+type Strategy interface {
+	Buy(ctx context.Context, m *Market, n int) (int, error)
+}
+
+// Market describes the ticket market at the block tickets are being purchased
+// for.  Tickets are bought for the ticket window (stake difficulty interval)
+// beginning at WindowStart, and must be mined before the next window.
+type Market struct {
+	Params *chaincfg.Params
+	Tip    *wire.BlockHeader
+
+	// NextSDiff is the price of tickets purchased at this block.
+	NextSDiff dcrutil.Amount
+
+	// WindowStart is the height of the first block of the ticket window, and
+	// BlocksLeft is the number of blocks, including this one, at which
+	// tickets may still be purchased for the window.
+	WindowStart int32
+	BlocksLeft  int32
+
+	// Spendable is the account balance available for tickets, after the
+	// balance to maintain.  WindowSpent is the total price of the tickets
+	// purchased for the window by the ticket buyer since it started.
+	Spendable   dcrutil.Amount
+	WindowSpent dcrutil.Amount
+
+	header func(ctx context.Context, height int32) (*wire.BlockHeader, error)
+}
+
+// Header returns the main chain block header at a height.
+func (m *Market) Header(ctx context.Context, height int32) (*wire.BlockHeader, error) {
+	return m.header(ctx, height)
+}
+
+// MaxPrice is a strategy which does not buy tickets priced above Price.
+type MaxPrice struct {
+	Price dcrutil.Amount
+}
+
+// Buy implements the Strategy interface.
+func (s *MaxPrice) Buy(ctx context.Context, m *Market, n int) (int, error) {
+	if m.NextSDiff > s.Price {
+		return 0, nil
+	}
+	return n, nil
+}
+
+func (s *MaxPrice) String() string {
+	return fmt.Sprintf("maximum price %v", s.Price)
+}
+
+// MovingAverage is a strategy which only buys tickets priced below the average
+// price of the previous Windows ticket windows.  Fewer windows are averaged
+// when the chain is shorter.
+type MovingAverage struct {
+	Windows int
+}
+
+// Buy implements the Strategy interface.
+func (s *MovingAverage) Buy(ctx context.Context, m *Market, n int) (int, error) {
+	size := int32(m.Params.StakeDiffWindowSize)
+	var sum dcrutil.Amount
+	var count int64
+	for i := 1; i <= s.Windows; i++ {
+		start := m.WindowStart - int32(i)*size
+		if start < 0 {
+			break
+		}
+		h, err := m.Header(ctx, start)
+		if err != nil {
+			return 0, err
+		}
+		sum += dcrutil.Amount(h.SBits)
+		count++
+	}
+	if count == 0 || m.NextSDiff >= sum/dcrutil.Amount(count) {
+		return 0, nil
+	}
+	return n, nil
+}
+
+func (s *MovingAverage) String() string {
+	return fmt.Sprintf("%d window moving average", s.Windows)
+}
+
+// Spread is a strategy which spreads purchases evenly across the blocks of
+// each ticket window, buying an equal share of the affordable tickets at each
+// remaining block.
+type Spread struct{}
+
+// Buy implements the Strategy interface.
+func (s *Spread) Buy(ctx context.Context, m *Market, n int) (int, error) {
+	if m.BlocksLeft <= 1 {
+		return n, nil
+	}
+	left := int(m.BlocksLeft)
+	return (n + left - 1) / left, nil
+}
+
+func (s *Spread) String() string {
+	return "spread"
+}
+
+// Budget is a strategy which limits the total price of the tickets purchased
+// for each ticket window to Amount.
+type Budget struct {
+	Amount dcrutil.Amount
+}
+
+// Buy implements the Strategy interface.
+func (s *Budget) Buy(ctx context.Context, m *Market, n int) (int, error) {
+	remaining := s.Amount - m.WindowSpent
+	if remaining <= 0 || m.NextSDiff <= 0 {
+		return 0, nil
+	}
+	if max := int(remaining / m.NextSDiff); n > max {
+		n = max
+	}
+	return n, nil
+}
+
+func (s *Budget) String() string {
+	return fmt.Sprintf("window budget %v", s.Amount)
+}
+
+// PoolSize is a strategy which holds back purchases while the ticket pool
+// size projected after the ticket maturity period is above Target.  The
+// projection adds the tickets purchased in the last TicketMaturity blocks,
+// which become live during the period, and removes the tickets called to vote
+// by each block of the period.
+type PoolSize struct {
+	Target uint32
+}
+
+// Buy implements the Strategy interface.
+func (s *PoolSize) Buy(ctx context.Context, m *Market, n int) (int, error) {
+	projected, err := ProjectedPoolSize(ctx, m)
+	if err != nil {
+		return 0, err
+	}
+	if projected > int64(s.Target) {
+		return 0, nil
+	}
+	return n, nil
+}
+
+func (s *PoolSize) String() string {
+	return fmt.Sprintf("target pool size %d", s.Target)
+}
+
+// ProjectedPoolSize returns the ticket pool size projected TicketMaturity
+// blocks after the tip block of the market.
+func ProjectedPoolSize(ctx context.Context, m *Market) (int64, error) {
+	maturity := int32(m.Params.TicketMaturity)
+	tipHeight := int32(m.Tip.Height)
+	projected := int64(m.Tip.PoolSize)
+	for h := tipHeight; h > tipHeight-maturity && h > 0; h-- {
+		header := m.Tip
+		if h != tipHeight {
+			var err error
+			header, err = m.Header(ctx, h)
+			if err != nil {
+				return 0, err
+			}
+		}
+		projected += int64(header.FreshStake)
+	}
+	projected -= int64(maturity) * int64(m.Params.TicketsPerBlock)
+	return projected, nil
+}
+
+// strategies returns the strategies selected by a config.
+func (c *Config) strategies() []Strategy {
+	var s []Strategy
+	if c.MaxPrice > 0 {
+		s = append(s, &MaxPrice{Price: c.MaxPrice})
+	}
+	if c.AverageWindows > 0 {
+		s = append(s, &MovingAverage{Windows: c.AverageWindows})
+	}
+	if c.TargetPoolSize > 0 {
+		s = append(s, &PoolSize{Target: c.TargetPoolSize})
+	}
+	if c.WindowBudget > 0 {
+		s = append(s, &Budget{Amount: c.WindowBudget})
+	}
+	s = append(s, c.Strategies...)
+	if c.Spread {
+		s = append(s, &Spread{})
+	}
+	return s
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package ticketbuyer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/wire"
+)
+
+// testMarket returns a market with a tip at height and headers created by f.
+func testMarket(height int32, f func(height int32) *wire.BlockHeader) *Market {
+	params := chaincfg.SimNetParams()
+	size := int32(params.StakeDiffWindowSize)
+	windowStart := (height/size + 1) * size
+	return &Market{
+		Params:      params,
+		Tip:         f(height),
+		WindowStart: windowStart,
+		BlocksLeft:  windowStart + size - 2 - height,
+		header: func(ctx context.Context, h int32) (*wire.BlockHeader, error) {
+			if h < 0 || h > height {
+				return nil, errors.New("no header")
+			}
+			return f(h), nil
+		},
+	}
+}
+
+func TestStrategies(t *testing.T) {
+	ctx := context.Background()
+	params := chaincfg.SimNetParams()
+	size := int32(params.StakeDiffWindowSize)
+	maturity := int32(params.TicketMaturity)
+
+	// Ticket prices are 10, 20, 30, ... DCR for each window and every block
+	// purchases 2 fresh tickets.
+	price := func(h int32) *wire.BlockHeader {
+		return &wire.BlockHeader{
+			Height:     uint32(h),
+			SBits:      int64(h/size+1) * 10e8,
+			FreshStake: 2,
+			PoolSize:   1000,
+		}
+	}
+	height := 4*size + 1
+	projected := int64(1000) + 2*int64(maturity) -
+		int64(maturity)*int64(params.TicketsPerBlock)
+
+	tests := []struct {
+		name  string
+		s     Strategy
+		sdiff dcrutil.Amount
+		spent dcrutil.Amount
+		left  int32
+		n     int
+		want  int
+	}{
+		{"below max price", &MaxPrice{Price: 50e8}, 50e8, 0, 0, 10, 10},
+		{"above max price", &MaxPrice{Price: 50e8}, 51e8, 0, 0, 10, 0},
+		// Windows starting at 2, 3 and 4 window sizes have prices 30, 40
+		// and 50 DCR; average 40 DCR.  All five windows average 30 DCR.
+		{"below average", &MovingAverage{Windows: 3}, 39e8, 0, 0, 10, 10},
+		{"at average", &MovingAverage{Windows: 3}, 40e8, 0, 0, 10, 0},
+		{"average of short chain", &MovingAverage{Windows: 100}, 29e8, 0, 0, 10, 10},
+		{"budget", &Budget{Amount: 100e8}, 20e8, 30e8, 0, 10, 3},
+		{"budget spent", &Budget{Amount: 100e8}, 20e8, 100e8, 0, 10, 0},
+		{"spread", &Spread{}, 1e8, 0, 4, 10, 3},
+		{"spread last block", &Spread{}, 1e8, 0, 1, 10, 10},
+		{"below target pool size", &PoolSize{Target: uint32(projected)}, 1e8, 0, 0, 10, 10},
+		{"above target pool size", &PoolSize{Target: uint32(projected - 1)}, 1e8, 0, 0, 10, 0},
+	}
+	for _, test := range tests {
+		m := testMarket(height, price)
+		m.NextSDiff = test.sdiff
+		m.WindowSpent = test.spent
+		if test.left != 0 {
+			m.BlocksLeft = test.left
+		}
+		n, err := test.s.Buy(ctx, m, test.n)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if n != test.want {
+			t.Errorf("%s: buy %d tickets, want %d", test.name, n, test.want)
+		}
+	}
+
+}
+
+func TestConfigStrategies(t *testing.T) {
+	custom := &MaxPrice{Price: 1}
+	cfg := &Config{
+		MaxPrice:       1,
+		AverageWindows: 1,
+		Spread:         true,
+		WindowBudget:   1,
+		TargetPoolSize: 1,
+		Strategies:     []Strategy{custom},
+	}
+	s := cfg.strategies()
+	if len(s) != 6 {
+		t.Fatalf("got %d strategies, want 6", len(s))
+	}
+	if s[4] != custom {
+		t.Errorf("custom strategy is not applied after built-in strategies")
+	}
+	if _, ok := s[5].(*Spread); !ok {
+		t.Errorf("spread strategy is not applied last")
+	}
+	if s := new(Config).strategies(); len(s) != 0 {
+		t.Errorf("default config has %d strategies, want 0", len(s))
+	}
+}
@@ -5,6 +5,7 @@
 package ticketbuyer
 
 import (
+	"bytes"
 	"context"
 	"net"
 	"runtime/trace"
@@ -51,6 +52,31 @@ type Config struct {
 	TicketSplitAccount uint32
 	ChangeAccount      uint32
 	MixChange          bool
+
+	// Purchasing strategies.  Each option is ignored when zero.
+
+	// Do not buy tickets priced above MaxPrice
+	MaxPrice dcrutil.Amount
+
+	// Only buy tickets priced below the average price of the previous
+	// AverageWindows ticket windows
+	AverageWindows int
+
+	// Spread purchases evenly across the blocks of each ticket window
+	Spread bool
+
+	// Maximum total price of the tickets purchased for each ticket window.
+	// Spending is tracked from when the ticket buyer is started and is not
+	// persisted across restarts.
+	WindowBudget dcrutil.Amount
+
+	// Hold back purchases while the projected ticket pool size is above
+	// TargetPoolSize
+	TargetPoolSize uint32
+
+	// Additional strategies, applied after the above options and before
+	// Spread
+	Strategies []Strategy
 }
 
 // TB is an automated ticket buyer, buying as many tickets as possible given an
@@ -61,6 +87,13 @@ type TB struct {
 
 	cfg Config
 	mu  sync.Mutex
+
+	// Budget accounting for the current ticket window.  marketMu is held
+	// while applying strategies and reserving the price of the tickets
+	// being purchased, serializing concurrent purchases.
+	marketMu    sync.Mutex
+	windowStart int32
+	windowSpent dcrutil.Amount
 }
 
 // New returns a new TB to buy tickets from a wallet using the default config.
@@ -227,6 +260,7 @@ func (tb *TB) buy(ctx context.Context, passphrase []byte, tip *wire.BlockHeader,
 	mixedBranch := tb.cfg.MixedAccountBranch
 	splitAccount := tb.cfg.TicketSplitAccount
 	changeAccount := tb.cfg.ChangeAccount
+	strategies := tb.cfg.strategies()
 	tb.mu.Unlock()
 
 	// Determine how many tickets to buy
@@ -249,12 +283,49 @@ func (tb *TB) buy(ctx context.Context, passphrase []byte, tip *wire.BlockHeader,
 		log.Debugf("Skipping purchase: low available balance")
 		return nil
 	}
-	if max := int(w.ChainParams().MaxFreshStakePerBlock); buy > max {
+
+	params := w.ChainParams()
+	windowStart := expiry - int32(params.StakeDiffWindowSize)
+	tb.marketMu.Lock()
+	if windowStart != tb.windowStart {
+		tb.windowStart = windowStart
+		tb.windowSpent = 0
+	}
+	m := &Market{
+		Params:      params,
+		Tip:         tip,
+		NextSDiff:   sdiff,
+		WindowStart: windowStart,
+		BlocksLeft:  expiry - 2 - int32(tip.Height),
+		Spendable:   spendable,
+		WindowSpent: tb.windowSpent,
+		header:      tb.header,
+	}
+	for _, s := range strategies {
+		n, err := s.Buy(ctx, m, buy)
+		if err != nil {
+			tb.marketMu.Unlock()
+			return err
+		}
+		if n < buy {
+			log.Debugf("Strategy %v reduced purchase from %d to %d tickets",
+				s, buy, n)
+			buy = n
+		}
+		if buy <= 0 {
+			tb.marketMu.Unlock()
+			log.Debugf("Skipping purchase: held back by strategy %v", s)
+			return nil
+		}
+	}
+	if max := int(params.MaxFreshStakePerBlock); buy > max {
 		buy = max
 	}
 	if limit > 0 && buy > limit {
 		buy = limit
 	}
+	tb.windowSpent += sdiff * dcrutil.Amount(buy)
+	tb.marketMu.Unlock()
 
 	tix, err := w.PurchaseTicketsContext(ctx, n, &wallet.PurchaseTicketsRequest{
 		Count:         buy,
@@ -279,6 +350,14 @@ func (tb *TB) buy(ctx context.Context, passphrase []byte, tip *wire.BlockHeader,
 	for _, hash := range tix {
 		log.Infof("Purchased ticket %v at stake difficulty %v", hash, sdiff)
 	}
+	if len(tix) < buy {
+		// Return the reservation for tickets which were not purchased.
+		tb.marketMu.Lock()
+		if tb.windowStart == windowStart {
+			tb.windowSpent -= sdiff * dcrutil.Amount(buy-len(tix))
+		}
+		tb.marketMu.Unlock()
+	}
 	if err != nil && !errors.Is(errors.InsufficientBalance, err) {
 		// Invalid passphrase errors must be returned so Run exits.
 		if errors.Is(err, errors.Passphrase) {
@@ -289,6 +368,21 @@ func (tb *TB) buy(ctx context.Context, passphrase []byte, tip *wire.BlockHeader,
 	return nil
 }
 
+// header returns the main chain block header at a height.
+func (tb *TB) header(ctx context.Context, height int32) (*wire.BlockHeader, error) {
+	const op errors.Op = "ticketbuyer.header"
+	info, err := tb.wallet.BlockInfo(ctx, wallet.NewBlockIdentifierFromHeight(height))
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	h := new(wire.BlockHeader)
+	err = h.Deserialize(bytes.NewReader(info.Header))
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	return h, nil
+}
+
 // AccessConfig runs f with the current config passed as a parameter.  The
 // config is protected by a mutex and this function is safe for concurrent
 // access to read or modify the config.  It is unsafe to leak a pointer to the
@@ -0,0 +1,188 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"time"
+
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3/walletdb"
+)
+
+// SetAccountPassphrase encrypts the private keys of a BIP0044 account with a
+// passphrase separate from the private passphrase of the wallet.  Unlocking
+// the wallet no longer provides access to the account keys, and SignTransaction
+// and CreateSignature refuse to sign for the account until it is unlocked with
+// UnlockAccount.  This isolates the keys of accounts which are rarely spent
+// from while the wallet is unlocked for automated spending from other
+// accounts, such as ticket purchasing.
+//
+// An empty passphrase removes the account passphrase.  The wallet must be
+// unlocked, as must the account if it already has a passphrase.  The account
+// is locked after a new passphrase is set.
+//
+// Account passphrases restrict which keys the wallet API uses, but do not
+// isolate the account keys cryptographically: the wallet private passphrase
+// still decrypts the coin type private key, from which every account key is
+// derived, and the seed derives every key as well.  CoinTypePrivKey refuses to
+// return the coin type key while any account has a passphrase, but anyone with
+// the wallet passphrase and the wallet database can recover the account keys.
+func (w *Wallet) SetAccountPassphrase(ctx context.Context, account uint32, passphrase []byte) error {
+	const op errors.Op = "wallet.SetAccountPassphrase"
+	heldUnlock, err := w.holdUnlock()
+	if err != nil {
+		return errors.E(op, err)
+	}
+	defer heldUnlock.release()
+
+	w.cancelAccountTimeout(account)
+	var commit func()
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		var err error
+		commit, err = w.Manager.SetAccountPassphrase(ns, account, passphrase)
+		return err
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	commit()
+	return nil
+}
+
+// UnlockAccount unlocks an account encrypted by its own passphrase.  The
+// wallet must be unlocked first and locking the wallet locks every account.
+// If a non-nil timeout is provided, the account is locked in the background
+// after reading from the channel.  The timeout replaces any prior timeout of
+// the account.
+func (w *Wallet) UnlockAccount(ctx context.Context, account uint32, passphrase []byte, timeout <-chan time.Time) error {
+	const op errors.Op = "wallet.UnlockAccount"
+	heldUnlock, err := w.holdUnlock()
+	if err != nil {
+		return errors.E(op, err)
+	}
+	defer heldUnlock.release()
+
+	// The account timeouts are held until the new timeout is recorded, so
+	// that a replaced timeout can not lock the account after it is unlocked
+	// again.
+	w.passphraseTimeoutMu.Lock()
+	defer w.passphraseTimeoutMu.Unlock()
+
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		return w.Manager.UnlockAccount(ns, account, passphrase)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+
+	w.cancelAccountTimeoutLocked(account)
+	if timeout == nil {
+		log.Infof("Account %d has been unlocked without a time limit", account)
+		return nil
+	}
+	cancel := make(chan struct{}, 1)
+	if w.accountTimeoutCancel == nil {
+		w.accountTimeoutCancel = make(map[uint32]chan struct{})
+	}
+	w.accountTimeoutCancel[account] = cancel
+	go func() {
+		select {
+		case <-timeout:
+			w.passphraseTimeoutMu.Lock()
+			defer w.passphraseTimeoutMu.Unlock()
+
+			// The timeout may have been cancelled or replaced by a
+			// later unlock while waiting for the mutex.
+			if w.accountTimeoutCancel[account] != cancel {
+				return
+			}
+			delete(w.accountTimeoutCancel, account)
+			err := w.lockAccount(context.Background(), account)
+			if err != nil {
+				log.Errorf("Failed to lock account %d: %v", account, err)
+				return
+			}
+			log.Infof("Account %d has been locked due to timeout", account)
+		case <-cancel:
+			<-timeout
+		}
+	}()
+	log.Infof("Account %d has been temporarily unlocked", account)
+	return nil
+}
+
+// LockAccount locks an account encrypted by its own passphrase.  Other
+// accounts remain unlocked while the wallet is unlocked.
+func (w *Wallet) LockAccount(ctx context.Context, account uint32) error {
+	const op errors.Op = "wallet.LockAccount"
+	w.passphraseTimeoutMu.Lock()
+	defer w.passphraseTimeoutMu.Unlock()
+	w.cancelAccountTimeoutLocked(account)
+	err := w.lockAccount(ctx, account)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+func (w *Wallet) lockAccount(ctx context.Context, account uint32) error {
+	return walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		return w.Manager.LockAccount(ns, account)
+	})
+}
+
+// AccountLocked returns whether the private keys of an account are
+// unavailable for signing, either because the wallet is locked or because
+// the account is encrypted by its own passphrase and has not been unlocked.
+func (w *Wallet) AccountLocked(ctx context.Context, account uint32) (bool, error) {
+	const op errors.Op = "wallet.AccountLocked"
+	var locked bool
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		var err error
+		locked, err = w.Manager.AccountLocked(ns, account)
+		return err
+	})
+	if err != nil {
+		return false, errors.E(op, err)
+	}
+	return locked, nil
+}
+
+// AccountHasPassphrase returns whether an account is encrypted by its own
+// passphrase.
+func (w *Wallet) AccountHasPassphrase(ctx context.Context, account uint32) (bool, error) {
+	const op errors.Op = "wallet.AccountHasPassphrase"
+	var has bool
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		has = w.Manager.AccountHasPassphrase(dbtx.ReadBucket(waddrmgrNamespaceKey), account)
+		return nil
+	})
+	if err != nil {
+		return false, errors.E(op, err)
+	}
+	return has, nil
+}
+
+// cancelAccountTimeout stops any pending timeout of an unlocked account.
+func (w *Wallet) cancelAccountTimeout(account uint32) {
+	w.passphraseTimeoutMu.Lock()
+	w.cancelAccountTimeoutLocked(account)
+	w.passphraseTimeoutMu.Unlock()
+}
+
+// cancelAccountTimeoutLocked stops any pending timeout of an unlocked account.
+//
+// This method must be called with the passphraseTimeoutMu held.
+func (w *Wallet) cancelAccountTimeoutLocked(account uint32) {
+	if cancel := w.accountTimeoutCancel[account]; cancel != nil {
+		cancel <- struct{}{}
+	}
+	delete(w.accountTimeoutCancel, account)
+}
@@ -0,0 +1,205 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/txscript/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3/walletdb"
+)
+
+func TestAccountPassphrases(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	cfg.AccountGapLimit = DefaultAccountGapLimit
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	err := w.Unlock(ctx, testPrivPass, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	treasury, err := w.NextAccount(ctx, "treasury")
+	if err != nil {
+		t.Fatal(err)
+	}
+	hotAddr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	treasuryAddr, err := w.NewExternalAddress(ctx, treasury)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sign := func(addr dcrutil.Address) error {
+		pkScript, _, err := addressScript(addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tx := wire.NewMsgTx()
+		tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, 1e8, nil))
+		tx.AddTxOut(wire.NewTxOut(1e8, pkScript))
+		_, _, err = w.CreateSignature(ctx, tx, 0, addr, txscript.SigHashAll, pkScript)
+		return err
+	}
+	accountLocked := func(account uint32) bool {
+		locked, err := w.AccountLocked(ctx, account)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return locked
+	}
+
+	// A passphrase set by a transaction which does not commit does not lock
+	// the account.
+	errRollback := errors.New("rollback")
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		_, err := w.Manager.SetAccountPassphrase(ns, treasury, []byte("treasury"))
+		if err != nil {
+			return err
+		}
+		return errRollback
+	})
+	if err != errRollback {
+		t.Fatalf("rolled back passphrase change: %v", err)
+	}
+	if has, _ := w.AccountHasPassphrase(ctx, treasury); has || accountLocked(treasury) {
+		t.Fatal("rolled back passphrase change modified the account")
+	}
+	if err := sign(treasuryAddr); err != nil {
+		t.Fatalf("sign after rolled back passphrase change: %v", err)
+	}
+
+	err = w.SetAccountPassphrase(ctx, treasury, []byte("treasury"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if has, _ := w.AccountHasPassphrase(ctx, treasury); !has {
+		t.Fatal("treasury account has no passphrase")
+	}
+	if !accountLocked(treasury) || accountLocked(0) {
+		t.Fatal("only the treasury account should be locked")
+	}
+	if _, err := w.CoinTypePrivKey(ctx); !errors.Is(err, errors.Invalid) {
+		t.Fatalf("coin type key with account passphrase: expected Invalid, got %v", err)
+	}
+	if err := sign(hotAddr); err != nil {
+		t.Fatalf("sign for unlocked account: %v", err)
+	}
+	if err := sign(treasuryAddr); !errors.Is(err, errors.Locked) {
+		t.Fatalf("sign for locked account: expected Locked, got %v", err)
+	}
+
+	err = w.UnlockAccount(ctx, treasury, []byte("wrong"), nil)
+	if !errors.Is(err, errors.Passphrase) {
+		t.Fatalf("unlock with wrong passphrase: expected Passphrase, got %v", err)
+	}
+	err = w.UnlockAccount(ctx, treasury, []byte("treasury"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sign(treasuryAddr); err != nil {
+		t.Fatalf("sign for unlocked account: %v", err)
+	}
+	err = w.LockAccount(ctx, treasury)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sign(treasuryAddr); !errors.Is(err, errors.Locked) {
+		t.Fatalf("sign for relocked account: expected Locked, got %v", err)
+	}
+	if err := w.LockAccount(ctx, 0); !errors.Is(err, errors.Invalid) {
+		t.Fatalf("lock account without passphrase: expected Invalid, got %v", err)
+	}
+
+	// Unlocking the wallet does not unlock the account, and locking the
+	// wallet locks the account.
+	err = w.UnlockAccount(ctx, treasury, []byte("treasury"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Lock()
+	if err := w.UnlockAccount(ctx, treasury, []byte("treasury"), nil); !errors.Is(err, errors.Locked) {
+		t.Fatalf("unlock account of locked wallet: expected Locked, got %v", err)
+	}
+	err = w.Unlock(ctx, testPrivPass, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !accountLocked(treasury) {
+		t.Fatal("wallet unlock unlocked the treasury account")
+	}
+
+	// Accounts are locked again after the unlock timeout.
+	timeout := make(chan time.Time, 1)
+	err = w.UnlockAccount(ctx, treasury, []byte("treasury"), timeout)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if accountLocked(treasury) {
+		t.Fatal("account is locked before timeout")
+	}
+	timeout <- time.Time{}
+	time.Sleep(100 * time.Millisecond)
+	if !accountLocked(treasury) {
+		t.Fatal("account is unlocked after timeout")
+	}
+
+	// A replaced timeout does not lock the account or cancel the timeout of a
+	// later unlock, even when it expires as the account is unlocked again.
+	for i := 0; i < 3; i++ {
+		replaced := make(chan time.Time, 1)
+		err = w.UnlockAccount(ctx, treasury, []byte("treasury"), replaced)
+		if err != nil {
+			t.Fatal(err)
+		}
+		timeout := make(chan time.Time, 1)
+		replaced <- time.Time{}
+		err = w.UnlockAccount(ctx, treasury, []byte("treasury"), timeout)
+		if err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(10 * time.Millisecond)
+		if accountLocked(treasury) {
+			t.Fatal("replaced timeout locked the account")
+		}
+		timeout <- time.Time{}
+		time.Sleep(10 * time.Millisecond)
+		if !accountLocked(treasury) {
+			t.Fatal("account is unlocked after the replacing timeout")
+		}
+	}
+
+	// Removing the passphrase returns the account to the wallet passphrase.
+	err = w.UnlockAccount(ctx, treasury, []byte("treasury"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.SetAccountPassphrase(ctx, treasury, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Lock()
+	err = w.Unlock(ctx, testPrivPass, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if accountLocked(treasury) {
+		t.Fatal("account without passphrase is locked")
+	}
+	if err := sign(treasuryAddr); err != nil {
+		t.Fatalf("sign after passphrase removal: %v", err)
+	}
+	if _, err := w.CoinTypePrivKey(ctx); err != nil {
+		t.Fatalf("coin type key after passphrase removal: %v", err)
+	}
+}
@@ -595,6 +595,13 @@ func (w *Wallet) markUsedAddress(op errors.Op, dbtx walletdb.ReadWriteTx, addr u
 	if account == udb.ImportedAddrAccount {
 		return nil
 	}
+	if udb.IsDescriptorAccount(account) {
+		err := w.markUsedDescriptorAddress(dbtx, addr)
+		if err != nil {
+			return errors.E(op, err)
+		}
+		return nil
+	}
 	props, err := w.Manager.AccountProperties(ns, account)
 	if err != nil {
 		return errors.E(op, err)
@@ -617,12 +624,18 @@ func (w *Wallet) markUsedAddress(op errors.Op, dbtx walletdb.ReadWriteTx, addr u
 // NewExternalAddress returns an external address.
 func (w *Wallet) NewExternalAddress(ctx context.Context, account uint32, callOpts ...NextAddressCallOption) (dcrutil.Address, error) {
 	const op errors.Op = "wallet.NewExternalAddress"
+	if udb.IsDescriptorAccount(account) {
+		return w.nextDescriptorAddress(ctx, op, account, callOpts...)
+	}
 	return w.nextAddress(ctx, op, w.persistReturnedChild(ctx, nil), account, udb.ExternalBranch, callOpts...)
 }
 
 // NewInternalAddress returns an internal address.
 func (w *Wallet) NewInternalAddress(ctx context.Context, account uint32, callOpts ...NextAddressCallOption) (dcrutil.Address, error) {
 	const op errors.Op = "wallet.NewExternalAddress"
+	if udb.IsDescriptorAccount(account) {
+		return nil, errors.E(op, errors.Invalid, "descriptor accounts do not have an internal branch")
+	}
 	return w.nextAddress(ctx, op, w.persistReturnedChild(ctx, nil), account, udb.InternalBranch, callOpts...)
 }
 
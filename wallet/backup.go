@@ -0,0 +1,428 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"encoding/hex"
+	"io"
+	"strconv"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/hdkeychain/v2"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3/udb"
+	"github.com/decred/dcrwallet/wallet/v3/walletdb"
+)
+
+// ExportVersion is the version of the wallet export format created by Export.
+const ExportVersion = 1
+
+// Export describes the wallet state which can not be recovered from the wallet
+// seed alone.  It is created by Export and restored by RestoreExport, and is
+// intended to be serialized as JSON.
+type Export struct {
+	Version            int                       `json:"version"`
+	Network            string                    `json:"network"`
+	Accounts           []ExportAccount           `json:"accounts"`
+	XpubAccounts       []ExportAccount           `json:"xpubaccounts"`
+	DescriptorAccounts []ExportDescriptorAccount `json:"descriptoraccounts"`
+	PrivateKeys        []string                  `json:"privatekeys"`
+	Scripts            []string                  `json:"scripts"`
+	Labels             []ExportLabel             `json:"labels"`
+	VoteChoices        []ExportAgendaChoice      `json:"votechoices"`
+}
+
+// ExportAccount describes a BIP0044 account or an account created from an
+// imported xpub.  The account number is only meaningful for BIP0044 accounts.
+type ExportAccount struct {
+	Number uint32 `json:"number"`
+	Name   string `json:"name"`
+	Xpub   string `json:"xpub"`
+}
+
+// ExportDescriptorAccount describes a descriptor account.  Address usage is
+// not exported and is found again by address discovery.
+type ExportDescriptorAccount struct {
+	Name       string `json:"name"`
+	Descriptor string `json:"descriptor"`
+}
+
+// ExportLabel describes a label of an address, transaction or transaction
+// output.  Hashes are encoded as hex strings in the byte-reversed order used by
+// transaction IDs.
+type ExportLabel struct {
+	Kind    string `json:"kind"`
+	Address string `json:"address,omitempty"`
+	TxHash  string `json:"txhash,omitempty"`
+	Index   uint32 `json:"index,omitempty"`
+	Label   string `json:"label"`
+}
+
+// ExportAgendaChoice describes a vote preference for a consensus agenda.
+type ExportAgendaChoice struct {
+	AgendaID string `json:"agendaid"`
+	ChoiceID string `json:"choiceid"`
+}
+
+// BackupDB writes a consistent copy of the wallet database to dst.  The copy
+// is created from a single database read transaction and may be made while the
+// wallet is running.
+func (w *Wallet) BackupDB(ctx context.Context, dst io.Writer) error {
+	const op errors.Op = "wallet.BackupDB"
+	err := w.db.Copy(dst)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// Export returns the account names, xpub and descriptor accounts, imported
+// private keys and scripts, labels and vote preferences of the wallet.
+// Exporting imported private keys requires the wallet to be unlocked.
+func (w *Wallet) Export(ctx context.Context) (*Export, error) {
+	const op errors.Op = "wallet.Export"
+	e := &Export{
+		Version:            ExportVersion,
+		Network:            w.chainParams.Name,
+		Accounts:           []ExportAccount{},
+		XpubAccounts:       []ExportAccount{},
+		DescriptorAccounts: []ExportDescriptorAccount{},
+		PrivateKeys:        []string{},
+		Scripts:            []string{},
+		Labels:             []ExportLabel{},
+		VoteChoices:        []ExportAgendaChoice{},
+	}
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+		err := w.Manager.ForEachAccount(addrmgrNs, func(account uint32) error {
+			if account == udb.ImportedAddrAccount {
+				return nil
+			}
+			name, err := w.Manager.AccountName(addrmgrNs, account)
+			if err != nil {
+				return err
+			}
+			xpub, err := w.Manager.GetMasterPubkey(addrmgrNs, account)
+			if err != nil {
+				return err
+			}
+			a := ExportAccount{Number: account, Name: name, Xpub: xpub}
+			if account > udb.ImportedAddrAccount {
+				a.Number = 0
+				e.XpubAccounts = append(e.XpubAccounts, a)
+			} else {
+				e.Accounts = append(e.Accounts, a)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		err = w.Manager.ForEachDescriptorAccount(addrmgrNs, func(account uint32) error {
+			props, err := w.Manager.DescriptorAccount(addrmgrNs, account)
+			if err != nil {
+				return err
+			}
+			e.DescriptorAccounts = append(e.DescriptorAccounts, ExportDescriptorAccount{
+				Name:       props.AccountName,
+				Descriptor: props.Descriptor,
+			})
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		var imported []dcrutil.Address
+		err = w.Manager.ForEachAccountAddress(addrmgrNs, udb.ImportedAddrAccount,
+			func(maddr udb.ManagedAddress) error {
+				if _, ok := maddr.(udb.ManagedPubKeyAddress); ok {
+					imported = append(imported, maddr.Address())
+				}
+				return nil
+			})
+		if err != nil {
+			return err
+		}
+		for _, addr := range imported {
+			key, done, err := w.Manager.PrivateKey(addrmgrNs, addr)
+			if err != nil {
+				return err
+			}
+			wif := dcrutil.NewWIF(key, w.chainParams.PrivateKeyID,
+				dcrec.SignatureType(key.GetType()))
+			done()
+			e.PrivateKeys = append(e.PrivateKeys, wif.String())
+		}
+
+		for _, script := range w.TxStore.StoredTxScripts(txmgrNs) {
+			// Redeem scripts of descriptor accounts are derived again
+			// when the account is restored.
+			p2sh, err := dcrutil.NewAddressScriptHash(script, w.chainParams)
+			if err != nil {
+				return err
+			}
+			account, err := w.Manager.AddrAccount(addrmgrNs, p2sh)
+			if err == nil && udb.IsDescriptorAccount(account) {
+				continue
+			}
+			e.Scripts = append(e.Scripts, hex.EncodeToString(script))
+		}
+
+		return w.TxStore.ForEachLabel(txmgrNs, func(t *udb.LabelTarget, label string) error {
+			l := ExportLabel{Kind: t.Kind.String(), Label: label}
+			switch t.Kind {
+			case udb.LabelAddress:
+				l.Address = t.Address
+			case udb.LabelTransaction:
+				l.TxHash = t.Hash.String()
+			case udb.LabelOutput:
+				l.TxHash = t.Hash.String()
+				l.Index = t.Index
+			}
+			e.Labels = append(e.Labels, l)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	choices, _, err := w.AgendaChoices(ctx)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	for _, c := range choices {
+		e.VoteChoices = append(e.VoteChoices, ExportAgendaChoice{
+			AgendaID: c.AgendaID,
+			ChoiceID: c.ChoiceID,
+		})
+	}
+
+	return e, nil
+}
+
+// RestoreExport restores the wallet state described by an export, and is
+// intended to be used with a wallet created from the same seed as the
+// exporting wallet.  Missing BIP0044 accounts are created and all accounts are
+// renamed to their exported names.  The extended public keys of the restored
+// accounts must match the export.  Imported private keys, scripts, xpub and
+// descriptor accounts, labels and vote preferences are added to the wallet,
+// and entries which already exist are ignored.  Vote preferences for agendas which are not
+// defined by the wallet's supported stake version are ignored.
+//
+// Restoring requires the wallet to be unlocked.  The wallet must be rescanned
+// afterwards to discover transactions of imported keys and scripts.
+func (w *Wallet) RestoreExport(ctx context.Context, e *Export) error {
+	const op errors.Op = "wallet.RestoreExport"
+	if e.Version != ExportVersion {
+		return errors.E(op, errors.Invalid, errors.Errorf("unsupported export version %d", e.Version))
+	}
+	if e.Network != w.chainParams.Name {
+		return errors.E(op, errors.Invalid, errors.Errorf("export is for network %q", e.Network))
+	}
+
+	// Decode everything before modifying the wallet.
+	wifs := make([]*dcrutil.WIF, len(e.PrivateKeys))
+	for i, s := range e.PrivateKeys {
+		wif, err := dcrutil.DecodeWIF(s, w.chainParams.PrivateKeyID)
+		if err != nil {
+			return errors.E(op, errors.Encoding, err)
+		}
+		wifs[i] = wif
+	}
+	scripts := make([][]byte, len(e.Scripts))
+	for i, s := range e.Scripts {
+		script, err := hex.DecodeString(s)
+		if err != nil {
+			return errors.E(op, errors.Encoding, err)
+		}
+		scripts[i] = script
+	}
+	labels := make([]udb.LabelTarget, len(e.Labels))
+	for i, l := range e.Labels {
+		var hash *chainhash.Hash
+		if l.Kind != "address" {
+			var err error
+			hash, err = chainhash.NewHashFromStr(l.TxHash)
+			if err != nil {
+				return errors.E(op, errors.Encoding, err)
+			}
+		}
+		switch l.Kind {
+		case "address":
+			labels[i] = udb.AddressLabelTarget(l.Address)
+		case "transaction":
+			labels[i] = udb.TxLabelTarget(hash)
+		case "output":
+			labels[i] = udb.OutputLabelTarget(hash, l.Index)
+		default:
+			return errors.E(op, errors.Encoding, errors.Errorf("unknown label kind %q", l.Kind))
+		}
+	}
+
+	for _, a := range e.Accounts {
+		if a.Number > udb.MaxAccountNum {
+			return errors.E(op, errors.Invalid, errors.Errorf("invalid account number %d", a.Number))
+		}
+		err := w.restoreAccount(ctx, &a)
+		if err != nil {
+			return errors.E(op, err)
+		}
+	}
+	for _, a := range e.XpubAccounts {
+		err := w.restoreXpubAccount(ctx, &a)
+		if err != nil {
+			return errors.E(op, err)
+		}
+	}
+	for _, a := range e.DescriptorAccounts {
+		err := w.restoreDescriptorAccount(ctx, &a)
+		if err != nil {
+			return errors.E(op, err)
+		}
+	}
+
+	for _, wif := range wifs {
+		_, err := w.ImportPrivateKey(ctx, wif)
+		if err != nil && !errors.Is(err, errors.Exist) {
+			return errors.E(op, err)
+		}
+	}
+	for _, script := range scripts {
+		err := w.ImportScript(ctx, script)
+		if err != nil {
+			return errors.E(op, err)
+		}
+	}
+	for i := range labels {
+		err := w.SetLabel(ctx, &labels[i], e.Labels[i].Label)
+		if err != nil {
+			return errors.E(op, err)
+		}
+	}
+
+	_, deployments := CurrentAgendas(w.chainParams)
+	var choices []AgendaChoice
+	for _, c := range e.VoteChoices {
+		for i := range deployments {
+			if deployments[i].Vote.Id == c.AgendaID {
+				choices = append(choices, AgendaChoice{AgendaID: c.AgendaID, ChoiceID: c.ChoiceID})
+				break
+			}
+		}
+	}
+	if len(choices) != 0 {
+		_, err := w.SetAgendaChoices(ctx, choices...)
+		if err != nil {
+			return errors.E(op, err)
+		}
+	}
+
+	return nil
+}
+
+// restoreAccount creates the BIP0044 account described by an export, along
+// with any missing accounts before it, and renames it to its exported name.
+func (w *Wallet) restoreAccount(ctx context.Context, a *ExportAccount) error {
+	for {
+		var last uint32
+		err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+			var err error
+			last, err = w.Manager.LastAccount(dbtx.ReadBucket(waddrmgrNamespaceKey))
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		if last >= a.Number {
+			break
+		}
+		// Intermediate accounts are given a temporary name and are
+		// renamed when their own export entry is restored.
+		_, err = w.NextAccount(ctx, "restored-"+strconv.FormatUint(uint64(last+1), 10))
+		if err != nil {
+			return err
+		}
+	}
+
+	xpub, err := w.MasterPubKey(ctx, a.Number)
+	if err != nil {
+		return err
+	}
+	if xpub.String() != a.Xpub {
+		return errors.E(errors.Invalid, errors.Errorf("account %d extended "+
+			"public key does not match export; wallet was created from a "+
+			"different seed", a.Number))
+	}
+	name, err := w.AccountName(ctx, a.Number)
+	if err != nil {
+		return err
+	}
+	if name != a.Name {
+		return w.RenameAccount(ctx, a.Number, a.Name)
+	}
+	return nil
+}
+
+// restoreXpubAccount imports an xpub account described by an export unless an
+// account with the same name and extended public key already exists.
+func (w *Wallet) restoreXpubAccount(ctx context.Context, a *ExportAccount) error {
+	xpub, err := hdkeychain.NewKeyFromString(a.Xpub, w.chainParams)
+	if err != nil {
+		return errors.E(errors.Encoding, err)
+	}
+	account, err := w.AccountNumber(ctx, a.Name)
+	if err == nil {
+		existing, err := w.MasterPubKey(ctx, account)
+		if err != nil {
+			return err
+		}
+		if existing.String() != a.Xpub {
+			return errors.E(errors.Exist, errors.Errorf("account %q exists "+
+				"with a different extended public key", a.Name))
+		}
+		return nil
+	}
+	if !errors.Is(err, errors.NotExist) {
+		return err
+	}
+	return w.ImportXpubAccount(ctx, a.Name, xpub)
+}
+
+// restoreDescriptorAccount imports a descriptor account described by an
+// export unless an account with the same name and descriptor already exists.
+func (w *Wallet) restoreDescriptorAccount(ctx context.Context, a *ExportDescriptorAccount) error {
+	account, err := w.AccountNumber(ctx, a.Name)
+	if err == nil {
+		var props *udb.DescriptorAccountProperties
+		if udb.IsDescriptorAccount(account) {
+			err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+				var err error
+				props, err = w.Manager.DescriptorAccount(dbtx.ReadBucket(waddrmgrNamespaceKey), account)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if props == nil || props.Descriptor != a.Descriptor {
+			return errors.E(errors.Exist, errors.Errorf("account %q exists "+
+				"with a different descriptor", a.Name))
+		}
+		return nil
+	}
+	if !errors.Is(err, errors.NotExist) {
+		return err
+	}
+	_, err = w.ImportDescriptorAccount(ctx, a.Name, a.Descriptor)
+	return err
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/chaincfg/v2/chainec"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/hdkeychain/v2"
+	"github.com/decred/dcrd/txscript/v2"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3/udb"
+)
+
+func TestExportRestore(t *testing.T) {
+	ctx := context.Background()
+	seed := bytes.Repeat([]byte{0x01}, hdkeychain.RecommendedSeedLen)
+
+	cfg := basicWalletConfig
+	cfg.AccountGapLimit = 10
+	w, teardown := testWalletSeed(t, &cfg, seed)
+	defer teardown()
+	err := w.Unlock(ctx, testPrivPass, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	account, err := w.NextAccount(ctx, "savings")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.RenameAccount(ctx, 0, "spending")
+	if err != nil {
+		t.Fatal(err)
+	}
+	priv, _ := chainec.Secp256k1.PrivKeyFromBytes(bytes.Repeat([]byte{0x02}, 32))
+	wif := dcrutil.NewWIF(priv, w.ChainParams().PrivateKeyID, dcrec.STEcdsaSecp256k1)
+	addr, err := w.ImportPrivateKey(ctx, wif)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.ImportScript(ctx, []byte{txscript.OP_TRUE})
+	if err != nil {
+		t.Fatal(err)
+	}
+	xpub, err := w.MasterPubKey(ctx, account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.ImportXpubAccount(ctx, "watched", xpub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	txHash := chainhash.HashH([]byte("labeled"))
+	labels := []udb.LabelTarget{
+		udb.AddressLabelTarget(addr),
+		udb.TxLabelTarget(&txHash),
+		udb.OutputLabelTarget(&txHash, 2),
+	}
+	for i := range labels {
+		err = w.SetLabel(ctx, &labels[i], labels[i].Kind.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	export, err := w.Export(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(export.Accounts) != 2 || len(export.XpubAccounts) != 1 ||
+		len(export.PrivateKeys) != 1 || len(export.Scripts) != 1 ||
+		len(export.Labels) != 3 {
+		t.Fatalf("incomplete export: %+v", export)
+	}
+
+	// Restore from the serialized export into a new wallet created from
+	// the same seed, and check that it exports identically.
+	b, err := json.Marshal(export)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var restored Export
+	err = json.Unmarshal(b, &restored)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg2 := basicWalletConfig
+	cfg2.AccountGapLimit = 10
+	w2, teardown2 := testWalletSeed(t, &cfg2, seed)
+	defer teardown2()
+	err = w2.Unlock(ctx, testPrivPass, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w2.RestoreExport(ctx, &restored)
+	if err != nil {
+		t.Fatal(err)
+	}
+	export2, err := w2.Export(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(export, export2) {
+		t.Fatalf("restored wallet exports differently:\n%+v\n%+v", export, export2)
+	}
+
+	// Restoring twice is harmless.
+	err = w2.RestoreExport(ctx, &restored)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Wallets created from another seed can not be restored.
+	cfg3 := basicWalletConfig
+	cfg3.AccountGapLimit = 10
+	w3, teardown3 := testWallet(t, &cfg3)
+	defer teardown3()
+	err = w3.Unlock(ctx, testPrivPass, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w3.RestoreExport(ctx, &restored)
+	if !errors.Is(err, errors.Invalid) {
+		t.Fatalf("expected Invalid error restoring into wallet with another seed, got %v", err)
+	}
+}
+
+func TestBackupDB(t *testing.T) {
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	var buf bytes.Buffer
+	err := w.BackupDB(context.Background(), &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() == 0 {
+		t.Fatal("empty database backup")
+	}
+}
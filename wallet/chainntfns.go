@@ -196,6 +196,10 @@ func (w *Wallet) ChainSwitch(ctx context.Context, forest *SidechainForest, chain
 		return nil, errors.E(op, err)
 	}
 
+	for _, n := range chain {
+		w.feeEst.processBlock(int32(n.Header.Height), relevantTxs[*n.Hash], n.Hash, n.Filter)
+	}
+
 	if n, err := w.NetworkBackend(); err == nil {
 		_, err = w.watchHDAddrs(ctx, false, n)
 		if err != nil {
@@ -387,6 +391,9 @@ func (w *Wallet) processTransactionRecord(ctx context.Context, dbtx walletdb.Rea
 				"transaction already exists mined", &rec.Hash)
 			return nil, nil
 		}
+		if err == nil {
+			w.feeEst.observe(&rec.MsgTx, &rec.Hash)
+		}
 	} else {
 		err = w.TxStore.InsertMinedTx(txmgrNs, addrmgrNs, rec, &blockMeta.Hash)
 	}
@@ -679,6 +686,13 @@ func (w *Wallet) processTransactionRecord(ctx context.Context, dbtx walletdb.Rea
 			if err != nil {
 				return nil, errors.E(op, err)
 			}
+			if !isTicketCommit && !isStakeType {
+				err = w.recordInvoicePayment(dbtx, addr, &rec.Hash,
+					uint32(i), dcrutil.Amount(output.Value))
+				if err != nil {
+					return nil, errors.E(op, err)
+				}
+			}
 			err = w.markUsedAddress(op, dbtx, ma)
 			if err != nil {
 				return nil, err
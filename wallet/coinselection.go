@@ -0,0 +1,458 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"math"
+	"sort"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3/internal/txsizes"
+	"github.com/decred/dcrwallet/wallet/v3/txauthor"
+	"github.com/decred/dcrwallet/wallet/v3/txrules"
+	"github.com/decred/dcrwallet/wallet/v3/walletdb"
+)
+
+// bnbMaxTries limits the number of search steps performed by the
+// branch-and-bound output selection before falling back to largest-first
+// selection.
+const bnbMaxTries = 100000
+
+// ParseOutputSelectionAlgorithm returns the output selection algorithm
+// described by a string.  The empty string selects the default algorithm.
+func ParseOutputSelectionAlgorithm(s string) (OutputSelectionAlgorithm, error) {
+	switch s {
+	case "", "default":
+		return OutputSelectionAlgorithmDefault, nil
+	case "all":
+		return OutputSelectionAlgorithmAll, nil
+	case "largestfirst":
+		return OutputSelectionAlgorithmLargestFirst, nil
+	case "smallestfirst":
+		return OutputSelectionAlgorithmSmallestFirst, nil
+	case "oldestfirst":
+		return OutputSelectionAlgorithmOldestFirst, nil
+	case "branchandbound":
+		return OutputSelectionAlgorithmBranchAndBound, nil
+	case "avoidmixing":
+		return OutputSelectionAlgorithmAvoidMixing, nil
+	default:
+		return 0, errors.E(errors.Invalid,
+			errors.Errorf("unknown output selection algorithm %q", s))
+	}
+}
+
+// String returns the name of the algorithm as accepted by
+// ParseOutputSelectionAlgorithm.
+func (a OutputSelectionAlgorithm) String() string {
+	switch a {
+	case OutputSelectionAlgorithmDefault:
+		return "default"
+	case OutputSelectionAlgorithmAll:
+		return "all"
+	case OutputSelectionAlgorithmLargestFirst:
+		return "largestfirst"
+	case OutputSelectionAlgorithmSmallestFirst:
+		return "smallestfirst"
+	case OutputSelectionAlgorithmOldestFirst:
+		return "oldestfirst"
+	case OutputSelectionAlgorithmBranchAndBound:
+		return "branchandbound"
+	case OutputSelectionAlgorithmAvoidMixing:
+		return "avoidmixing"
+	default:
+		return "unknown"
+	}
+}
+
+func sumOutputValues(outputs []*wire.TxOut) (total dcrutil.Amount) {
+	for _, out := range outputs {
+		total += dcrutil.Amount(out.Value)
+	}
+	return total
+}
+
+// changelessTarget describes the transaction being funded so that
+// branch-and-bound selection can search for inputs which avoid a change
+// output.
+type changelessTarget struct {
+	// amount is the total value of all non-change outputs.
+	amount dcrutil.Amount
+
+	// outputs are the non-change outputs used to estimate the transaction
+	// size.  It may be nil when fees are not paid by the selected inputs.
+	outputs []*wire.TxOut
+
+	// relayFeePerKb is the fee rate used to determine dust change and,
+	// when fees is true, the fee paid by the selected inputs.
+	relayFeePerKb    dcrutil.Amount
+	fees             bool
+	changeScriptSize int
+}
+
+// fee returns the fee that must be paid by a transaction spending inputs with
+// the redeem script sizes, including a change output.  This matches the fee
+// calculated by txauthor.NewUnsignedTransaction.
+func (t *changelessTarget) fee(scriptSizes []int) dcrutil.Amount {
+	if !t.fees {
+		return 0
+	}
+	size := txsizes.EstimateSerializeSize(scriptSizes, t.outputs, t.changeScriptSize)
+	return txrules.FeeForSerializeSize(t.relayFeePerKb, size)
+}
+
+// changeless returns whether the inputs pay for every output and fee without
+// leaving any non-dust change.
+func (t *changelessTarget) changeless(input dcrutil.Amount, scriptSizes []int) bool {
+	excess := input - t.amount - t.fee(scriptSizes)
+	if excess < 0 {
+		return false
+	}
+	return excess == 0 || txrules.IsDustAmount(excess, t.changeScriptSize, t.relayFeePerKb)
+}
+
+// inputDetailSorter sorts the inputs, scripts and redeem script sizes of an
+// input detail together.
+type inputDetailSorter struct {
+	d    *txauthor.InputDetail
+	less func(a, b *wire.TxIn) bool
+}
+
+func (s *inputDetailSorter) Len() int { return len(s.d.Inputs) }
+func (s *inputDetailSorter) Less(i, j int) bool {
+	return s.less(s.d.Inputs[i], s.d.Inputs[j])
+}
+func (s *inputDetailSorter) Swap(i, j int) {
+	d := s.d
+	d.Inputs[i], d.Inputs[j] = d.Inputs[j], d.Inputs[i]
+	d.Scripts[i], d.Scripts[j] = d.Scripts[j], d.Scripts[i]
+	d.RedeemScriptSizes[i], d.RedeemScriptSizes[j] = d.RedeemScriptSizes[j], d.RedeemScriptSizes[i]
+}
+
+func sortInputs(d *txauthor.InputDetail, less func(a, b *wire.TxIn) bool) {
+	sort.Stable(&inputDetailSorter{d: d, less: less})
+}
+
+func largestFirst(a, b *wire.TxIn) bool  { return a.ValueIn > b.ValueIn }
+func smallestFirst(a, b *wire.TxIn) bool { return a.ValueIn < b.ValueIn }
+
+// subsetInputs returns the input detail for the inputs at indexes idx of d.
+func subsetInputs(d *txauthor.InputDetail, idx []int) *txauthor.InputDetail {
+	s := &txauthor.InputDetail{
+		Inputs:            make([]*wire.TxIn, 0, len(idx)),
+		Scripts:           make([][]byte, 0, len(idx)),
+		RedeemScriptSizes: make([]int, 0, len(idx)),
+	}
+	for _, i := range idx {
+		s.Amount += dcrutil.Amount(d.Inputs[i].ValueIn)
+		s.Inputs = append(s.Inputs, d.Inputs[i])
+		s.Scripts = append(s.Scripts, d.Scripts[i])
+		s.RedeemScriptSizes = append(s.RedeemScriptSizes, d.RedeemScriptSizes[i])
+	}
+	return s
+}
+
+// prefixInputSource returns an InputSource which selects the fewest inputs
+// from the beginning of all that meet the target amount.
+func prefixInputSource(all *txauthor.InputDetail) txauthor.InputSource {
+	var n int
+	var tot dcrutil.Amount
+	return func(target dcrutil.Amount) (*txauthor.InputDetail, error) {
+		if all.Amount <= target {
+			return all, nil
+		}
+		for n < len(all.Inputs) {
+			tot += dcrutil.Amount(all.Inputs[n].ValueIn)
+			n++
+			if tot >= target {
+				break
+			}
+		}
+		selected := &txauthor.InputDetail{
+			Amount:            tot,
+			Inputs:            all.Inputs[:n],
+			Scripts:           all.Scripts[:n],
+			RedeemScriptSizes: all.RedeemScriptSizes[:n],
+		}
+		return selected, nil
+	}
+}
+
+// errInputSource returns an InputSource which always errors with err.
+func errInputSource(err error) txauthor.InputSource {
+	return func(dcrutil.Amount) (*txauthor.InputDetail, error) {
+		return nil, err
+	}
+}
+
+// branchAndBound performs a depth-first search for a subset of values, which
+// must be sorted in descending order, with a sum in the range [lower, upper].
+// Each candidate subset is checked by accept, and the indexes of the first
+// accepted subset are returned.  A nil slice is returned if no subset is
+// accepted within bnbMaxTries search steps.
+func branchAndBound(values []int64, lower, upper int64, accept func(idx []int) bool) []int {
+	// remaining[i] is the sum of values[i:], used to prune branches which
+	// can no longer reach the lower bound.
+	remaining := make([]int64, len(values)+1)
+	for i := len(values) - 1; i >= 0; i-- {
+		remaining[i] = remaining[i+1] + values[i]
+	}
+
+	var selected []int
+	tries := 0
+	var search func(i int, sum int64) bool
+	search = func(i int, sum int64) bool {
+		tries++
+		if tries > bnbMaxTries || sum > upper {
+			return false
+		}
+		if sum >= lower && accept(selected) {
+			return true
+		}
+		if i == len(values) || sum+remaining[i] < lower {
+			return false
+		}
+		selected = append(selected, i)
+		if search(i+1, sum+values[i]) {
+			return true
+		}
+		selected = selected[:len(selected)-1]
+		// Excluding values[i] makes any subset that includes an equal
+		// value following it a duplicate of an already searched subset.
+		j := i + 1
+		for j < len(values) && values[j] == values[i] {
+			j++
+		}
+		return search(j, sum)
+	}
+	if !search(0, 0) {
+		return nil
+	}
+	return selected
+}
+
+// changelessInputs searches all for inputs which fund the target without a
+// change output.  The returned input detail is nil if no such inputs were
+// found.
+func changelessInputs(all *txauthor.InputDetail, t *changelessTarget) *txauthor.InputDetail {
+	// Inputs are searched by their effective value: their value minus the
+	// additional fee required to spend them.
+	feeRate := int64(0)
+	if t.fees {
+		feeRate = int64(t.relayFeePerKb)
+	}
+	var idx []int
+	var values []int64
+	for i, in := range all.Inputs {
+		v := in.ValueIn - feeRate*int64(txsizes.EstimateInputSize(all.RedeemScriptSizes[i]))/1000
+		if v > 0 {
+			idx = append(idx, i)
+			values = append(values, v)
+		}
+	}
+	sort.Sort(byDescValue{idx, values})
+
+	baseFee := dcrutil.Amount(0)
+	if t.fees {
+		baseSize := txsizes.EstimateSerializeSize(nil, t.outputs, t.changeScriptSize)
+		baseFee = dcrutil.Amount(feeRate * int64(baseSize) / 1000)
+	}
+	lower := int64(t.amount + baseFee)
+	// Leftover value is dropped when it is dust.  Dust is any amount below
+	// three times the relay fee for spending and creating the change
+	// output (see txrules.IsDustAmount).  The search tolerance includes
+	// one extra atom per input to allow for fee rounding, and every
+	// candidate is checked against the exact fee.
+	changeCost := int64(t.relayFeePerKb) * 3 * int64(txsizes.EstimateOutputSize(t.changeScriptSize)+txrules.DustInputSize) / 1000
+	upper := lower + changeCost + int64(len(values))
+
+	sel := branchAndBound(values, lower, upper, func(sel []int) bool {
+		var amount dcrutil.Amount
+		sizes := make([]int, len(sel))
+		for i, s := range sel {
+			amount += dcrutil.Amount(all.Inputs[idx[s]].ValueIn)
+			sizes[i] = all.RedeemScriptSizes[idx[s]]
+		}
+		return t.changeless(amount, sizes)
+	})
+	if sel == nil {
+		return nil
+	}
+	for i := range sel {
+		sel[i] = idx[sel[i]]
+	}
+	return subsetInputs(all, sel)
+}
+
+type byDescValue struct {
+	idx    []int
+	values []int64
+}
+
+func (s byDescValue) Len() int           { return len(s.idx) }
+func (s byDescValue) Less(i, j int) bool { return s.values[i] > s.values[j] }
+func (s byDescValue) Swap(i, j int) {
+	s.idx[i], s.idx[j] = s.idx[j], s.idx[i]
+	s.values[i], s.values[j] = s.values[j], s.values[i]
+}
+
+// branchAndBoundInputSource returns an InputSource which prefers a set of
+// inputs that funds the target transaction without creating change.  When no
+// changeless solution exists, inputs are selected largest-first.
+func branchAndBoundInputSource(all *txauthor.InputDetail, t *changelessTarget) txauthor.InputSource {
+	sortInputs(all, largestFirst)
+	exact := changelessInputs(all, t)
+	fallback := prefixInputSource(all)
+	return func(target dcrutil.Amount) (*txauthor.InputDetail, error) {
+		if exact != nil && exact.Amount >= target {
+			return exact, nil
+		}
+		return fallback(target)
+	}
+}
+
+// isMixedOutput returns whether output index of tx was created by a
+// CoinShuffle++ mix.  Mixed outputs pay one of the standard mixing
+// denominations, which is shared by other outputs of the coinjoin.
+func isMixedOutput(tx *wire.MsgTx, index uint32) bool {
+	value := tx.TxOut[index].Value
+	denomination := false
+	for _, v := range splitPoints {
+		if int64(v) == value {
+			denomination = true
+			break
+		}
+	}
+	if !denomination {
+		return false
+	}
+	for i, out := range tx.TxOut {
+		if uint32(i) != index && out.Value == value {
+			return true
+		}
+	}
+	return false
+}
+
+// avoidMixingInputSource returns an InputSource which never spends mixed and
+// unmixed outputs together.  Unmixed outputs are preferred to retain mixed
+// outputs for uses requiring privacy.  When neither set alone can fund the
+// target, the larger set is returned, resulting in an insufficient balance
+// error.
+func (w *Wallet) avoidMixingInputSource(dbtx walletdb.ReadTx, all *txauthor.InputDetail) (txauthor.InputSource, error) {
+	txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+	var mixedIdx, unmixedIdx []int
+	txs := make(map[chainhash.Hash]*wire.MsgTx)
+	for i, in := range all.Inputs {
+		prevOut := &in.PreviousOutPoint
+		tx, ok := txs[prevOut.Hash]
+		if !ok {
+			txDetails, err := w.TxStore.TxDetails(txmgrNs, &prevOut.Hash)
+			if err != nil {
+				return nil, err
+			}
+			tx = &txDetails.MsgTx
+			txs[prevOut.Hash] = tx
+		}
+		if isMixedOutput(tx, prevOut.Index) {
+			mixedIdx = append(mixedIdx, i)
+		} else {
+			unmixedIdx = append(unmixedIdx, i)
+		}
+	}
+	mixed, unmixed := subsetInputs(all, mixedIdx), subsetInputs(all, unmixedIdx)
+	sortInputs(mixed, largestFirst)
+	sortInputs(unmixed, largestFirst)
+	mixedSource, unmixedSource := prefixInputSource(mixed), prefixInputSource(unmixed)
+	return func(target dcrutil.Amount) (*txauthor.InputDetail, error) {
+		switch {
+		case unmixed.Amount >= target:
+			return unmixedSource(target)
+		case mixed.Amount >= target:
+			return mixedSource(target)
+		case unmixed.Amount >= mixed.Amount:
+			return unmixed, nil
+		default:
+			return mixed, nil
+		}
+	}, nil
+}
+
+// selectionInputSource wraps source, which must select inputs in an
+// unspecified order, with an InputSource implementing the output selection
+// algorithm.  t describes the transaction being funded and is only used by
+// branch-and-bound selection.
+func (w *Wallet) selectionInputSource(dbtx walletdb.ReadTx, source txauthor.InputSource,
+	algo OutputSelectionAlgorithm, t *changelessTarget) (txauthor.InputSource, error) {
+
+	switch algo {
+	case OutputSelectionAlgorithmDefault:
+		return source, nil
+	case OutputSelectionAlgorithmAll:
+		// Wrap the source with one that always fetches the max amount
+		// available and ignores insufficient balance issues.
+		return func(dcrutil.Amount) (*txauthor.InputDetail, error) {
+			inputDetail, err := source(dcrutil.MaxAmount)
+			if errors.Is(err, errors.InsufficientBalance) {
+				err = nil
+			}
+			return inputDetail, err
+		}, nil
+	}
+
+	all, err := source(dcrutil.MaxAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	switch algo {
+	case OutputSelectionAlgorithmLargestFirst:
+		sortInputs(all, largestFirst)
+		return prefixInputSource(all), nil
+
+	case OutputSelectionAlgorithmSmallestFirst:
+		sortInputs(all, smallestFirst)
+		return prefixInputSource(all), nil
+
+	case OutputSelectionAlgorithmOldestFirst:
+		heights := make(map[chainhash.Hash]int32)
+		for _, in := range all.Inputs {
+			hash := &in.PreviousOutPoint.Hash
+			if _, ok := heights[*hash]; ok {
+				continue
+			}
+			height, err := w.TxStore.TxBlockHeight(dbtx, hash)
+			if err != nil {
+				return nil, err
+			}
+			if height == -1 {
+				// Unmined outputs are the newest.
+				height = math.MaxInt32
+			}
+			heights[*hash] = height
+		}
+		sortInputs(all, func(a, b *wire.TxIn) bool {
+			return heights[a.PreviousOutPoint.Hash] < heights[b.PreviousOutPoint.Hash]
+		})
+		return prefixInputSource(all), nil
+
+	case OutputSelectionAlgorithmBranchAndBound:
+		if t == nil {
+			return nil, errors.E(errors.Invalid,
+				"branch-and-bound selection requires a target transaction")
+		}
+		return branchAndBoundInputSource(all, t), nil
+
+	case OutputSelectionAlgorithmAvoidMixing:
+		return w.avoidMixingInputSource(dbtx, all)
+
+	default:
+		return nil, errors.E(errors.Invalid,
+			errors.Errorf("unknown output selection algorithm %d", algo))
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/wallet/v3/internal/txsizes"
+	"github.com/decred/dcrwallet/wallet/v3/txauthor"
+)
+
+func testInputDetail(values ...int64) *txauthor.InputDetail {
+	d := new(txauthor.InputDetail)
+	for i, v := range values {
+		hash := chainhash.HashH([]byte{byte(i)})
+		d.Amount += dcrutil.Amount(v)
+		d.Inputs = append(d.Inputs, wire.NewTxIn(wire.NewOutPoint(&hash, 0, 0), v, nil))
+		d.Scripts = append(d.Scripts, nil)
+		d.RedeemScriptSizes = append(d.RedeemScriptSizes, txsizes.RedeemP2PKHSigScriptSize)
+	}
+	return d
+}
+
+func inputValues(d *txauthor.InputDetail) []int64 {
+	values := make([]int64, len(d.Inputs))
+	for i, in := range d.Inputs {
+		values[i] = in.ValueIn
+	}
+	return values
+}
+
+func equalValues(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestSortedInputSources(t *testing.T) {
+	tests := []struct {
+		name   string
+		less   func(a, b *wire.TxIn) bool
+		target dcrutil.Amount
+		want   []int64
+	}{
+		{"largest first", largestFirst, 9, []int64{8, 5}},
+		{"smallest first", smallestFirst, 9, []int64{1, 2, 5, 8}},
+		{"largest first exceeds total", largestFirst, 100, []int64{8, 5, 2, 1}},
+	}
+	for _, test := range tests {
+		all := testInputDetail(5, 1, 8, 2)
+		sortInputs(all, test.less)
+		selected, err := prefixInputSource(all)(test.target)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := inputValues(selected); !equalValues(got, test.want) {
+			t.Errorf("%s: selected %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestBranchAndBound(t *testing.T) {
+	tests := []struct {
+		values       []int64
+		lower, upper int64
+		want         []int64 // nil if no solution
+	}{
+		{[]int64{10, 7, 5, 3, 1}, 8, 8, []int64{7, 1}},
+		{[]int64{10, 7, 5, 3, 1}, 15, 15, []int64{10, 5}},
+		{[]int64{10, 7, 5, 3, 1}, 2, 2, nil},
+		{[]int64{4, 4, 4, 4}, 12, 13, []int64{4, 4, 4}},
+		{[]int64{10, 7}, 18, 20, nil},
+	}
+	for i, test := range tests {
+		sel := branchAndBound(test.values, test.lower, test.upper,
+			func([]int) bool { return true })
+		if test.want == nil {
+			if sel != nil {
+				t.Errorf("test %d: unexpected solution %v", i, sel)
+			}
+			continue
+		}
+		got := make([]int64, len(sel))
+		for j, idx := range sel {
+			got[j] = test.values[idx]
+		}
+		if !equalValues(got, test.want) {
+			t.Errorf("test %d: selected %v, want %v", i, got, test.want)
+		}
+	}
+}
+
+func TestChangelessInputs(t *testing.T) {
+	const relayFee = 1e4
+	outputs := []*wire.TxOut{{Value: 3e8, PkScript: make([]byte, txsizes.P2PKHPkScriptSize)}}
+	target := &changelessTarget{
+		amount:           3e8,
+		outputs:          outputs,
+		relayFeePerKb:    relayFee,
+		fees:             true,
+		changeScriptSize: txsizes.P2PKHPkScriptSize,
+	}
+
+	// Two inputs paying exactly for the output and the fee of spending
+	// them, and decoys which would all require change.
+	fee := int64(target.fee([]int{txsizes.RedeemP2PKHSigScriptSize, txsizes.RedeemP2PKHSigScriptSize}))
+	all := testInputDetail(4e8, 2e8, 1e8+fee, 5e8, 7e7)
+	sortInputs(all, largestFirst)
+	selected := changelessInputs(all, target)
+	if selected == nil {
+		t.Fatal("no changeless solution found")
+	}
+	if !target.changeless(selected.Amount, selected.RedeemScriptSizes) {
+		t.Fatalf("selected inputs %v require change", inputValues(selected))
+	}
+	if selected.Amount != 3e8+dcrutil.Amount(fee) {
+		t.Fatalf("selected inputs %v do not pay the exact amount", inputValues(selected))
+	}
+
+	// The branch-and-bound source falls back to largest-first when no
+	// changeless solution exists.
+	all = testInputDetail(1e8, 4e8, 2e8)
+	source := branchAndBoundInputSource(all, target)
+	detail, err := source(3e8 + 1e5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := inputValues(detail); !equalValues(got, []int64{4e8}) {
+		t.Fatalf("fallback selected %v", got)
+	}
+}
+
+func TestIsMixedOutput(t *testing.T) {
+	denom := int64(splitPoints[3])
+	tx := &wire.MsgTx{TxOut: []*wire.TxOut{
+		{Value: denom}, {Value: 12345}, {Value: denom}, {Value: 12345},
+	}}
+	if !isMixedOutput(tx, 0) {
+		t.Error("mixed output was not recognized")
+	}
+	if isMixedOutput(tx, 1) {
+		t.Error("non-denomination output recognized as mixed")
+	}
+	tx.TxOut = tx.TxOut[:2]
+	if isMixedOutput(tx, 0) {
+		t.Error("unique denomination output recognized as mixed")
+	}
+}
@@ -78,6 +78,32 @@ const (
 	// OutputSelectionAlgorithmAll describes the output selection algorithm of
 	// picking every possible available output.  This is useful for sweeping.
 	OutputSelectionAlgorithmAll
+
+	// OutputSelectionAlgorithmLargestFirst describes the output selection
+	// algorithm of picking the largest outputs first.  This minimizes the
+	// number of inputs and the transaction fee.
+	OutputSelectionAlgorithmLargestFirst
+
+	// OutputSelectionAlgorithmSmallestFirst describes the output selection
+	// algorithm of picking the smallest outputs first.  This is useful for
+	// consolidating many small outputs at times of low fees.
+	OutputSelectionAlgorithmSmallestFirst
+
+	// OutputSelectionAlgorithmOldestFirst describes the output selection
+	// algorithm of picking the outputs mined in the earliest blocks first.
+	OutputSelectionAlgorithmOldestFirst
+
+	// OutputSelectionAlgorithmBranchAndBound describes the output selection
+	// algorithm of searching for a set of outputs which pays for the
+	// transaction and its fee without requiring a change output.  If no
+	// such set exists, the largest outputs are picked first.
+	OutputSelectionAlgorithmBranchAndBound
+
+	// OutputSelectionAlgorithmAvoidMixing describes the output selection
+	// algorithm of never spending CoinShuffle++ mixed outputs together with
+	// unmixed outputs, which would link the mixed outputs to the wallet's
+	// other transactions.  Unmixed outputs are preferred.
+	OutputSelectionAlgorithmAvoidMixing
 )
 
 // NewUnsignedTransaction constructs an unsigned transaction using unspent
@@ -124,27 +150,6 @@ func (w *Wallet) NewUnsignedTransaction(ctx context.Context, outputs []*wire.TxO
 			}
 		}
 
-		sourceImpl := w.TxStore.MakeIgnoredInputSource(txmgrNs, addrmgrNs, account,
-			minConf, tipHeight, ignoreInput)
-		var inputSource txauthor.InputSource
-		switch algo {
-		case OutputSelectionAlgorithmDefault:
-			inputSource = sourceImpl.SelectInputs
-		case OutputSelectionAlgorithmAll:
-			// Wrap the source with one that always fetches the max amount
-			// available and ignores insufficient balance issues.
-			inputSource = func(dcrutil.Amount) (*txauthor.InputDetail, error) {
-				inputDetail, err := sourceImpl.SelectInputs(dcrutil.MaxAmount)
-				if errors.Is(err, errors.InsufficientBalance) {
-					err = nil
-				}
-				return inputDetail, err
-			}
-		default:
-			return errors.E(errors.Invalid,
-				errors.Errorf("unknown output selection algorithm %v", algo))
-		}
-
 		if changeSource == nil {
 			changeSource = &p2PKHChangeSource{
 				persist: w.deferPersistReturnedChild(ctx, &changeSourceUpdates),
@@ -157,7 +162,20 @@ func (w *Wallet) NewUnsignedTransaction(ctx context.Context, outputs []*wire.TxO
 		defer w.lockedOutpointMu.Unlock()
 		w.lockedOutpointMu.Lock()
 
-		var err error
+		sourceImpl := w.TxStore.MakeIgnoredInputSource(txmgrNs, addrmgrNs, account,
+			minConf, tipHeight, ignoreInput)
+		inputSource, err := w.selectionInputSource(dbtx, sourceImpl.SelectInputs,
+			algo, &changelessTarget{
+				amount:           sumOutputValues(outputs),
+				outputs:          outputs,
+				relayFeePerKb:    relayFeePerKb,
+				fees:             true,
+				changeScriptSize: changeSource.ScriptSize(),
+			})
+		if err != nil {
+			return err
+		}
+
 		authoredTx, err = txauthor.NewUnsignedTransaction(outputs, relayFeePerKb,
 			inputSource, changeSource)
 		if err != nil {
@@ -315,13 +333,14 @@ func (w *Wallet) checkHighFees(totalInput dcrutil.Amount, tx *wire.MsgTx) error
 // account's UTXO set and minconf policy. An additional output may be added to
 // return change to the wallet.  An appropriate fee is included based on the
 // wallet's current relay fee.  The wallet must be unlocked to create the
-// transaction.
+// transaction.  Transactions violating the spending policy of the account are
+// rejected with a Policy error.
 //
 // Decred: This func also sends the transaction, and if successful, inserts it
 // into the database, rather than delegating this work to the caller as
 // btcwallet does.
 func (w *Wallet) txToOutputs(ctx context.Context, op errors.Op, outputs []*wire.TxOut, account, changeAccount uint32, minconf int32,
-	n NetworkBackend, randomizeChangeIdx bool, txFee dcrutil.Amount) (*txauthor.AuthoredTx, error) {
+	n NetworkBackend, randomizeChangeIdx bool, txFee dcrutil.Amount, algo OutputSelectionAlgorithm) (*txauthor.AuthoredTx, error) {
 
 	if n == nil {
 		var err error
@@ -348,6 +367,7 @@ func (w *Wallet) txToOutputs(ctx context.Context, op errors.Op, outputs []*wire.
 
 	var atx *txauthor.AuthoredTx
 	var changeSourceUpdates []func(walletdb.ReadWriteTx) error
+	signer := w.watchingOnlySigner()
 	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
 		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
 		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
@@ -358,7 +378,7 @@ func (w *Wallet) txToOutputs(ctx context.Context, op errors.Op, outputs []*wire.
 
 		// Create the unsigned transaction.
 		_, tipHeight := w.TxStore.MainChainTip(txmgrNs)
-		inputSource := w.TxStore.MakeIgnoredInputSource(txmgrNs, addrmgrNs, account,
+		sourceImpl := w.TxStore.MakeIgnoredInputSource(txmgrNs, addrmgrNs, account,
 			minconf, tipHeight, ignoreInput)
 		changeSource := &p2PKHChangeSource{
 			persist: w.deferPersistReturnedChild(ctx, &changeSourceUpdates),
@@ -366,9 +386,19 @@ func (w *Wallet) txToOutputs(ctx context.Context, op errors.Op, outputs []*wire.
 			wallet:  w,
 			ctx:     ctx,
 		}
-		var err error
+		inputSource, err := w.selectionInputSource(dbtx, sourceImpl.SelectInputs,
+			algo, &changelessTarget{
+				amount:           sumOutputValues(outputs),
+				outputs:          outputs,
+				relayFeePerKb:    txFee,
+				fees:             true,
+				changeScriptSize: changeSource.ScriptSize(),
+			})
+		if err != nil {
+			return err
+		}
 		atx, err = txauthor.NewUnsignedTransaction(outputs, txFee,
-			inputSource.SelectInputs, changeSource)
+			inputSource, changeSource)
 		if err != nil {
 			return err
 		}
@@ -385,7 +415,11 @@ func (w *Wallet) txToOutputs(ctx context.Context, op errors.Op, outputs []*wire.
 			atx.RandomizeChangePosition()
 		}
 
-		// Sign the transaction
+		// Sign the transaction.  Transactions of watching-only wallets
+		// are signed by the external signer after the view is closed.
+		if signer != nil {
+			return nil
+		}
 		secrets := &secretSource{Manager: w.Manager, addrmgrNs: addrmgrNs}
 		err = atx.AddAllInputScripts(secrets)
 		for _, done := range secrets.doneFuncs {
@@ -396,6 +430,12 @@ func (w *Wallet) txToOutputs(ctx context.Context, op errors.Op, outputs []*wire.
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
+	if signer != nil {
+		err = w.signExternal(ctx, signer, atx.Tx, atx.PrevScripts)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
 
 	// Ensure valid signatures were created.
 	err = validateMsgTx(op, atx.Tx, atx.PrevScripts)
@@ -416,7 +456,23 @@ func (w *Wallet) txToOutputs(ctx context.Context, op errors.Op, outputs []*wire.
 		return nil, errors.E(op, err)
 	}
 
-	rec, err := udb.NewTxRecordFromMsgTx(atx.Tx, time.Now())
+	// Enforce the spending policy of the account.  The policy lock is held
+	// until the spend is recorded so concurrent spends can not exceed the
+	// spending limits.
+	w.spendPolicyMu.Lock()
+	defer w.spendPolicyMu.Unlock()
+	now := time.Now()
+	var spends []policySpend
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		spends, err = w.checkSpendPolicy(ctx, dbtx, atx.Tx, atx.PrevScripts, atx.ChangeIndex, now)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	rec, err := udb.NewTxRecordFromMsgTx(atx.Tx, now)
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
@@ -451,6 +507,10 @@ func (w *Wallet) txToOutputs(ctx context.Context, op errors.Op, outputs []*wire.
 		}
 		return nil, errors.E(op, err)
 	}
+	err = w.recordPolicySpends(ctx, &rec.Hash, spends, now)
+	if err != nil {
+		log.Errorf("Failed to record spend of transaction %v: %v", &rec.Hash, err)
+	}
 
 	// Watch for future relevant transactions.
 	_, err = w.watchHDAddrs(ctx, false, n)
@@ -472,31 +532,48 @@ func (w *Wallet) txToOutputs(ctx context.Context, op errors.Op, outputs []*wire.
 func (w *Wallet) txToMultisig(ctx context.Context, op errors.Op, account uint32, amount dcrutil.Amount, pubkeys []*dcrutil.AddressSecpPubKey,
 	nRequired int8, minconf int32) (*CreatedTx, dcrutil.Address, []byte, error) {
 
+	// The spending policy lock is held until the spend is recorded so
+	// concurrent spends can not exceed the spending limits.
+	w.spendPolicyMu.Lock()
+	defer w.spendPolicyMu.Unlock()
+	now := time.Now()
+
 	var (
 		created  *CreatedTx
 		addr     dcrutil.Address
 		msScript []byte
+		spends   []policySpend
 	)
 	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
 		var err error
-		created, addr, msScript, err = w.txToMultisigInternal(ctx, op, dbtx,
-			account, amount, pubkeys, nRequired, minconf)
+		created, addr, msScript, spends, err = w.txToMultisigInternal(ctx, op, dbtx,
+			account, amount, pubkeys, nRequired, minconf, now)
 		return err
 	})
 	if err != nil {
 		return nil, nil, nil, errors.E(op, err)
 	}
+	hash := created.MsgTx.TxHash()
+	err = w.recordPolicySpends(ctx, &hash, spends, now)
+	if err != nil {
+		log.Errorf("Failed to record spend of transaction %v: %v", &hash, err)
+	}
 	return created, addr, msScript, nil
 }
 
+// txToMultisigInternal creates and publishes the multisig transaction.  The
+// spending policy is checked before publishing and the spends to record are
+// returned.
+//
+// This method must be called with the spendPolicyMu held.
 func (w *Wallet) txToMultisigInternal(ctx context.Context, op errors.Op, dbtx walletdb.ReadWriteTx, account uint32, amount dcrutil.Amount,
-	pubkeys []*dcrutil.AddressSecpPubKey, nRequired int8, minconf int32) (*CreatedTx, dcrutil.Address, []byte, error) {
+	pubkeys []*dcrutil.AddressSecpPubKey, nRequired int8, minconf int32, now time.Time) (*CreatedTx, dcrutil.Address, []byte, []policySpend, error) {
 
 	addrmgrNs := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
 	txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
 
-	txToMultisigError := func(err error) (*CreatedTx, dcrutil.Address, []byte, error) {
-		return nil, nil, nil, err
+	txToMultisigError := func(err error) (*CreatedTx, dcrutil.Address, []byte, []policySpend, error) {
+		return nil, nil, nil, nil, err
 	}
 
 	n, err := w.NetworkBackend()
@@ -615,6 +692,18 @@ func (w *Wallet) txToMultisigInternal(ctx context.Context, op errors.Op, dbtx wa
 		return txToMultisigError(errors.E(op, err))
 	}
 
+	// Enforce the spending policy of the account.  The multisig script
+	// recorded above is an imported script and the multisig output is
+	// checked as an external output.
+	changeIndex := -1
+	if len(msgtx.TxOut) > 1 {
+		changeIndex = len(msgtx.TxOut) - 1
+	}
+	spends, err := w.checkSpendPolicy(ctx, dbtx, msgtx, nil, changeIndex, now)
+	if err != nil {
+		return txToMultisigError(errors.E(op, err))
+	}
+
 	err = n.PublishTransactions(ctx, msgtx)
 	if err != nil {
 		return txToMultisigError(errors.E(op, err))
@@ -638,7 +727,7 @@ func (w *Wallet) txToMultisigInternal(ctx context.Context, op errors.Op, dbtx wa
 		ChangeIndex: -1,
 	}
 
-	return created, scAddr, msScript, nil
+	return created, scAddr, msScript, spends, nil
 }
 
 // validateMsgTx verifies transaction input scripts for tx.  All previous output
@@ -940,7 +1029,7 @@ func (w *Wallet) mixedSplit(ctx context.Context, req *PurchaseTicketsRequest, ne
 	}
 	relayFee := req.txFee
 	if relayFee == 0 {
-		relayFee = w.RelayFee()
+		relayFee = w.txFeeRate()
 	}
 	var changeSourceUpdates []func(walletdb.ReadWriteTx) error
 	defer func() {
@@ -1081,10 +1170,10 @@ func (w *Wallet) individualSplit(ctx context.Context, req *PurchaseTicketsReques
 
 	txFeeIncrement := req.txFee
 	if txFeeIncrement == 0 {
-		txFeeIncrement = w.RelayFee()
+		txFeeIncrement = w.txFeeRate()
 	}
 	splitTx, err := w.txToOutputs(ctx, "", splitOuts, req.SourceAccount, req.ChangeAccount, req.MinConf,
-		nil, false, txFeeIncrement)
+		nil, false, txFeeIncrement, OutputSelectionAlgorithmDefault)
 	if err != nil {
 		return
 	}
@@ -1131,10 +1220,10 @@ func (w *Wallet) vspSplit(ctx context.Context, req *PurchaseTicketsRequest, need
 
 	txFeeIncrement := req.txFee
 	if txFeeIncrement == 0 {
-		txFeeIncrement = w.RelayFee()
+		txFeeIncrement = w.txFeeRate()
 	}
 	splitTx, err := w.txToOutputs(ctx, "", splitOuts, req.SourceAccount, req.ChangeAccount, req.MinConf,
-		nil, false, txFeeIncrement)
+		nil, false, txFeeIncrement, OutputSelectionAlgorithmDefault)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,356 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package descriptor parses output script descriptors and derives the output
+// scripts they describe.
+//
+// The following descriptors are supported:
+//
+//	pkh(KEY)                    P2PKH output paying to KEY
+//	multi(k,KEY,...)            bare k-of-n multisig output
+//	sortedmulti(k,KEY,...)      bare k-of-n multisig output with sorted keys
+//	sh(multi(k,KEY,...))        P2SH output of a multisig redeem script
+//	sh(sortedmulti(k,KEY,...))  P2SH output of a sorted multisig redeem script
+//
+// KEY is either a hex-encoded compressed secp256k1 public key, or an extended
+// public key followed by any number of non-hardened /NUM derivation steps and
+// optionally ending in /*.  Descriptors with keys ending in /* are ranged and
+// describe a different output script for every child index.  Sorted multisig
+// scripts order their public keys lexicographically (as in BIP0067).
+package descriptor
+
+import (
+	"bytes"
+	"encoding/hex"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrec/secp256k1/v2"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/hdkeychain/v2"
+	"github.com/decred/dcrd/txscript/v2"
+	"github.com/decred/dcrwallet/errors/v2"
+)
+
+// Type describes the output script template of a descriptor.
+type Type int
+
+// Descriptor types.
+const (
+	PKH Type = iota
+	Multi
+	SortedMulti
+	SHMulti
+	SHSortedMulti
+)
+
+// Key is a public key of a descriptor.
+type Key struct {
+	// PubKey is set for keys which are not derived from an extended key.
+	PubKey []byte
+
+	// Xpub is set for extended public keys, and Path describes the
+	// derivation steps from it.  Ranged keys are additionally derived by
+	// the child index.
+	Xpub   *hdkeychain.ExtendedKey
+	Path   []uint32
+	Ranged bool
+}
+
+// String returns the descriptor encoding of the key.
+func (k *Key) String() string {
+	if k.Xpub == nil {
+		return hex.EncodeToString(k.PubKey)
+	}
+	var b strings.Builder
+	b.WriteString(k.Xpub.String())
+	for _, step := range k.Path {
+		b.WriteByte('/')
+		b.WriteString(strconv.FormatUint(uint64(step), 10))
+	}
+	if k.Ranged {
+		b.WriteString("/*")
+	}
+	return b.String()
+}
+
+// derive returns the serialized compressed public key of the key at a child
+// index.  The index is ignored for keys which are not ranged.
+func (k *Key) derive(index uint32) ([]byte, error) {
+	if k.Xpub == nil {
+		return k.PubKey, nil
+	}
+	xpub := k.Xpub
+	var err error
+	for _, step := range k.Path {
+		xpub, err = xpub.Child(step)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if k.Ranged {
+		if index >= hdkeychain.HardenedKeyStart {
+			return nil, errors.E(errors.Invalid, errors.Errorf("child index %d is hardened", index))
+		}
+		xpub, err = xpub.Child(index)
+		if err != nil {
+			return nil, err
+		}
+	}
+	pubKey, err := xpub.ECPubKey()
+	if err != nil {
+		return nil, err
+	}
+	return pubKey.SerializeCompressed(), nil
+}
+
+// Descriptor describes an output script, or a range of output scripts when
+// any key is ranged.
+type Descriptor struct {
+	Type      Type
+	Threshold int // Number of required signatures of multisig descriptors
+	Keys      []Key
+
+	params *chaincfg.Params
+}
+
+// String returns the canonical encoding of the descriptor.
+func (d *Descriptor) String() string {
+	keys := make([]string, len(d.Keys))
+	for i := range d.Keys {
+		keys[i] = d.Keys[i].String()
+	}
+	multi := func(name string) string {
+		return name + "(" + strconv.Itoa(d.Threshold) + "," + strings.Join(keys, ",") + ")"
+	}
+	switch d.Type {
+	case PKH:
+		return "pkh(" + keys[0] + ")"
+	case Multi:
+		return multi("multi")
+	case SortedMulti:
+		return multi("sortedmulti")
+	case SHMulti:
+		return "sh(" + multi("multi") + ")"
+	case SHSortedMulti:
+		return "sh(" + multi("sortedmulti") + ")"
+	default:
+		return "unknown"
+	}
+}
+
+// IsRange returns whether the descriptor describes a different output script
+// for every child index.
+func (d *Descriptor) IsRange() bool {
+	for i := range d.Keys {
+		if d.Keys[i].Ranged {
+			return true
+		}
+	}
+	return false
+}
+
+// HasAddress returns whether the outputs of the descriptor are paid to by an
+// address.  Bare multisig outputs have no address.
+func (d *Descriptor) HasAddress() bool {
+	return d.Type != Multi && d.Type != SortedMulti
+}
+
+// Output is an output script derived from a descriptor.
+type Output struct {
+	// Script is the version 0 output script.
+	Script []byte
+
+	// Address is the address paid by the output script, or nil for bare
+	// multisig outputs.
+	Address dcrutil.Address
+
+	// RedeemScript is the redeem script of P2SH outputs.
+	RedeemScript []byte
+
+	// PubKeys are the serialized compressed public keys of the script, in
+	// script order.
+	PubKeys [][]byte
+}
+
+// Derive returns the output script of the descriptor at a child index.  The
+// index is ignored if the descriptor is not ranged.
+func (d *Descriptor) Derive(index uint32) (*Output, error) {
+	const op errors.Op = "descriptor.Derive"
+	pubKeys := make([][]byte, len(d.Keys))
+	for i := range d.Keys {
+		pubKey, err := d.Keys[i].derive(index)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		pubKeys[i] = pubKey
+	}
+
+	if d.Type == PKH {
+		addr, err := dcrutil.NewAddressPubKeyHash(dcrutil.Hash160(pubKeys[0]),
+			d.params, dcrec.STEcdsaSecp256k1)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		script, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		return &Output{Script: script, Address: addr, PubKeys: pubKeys}, nil
+	}
+
+	if d.Type == SortedMulti || d.Type == SHSortedMulti {
+		sort.Slice(pubKeys, func(i, j int) bool {
+			return bytes.Compare(pubKeys[i], pubKeys[j]) < 0
+		})
+	}
+	addrs := make([]*dcrutil.AddressSecpPubKey, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		addr, err := dcrutil.NewAddressSecpPubKey(pubKey, d.params)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		addrs[i] = addr
+	}
+	multisig, err := txscript.MultiSigScript(addrs, d.Threshold)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if d.Type == Multi || d.Type == SortedMulti {
+		return &Output{Script: multisig, PubKeys: pubKeys}, nil
+	}
+	addr, err := dcrutil.NewAddressScriptHash(multisig, d.params)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	script, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return &Output{Script: script, Address: addr, RedeemScript: multisig, PubKeys: pubKeys}, nil
+}
+
+// Parse parses a descriptor.  Extended keys must be public keys for the
+// network described by params.
+func Parse(s string, params *chaincfg.Params) (*Descriptor, error) {
+	const op errors.Op = "descriptor.Parse"
+	d := &Descriptor{params: params}
+	s = strings.TrimSpace(s)
+	inner, ok := unwrap(s, "sh")
+	if ok {
+		s = inner
+	}
+	switch {
+	case !ok && strings.HasPrefix(s, "pkh("):
+		keys, ok := unwrap(s, "pkh")
+		if !ok || strings.Contains(keys, ",") {
+			return nil, errors.E(op, errors.Encoding, "pkh descriptor requires a single key")
+		}
+		d.Type = PKH
+		k, err := parseKey(keys, params)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		d.Keys = []Key{*k}
+		return d, nil
+	case strings.HasPrefix(s, "multi("):
+		d.Type = Multi
+	case strings.HasPrefix(s, "sortedmulti("):
+		d.Type = SortedMulti
+	default:
+		return nil, errors.E(op, errors.Encoding, errors.Errorf("unsupported descriptor %q", s))
+	}
+	name := "multi"
+	if d.Type == SortedMulti {
+		name = "sortedmulti"
+	}
+	if ok {
+		d.Type += SHMulti - Multi
+	}
+
+	args, ok := unwrap(s, name)
+	if !ok {
+		return nil, errors.E(op, errors.Encoding, errors.Errorf("malformed descriptor %q", s))
+	}
+	fields := strings.Split(args, ",")
+	if len(fields) < 2 {
+		return nil, errors.E(op, errors.Encoding, "multisig descriptor requires a threshold and keys")
+	}
+	threshold, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, errors.Errorf("invalid threshold %q", fields[0]))
+	}
+	n := len(fields) - 1
+	if threshold < 1 || threshold > n || n > txscript.MaxPubKeysPerMultiSig {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("invalid %d-of-%d multisig", threshold, n))
+	}
+	d.Threshold = threshold
+	d.Keys = make([]Key, n)
+	for i, f := range fields[1:] {
+		k, err := parseKey(f, params)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		d.Keys[i] = *k
+	}
+	if d.HasAddress() {
+		// Check the redeem script size using any child index.
+		out, err := d.Derive(0)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		if len(out.RedeemScript) > txscript.MaxScriptElementSize {
+			return nil, errors.E(op, errors.Invalid, "redeem script is too large")
+		}
+	}
+	return d, nil
+}
+
+// unwrap returns the arguments of the function call name(args).
+func unwrap(s, name string) (string, bool) {
+	if !strings.HasPrefix(s, name+"(") || !strings.HasSuffix(s, ")") {
+		return "", false
+	}
+	return s[len(name)+1 : len(s)-1], true
+}
+
+func parseKey(s string, params *chaincfg.Params) (*Key, error) {
+	s = strings.TrimSpace(s)
+	parts := strings.Split(s, "/")
+	if len(parts) == 1 && len(s) == 2*secp256k1.PubKeyBytesLenCompressed {
+		pubKey, err := hex.DecodeString(s)
+		if err != nil {
+			return nil, errors.E(errors.Encoding, err)
+		}
+		if _, err := secp256k1.ParsePubKey(pubKey); err != nil {
+			return nil, errors.E(errors.Encoding, err)
+		}
+		return &Key{PubKey: pubKey}, nil
+	}
+
+	xpub, err := hdkeychain.NewKeyFromString(parts[0], params)
+	if err != nil {
+		return nil, errors.E(errors.Encoding, errors.Errorf("invalid key %q: %v", parts[0], err))
+	}
+	if xpub.IsPrivate() {
+		return nil, errors.E(errors.Invalid, "descriptor keys must be public")
+	}
+	k := &Key{Xpub: xpub}
+	for i, step := range parts[1:] {
+		if step == "*" && i == len(parts)-2 {
+			k.Ranged = true
+			break
+		}
+		n, err := strconv.ParseUint(step, 10, 32)
+		if err != nil || n >= hdkeychain.HardenedKeyStart {
+			return nil, errors.E(errors.Encoding, errors.Errorf("invalid "+
+				"non-hardened derivation step %q", step))
+		}
+		k.Path = append(k.Path, uint32(n))
+	}
+	return k, nil
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package descriptor
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/hdkeychain/v2"
+	"github.com/decred/dcrd/txscript/v2"
+	"github.com/decred/dcrwallet/errors/v2"
+)
+
+var params = chaincfg.MainNetParams()
+
+func testXprv(t *testing.T, b byte) *hdkeychain.ExtendedKey {
+	t.Helper()
+	xprv, err := hdkeychain.NewMaster(bytes.Repeat([]byte{b}, 32), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return xprv
+}
+
+func testXpub(t *testing.T, b byte) *hdkeychain.ExtendedKey {
+	t.Helper()
+	xpub, err := testXprv(t, b).Neuter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return xpub
+}
+
+func childPubKey(t *testing.T, xpub *hdkeychain.ExtendedKey, path ...uint32) []byte {
+	t.Helper()
+	var err error
+	for _, i := range path {
+		xpub, err = xpub.Child(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	pub, err := xpub.ECPubKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pub.SerializeCompressed()
+}
+
+func TestParseRoundTrip(t *testing.T) {
+	a, b := testXpub(t, 1).String(), testXpub(t, 2).String()
+	pk := hex.EncodeToString(childPubKey(t, testXpub(t, 3), 0))
+	tests := []string{
+		"pkh(" + a + "/0/*)",
+		"pkh(" + pk + ")",
+		"multi(1," + a + "/*," + pk + ")",
+		"sortedmulti(2," + a + "/*," + b + "/*)",
+		"sh(multi(2," + a + "/0/*," + b + "/0/*," + pk + "))",
+		"sh(sortedmulti(1," + a + "/1/2/*," + b + "))",
+	}
+	for _, s := range tests {
+		d, err := Parse(s, params)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", s, err)
+			continue
+		}
+		if d.String() != s {
+			t.Errorf("round trip mismatch:\n got %s\nwant %s", d.String(), s)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	a := testXpub(t, 1).String()
+	tests := []struct {
+		desc string
+		kind errors.Kind
+	}{
+		{"wpkh(" + a + "/*)", errors.Encoding},
+		{"pkh(" + a + "/*," + a + "/*)", errors.Encoding},
+		{"pkh(" + a + "/0'/*)", errors.Encoding},
+		{"pkh(" + a + "/*/0)", errors.Encoding},
+		{"pkh(" + testXprv(t, 1).String() + "/*)", errors.Invalid},
+		{"pkh(" + testXpub(t, 1).String()[:20] + ")", errors.Encoding},
+		{"sh(pkh(" + a + "/*))", errors.Encoding},
+		{"multi(3," + a + "/*," + a + "/0/*)", errors.Invalid},
+		{"multi(0," + a + "/*)", errors.Invalid},
+		{"multi(x," + a + "/*)", errors.Encoding},
+	}
+	for _, test := range tests {
+		_, err := Parse(test.desc, params)
+		if !errors.Is(err, test.kind) {
+			t.Errorf("Parse(%q): expected error kind %v, got %v", test.desc, test.kind, err)
+		}
+	}
+
+	// Keys for other networks are rejected.
+	testnetXprv, err := hdkeychain.NewMaster(bytes.Repeat([]byte{1}, 32), chaincfg.TestNet3Params())
+	if err != nil {
+		t.Fatal(err)
+	}
+	testnetXpub, err := testnetXprv.Neuter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = Parse("pkh("+testnetXpub.String()+"/*)", params)
+	if err == nil {
+		t.Errorf("Parse accepted key for wrong network")
+	}
+}
+
+func TestDerive(t *testing.T) {
+	xpubA, xpubB := testXpub(t, 1), testXpub(t, 2)
+	a, b := xpubA.String(), xpubB.String()
+
+	d, err := Parse("pkh("+a+"/0/*)", params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !d.IsRange() || !d.HasAddress() {
+		t.Fatalf("pkh descriptor should be ranged with addresses")
+	}
+	out, err := d.Derive(5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub := childPubKey(t, xpubA, 0, 5)
+	wantAddr, err := dcrutil.NewAddressPubKeyHash(dcrutil.Hash160(pub), params, dcrec.STEcdsaSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.Address.Address() != wantAddr.Address() {
+		t.Errorf("pkh address: got %v want %v", out.Address, wantAddr)
+	}
+	wantScript, _ := txscript.PayToAddrScript(wantAddr)
+	if !bytes.Equal(out.Script, wantScript) {
+		t.Errorf("pkh script mismatch")
+	}
+
+	// Sorted multisig keys are sorted regardless of descriptor key order.
+	d1, err := Parse("sh(sortedmulti(2,"+a+"/*,"+b+"/*))", params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d2, err := Parse("sh(sortedmulti(2,"+b+"/*,"+a+"/*))", params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := uint32(0); i < 10; i++ {
+		o1, err := d1.Derive(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		o2, err := d2.Derive(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(o1.RedeemScript, o2.RedeemScript) {
+			t.Errorf("index %d: sorted multisig redeem scripts differ", i)
+		}
+		if bytes.Compare(o1.PubKeys[0], o1.PubKeys[1]) >= 0 {
+			t.Errorf("index %d: pubkeys are not sorted", i)
+		}
+		p2sh, err := dcrutil.NewAddressScriptHash(o1.RedeemScript, params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if o1.Address.Address() != p2sh.Address() {
+			t.Errorf("index %d: P2SH address mismatch", i)
+		}
+	}
+
+	// Unsorted multisig keeps descriptor key order.
+	d, err = Parse("multi(1,"+a+"/*,"+b+"/*)", params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err = d.Derive(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.Address != nil || out.RedeemScript != nil {
+		t.Errorf("bare multisig should have no address or redeem script")
+	}
+	if !bytes.Equal(out.PubKeys[0], childPubKey(t, xpubA, 3)) ||
+		!bytes.Equal(out.PubKeys[1], childPubKey(t, xpubB, 3)) {
+		t.Errorf("multisig pubkeys are not in descriptor order")
+	}
+	if txscript.GetScriptClass(0, out.Script) != txscript.MultiSigTy {
+		t.Errorf("bare multisig script has wrong class")
+	}
+
+	if _, err := d.Derive(hdkeychain.HardenedKeyStart); !errors.Is(err, errors.Invalid) {
+		t.Errorf("deriving hardened index: expected Invalid, got %v", err)
+	}
+}
@@ -0,0 +1,362 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/hdkeychain/v2"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3/descriptor"
+	"github.com/decred/dcrwallet/wallet/v3/udb"
+	"github.com/decred/dcrwallet/wallet/v3/walletdb"
+)
+
+// ImportDescriptorAccount creates a watching-only account which derives its
+// addresses from a ranged output script descriptor, such as
+// sh(sortedmulti(2,xpubA/0/*,xpubB/0/*,xpubC/0/*)) for an HD multisig vault.
+// The descriptor must describe P2PKH or P2SH outputs.  Addresses up to the gap
+// limit are recorded immediately and, if the wallet is associated with a
+// network backend, added to the transaction filter.  A rescan is required to
+// discover previous transactions paying to the account.
+func (w *Wallet) ImportDescriptorAccount(ctx context.Context, name, desc string) (uint32, error) {
+	const op errors.Op = "wallet.ImportDescriptorAccount"
+	d, err := descriptor.Parse(desc, w.chainParams)
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	if !d.IsRange() {
+		return 0, errors.E(op, errors.Invalid, "descriptor accounts require a ranged descriptor")
+	}
+	if !d.HasAddress() {
+		return 0, errors.E(op, errors.Invalid, "descriptor accounts require P2PKH or P2SH outputs")
+	}
+
+	var account uint32
+	var addrs []dcrutil.Address
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		var err error
+		account, err = w.Manager.NewDescriptorAccount(ns, name, d.String())
+		if err != nil {
+			return err
+		}
+		addrs, err = w.recordDescriptorAddresses(dbtx, account, d, uint32(w.gapLimit)-1)
+		return err
+	})
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+
+	if n, err := w.NetworkBackend(); err == nil {
+		err = n.LoadTxFilter(ctx, false, addrs, nil)
+		if err != nil {
+			return 0, errors.E(op, err)
+		}
+		w.addressBuffersMu.Lock()
+		w.descriptorWatched[account] = uint32(len(addrs))
+		w.addressBuffersMu.Unlock()
+	}
+
+	return account, nil
+}
+
+// DescriptorAccount returns the properties of a descriptor account.
+func (w *Wallet) DescriptorAccount(ctx context.Context, account uint32) (*udb.DescriptorAccountProperties, error) {
+	const op errors.Op = "wallet.DescriptorAccount"
+	var props *udb.DescriptorAccountProperties
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		props, err = w.Manager.DescriptorAccount(dbtx.ReadBucket(waddrmgrNamespaceKey), account)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return props, nil
+}
+
+// DescriptorAccounts returns the properties of all descriptor accounts.
+func (w *Wallet) DescriptorAccounts(ctx context.Context) ([]*udb.DescriptorAccountProperties, error) {
+	const op errors.Op = "wallet.DescriptorAccounts"
+	var accounts []*udb.DescriptorAccountProperties
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		return w.Manager.ForEachDescriptorAccount(ns, func(account uint32) error {
+			props, err := w.Manager.DescriptorAccount(ns, account)
+			if err != nil {
+				return err
+			}
+			accounts = append(accounts, props)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return accounts, nil
+}
+
+// recordDescriptorAddresses records all descriptor account addresses which
+// have not yet been recorded through the child index end, and returns the
+// newly recorded addresses.  P2SH redeem scripts are saved to the transaction
+// store so the wallet may recognize and spend outputs of its multisig scripts.
+func (w *Wallet) recordDescriptorAddresses(dbtx walletdb.ReadWriteTx, account uint32,
+	d *descriptor.Descriptor, end uint32) ([]dcrutil.Address, error) {
+
+	addrmgrNs := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+	txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+
+	props, err := w.Manager.DescriptorAccount(addrmgrNs, account)
+	if err != nil {
+		return nil, err
+	}
+	end = minUint32(end, hdkeychain.HardenedKeyStart-1)
+	if props.RecordedCount > end {
+		return nil, nil
+	}
+
+	// Keys of the descriptor which are derived from this wallet's own
+	// accounts must be recorded by the account as well, so the private keys
+	// can be found by the address manager when signing.
+	locals, err := w.localDescriptorKeys(addrmgrNs, d)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range locals {
+		err := w.Manager.SyncAccountToAddrIndex(addrmgrNs, l.account, end, l.branch)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var addrs []dcrutil.Address
+	for i := props.RecordedCount; i <= end; i++ {
+		out, err := d.Derive(i)
+		if err != nil {
+			return nil, err
+		}
+		var pubKey []byte
+		if out.RedeemScript == nil {
+			pubKey = out.PubKeys[0]
+		}
+		err = w.Manager.RecordDescriptorAddress(addrmgrNs, account, i, out.Address, pubKey)
+		if err != nil {
+			return nil, err
+		}
+		if out.RedeemScript != nil {
+			err = w.TxStore.InsertTxScript(txmgrNs, out.RedeemScript)
+			if err != nil {
+				return nil, err
+			}
+		}
+		addrs = append(addrs, out.Address)
+	}
+	return addrs, nil
+}
+
+// localDescriptorKey describes an account branch of the wallet which derives
+// a ranged descriptor key.
+type localDescriptorKey struct {
+	account uint32
+	branch  uint32
+}
+
+// localDescriptorKeys returns the BIP0044 account branches of the wallet from
+// which ranged keys of a descriptor are derived.  Only keys described as an
+// account extended public key followed by a single external or internal branch
+// step are considered.
+func (w *Wallet) localDescriptorKeys(ns walletdb.ReadBucket, d *descriptor.Descriptor) ([]localDescriptorKey, error) {
+	xpubs := make(map[string]uint32)
+	for i := range d.Keys {
+		k := &d.Keys[i]
+		if k.Xpub == nil || !k.Ranged || len(k.Path) != 1 {
+			continue
+		}
+		if k.Path[0] != udb.ExternalBranch && k.Path[0] != udb.InternalBranch {
+			continue
+		}
+		xpubs[k.Xpub.String()] = k.Path[0]
+	}
+	if len(xpubs) == 0 {
+		return nil, nil
+	}
+
+	var locals []localDescriptorKey
+	lastAcct, err := w.Manager.LastAccount(ns)
+	if err != nil {
+		return nil, err
+	}
+	for account := uint32(0); account <= lastAcct; account++ {
+		xpubStr, err := w.Manager.GetMasterPubkey(ns, account)
+		if err != nil {
+			return nil, err
+		}
+		xpub, err := hdkeychain.NewKeyFromString(xpubStr, w.chainParams)
+		if err != nil {
+			return nil, err
+		}
+		if branch, ok := xpubs[xpub.String()]; ok {
+			locals = append(locals, localDescriptorKey{account, branch})
+		}
+	}
+	return locals, nil
+}
+
+// descriptorAccount returns the properties and parsed descriptor of a
+// descriptor account.
+func (w *Wallet) descriptorAccount(ns walletdb.ReadBucket, account uint32) (*udb.DescriptorAccountProperties, *descriptor.Descriptor, error) {
+	props, err := w.Manager.DescriptorAccount(ns, account)
+	if err != nil {
+		return nil, nil, err
+	}
+	d, err := descriptor.Parse(props.Descriptor, w.chainParams)
+	if err != nil {
+		return nil, nil, err
+	}
+	return props, d, nil
+}
+
+// markUsedDescriptorAddress records that a descriptor account address has been
+// used and records additional addresses through the gap limit.
+func (w *Wallet) markUsedDescriptorAddress(dbtx walletdb.ReadWriteTx, addr udb.ManagedAddress) error {
+	ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+	account, index, err := w.Manager.DescriptorAddressIndex(ns, addr.Address())
+	if errors.Is(err, errors.NotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	err = w.Manager.MarkDescriptorChildUsed(ns, account, index)
+	if err != nil {
+		return err
+	}
+	_, d, err := w.descriptorAccount(ns, account)
+	if err != nil {
+		return err
+	}
+	_, err = w.recordDescriptorAddresses(dbtx, account, d, index+uint32(w.gapLimit))
+	return err
+}
+
+// nextDescriptorAddress returns the next unreturned address of a descriptor
+// account, observing the gap limit policy of the call options.
+func (w *Wallet) nextDescriptorAddress(ctx context.Context, op errors.Op, account uint32,
+	callOpts ...NextAddressCallOption) (dcrutil.Address, error) {
+
+	var opts nextAddressCallOptions
+	for _, c := range callOpts {
+		c(&opts)
+	}
+
+	var addr dcrutil.Address
+	var watch []dcrutil.Address
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		props, d, err := w.descriptorAccount(ns, account)
+		if err != nil {
+			return err
+		}
+		child := props.LastReturnedIndex + 1
+		if child > props.LastUsedIndex+uint32(w.gapLimit) {
+			switch opts.policy {
+			case gapPolicyError:
+				return errors.E(errors.Policy, "generating next address "+
+					"violates the unused address gap limit policy")
+			case gapPolicyWrap:
+				child = props.LastUsedIndex + 1
+			}
+		}
+		if child >= hdkeychain.HardenedKeyStart {
+			return errors.E(errors.Errorf("account %d exhausted", account))
+		}
+		watch, err = w.recordDescriptorAddresses(dbtx, account, d, child)
+		if err != nil {
+			return err
+		}
+		err = w.Manager.MarkDescriptorChildReturned(ns, account, child)
+		if err != nil {
+			return err
+		}
+		out, err := d.Derive(child)
+		if err != nil {
+			return err
+		}
+		addr = out.Address
+		log.Infof("Returning address (account=%v child=%v)", account, child)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	// Addresses beyond the gap limit are not already watched.
+	if len(watch) != 0 {
+		if n, err := w.NetworkBackend(); err == nil {
+			err = n.LoadTxFilter(ctx, false, watch, nil)
+			if err != nil {
+				return nil, errors.E(op, err)
+			}
+		}
+	}
+	return addr, nil
+}
+
+// watchDescriptorAddrs loads the network backend's transaction filter with all
+// recorded descriptor account addresses which are not yet watched.  All
+// recorded addresses are watched when firstWatch is true.
+func (w *Wallet) watchDescriptorAddrs(ctx context.Context, firstWatch bool, n NetworkBackend) (count uint64, err error) {
+	type descAccount struct {
+		account  uint32
+		desc     *descriptor.Descriptor
+		recorded uint32
+	}
+	var accounts []descAccount
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		return w.Manager.ForEachDescriptorAccount(ns, func(account uint32) error {
+			props, d, err := w.descriptorAccount(ns, account)
+			if err != nil {
+				return err
+			}
+			accounts = append(accounts, descAccount{account, d, props.RecordedCount})
+			return nil
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, a := range accounts {
+		var watched uint32
+		if !firstWatch {
+			w.addressBuffersMu.Lock()
+			watched = w.descriptorWatched[a.account]
+			w.addressBuffersMu.Unlock()
+		}
+		if watched >= a.recorded {
+			continue
+		}
+		addrs := make([]dcrutil.Address, 0, a.recorded-watched)
+		for i := watched; i < a.recorded; i++ {
+			out, err := a.desc.Derive(i)
+			if err != nil {
+				return count, err
+			}
+			addrs = append(addrs, out.Address)
+		}
+		err = n.LoadTxFilter(ctx, false, addrs, nil)
+		if err != nil {
+			return count, err
+		}
+		count += uint64(len(addrs))
+		w.addressBuffersMu.Lock()
+		w.descriptorWatched[a.account] = a.recorded
+		w.addressBuffersMu.Unlock()
+	}
+	return count, nil
+}
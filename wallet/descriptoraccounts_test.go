@@ -0,0 +1,188 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/decred/dcrd/hdkeychain/v2"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3/descriptor"
+	"github.com/decred/dcrwallet/wallet/v3/udb"
+	"github.com/decred/dcrwallet/wallet/v3/walletdb"
+)
+
+func TestDescriptorAccounts(t *testing.T) {
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	xpubs := make([]string, 3)
+	for i := range xpubs {
+		xprv, err := hdkeychain.NewMaster(bytes.Repeat([]byte{byte(i + 1)}, 32), cfg.Params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		xpub, err := xprv.Neuter()
+		if err != nil {
+			t.Fatal(err)
+		}
+		xpubs[i] = xpub.String()
+	}
+	desc := "sh(sortedmulti(2," + xpubs[0] + "/0/*," + xpubs[1] + "/0/*," + xpubs[2] + "/0/*))"
+	d, err := descriptor.Parse(desc, cfg.Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	account, err := w.ImportDescriptorAccount(ctx, "vault", desc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if account != udb.DescriptorAccountStart {
+		t.Errorf("first descriptor account number %d, want %d", account, uint32(udb.DescriptorAccountStart))
+	}
+	n, err := w.AccountNumber(ctx, "vault")
+	if err != nil || n != account {
+		t.Errorf("AccountNumber: got %d, %v", n, err)
+	}
+
+	_, err = w.ImportDescriptorAccount(ctx, "vault", "pkh("+xpubs[0]+"/1/*)")
+	if !errors.Is(err, errors.Exist) {
+		t.Errorf("duplicate account name: expected Exist, got %v", err)
+	}
+	_, err = w.ImportDescriptorAccount(ctx, "single", "pkh("+xpubs[0]+"/1)")
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("unranged descriptor: expected Invalid, got %v", err)
+	}
+	_, err = w.ImportDescriptorAccount(ctx, "bare", "multi(1,"+xpubs[0]+"/*)")
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("bare multisig descriptor: expected Invalid, got %v", err)
+	}
+
+	// The gap limit of addresses is recorded with their redeem scripts.
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		props, err := w.Manager.DescriptorAccount(addrmgrNs, account)
+		if err != nil {
+			return err
+		}
+		if props.RecordedCount != uint32(cfg.GapLimit) {
+			t.Errorf("recorded %d addresses, want %d", props.RecordedCount, cfg.GapLimit)
+		}
+		out, err := d.Derive(uint32(cfg.GapLimit) - 1)
+		if err != nil {
+			return err
+		}
+		acct, err := w.Manager.AddrAccount(addrmgrNs, out.Address)
+		if err != nil {
+			return err
+		}
+		if acct != account {
+			t.Errorf("address recorded for account %d", acct)
+		}
+		script, err := w.TxStore.GetTxScript(txmgrNs, out.Address.ScriptAddress())
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(script, out.RedeemScript) {
+			t.Errorf("recorded redeem script does not match")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Addresses are returned in order until the gap limit is reached.
+	for i := uint32(0); i < uint32(cfg.GapLimit); i++ {
+		addr, err := w.NewExternalAddress(ctx, account)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out, err := d.Derive(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if addr.Address() != out.Address.Address() {
+			t.Fatalf("address %d: got %v want %v", i, addr, out.Address)
+		}
+	}
+	_, err = w.NewExternalAddress(ctx, account)
+	if !errors.Is(err, errors.Policy) {
+		t.Errorf("exceeding gap limit: expected Policy, got %v", err)
+	}
+	_, err = w.NewInternalAddress(ctx, account)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("internal address: expected Invalid, got %v", err)
+	}
+
+	// Using an address records more addresses beyond it.
+	used, err := d.Derive(5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ma, err := w.Manager.Address(dbtx.ReadBucket(waddrmgrNamespaceKey), used.Address)
+		if err != nil {
+			return err
+		}
+		return w.markUsedAddress("", dbtx, ma)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	accounts, err := w.DescriptorAccounts(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(accounts) != 1 {
+		t.Fatalf("got %d descriptor accounts", len(accounts))
+	}
+	props := accounts[0]
+	if props.AccountName != "vault" || props.Descriptor != d.String() {
+		t.Errorf("unexpected descriptor account %+v", props)
+	}
+	if props.LastUsedIndex != 5 || props.RecordedCount != 5+uint32(cfg.GapLimit)+1 {
+		t.Errorf("last used %d recorded %d after use", props.LastUsedIndex, props.RecordedCount)
+	}
+
+	// Descriptor accounts are exported without their redeem scripts and
+	// restored by the descriptor.
+	e, err := w.Export(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(e.DescriptorAccounts) != 1 || e.DescriptorAccounts[0].Descriptor != d.String() {
+		t.Errorf("unexpected exported descriptor accounts %+v", e.DescriptorAccounts)
+	}
+	if len(e.Scripts) != 0 {
+		t.Errorf("exported %d descriptor redeem scripts", len(e.Scripts))
+	}
+	cfg2 := basicWalletConfig
+	w2, teardown2 := testWallet(t, &cfg2)
+	defer teardown2()
+	err = w2.Unlock(ctx, testPrivPass, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e.Accounts = nil
+	err = w2.RestoreExport(ctx, e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	accounts, err = w2.DescriptorAccounts(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(accounts) != 1 || accounts[0].Descriptor != d.String() {
+		t.Errorf("unexpected restored descriptor accounts")
+	}
+}
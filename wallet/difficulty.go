@@ -441,6 +441,161 @@ func (w *Wallet) nextRequiredDCP0001PoSDifficulty(dbtx walletdb.ReadTx, curHeade
 	return dcrutil.Amount(sdiff), nil
 }
 
+// estimateNextStakeDifficultyV2 estimates the stake difficulty of the next
+// retarget interval after curHeader using the algorithm defined in DCP0001, by
+// pretending newTickets will be purchased in the remainder of the current
+// interval.  When useMaxTickets is set, the maximum number of tickets that can
+// be purchased in the remainder of the interval is used instead.
+func (w *Wallet) estimateNextStakeDifficultyV2(dbtx walletdb.ReadTx, curHeader *wire.BlockHeader, newTickets int64, useMaxTickets bool) (dcrutil.Amount, error) {
+	// Calculate the next retarget interval height.
+	curHeight := int64(curHeader.Height)
+	ticketMaturity := int64(w.chainParams.TicketMaturity)
+	intervalSize := w.chainParams.StakeDiffWindowSize
+	blocksUntilRetarget := intervalSize - curHeight%intervalSize
+	nextRetargetHeight := curHeight + blocksUntilRetarget
+
+	// Calculate the maximum possible number of tickets that could be sold
+	// in the remainder of the interval and potentially override the number
+	// of new tickets to include in the estimate per the user-specified
+	// flag.
+	maxTicketsPerBlock := int64(w.chainParams.MaxFreshStakePerBlock)
+	maxRemainingTickets := (blocksUntilRetarget - 1) * maxTicketsPerBlock
+	if useMaxTickets {
+		newTickets = maxRemainingTickets
+	}
+
+	// Ensure the specified number of tickets is not too high.
+	if newTickets > maxRemainingTickets {
+		return 0, errors.Errorf("unable to create an estimated stake "+
+			"difficulty with %d tickets since it is more than the "+
+			"maximum remaining of %d", newTickets, maxRemainingTickets)
+	}
+
+	// Stake difficulty before any tickets could possibly be purchased is
+	// the minimum value.
+	stakeDiffStartHeight := int64(w.chainParams.CoinbaseMaturity) + 1
+	if nextRetargetHeight < stakeDiffStartHeight {
+		return dcrutil.Amount(w.chainParams.MinimumStakeDiff), nil
+	}
+
+	// Get the pool size and number of tickets that were immature at the
+	// previous retarget interval.
+	//
+	// NOTE: Since the stake difficulty must be calculated based on existing
+	// blocks, it is always calculated for the block after a given block, so
+	// the information for the previous retarget interval must be retrieved
+	// relative to the block just before it to coincide with how it was
+	// originally calculated.
+	var prevPoolSize int64
+	prevRetargetHeight := nextRetargetHeight - intervalSize - 1
+	prevRetargetHeader, err := w.ancestorHeaderAtHeight(dbtx, curHeader, nil, int32(prevRetargetHeight))
+	if err != nil {
+		return 0, err
+	}
+	if prevRetargetHeader != nil {
+		prevPoolSize = int64(prevRetargetHeader.PoolSize)
+	}
+	prevImmatureTickets, err := w.sumPurchasedTickets(dbtx, prevRetargetHeader, nil, ticketMaturity)
+	if err != nil {
+		return 0, err
+	}
+
+	// Return the existing ticket price for the first few intervals to avoid
+	// division by zero and encourage initial pool population.
+	curDiff := curHeader.SBits
+	prevPoolSizeAll := prevPoolSize + prevImmatureTickets
+	if prevPoolSizeAll == 0 {
+		return dcrutil.Amount(curDiff), nil
+	}
+
+	// Calculate the number of tickets that will still be immature at the
+	// next retarget based on the known (non-estimated) data.
+	//
+	// Note that when the interval size is larger than the ticket maturity,
+	// the current height might be before the maturity floor (the point
+	// after which the remaining tickets will remain immature).  There are
+	// therefore no possible remaining immature tickets from the blocks that
+	// are not being estimated in that case.
+	var remainingImmatureTickets int64
+	nextMaturityFloor := nextRetargetHeight - ticketMaturity - 1
+	if curHeight > nextMaturityFloor {
+		remainingImmatureTickets, err = w.sumPurchasedTickets(dbtx, curHeader, nil, curHeight-nextMaturityFloor)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	// Add the number of tickets that will still be immature at the next
+	// retarget based on the estimated data.
+	maxImmatureTickets := ticketMaturity * maxTicketsPerBlock
+	if newTickets > maxImmatureTickets {
+		remainingImmatureTickets += maxImmatureTickets
+	} else {
+		remainingImmatureTickets += newTickets
+	}
+
+	// Calculate the number of tickets that will mature in the remaining
+	// blocks of the current interval based on the known (non-estimated)
+	// data.
+	//
+	// NOTE: The pool size in the block headers does not include the tickets
+	// maturing at the height in which they mature since they are not
+	// eligible for selection until the next block, so exclude them by
+	// starting one block before the next maturity floor.
+	finalMaturingHeight := nextMaturityFloor - 1
+	if finalMaturingHeight > curHeight {
+		finalMaturingHeight = curHeight
+	}
+	finalMaturingHeader, err := w.ancestorHeaderAtHeight(dbtx, curHeader, nil, int32(finalMaturingHeight))
+	if err != nil {
+		return 0, err
+	}
+	firstMaturingHeight := curHeight - ticketMaturity
+	maturingTickets, err := w.sumPurchasedTickets(dbtx, finalMaturingHeader, nil,
+		finalMaturingHeight-firstMaturingHeight+1)
+	if err != nil {
+		return 0, err
+	}
+
+	// Add the number of tickets that will mature based on the estimated data.
+	//
+	// Note that when the ticket maturity is greater than or equal to the
+	// interval size, the current height will always be after the maturity
+	// floor.  There are therefore no possible maturing estimated tickets
+	// in that case.
+	if curHeight < nextMaturityFloor {
+		maturingEstimateNodes := nextMaturityFloor - curHeight - 1
+		maturingEstimatedTickets := maxTicketsPerBlock * maturingEstimateNodes
+		if maturingEstimatedTickets > newTickets {
+			maturingEstimatedTickets = newTickets
+		}
+		maturingTickets += maturingEstimatedTickets
+	}
+
+	// Calculate the number of votes that will occur during the remaining
+	// blocks of the interval.
+	stakeValidationHeight := w.chainParams.StakeValidationHeight
+	votesPerBlock := int64(w.chainParams.TicketsPerBlock)
+	var pendingVotes int64
+	if nextRetargetHeight > stakeValidationHeight {
+		votingBlocks := blocksUntilRetarget - 1
+		if curHeight < stakeValidationHeight {
+			votingBlocks = nextRetargetHeight - stakeValidationHeight
+		}
+		pendingVotes = votingBlocks * votesPerBlock
+	}
+
+	// Calculate what the pool size would be as of the next interval.
+	curPoolSize := int64(curHeader.PoolSize)
+	estimatedPoolSize := curPoolSize + maturingTickets - pendingVotes
+	estimatedPoolSizeAll := estimatedPoolSize + remainingImmatureTickets
+
+	// Calculate and return the final estimated difficulty.
+	sdiff := calcNextStakeDiffV2(w.chainParams, nextRetargetHeight, curDiff,
+		prevPoolSizeAll, estimatedPoolSizeAll)
+	return dcrutil.Amount(sdiff), nil
+}
+
 // NextStakeDifficulty returns the ticket price for the next block after the
 // current main chain tip block.  This function only succeeds when DCP0001 is
 // known to be active.  As a fallback, the StakeDifficulty method of
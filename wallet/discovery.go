@@ -18,6 +18,7 @@ import (
 	"github.com/decred/dcrwallet/errors/v2"
 	"github.com/decred/dcrwallet/rpc/client/dcrd"
 	"github.com/decred/dcrwallet/validate"
+	"github.com/decred/dcrwallet/wallet/v3/descriptor"
 	"github.com/decred/dcrwallet/wallet/v3/udb"
 	"github.com/decred/dcrwallet/wallet/v3/walletdb"
 	"golang.org/x/sync/errgroup"
@@ -729,6 +730,103 @@ func (f *existsAddrIndexFinder) find(ctx context.Context, finder *addrFinder) er
 	return g.Wait()
 }
 
+// discoverDescriptorAddresses searches for address usage of all descriptor
+// accounts in blocks starting from startBlock.  Child addresses are searched
+// in windows of the gap limit beginning after the last known used child, and
+// the search ends with the first window without any usage.  Addresses through
+// the gap limit beyond the last used child are recorded.
+func (w *Wallet) discoverDescriptorAddresses(ctx context.Context, p Peer, startBlock *chainhash.Hash,
+	cache blockCommitmentCache) error {
+
+	type descAccount struct {
+		account  uint32
+		desc     *descriptor.Descriptor
+		lastUsed uint32
+	}
+	var accounts []descAccount
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		return w.Manager.ForEachDescriptorAccount(ns, func(account uint32) error {
+			props, d, err := w.descriptorAccount(ns, account)
+			if err != nil {
+				return err
+			}
+			accounts = append(accounts, descAccount{account, d, props.LastUsedIndex})
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+	if len(accounts) == 0 {
+		return nil
+	}
+	log.Infof("Discovering used addresses for %d descriptor account(s)", len(accounts))
+
+	gapLimit := uint32(w.gapLimit)
+	for i := range accounts {
+		a := &accounts[i]
+		for begin := a.lastUsed + 1; begin < hd.HardenedKeyStart; begin = a.lastUsed + 1 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			end := minUint32(begin+gapLimit, hd.HardenedKeyStart)
+			data := make([][]byte, 0, end-begin)
+			children := make(map[string]uint32, end-begin)
+			for child := begin; child < end; child++ {
+				out, err := a.desc.Derive(child)
+				if err != nil {
+					return err
+				}
+				data = append(data, out.Script)
+				children[string(out.Script)] = child
+			}
+			blocks, err := w.filterBlocks(ctx, startBlock, data)
+			if err != nil {
+				return err
+			}
+			err = cacheMissingCommitments(ctx, p, cache, blocks)
+			if err != nil {
+				return err
+			}
+			found := false
+			for _, b := range blocks {
+				for scr := range cache[*b] {
+					child, ok := children[scr]
+					if !ok {
+						continue
+					}
+					if !found || child > a.lastUsed {
+						a.lastUsed = child
+						found = true
+					}
+				}
+			}
+			if !found {
+				break
+			}
+		}
+		log.Infof("Descriptor account %d next child index: %d", a.account, a.lastUsed+1)
+
+		err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+			ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+			if a.lastUsed < hd.HardenedKeyStart {
+				err := w.Manager.MarkDescriptorChildUsed(ns, a.account, a.lastUsed)
+				if err != nil {
+					return err
+				}
+			}
+			_, err := w.recordDescriptorAddresses(dbtx, a.account, a.desc, a.lastUsed+gapLimit)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func rpcFromPeer(p Peer) (*dcrd.RPC, bool) {
 	switch p := p.(type) {
 	case Caller:
@@ -968,6 +1066,12 @@ func (w *Wallet) DiscoverActiveAddresses(ctx context.Context, p Peer, startBlock
 		}
 	}
 
+	// Discover address usage of descriptor accounts.
+	err = w.discoverDescriptorAddresses(ctx, p, startBlock, blockAddresses)
+	if err != nil {
+		return errors.E(op, err)
+	}
+
 	// If the wallet does not know the current coin type (e.g. it is a watching
 	// only wallet created from an account master pubkey) or when the wallet
 	// uses the SLIP0044 coin type, there is nothing more to do.
@@ -0,0 +1,190 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrec/secp256k1/v2"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/hdkeychain/v2"
+	"github.com/decred/dcrd/txscript/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3/udb"
+	"github.com/decred/dcrwallet/wallet/v3/walletdb"
+)
+
+// SignRequest describes a signature to be created by an ExternalSigner with a
+// private key derived from a BIP0044 account extended key.
+type SignRequest struct {
+	// AccountXpub is the extended public key of the BIP0044 account.  The
+	// signer is expected to hold the corresponding extended private key.
+	AccountXpub *hdkeychain.ExtendedKey
+
+	// Branch and Child describe the derivation path of the signing key
+	// from the account key (m/44'/coin'/account'/branch/child).
+	Branch uint32
+	Child  uint32
+
+	// PubKey is the serialized compressed secp256k1 public key of the
+	// signing key.
+	PubKey []byte
+
+	// SigHash is the 32 byte signature hash to sign.
+	SigHash []byte
+}
+
+// ExternalSigner creates signatures using private keys which are not held by
+// the wallet, such as keys held by hardware wallets, signing bridges, or HSMs.
+// It allows watching-only wallets to sign the transactions they create.
+type ExternalSigner interface {
+	// SignHash returns the DER-encoded secp256k1 signature of the
+	// request's signature hash, without any appended signature hash type.
+	SignHash(ctx context.Context, req *SignRequest) ([]byte, error)
+}
+
+// SetExternalSigner sets the signer used to sign transactions created by a
+// watching-only wallet.  Setting a nil signer removes the current signer.
+func (w *Wallet) SetExternalSigner(s ExternalSigner) {
+	w.externalSignerMu.Lock()
+	w.externalSigner = s
+	w.externalSignerMu.Unlock()
+}
+
+// ExternalSigner returns the external signer of the wallet, or nil if none is
+// set.
+func (w *Wallet) ExternalSigner() ExternalSigner {
+	w.externalSignerMu.Lock()
+	s := w.externalSigner
+	w.externalSignerMu.Unlock()
+	return s
+}
+
+// watchingOnlySigner returns the external signer if transactions must be
+// signed externally.  This is only the case for watching-only wallets with a
+// configured signer.
+func (w *Wallet) watchingOnlySigner() ExternalSigner {
+	if !w.Manager.WatchingOnly() {
+		return nil
+	}
+	return w.ExternalSigner()
+}
+
+// xpubPather returns the BIP0044 derivation path of a P2PKH wallet address.
+func (w *Wallet) xpubPather(ctx context.Context, addr *dcrutil.AddressPubKeyHash) (BIP44AccountXpubPather, error) {
+	var p *xpubAddress
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		ma, err := w.Manager.Address(addrmgrNs, addr)
+		if err != nil {
+			return err
+		}
+		pka, ok := ma.(udb.ManagedPubKeyAddress)
+		if !ok || ma.Imported() {
+			return errors.E(errors.Invalid, errors.Errorf("address %v is "+
+				"not derived from an account extended key", addr))
+		}
+		s, err := w.Manager.GetMasterPubkey(addrmgrNs, ma.Account())
+		if err != nil {
+			return err
+		}
+		xpub, err := hdkeychain.NewKeyFromString(s, w.chainParams)
+		if err != nil {
+			return err
+		}
+		var branch uint32
+		if ma.Internal() {
+			branch = udb.InternalBranch
+		}
+		p = &xpubAddress{
+			AddressPubKeyHash: addr,
+			xpub:              xpub,
+			branch:            branch,
+			child:             pka.Index(),
+		}
+		return nil
+	})
+	return p, err
+}
+
+// signInputExternal returns the signature script for a transaction input
+// redeeming a P2PKH output (including stake-tagged P2PKH outputs) of a BIP0044
+// account.  The signature is created by the external signer and is checked
+// before it is returned.
+func (w *Wallet) signInputExternal(ctx context.Context, signer ExternalSigner, tx *wire.MsgTx,
+	index int, prevScript []byte, hashType txscript.SigHashType) ([]byte, error) {
+
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(0, prevScript, w.chainParams)
+	if err != nil {
+		return nil, err
+	}
+	var apkh *dcrutil.AddressPubKeyHash
+	if len(addrs) == 1 {
+		apkh, _ = addrs[0].(*dcrutil.AddressPubKeyHash)
+	}
+	if apkh == nil || apkh.DSA() != dcrec.STEcdsaSecp256k1 {
+		return nil, errors.E(errors.Invalid, errors.Errorf("input %d does "+
+			"not redeem a P2PKH output and can not be externally signed", index))
+	}
+	p, err := w.xpubPather(ctx, apkh)
+	if err != nil {
+		return nil, err
+	}
+	xpub, branch, child := p.BIP44AccountXpubPath()
+	pubKey := p.(SecpPubKeyer).SecpPubKey()
+	sigHash, err := txscript.CalcSignatureHash(prevScript, hashType, tx, index, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := signer.SignHash(ctx, &SignRequest{
+		AccountXpub: xpub,
+		Branch:      branch,
+		Child:       child,
+		PubKey:      pubKey,
+		SigHash:     sigHash,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Do not trust the signer to sign with the requested key.
+	parsedSig, err := secp256k1.ParseDERSignature(sig)
+	if err != nil {
+		return nil, errors.E(errors.Crypto, errors.Errorf("external signer "+
+			"returned invalid signature: %v", err))
+	}
+	parsedPubKey, err := secp256k1.ParsePubKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	if !parsedSig.Verify(sigHash, parsedPubKey) {
+		return nil, errors.E(errors.Crypto, "external signer returned "+
+			"signature which does not verify")
+	}
+
+	sig = append(sig[:len(sig):len(sig)], byte(hashType))
+	return txscript.NewScriptBuilder().AddData(sig).AddData(pubKey).Script()
+}
+
+// signExternal adds input scripts for every input of tx using the external
+// signer.  Previous output scripts must be passed in prevScripts and the slice
+// length must match the number of inputs.
+func (w *Wallet) signExternal(ctx context.Context, signer ExternalSigner, tx *wire.MsgTx, prevScripts [][]byte) error {
+	if len(tx.TxIn) != len(prevScripts) {
+		return errors.E(errors.Invalid, "tx.TxIn and prevScripts slices "+
+			"must have equal length")
+	}
+	for i, in := range tx.TxIn {
+		script, err := w.signInputExternal(ctx, signer, tx, i, prevScripts[i], txscript.SigHashAll)
+		if err != nil {
+			return err
+		}
+		in.SignatureScript = script
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/hdkeychain/v2"
+	"github.com/decred/dcrd/txscript/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/errors/v2"
+)
+
+// mockSigner is an ExternalSigner holding an account extended private key.
+type mockSigner struct {
+	xprv  *hdkeychain.ExtendedKey
+	calls int
+}
+
+func (s *mockSigner) SignHash(ctx context.Context, req *SignRequest) ([]byte, error) {
+	s.calls++
+	xpub, err := s.xprv.Neuter()
+	if err != nil {
+		return nil, err
+	}
+	if xpub.String() != req.AccountXpub.String() {
+		return nil, errors.New("unknown account")
+	}
+	branch, err := s.xprv.Child(req.Branch)
+	if err != nil {
+		return nil, err
+	}
+	child, err := branch.Child(req.Child)
+	if err != nil {
+		return nil, err
+	}
+	key, err := child.ECPrivKey()
+	if err != nil {
+		return nil, err
+	}
+	sig, err := key.Sign(req.SigHash)
+	if err != nil {
+		return nil, err
+	}
+	return sig.Serialize(), nil
+}
+
+func TestExternalSigner(t *testing.T) {
+	ctx := context.Background()
+
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+	err := w.Unlock(ctx, testPrivPass, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	xprv, err := w.MasterPrivKey(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	xpub, err := w.MasterPubKey(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wocfg := basicWalletConfig
+	wo, woTeardown := testWatchingOnlyWallet(t, &wocfg, xpub.String())
+	defer woTeardown()
+
+	extAddr, err := wo.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	intAddr, err := wo.NewInternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := wire.NewMsgTx()
+	prevScripts := make(map[wire.OutPoint][]byte)
+	for i, addr := range []V0Scripter{extAddr.(V0Scripter), intAddr.(V0Scripter)} {
+		prevHash := chainhash.HashH([]byte{byte(i)})
+		op := wire.NewOutPoint(&prevHash, 0, wire.TxTreeRegular)
+		tx.AddTxIn(wire.NewTxIn(op, 1e8, nil))
+		prevScripts[*op] = addr.ScriptV0()
+	}
+	tx.AddTxOut(wire.NewTxOut(1.9e8, extAddr.(V0Scripter).ScriptV0()))
+
+	// Without a signer, watching-only wallets can not sign.
+	signErrs, err := wo.SignTransaction(ctx, tx, txscript.SigHashAll, prevScripts, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(signErrs) != 2 {
+		t.Fatalf("expected 2 signature errors without signer, got %d", len(signErrs))
+	}
+
+	signer := &mockSigner{xprv: xprv}
+	wo.SetExternalSigner(signer)
+	signErrs, err = wo.SignTransaction(ctx, tx, txscript.SigHashAll, prevScripts, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(signErrs) != 0 {
+		t.Fatalf("signature errors with external signer: %v", signErrs[0].Error)
+	}
+	if signer.calls != 2 {
+		t.Errorf("external signer called %d times, expected 2", signer.calls)
+	}
+
+	// Signatures made with other keys are rejected.
+	other, err := xprv.Child(hdkeychain.HardenedKeyStart)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wo.SetExternalSigner(&wrongKeySigner{mockSigner{xprv: other}})
+	_, err = wo.signInputExternal(ctx, wo.ExternalSigner(), tx, 0,
+		prevScripts[tx.TxIn[0].PreviousOutPoint], txscript.SigHashAll)
+	if !errors.Is(err, errors.Crypto) {
+		t.Errorf("expected Crypto error for signature by wrong key, got %v", err)
+	}
+}
+
+// wrongKeySigner signs with a key of another account.
+type wrongKeySigner struct {
+	mockSigner
+}
+
+func (s *wrongKeySigner) SignHash(ctx context.Context, req *SignRequest) ([]byte, error) {
+	r := *req
+	r.AccountXpub, _ = s.xprv.Neuter()
+	return s.mockSigner.SignHash(ctx, &r)
+}
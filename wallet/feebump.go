@@ -0,0 +1,502 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"time"
+
+	"github.com/decred/dcrd/blockchain/stake/v2"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/txscript/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3/internal/txsizes"
+	"github.com/decred/dcrwallet/wallet/v3/txauthor"
+	"github.com/decred/dcrwallet/wallet/v3/txrules"
+	"github.com/decred/dcrwallet/wallet/v3/udb"
+	"github.com/decred/dcrwallet/wallet/v3/walletdb"
+)
+
+// FeeBump describes a transaction published to increase the fee paid for
+// mining a stuck transaction.  Tx either replaces the stuck transaction or, for
+// child-pays-for-parent bumps, spends its outputs.
+type FeeBump struct {
+	Tx *wire.MsgTx
+
+	// OrigFee is the fee paid by the stuck transaction.
+	OrigFee dcrutil.Amount
+
+	// Fee is the fee paid by Tx.
+	Fee dcrutil.Amount
+
+	// UnminedAncestors is set when the stuck transaction spends outputs of
+	// other unmined transactions.  Their fees and sizes are not considered
+	// when calculating the fee of a child transaction, and the package may
+	// not be mined at the intended fee rate.
+	UnminedAncestors bool
+}
+
+// BumpFee replaces the unmined transaction txHash with a conflicting
+// transaction spending the same inputs, which pays a higher fee by reducing
+// the value of the change output.  If the remaining change is dust, the change
+// output is removed.  The fee rate of the replacement is feePerKb, or the
+// original fee rate increased by the wallet's relay fee if zero.  To be relayed
+// by nodes, the replacement must increase the fee by at least the relay fee for
+// its size.
+//
+// Every input of the transaction must spend a P2PKH or P2PK wallet output, and
+// none of its outputs may be spent by other unmined transactions.  The
+// replacement is published to the network before it replaces the original
+// transaction in the wallet.  Whichever transaction is mined first removes the
+// other from the wallet.
+//
+// The wallet must be unlocked to sign the replacement.
+func (w *Wallet) BumpFee(ctx context.Context, n NetworkBackend, txHash *chainhash.Hash,
+	feePerKb dcrutil.Amount) (*FeeBump, error) {
+
+	const opf = "wallet.BumpFee(%v)"
+
+	heldUnlock, err := w.holdUnlock()
+	if err != nil {
+		return nil, errors.E(errors.Opf(opf, txHash), err)
+	}
+	defer heldUnlock.release()
+
+	relayFee := w.RelayFee()
+	var bump *FeeBump
+	var prevScripts [][]byte
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+		details, err := w.TxStore.TxDetails(txmgrNs, txHash)
+		if err != nil {
+			return err
+		}
+		if details.Block.Height != -1 {
+			return errors.E(errors.Invalid, "transaction is mined")
+		}
+		if details.TxType != stake.TxTypeRegular {
+			return errors.E(errors.Invalid, "stake transactions can not be replaced")
+		}
+		tx := &details.MsgTx
+		if len(details.Debits) != len(tx.TxIn) {
+			return errors.E(errors.Invalid, "transaction spends outputs not controlled by the wallet")
+		}
+
+		changeIndex := -1
+		for _, c := range details.Credits {
+			if c.Spent {
+				return errors.E(errors.Invalid,
+					errors.Errorf("output %d is spent by an unmined transaction", c.Index))
+			}
+			if c.Change && changeIndex == -1 {
+				changeIndex = int(c.Index)
+			}
+		}
+		if changeIndex == -1 {
+			return errors.E(errors.Invalid, "transaction has no change output to reduce")
+		}
+
+		var totalInput dcrutil.Amount
+		for _, d := range details.Debits {
+			totalInput += d.Amount
+		}
+		origFee := totalInput - sumOutputValues(tx.TxOut)
+
+		scriptSizes := make([]int, len(tx.TxIn))
+		prevScripts = make([][]byte, len(tx.TxIn))
+		for i, in := range tx.TxIn {
+			prevOut := &in.PreviousOutPoint
+			prev, err := w.TxStore.TxDetails(txmgrNs, &prevOut.Hash)
+			if err != nil {
+				return err
+			}
+			out := prev.MsgTx.TxOut[prevOut.Index]
+			size, ok := sigScriptSize(out.Version, out.PkScript)
+			if !ok {
+				return errors.E(errors.Invalid, errors.Errorf("input %d spends "+
+					"an output whose signature script size can not be estimated", i))
+			}
+			scriptSizes[i] = size
+			prevScripts[i] = out.PkScript
+		}
+		size := txsizes.EstimateSerializeSize(scriptSizes, tx.TxOut, 0)
+
+		if feePerKb == 0 {
+			feePerKb = origFee*1000/dcrutil.Amount(size) + relayFee
+		}
+		fee := txrules.FeeForSerializeSize(feePerKb, size)
+		if fee < origFee+txrules.FeeForSerializeSize(relayFee, size) {
+			return errors.E(errors.Invalid, errors.Errorf("fee %v does not "+
+				"exceed the original fee %v by the relay fee", fee, origFee))
+		}
+
+		replacement := tx.Copy()
+		for _, in := range replacement.TxIn {
+			in.SignatureScript = nil
+		}
+		change := replacement.TxOut[changeIndex]
+		change.Value -= int64(fee - origFee)
+		if change.Value < 0 {
+			return errors.E(errors.InsufficientBalance,
+				"change output can not pay the increased fee")
+		}
+		if txrules.IsDustOutput(change, relayFee) {
+			// Removing the dust change output adds its value to
+			// the fee.
+			fee += dcrutil.Amount(change.Value)
+			replacement.TxOut = append(replacement.TxOut[:changeIndex],
+				replacement.TxOut[changeIndex+1:]...)
+		}
+
+		secrets := &secretSource{Manager: w.Manager, addrmgrNs: addrmgrNs}
+		err = txauthor.AddAllInputScripts(replacement, prevScripts, secrets)
+		for _, done := range secrets.doneFuncs {
+			done()
+		}
+		if err != nil {
+			return err
+		}
+
+		err = w.checkHighFees(totalInput, replacement)
+		if err != nil {
+			return err
+		}
+
+		bump = &FeeBump{Tx: replacement, OrigFee: origFee, Fee: fee}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(errors.Opf(opf, txHash), err)
+	}
+
+	replacementHash := bump.Tx.TxHash()
+	op := errors.Opf(opf, &replacementHash)
+	err = validateMsgTx(op, bump.Tx, prevScripts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Relay the replacement before modifying the wallet.  Nodes which do not
+	// accept replacements reject it as a double spend, and the original
+	// transaction is kept.
+	err = n.PublishTransactions(ctx, bump.Tx)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	var watchOutPoints []wire.OutPoint
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		err := w.TxStore.RecordTxReplacement(txmgrNs, txHash, &replacementHash)
+		if err != nil {
+			return err
+		}
+		rec, err := udb.NewTxRecordFromMsgTx(bump.Tx, time.Now())
+		if err != nil {
+			return err
+		}
+		watchOutPoints, err = w.processTransactionRecord(ctx, dbtx, rec, nil, nil)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	if len(watchOutPoints) > 0 {
+		err := n.LoadTxFilter(ctx, false, nil, watchOutPoints)
+		if err != nil {
+			log.Errorf("Failed to watch outpoints: %v", err)
+		}
+	}
+
+	log.Infof("Replaced transaction %v with %v (fee increased from %v to %v)",
+		txHash, &replacementHash, bump.OrigFee, bump.Fee)
+	return bump, nil
+}
+
+// sigScriptSize returns the estimated size of the signature script redeeming
+// an output signed by a single wallet key.  ok is false for other scripts, such
+// as P2SH scripts, whose signature script size is not known.
+func sigScriptSize(version uint16, pkScript []byte) (size int, ok bool) {
+	class := txscript.GetScriptClass(version, pkScript)
+	switch class {
+	case txscript.StakeRevocationTy, txscript.StakeSubChangeTy, txscript.StakeGenTy:
+		var err error
+		class, err = txscript.GetStakeOutSubclass(pkScript)
+		if err != nil {
+			return 0, false
+		}
+	}
+	switch class {
+	case txscript.PubKeyHashTy:
+		return txsizes.RedeemP2PKHSigScriptSize, true
+	case txscript.PubKeyTy:
+		return txsizes.RedeemP2PKSigScriptSize, true
+	}
+	return 0, false
+}
+
+// CPFP bumps the fee of the unmined transaction txHash by publishing a child
+// transaction which spends the wallet's unspent P2PKH outputs of the
+// transaction, together with additional inputs from account when these
+// outputs do not pay for the child fee.  The child pays a fee such that the
+// fee rate of the combined parent and child package is feePerKb, or the
+// wallet's relay fee if zero.  All value is returned to an internal address of
+// account.
+//
+// When the wallet can not calculate the fee paid by the parent, such as when
+// it spends outputs of transactions not recorded by the wallet, the parent fee
+// is assumed to be zero.
+//
+// The wallet must be unlocked to sign the child transaction.
+func (w *Wallet) CPFP(ctx context.Context, n NetworkBackend, txHash *chainhash.Hash,
+	account uint32, feePerKb dcrutil.Amount) (*FeeBump, error) {
+
+	const opf = "wallet.CPFP(%v)"
+
+	heldUnlock, err := w.holdUnlock()
+	if err != nil {
+		return nil, errors.E(errors.Opf(opf, txHash), err)
+	}
+	defer heldUnlock.release()
+
+	relayFee := w.RelayFee()
+	if feePerKb == 0 {
+		feePerKb = relayFee
+	}
+
+	var unlockOutpoints []*wire.OutPoint
+	defer func() {
+		if len(unlockOutpoints) != 0 {
+			w.lockedOutpointMu.Lock()
+			for _, op := range unlockOutpoints {
+				delete(w.lockedOutpoints, *op)
+			}
+			w.lockedOutpointMu.Unlock()
+		}
+	}()
+	ignoreInput := func(op *wire.OutPoint) bool {
+		_, ok := w.lockedOutpoints[*op]
+		return ok
+	}
+
+	var bump *FeeBump
+	var prevScripts [][]byte
+	var totalInput dcrutil.Amount
+	var changeSourceUpdates []func(walletdb.ReadWriteTx) error
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+		details, err := w.TxStore.TxDetails(txmgrNs, txHash)
+		if err != nil {
+			return err
+		}
+		if details.Block.Height != -1 {
+			return errors.E(errors.Invalid, "transaction is mined")
+		}
+		if details.TxType != stake.TxTypeRegular {
+			return errors.E(errors.Invalid, "outputs of stake transactions can not pay for the parent")
+		}
+		parent := &details.MsgTx
+		parentSize := parent.SerializeSize()
+		parentInput, err := w.TxStore.TotalInput(dbtx, parent)
+		if err != nil {
+			return err
+		}
+		var parentFee dcrutil.Amount
+		if parentInput != 0 {
+			parentFee = parentInput - sumOutputValues(parent.TxOut)
+		} else {
+			log.Warnf("Unable to calculate the fee of transaction %v; "+
+				"assuming no fee was paid", txHash)
+		}
+
+		bump = &FeeBump{OrigFee: parentFee}
+		for _, in := range parent.TxIn {
+			prev, err := w.TxStore.TxDetails(txmgrNs, &in.PreviousOutPoint.Hash)
+			if errors.Is(err, errors.NotExist) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if prev.Block.Height == -1 {
+				bump.UnminedAncestors = true
+				break
+			}
+		}
+
+		defer w.lockedOutpointMu.Unlock()
+		w.lockedOutpointMu.Lock()
+
+		parentCredits := new(txauthor.InputDetail)
+		for _, c := range details.Credits {
+			out := parent.TxOut[c.Index]
+			outpoint := wire.NewOutPoint(txHash, c.Index, wire.TxTreeRegular)
+			if c.Spent || ignoreInput(outpoint) ||
+				txscript.GetScriptClass(out.Version, out.PkScript) != txscript.PubKeyHashTy {
+				continue
+			}
+			parentCredits.Amount += c.Amount
+			parentCredits.Inputs = append(parentCredits.Inputs,
+				wire.NewTxIn(outpoint, out.Value, nil))
+			parentCredits.Scripts = append(parentCredits.Scripts, out.PkScript)
+			parentCredits.RedeemScriptSizes = append(parentCredits.RedeemScriptSizes,
+				txsizes.RedeemP2PKHSigScriptSize)
+		}
+		if len(parentCredits.Inputs) == 0 {
+			return errors.E(errors.Invalid, "transaction has no spendable wallet outputs")
+		}
+
+		// Outputs of the parent are always spent.  Additional inputs are
+		// only selected from the account when the parent outputs can not
+		// pay for the child fee.
+		_, tipHeight := w.TxStore.MainChainTip(txmgrNs)
+		sourceImpl := w.TxStore.MakeIgnoredInputSource(txmgrNs, addrmgrNs, account,
+			1, tipHeight, ignoreInput)
+		inputSource := func(target dcrutil.Amount) (*txauthor.InputDetail, error) {
+			if target <= parentCredits.Amount {
+				return parentCredits, nil
+			}
+			extra, err := sourceImpl.SelectInputs(target - parentCredits.Amount)
+			if err != nil {
+				return nil, err
+			}
+			detail := &txauthor.InputDetail{Amount: parentCredits.Amount + extra.Amount}
+			detail.Inputs = append(detail.Inputs, parentCredits.Inputs...)
+			detail.Inputs = append(detail.Inputs, extra.Inputs...)
+			detail.Scripts = append(detail.Scripts, parentCredits.Scripts...)
+			detail.Scripts = append(detail.Scripts, extra.Scripts...)
+			detail.RedeemScriptSizes = append(detail.RedeemScriptSizes, parentCredits.RedeemScriptSizes...)
+			detail.RedeemScriptSizes = append(detail.RedeemScriptSizes, extra.RedeemScriptSizes...)
+			return detail, nil
+		}
+		changeSource := &p2PKHChangeSource{
+			persist: w.deferPersistReturnedChild(ctx, &changeSourceUpdates),
+			account: account,
+			wallet:  w,
+			ctx:     ctx,
+		}
+		changeScriptSize := changeSource.ScriptSize()
+
+		var target dcrutil.Amount
+		for {
+			inputDetail, err := inputSource(target)
+			if err != nil {
+				return err
+			}
+			if inputDetail.Amount < target {
+				return errors.E(errors.InsufficientBalance)
+			}
+
+			size := txsizes.EstimateSerializeSize(inputDetail.RedeemScriptSizes,
+				nil, changeScriptSize)
+			fee := txrules.FeeForSerializeSize(feePerKb, parentSize+size) - parentFee
+			if minFee := txrules.FeeForSerializeSize(relayFee, size); fee < minFee {
+				fee = minFee
+			}
+			change := inputDetail.Amount - fee
+			if change <= 0 || txrules.IsDustAmount(change, changeScriptSize, relayFee) {
+				// A change amount of the relay fee is never dust
+				// for P2PKH scripts.
+				target = fee + relayFee
+				continue
+			}
+
+			changeScript, changeScriptVersion, err := changeSource.Script()
+			if err != nil {
+				return err
+			}
+			bump.Tx = &wire.MsgTx{
+				SerType: wire.TxSerializeFull,
+				Version: 1,
+				TxIn:    inputDetail.Inputs,
+				TxOut: []*wire.TxOut{{
+					Value:    int64(change),
+					Version:  changeScriptVersion,
+					PkScript: changeScript,
+				}},
+			}
+			bump.Fee = fee
+			prevScripts = inputDetail.Scripts
+			totalInput = inputDetail.Amount
+			break
+		}
+		for _, in := range bump.Tx.TxIn {
+			w.lockedOutpoints[in.PreviousOutPoint] = struct{}{}
+			unlockOutpoints = append(unlockOutpoints, &in.PreviousOutPoint)
+		}
+
+		secrets := &secretSource{Manager: w.Manager, addrmgrNs: addrmgrNs}
+		err = txauthor.AddAllInputScripts(bump.Tx, prevScripts, secrets)
+		for _, done := range secrets.doneFuncs {
+			done()
+		}
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(errors.Opf(opf, txHash), err)
+	}
+
+	childHash := bump.Tx.TxHash()
+	op := errors.Opf(opf, &childHash)
+	err = validateMsgTx(op, bump.Tx, prevScripts)
+	if err != nil {
+		return nil, err
+	}
+	err = w.checkHighFees(totalInput, bump.Tx)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+
+	if bump.UnminedAncestors {
+		log.Warnf("Transaction %v spends outputs of other unmined "+
+			"transactions which are not paid for by child %v", txHash, &childHash)
+	}
+
+	rec, err := udb.NewTxRecordFromMsgTx(bump.Tx, time.Now())
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	var watch []wire.OutPoint
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		for _, up := range changeSourceUpdates {
+			err := up(dbtx)
+			if err != nil {
+				return err
+			}
+		}
+		var err error
+		watch, err = w.processTransactionRecord(ctx, dbtx, rec, nil, nil)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	err = n.PublishTransactions(ctx, bump.Tx)
+	if err != nil {
+		log.Errorf("Abandoning transaction %v which failed to publish", &childHash)
+		if err := w.AbandonTransaction(ctx, &childHash); err != nil {
+			log.Errorf("Cannot abandon %v: %v", &childHash, err)
+		}
+		return nil, errors.E(op, err)
+	}
+
+	if len(watch) > 0 {
+		err := n.LoadTxFilter(ctx, false, nil, watch)
+		if err != nil {
+			log.Errorf("Failed to watch outpoints: %v", err)
+		}
+	}
+
+	log.Infof("Published child transaction %v paying %v for parent %v",
+		&childHash, bump.Fee, txHash)
+	return bump, nil
+}
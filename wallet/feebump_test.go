@@ -0,0 +1,289 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/txscript/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3/internal/txsizes"
+	"github.com/decred/dcrwallet/wallet/v3/txrules"
+	"github.com/decred/dcrwallet/wallet/v3/udb"
+	"github.com/decred/dcrwallet/wallet/v3/walletdb"
+)
+
+// feeBumpTestWallet returns an unlocked wallet with blocks 1 and 2 attached to
+// the main chain, and a function recording transactions in the wallet, mined
+// in block 2 when mined is true.
+func feeBumpTestWallet(t *testing.T) (*Wallet, func(tx *wire.MsgTx, mined bool) *chainhash.Hash, func()) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	err := w.Unlock(ctx, testPrivPass, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tg := maketg(t, cfg.Params)
+	tw := &tw{t, w}
+	forest := new(SidechainForest)
+	blocks := []*gblock{tg.createBlockOne("block-one")}
+	blocks = append(blocks, tg.nextBlock("block-2", nil, nil))
+	for _, b := range blocks {
+		mustAddBlockNode(t, forest, b.BlockNode)
+	}
+	tw.chainSwitch(forest, tw.evaluateBestChain(forest, len(blocks), blocks[len(blocks)-1].Hash))
+
+	record := func(tx *wire.MsgTx, mined bool) *chainhash.Hash {
+		rec, err := udb.NewTxRecordFromMsgTx(tx, time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		var header *wire.BlockHeader
+		var blockMeta *udb.BlockMeta
+		if mined {
+			b := blocks[1]
+			header = b.BlockNode.Header
+			blockMeta = &udb.BlockMeta{
+				Block: udb.Block{Hash: *b.Hash, Height: int32(header.Height)},
+				Time:  header.Timestamp,
+			}
+		}
+		err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+			_, err := w.processTransactionRecord(ctx, dbtx, rec, header, blockMeta)
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &rec.Hash
+	}
+	return w, record, teardown
+}
+
+func TestCPFP(t *testing.T) {
+	ctx := context.Background()
+	w, record, teardown := feeBumpTestWallet(t)
+	defer teardown()
+
+	script := func() []byte {
+		addr, err := w.NewExternalAddress(ctx, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		script, _, err := addressScript(addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return script
+	}
+	spend := func(prevHash *chainhash.Hash, index uint32, prevValue, value int64, pkScript []byte) *wire.MsgTx {
+		tx := wire.NewMsgTx()
+		tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(prevHash, index, wire.TxTreeRegular), prevValue, nil))
+		tx.AddTxOut(wire.NewTxOut(value, pkScript))
+		return tx
+	}
+
+	// A mined transaction pays the wallet two outputs.
+	unknownHash := chainhash.HashH([]byte("unknown"))
+	grandparent := wire.NewMsgTx()
+	grandparent.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&unknownHash, 0, wire.TxTreeRegular), 20e8, nil))
+	grandparent.AddTxOut(wire.NewTxOut(10e8, script()))
+	grandparent.AddTxOut(wire.NewTxOut(10e8, script()))
+	grandparentHash := record(grandparent, true)
+
+	// The unmined parent spends one output of the mined transaction and
+	// pays a fee of 10000 atoms.
+	const parentFee = 10000
+	parent := spend(grandparentHash, 0, 10e8, 10e8-parentFee, script())
+	parentHash := record(parent, false)
+
+	const feePerKb = 1e5
+	bump, err := w.CPFP(ctx, mockNetwork{}, parentHash, 0, feePerKb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bump.OrigFee != parentFee {
+		t.Errorf("parent fee %v, want %v", bump.OrigFee, dcrutil.Amount(parentFee))
+	}
+	if bump.UnminedAncestors {
+		t.Errorf("parent spending mined outputs has unmined ancestors")
+	}
+	child := bump.Tx
+	if len(child.TxIn) != 1 || child.TxIn[0].PreviousOutPoint.Hash != *parentHash {
+		t.Fatalf("child does not spend only the parent output")
+	}
+	if len(child.TxOut) != 1 || child.TxOut[0].Value != int64(10e8-parentFee-bump.Fee) {
+		t.Fatalf("child does not return the parent output less the fee")
+	}
+
+	// The child pays for the estimated size of the package at the fee rate,
+	// less the fee paid by the parent.
+	parentSize := parent.SerializeSize()
+	childSize := txsizes.EstimateSerializeSize([]int{txsizes.RedeemP2PKHSigScriptSize},
+		nil, txsizes.P2PKHPkScriptSize)
+	wantFee := txrules.FeeForSerializeSize(feePerKb, parentSize+childSize) - parentFee
+	if bump.Fee != wantFee {
+		t.Errorf("child fee %v, want %v", bump.Fee, wantFee)
+	}
+	if child.SerializeSize() > childSize {
+		t.Errorf("child size %d exceeds the estimate %d", child.SerializeSize(), childSize)
+	}
+	packageFee := parentFee + bump.Fee
+	packageSize := parentSize + child.SerializeSize()
+	if packageFee < txrules.FeeForSerializeSize(feePerKb, packageSize) {
+		t.Errorf("package fee %v does not pay %v/kB for %d bytes",
+			packageFee, dcrutil.Amount(feePerKb), packageSize)
+	}
+
+	// The child pays at least the relay fee for its own size when the parent
+	// already pays the package fee rate.
+	richParent := spend(grandparentHash, 1, 10e8, 10e8-1e6, script())
+	richParentHash := record(richParent, false)
+	bump, err = w.CPFP(ctx, mockNetwork{}, richParentHash, 0, feePerKb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if minFee := txrules.FeeForSerializeSize(w.RelayFee(), childSize); bump.Fee != minFee {
+		t.Errorf("child of parent paying the fee rate pays %v, want relay fee %v", bump.Fee, minFee)
+	}
+
+	// Parents spending outputs of other unmined transactions are bumped, but
+	// report that their unmined ancestors are not paid for.
+	ancestor := spend(&unknownHash, 1, 10e8, 5e8, script())
+	ancestor.AddTxOut(wire.NewTxOut(5e8, script()))
+	ancestorHash := record(ancestor, false)
+	descendant := spend(ancestorHash, 0, 5e8, 5e8-parentFee, script())
+	descendantHash := record(descendant, false)
+	bump, err = w.CPFP(ctx, mockNetwork{}, descendantHash, 0, feePerKb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bump.UnminedAncestors {
+		t.Errorf("parent spending an unmined output does not report unmined ancestors")
+	}
+
+	// Mined parents, parents unknown to the wallet and parents with no
+	// spendable wallet outputs are rejected.
+	if _, err := w.CPFP(ctx, mockNetwork{}, grandparentHash, 0, feePerKb); !errors.Is(err, errors.Invalid) {
+		t.Errorf("mined parent: expected Invalid, got %v", err)
+	}
+	if _, err := w.CPFP(ctx, mockNetwork{}, &unknownHash, 0, feePerKb); !errors.Is(err, errors.NotExist) {
+		t.Errorf("unknown parent: expected NotExist, got %v", err)
+	}
+	external, err := dcrutil.NewAddressPubKeyHash(bytes.Repeat([]byte{1}, 20),
+		w.chainParams, dcrec.STEcdsaSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	externalScript, _, err := addressScript(external)
+	if err != nil {
+		t.Fatal(err)
+	}
+	payment := spend(ancestorHash, 1, 5e8, 5e8-parentFee, externalScript)
+	paymentHash := record(payment, false)
+	if _, err := w.CPFP(ctx, mockNetwork{}, paymentHash, 0, feePerKb); !errors.Is(err, errors.Invalid) {
+		t.Errorf("parent without wallet outputs: expected Invalid, got %v", err)
+	}
+}
+
+func TestBumpFee(t *testing.T) {
+	ctx := context.Background()
+	w, record, teardown := feeBumpTestWallet(t)
+	defer teardown()
+
+	addrScript := func(addr dcrutil.Address) []byte {
+		script, _, err := addressScript(addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return script
+	}
+	external, err := dcrutil.NewAddressPubKeyHash(bytes.Repeat([]byte{1}, 20),
+		w.chainParams, dcrec.STEcdsaSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fundAddr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	changeAddr, err := w.NewInternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A mined transaction pays a P2PKH wallet address and a P2SH address of
+	// an imported 1-of-1 multisig script of a wallet key.
+	pubKeyAddr, err := w.PubKeyForAddress(ctx, fundAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	secpPubKey, err := dcrutil.NewAddressSecpPubKey(pubKeyAddr.SerializeCompressed(), w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	multisig, err := txscript.MultiSigScript([]*dcrutil.AddressSecpPubKey{secpPubKey}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.ImportScript(ctx, multisig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scriptAddr, err := dcrutil.NewAddressScriptHash(multisig, w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unknownHash := chainhash.HashH([]byte("unknown"))
+	funding := wire.NewMsgTx()
+	funding.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&unknownHash, 0, wire.TxTreeRegular), 20e8, nil))
+	funding.AddTxOut(wire.NewTxOut(10e8, addrScript(fundAddr)))
+	funding.AddTxOut(wire.NewTxOut(10e8, addrScript(scriptAddr)))
+	fundingHash := record(funding, true)
+
+	// Unmined payments spending each output return change to the wallet.
+	const origFee = 10000
+	payment := func(index uint32) *wire.MsgTx {
+		tx := wire.NewMsgTx()
+		tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(fundingHash, index, wire.TxTreeRegular), 10e8, nil))
+		tx.AddTxOut(wire.NewTxOut(1e8, addrScript(external)))
+		tx.AddTxOut(wire.NewTxOut(9e8-origFee, addrScript(changeAddr)))
+		return tx
+	}
+	p2pkhPayment := payment(0)
+	p2pkhHash := record(p2pkhPayment, false)
+	p2shHash := record(payment(1), false)
+
+	// The replacement pays for the estimated size of its P2PKH input.
+	const feePerKb = 3e5
+	bump, err := w.BumpFee(ctx, mockNetwork{}, p2pkhHash, feePerKb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	size := txsizes.EstimateSerializeSize([]int{txsizes.RedeemP2PKHSigScriptSize},
+		p2pkhPayment.TxOut, 0)
+	if wantFee := txrules.FeeForSerializeSize(feePerKb, size); bump.Fee != wantFee {
+		t.Errorf("replacement fee %v, want %v", bump.Fee, wantFee)
+	}
+	if bump.Tx.SerializeSize() > size {
+		t.Errorf("replacement size %d exceeds the estimate %d", bump.Tx.SerializeSize(), size)
+	}
+
+	// The size of the P2SH input can not be estimated.
+	_, err = w.BumpFee(ctx, mockNetwork{}, p2shHash, feePerKb)
+	if !errors.Is(err, errors.Invalid) || !strings.Contains(err.Error(), "can not be estimated") {
+		t.Errorf("P2SH input: expected Invalid estimation error, got %v", err)
+	}
+}
@@ -0,0 +1,321 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/decred/dcrd/blockchain/stake/v2"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/gcs"
+	"github.com/decred/dcrd/gcs/blockcf"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/errors/v2"
+)
+
+// MaxFeeEstimateTarget is the maximum number of blocks which fees may be
+// estimated for.
+const MaxFeeEstimateTarget = 32
+
+const (
+	// feeEstDecay is the factor every recorded confirmation is weighted by
+	// after each block, causing data from approximately the last 500 blocks
+	// (~two days) to be considered.
+	feeEstDecay = 0.998
+
+	// Fee rates (in atoms/kB) are grouped into exponentially spaced buckets
+	// between the minimum and maximum fee rates.
+	feeEstMinFeeRate    = 1e3
+	feeEstMaxFeeRate    = 1e8
+	feeEstBucketSpacing = 1.1
+
+	// feeEstSufficientTxs is the decayed number of transactions a range of
+	// buckets must contain to be evaluated.
+	feeEstSufficientTxs = 2.0
+
+	// feeEstMaxTrackedTxs limits the number of unmined transactions tracked
+	// at once.
+	feeEstMaxTrackedTxs = 5000
+
+	// Proportions of transactions which must be mined within the target for
+	// economical and conservative estimates.
+	feeEstSuccessEconomical   = 0.85
+	feeEstSuccessConservative = 0.95
+)
+
+// feeEstTx describes an unmined transaction tracked by the fee estimator.
+type feeEstTx struct {
+	height  int32 // main chain tip height when first observed
+	bucket  int
+	feeRate float64
+	spends  []byte // filter entry of the first previous outpoint
+}
+
+// feeEstimator records the number of blocks observed unmined transactions
+// take to be mined, grouped by their fee rate, to estimate the fee rate
+// required to be mined within some number of blocks.  Relevant transactions
+// and, when syncing with SPV, all transactions relayed by peers are observed.
+// Besides the relevant transactions of each block, transactions are detected
+// as mined when the block's committed filter matches the first outpoint they
+// spend.
+type feeEstimator struct {
+	mu     sync.Mutex
+	height int32
+
+	bounds    []float64   // upper fee rate bound of each bucket
+	txs       []float64   // decayed count of mined or expired transactions
+	feeRates  []float64   // decayed sum of the fee rates of txs
+	confirmed [][]float64 // decayed count of txs mined within index+1 blocks
+
+	tracked map[chainhash.Hash]feeEstTx
+}
+
+func newFeeEstimator(height int32) *feeEstimator {
+	var bounds []float64
+	for b := feeEstMinFeeRate; b < feeEstMaxFeeRate; b *= feeEstBucketSpacing {
+		bounds = append(bounds, b)
+	}
+	bounds = append(bounds, math.Inf(1))
+	confirmed := make([][]float64, MaxFeeEstimateTarget)
+	for i := range confirmed {
+		confirmed[i] = make([]float64, len(bounds))
+	}
+	return &feeEstimator{
+		height:    height,
+		bounds:    bounds,
+		txs:       make([]float64, len(bounds)),
+		feeRates:  make([]float64, len(bounds)),
+		confirmed: confirmed,
+		tracked:   make(map[chainhash.Hash]feeEstTx),
+	}
+}
+
+// observe begins tracking an unmined transaction.  Votes and revocations, which
+// are not prioritized by fee, and transactions without input values are
+// ignored.  The input values of transactions not relevant to the wallet are
+// not verified, so a misreported fee only affects its own bucket.
+func (e *feeEstimator) observe(tx *wire.MsgTx, txHash *chainhash.Hash) {
+	if stake.IsSSGen(tx) || stake.IsSSRtx(tx) {
+		return
+	}
+	var fee int64
+	for _, in := range tx.TxIn {
+		if in.ValueIn < 0 {
+			return
+		}
+		fee += in.ValueIn
+	}
+	for _, out := range tx.TxOut {
+		fee -= out.Value
+	}
+	if fee < 0 {
+		return
+	}
+	feeRate := float64(fee) * 1000 / float64(tx.SerializeSize())
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if _, ok := e.tracked[*txHash]; ok || len(e.tracked) >= feeEstMaxTrackedTxs {
+		return
+	}
+	var spends blockcf.Entries
+	spends.AddOutPoint(&tx.TxIn[0].PreviousOutPoint)
+	e.tracked[*txHash] = feeEstTx{
+		height:  e.height,
+		bucket:  sort.SearchFloat64s(e.bounds, feeRate),
+		feeRate: feeRate,
+		spends:  spends[0],
+	}
+}
+
+// processBlock records the confirmation of tracked transactions mined in a
+// new main chain block.  These are the relevant transactions txs of the block
+// and, if filter is not nil, the transactions whose first previous outpoint
+// is matched by the block's regular committed filter.  A filter match may be
+// a false positive or a conflicting transaction, which are rare enough to not
+// skew estimates.  Transactions which remained unmined for more blocks than
+// the maximum estimate target are recorded as failures and are no longer
+// tracked.
+func (e *feeEstimator) processBlock(height int32, txs []*wire.MsgTx, blockHash *chainhash.Hash, filter *gcs.Filter) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if height > e.height {
+		decay := math.Pow(feeEstDecay, float64(height-e.height))
+		for b := range e.bounds {
+			e.txs[b] *= decay
+			e.feeRates[b] *= decay
+			for t := range e.confirmed {
+				e.confirmed[t][b] *= decay
+			}
+		}
+		e.height = height
+	}
+
+	mined := make(map[chainhash.Hash]feeEstTx)
+	for _, tx := range txs {
+		txHash := tx.TxHash()
+		if t, ok := e.tracked[txHash]; ok {
+			mined[txHash] = t
+		}
+	}
+	if filter != nil && filter.N() != 0 {
+		key := blockcf.Key(blockHash)
+		for txHash, t := range e.tracked {
+			if _, ok := mined[txHash]; !ok && filter.Match(key, t.spends) {
+				mined[txHash] = t
+			}
+		}
+	}
+	for txHash, t := range mined {
+		delete(e.tracked, txHash)
+		blocks := int(height - t.height)
+		if blocks < 1 {
+			blocks = 1
+		}
+		e.txs[t.bucket]++
+		e.feeRates[t.bucket] += t.feeRate
+		for i := blocks - 1; i < len(e.confirmed); i++ {
+			e.confirmed[i][t.bucket]++
+		}
+	}
+
+	for txHash, t := range e.tracked {
+		if e.height-t.height > MaxFeeEstimateTarget {
+			delete(e.tracked, txHash)
+			e.txs[t.bucket]++
+			e.feeRates[t.bucket] += t.feeRate
+		}
+	}
+}
+
+// estimate returns the average fee rate of the lowest range of buckets in
+// which at least the success ratio of transactions were mined within target
+// blocks.  Buckets are grouped, beginning with the highest fee rates, until
+// each group contains enough transactions to be evaluated.  Transactions which
+// are still unmined after target blocks count against their bucket.
+func (e *feeEstimator) estimate(target int32, success float64) (float64, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	pending := make([]float64, len(e.bounds))
+	for _, t := range e.tracked {
+		if e.height-t.height >= target {
+			pending[t.bucket]++
+		}
+	}
+
+	confirmed := e.confirmed[target-1]
+	var groupTxs, groupPending, groupConfirmed, groupFeeRates float64
+	var bestTxs, bestFeeRates float64
+	for b := len(e.bounds) - 1; b >= 0; b-- {
+		groupTxs += e.txs[b]
+		groupPending += pending[b]
+		groupConfirmed += confirmed[b]
+		groupFeeRates += e.feeRates[b]
+		if groupTxs+groupPending < feeEstSufficientTxs {
+			continue
+		}
+		if groupConfirmed/(groupTxs+groupPending) < success {
+			break
+		}
+		bestTxs, bestFeeRates = groupTxs, groupFeeRates
+		groupTxs, groupPending, groupConfirmed, groupFeeRates = 0, 0, 0, 0
+	}
+	if bestTxs == 0 {
+		return 0, false
+	}
+	return bestFeeRates / bestTxs, true
+}
+
+// ObserveMempoolTxs records the fee rates of unmined transactions relayed by
+// the network, which need not be relevant to the wallet, for fee estimation.
+func (w *Wallet) ObserveMempoolTxs(txs []*wire.MsgTx) {
+	for _, tx := range txs {
+		txHash := tx.TxHash()
+		w.feeEst.observe(tx, &txHash)
+	}
+}
+
+// EstimateFee estimates the fee rate (per kB of serialized transaction)
+// required for a transaction to be mined within target blocks, based on the
+// number of blocks recent unmined transactions observed by the wallet took to
+// be mined.
+// When conservative is true, a higher proportion of transactions paying the
+// fee rate must have been mined within the target.  If there is insufficient
+// data for the target, larger targets are considered, and the target the
+// estimate is valid for is returned.  The estimate is never less than the
+// wallet's relay fee.
+//
+// Errors with the NotExist kind are returned when no estimate can be made.
+func (w *Wallet) EstimateFee(target int32, conservative bool) (feePerKb dcrutil.Amount, blocks int32, err error) {
+	const op errors.Op = "wallet.EstimateFee"
+	if target < 1 || target > MaxFeeEstimateTarget {
+		return 0, 0, errors.E(op, errors.Invalid,
+			errors.Errorf("target must be between 1 and %d", MaxFeeEstimateTarget))
+	}
+	success := feeEstSuccessEconomical
+	if conservative {
+		success = feeEstSuccessConservative
+	}
+	for blocks = target; blocks <= MaxFeeEstimateTarget; blocks++ {
+		feeRate, ok := w.feeEst.estimate(blocks, success)
+		if !ok {
+			continue
+		}
+		feePerKb = dcrutil.Amount(math.Ceil(feeRate))
+		if relayFee := w.RelayFee(); feePerKb < relayFee {
+			feePerKb = relayFee
+		}
+		return feePerKb, blocks, nil
+	}
+	return 0, 0, errors.E(op, errors.NotExist, "insufficient data to estimate fee")
+}
+
+// FeeEstimateTarget returns the number of blocks which transactions created by
+// the wallet are estimated to be mined within, or zero if the static relay fee
+// is used instead.
+func (w *Wallet) FeeEstimateTarget() int32 {
+	w.relayFeeMu.Lock()
+	target := w.feeEstTarget
+	w.relayFeeMu.Unlock()
+	return target
+}
+
+// SetFeeEstimateTarget sets the number of blocks which sent transactions and
+// ticket purchase split transactions should be mined within.  When the fee
+// rate can not be estimated, the relay fee is used.  A zero target always uses
+// the relay fee.
+func (w *Wallet) SetFeeEstimateTarget(target int32) error {
+	const op errors.Op = "wallet.SetFeeEstimateTarget"
+	if target < 0 || target > MaxFeeEstimateTarget {
+		return errors.E(op, errors.Invalid,
+			errors.Errorf("target must be between 0 and %d", MaxFeeEstimateTarget))
+	}
+	w.relayFeeMu.Lock()
+	w.feeEstTarget = target
+	w.relayFeeMu.Unlock()
+	return nil
+}
+
+// txFeeRate returns the fee rate used to create transactions paying the
+// default fee.  This is the estimated fee rate for the wallet's fee estimate
+// target when enabled and an estimate is available, and the relay fee
+// otherwise.
+func (w *Wallet) txFeeRate() dcrutil.Amount {
+	target := w.FeeEstimateTarget()
+	if target == 0 {
+		return w.RelayFee()
+	}
+	feePerKb, _, err := w.EstimateFee(target, false)
+	if err != nil {
+		log.Debugf("Using relay fee for new transaction: %v", err)
+		return w.RelayFee()
+	}
+	return feePerKb
+}
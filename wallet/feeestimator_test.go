@@ -0,0 +1,126 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/gcs/blockcf"
+	"github.com/decred/dcrd/wire"
+)
+
+// feeEstTestTx returns a transaction paying feePerKb (approximately) and a
+// unique output value.
+func feeEstTestTx(feePerKb int64, n int) *wire.MsgTx {
+	tx := wire.NewMsgTx()
+	prevHash := chainhash.HashH([]byte{byte(n), byte(n >> 8)})
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&prevHash, 0, 0), 0, make([]byte, 108)))
+	tx.AddTxOut(wire.NewTxOut(1e8+int64(n), make([]byte, 25)))
+	fee := feePerKb * int64(tx.SerializeSize()) / 1000
+	tx.TxIn[0].ValueIn = 1e8 + int64(n) + fee
+	return tx
+}
+
+func TestFeeEstimator(t *testing.T) {
+	e := newFeeEstimator(100)
+	if _, ok := e.estimate(1, feeEstSuccessEconomical); ok {
+		t.Fatal("estimate without data")
+	}
+
+	// Transactions paying 1e5 atoms/kB are mined in the next block, while
+	// transactions paying 1e4 atoms/kB take five blocks.
+	height := int32(100)
+	n := 0
+	for round := 0; round < 10; round++ {
+		fast := feeEstTestTx(1e5, n)
+		slow := feeEstTestTx(1e4, n+1)
+		n += 2
+		fastHash, slowHash := fast.TxHash(), slow.TxHash()
+		e.observe(fast, &fastHash)
+		e.observe(slow, &slowHash)
+		height++
+		e.processBlock(height, []*wire.MsgTx{fast}, nil, nil)
+		for i := 0; i < 3; i++ {
+			height++
+			e.processBlock(height, nil, nil, nil)
+		}
+		height++
+		e.processBlock(height, []*wire.MsgTx{slow}, nil, nil)
+	}
+
+	feeRate, ok := e.estimate(1, feeEstSuccessEconomical)
+	if !ok {
+		t.Fatal("no estimate for 1 block target")
+	}
+	if feeRate < 9e4 || feeRate > 1.1e5 {
+		t.Errorf("1 block estimate %v, expected ~1e5", feeRate)
+	}
+	feeRate, ok = e.estimate(5, feeEstSuccessEconomical)
+	if !ok {
+		t.Fatal("no estimate for 5 block target")
+	}
+	if feeRate < 9e3 || feeRate > 1.1e4 {
+		t.Errorf("5 block estimate %v, expected ~1e4", feeRate)
+	}
+
+	// Transactions without input values are not tracked.
+	tx := feeEstTestTx(1e4, n)
+	tx.TxIn[0].ValueIn = wire.NullValueIn
+	txHash := tx.TxHash()
+	e.observe(tx, &txHash)
+	if _, ok := e.tracked[txHash]; ok {
+		t.Error("tracked transaction without input values")
+	}
+
+	// Transactions never mined count against their fee rate.
+	for i := 0; i < 20; i++ {
+		tx := feeEstTestTx(1e5, n+i)
+		txHash := tx.TxHash()
+		e.observe(tx, &txHash)
+	}
+	for i := 0; i <= MaxFeeEstimateTarget; i++ {
+		height++
+		e.processBlock(height, nil, nil, nil)
+	}
+	if len(e.tracked) != 0 {
+		t.Errorf("%d expired transactions are still tracked", len(e.tracked))
+	}
+	if _, ok := e.estimate(1, feeEstSuccessEconomical); ok {
+		t.Error("estimate for 1 block target after unmined transactions")
+	}
+}
+
+func TestFeeEstimatorFilterMatch(t *testing.T) {
+	e := newFeeEstimator(100)
+	mined := feeEstTestTx(1e5, 0)
+	unmined := feeEstTestTx(1e5, 1)
+	minedHash, unminedHash := mined.TxHash(), unmined.TxHash()
+	e.observe(mined, &minedHash)
+	e.observe(unmined, &unminedHash)
+
+	// A transaction which is not relevant to the wallet is recorded as mined
+	// when the block filter commits to an outpoint it spends.
+	coinbase := wire.NewMsgTx()
+	coinbase.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: wire.MaxPrevOutIndex}, 0, nil))
+	coinbase.AddTxOut(wire.NewTxOut(0, make([]byte, 25)))
+	block := &wire.MsgBlock{Transactions: []*wire.MsgTx{coinbase, mined}}
+	filter, err := blockcf.Regular(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blockHash := block.BlockHash()
+	bucket := e.tracked[minedHash].bucket
+	e.processBlock(101, nil, &blockHash, filter)
+	if _, ok := e.tracked[minedHash]; ok {
+		t.Error("transaction matched by the block filter is still tracked")
+	}
+	if _, ok := e.tracked[unminedHash]; !ok {
+		t.Error("transaction not matched by the block filter is no longer tracked")
+	}
+	if e.confirmed[0][bucket] != 1 {
+		t.Error("confirmation of matched transaction was not recorded")
+	}
+}
@@ -184,3 +184,25 @@ func EstimateInputSize(scriptSize int) int {
 func EstimateOutputSize(scriptSize int) int {
 	return 8 + 2 + wire.VarIntSerializeSize(uint64(scriptSize)) + scriptSize
 }
+
+// RedeemP2SHMultiSigScriptSize returns the worst case serialize size of a
+// transaction input script that redeems a P2SH output of an m-of-n multisig
+// redeem script with compressed pubkeys.  It is calculated as:
+//
+//   - m * (OP_DATA_73 + 73-byte signature)
+//   - the data push opcode(s) of the redeem script
+//   - OP_m
+//   - n * (OP_DATA_33 + 33 bytes serialized compressed pubkey)
+//   - OP_n
+//   - OP_CHECKMULTISIG
+func RedeemP2SHMultiSigScriptSize(m, n int) int {
+	redeemScriptSize := 1 + n*(1+33) + 1 + 1
+	pushSize := 1
+	switch {
+	case redeemScriptSize > 0xff:
+		pushSize = 3 // OP_PUSHDATA2 + 2 byte length
+	case redeemScriptSize >= 0x4c:
+		pushSize = 2 // OP_PUSHDATA1 + 1 byte length
+	}
+	return m*(1+73) + pushSize + redeemScriptSize
+}
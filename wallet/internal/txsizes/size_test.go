@@ -68,3 +68,20 @@ func TestEstimateSerializeSize(t *testing.T) {
 		}
 	}
 }
+
+func TestRedeemP2SHMultiSigScriptSize(t *testing.T) {
+	tests := []struct {
+		m, n int
+		size int
+	}{
+		{1, 1, 74 + 1 + 37},
+		{2, 3, 148 + 2 + 105},
+		{15, 15, 1110 + 3 + 513},
+	}
+	for _, test := range tests {
+		size := RedeemP2SHMultiSigScriptSize(test.m, test.n)
+		if size != test.size {
+			t.Errorf("%d-of-%d: got %v expected %v", test.m, test.n, size, test.size)
+		}
+	}
+}
@@ -0,0 +1,263 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3/udb"
+	"github.com/decred/dcrwallet/wallet/v3/walletdb"
+)
+
+// InvoiceStatus describes the payment of an invoice.
+type InvoiceStatus int8
+
+// Invoice statuses.
+const (
+	// InvoiceUnpaid invoices have not received any payment.
+	InvoiceUnpaid InvoiceStatus = iota
+
+	// InvoicePartiallyPaid invoices have received less than the requested
+	// amount.
+	InvoicePartiallyPaid
+
+	// InvoicePaid invoices have received exactly the requested amount, or
+	// any payment when no amount was requested.
+	InvoicePaid
+
+	// InvoiceOverpaid invoices have received more than the requested
+	// amount.
+	InvoiceOverpaid
+
+	// InvoiceExpired invoices were not fully paid before their expiry.
+	InvoiceExpired
+)
+
+// String returns the name of the status.
+func (s InvoiceStatus) String() string {
+	switch s {
+	case InvoiceUnpaid:
+		return "unpaid"
+	case InvoicePartiallyPaid:
+		return "partiallypaid"
+	case InvoicePaid:
+		return "paid"
+	case InvoiceOverpaid:
+		return "overpaid"
+	case InvoiceExpired:
+		return "expired"
+	default:
+		return "unknown"
+	}
+}
+
+// Invoice is a request for payment of an amount to a fresh address of an
+// account.  Received is the total of payments with at least the minimum number
+// of confirmations the invoice was queried with, and Pending is the total of
+// payments with fewer confirmations.
+type Invoice struct {
+	ID       uint64
+	Account  uint32
+	Address  dcrutil.Address
+	Amount   dcrutil.Amount // Zero accepts any amount
+	Memo     string
+	Created  time.Time
+	Expires  time.Time // Zero when the invoice does not expire
+	Payments []InvoicePayment
+	Received dcrutil.Amount
+	Pending  dcrutil.Amount
+	Status   InvoiceStatus
+}
+
+// InvoicePayment is a transaction output paying an invoice.
+type InvoicePayment struct {
+	TxHash        chainhash.Hash
+	Index         uint32
+	Amount        dcrutil.Amount
+	BlockHeight   int32 // -1 when unmined
+	Confirmations int32
+}
+
+// Minimum number of confirmations of the payments counted by invoice
+// notifications.
+const invoiceNotificationMinConf = 1
+
+// CreateInvoice requests payment of an amount to a new external address of an
+// account, returned by NewExternalAddress with the call options.  A zero amount
+// accepts any payment, and a zero expiry creates an invoice which never
+// expires.  Addresses may only be requested by a single invoice, so wrapping
+// gap policies may fail with an Exist error.
+func (w *Wallet) CreateInvoice(ctx context.Context, account uint32, amount dcrutil.Amount, memo string,
+	expiry time.Duration, callOpts ...NextAddressCallOption) (*Invoice, error) {
+
+	const op errors.Op = "wallet.CreateInvoice"
+	if amount < 0 {
+		return nil, errors.E(op, errors.Invalid, "negative invoice amount")
+	}
+	if expiry < 0 {
+		return nil, errors.E(op, errors.Invalid, "negative invoice expiry")
+	}
+	addr, err := w.NewExternalAddress(ctx, account, callOpts...)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	now := time.Now()
+	rec := &udb.Invoice{
+		Account: account,
+		Address: addr.Address(),
+		Amount:  amount,
+		Memo:    memo,
+		Created: now.Unix(),
+	}
+	if expiry != 0 {
+		rec.Expires = now.Add(expiry).Unix()
+	}
+	var inv *Invoice
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		err := w.TxStore.CreateInvoice(txmgrNs, rec)
+		if err != nil {
+			return err
+		}
+		inv, err = w.invoice(dbtx, rec, 0, now)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return inv, nil
+}
+
+// Invoice returns an invoice by ID, counting payments with at least minConf
+// confirmations as received.
+func (w *Wallet) Invoice(ctx context.Context, id uint64, minConf int32) (*Invoice, error) {
+	const op errors.Op = "wallet.Invoice"
+	var inv *Invoice
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		rec, err := w.TxStore.Invoice(txmgrNs, id)
+		if err != nil {
+			return err
+		}
+		inv, err = w.invoice(dbtx, rec, minConf, time.Now())
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return inv, nil
+}
+
+// Invoices returns every invoice, ordered by ID, counting payments with at
+// least minConf confirmations as received.
+func (w *Wallet) Invoices(ctx context.Context, minConf int32) ([]*Invoice, error) {
+	const op errors.Op = "wallet.Invoices"
+	var invs []*Invoice
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		recs, err := w.TxStore.Invoices(txmgrNs)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		invs = make([]*Invoice, 0, len(recs))
+		for _, rec := range recs {
+			inv, err := w.invoice(dbtx, rec, minConf, now)
+			if err != nil {
+				return err
+			}
+			invs = append(invs, inv)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return invs, nil
+}
+
+// invoice determines the confirmations of the payments of an invoice record
+// and the status of the invoice at a time.  Payments by transactions which
+// are no longer recorded by the wallet, such as double spent unmined
+// transactions, are omitted.
+func (w *Wallet) invoice(dbtx walletdb.ReadTx, rec *udb.Invoice, minConf int32, now time.Time) (*Invoice, error) {
+	addr, err := dcrutil.DecodeAddress(rec.Address, w.chainParams)
+	if err != nil {
+		return nil, errors.E(errors.Encoding, err)
+	}
+	inv := &Invoice{
+		ID:       rec.ID,
+		Account:  rec.Account,
+		Address:  addr,
+		Amount:   rec.Amount,
+		Memo:     rec.Memo,
+		Created:  time.Unix(rec.Created, 0),
+		Payments: make([]InvoicePayment, 0, len(rec.Payments)),
+	}
+	if rec.Expires != 0 {
+		inv.Expires = time.Unix(rec.Expires, 0)
+	}
+	_, tipHeight := w.TxStore.MainChainTip(dbtx.ReadBucket(wtxmgrNamespaceKey))
+	for _, p := range rec.Payments {
+		height, err := w.TxStore.TxBlockHeight(dbtx, &p.TxHash)
+		if errors.Is(err, errors.NotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		confs := confirms(height, tipHeight)
+		inv.Payments = append(inv.Payments, InvoicePayment{
+			TxHash:        p.TxHash,
+			Index:         p.Index,
+			Amount:        p.Amount,
+			BlockHeight:   height,
+			Confirmations: confs,
+		})
+		if confs >= minConf {
+			inv.Received += p.Amount
+		} else {
+			inv.Pending += p.Amount
+		}
+	}
+	switch {
+	case inv.Received == 0 && (inv.Expires.IsZero() || now.Before(inv.Expires)):
+		inv.Status = InvoiceUnpaid
+	case inv.Received == 0:
+		inv.Status = InvoiceExpired
+	case inv.Amount == 0 || inv.Received == inv.Amount:
+		inv.Status = InvoicePaid
+	case inv.Received > inv.Amount:
+		inv.Status = InvoiceOverpaid
+	case inv.Expires.IsZero() || now.Before(inv.Expires):
+		inv.Status = InvoicePartiallyPaid
+	default:
+		inv.Status = InvoiceExpired
+	}
+	return inv, nil
+}
+
+// recordInvoicePayment records an output of a processed transaction paying
+// the address of an invoice and notifies clients of the updated invoice.
+func (w *Wallet) recordInvoicePayment(dbtx walletdb.ReadWriteTx, addr dcrutil.Address, txHash *chainhash.Hash,
+	index uint32, amount dcrutil.Amount) error {
+
+	txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+	rec, err := w.TxStore.RecordInvoicePayment(txmgrNs, addr.Address(), txHash, index, amount)
+	if err != nil || rec == nil {
+		return err
+	}
+	inv, err := w.invoice(dbtx, rec, invoiceNotificationMinConf, time.Now())
+	if err != nil {
+		return err
+	}
+	log.Infof("Invoice %d received payment %v:%d of %v (status %v)", inv.ID, txHash, index, amount, inv.Status)
+	w.NtfnServer.notifyInvoice(inv)
+	return nil
+}
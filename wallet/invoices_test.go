@@ -0,0 +1,134 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3/udb"
+	"github.com/decred/dcrwallet/wallet/v3/walletdb"
+)
+
+func TestInvoices(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	inv, err := w.CreateInvoice(ctx, 0, 2e8, "order 1", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inv.ID != 1 || inv.Status != InvoiceUnpaid || inv.Memo != "order 1" {
+		t.Fatalf("created invoice %+v", inv)
+	}
+	other, err := w.CreateInvoice(ctx, 0, 0, "", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other.ID != 2 || other.Address.Address() == inv.Address.Address() || !other.Expires.IsZero() {
+		t.Fatalf("second invoice %+v", other)
+	}
+	if _, err := w.CreateInvoice(ctx, 0, -1, "", 0); !errors.Is(err, errors.Invalid) {
+		t.Errorf("negative amount: expected Invalid, got %v", err)
+	}
+
+	ntfns := w.NtfnServer.InvoiceNotifications()
+	defer ntfns.Done()
+	var payments uint32
+	pay := func(amount dcrutil.Amount) {
+		script, _, err := addressScript(inv.Address)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tx := wire.NewMsgTx()
+		payments++
+		tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: payments}, int64(amount), nil))
+		tx.AddTxOut(wire.NewTxOut(int64(amount), script))
+		rec, err := udb.NewTxRecordFromMsgTx(tx, time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		errc := make(chan error, 1)
+		go func() {
+			errc <- walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+				_, err := w.processTransactionRecord(ctx, dbtx, rec, nil, nil)
+				return err
+			})
+		}()
+		select {
+		case n := <-ntfns.C:
+			if n.ID != inv.ID || n.Received != 0 || n.Status != InvoiceUnpaid {
+				t.Errorf("notified invoice %+v", n)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("no invoice notification")
+		}
+		if err := <-errc; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		pay     dcrutil.Amount
+		status  InvoiceStatus
+		pending dcrutil.Amount
+	}{
+		{1e8, InvoicePartiallyPaid, 1e8},
+		{1e8, InvoicePaid, 2e8},
+		{5e7, InvoiceOverpaid, 25e7},
+	}
+	for _, test := range tests {
+		pay(test.pay)
+		inv, err := w.Invoice(ctx, inv.ID, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if inv.Status != test.status || inv.Received != test.pending {
+			t.Errorf("after paying %v: status %v received %v", test.pay, inv.Status, inv.Received)
+		}
+		confirmed, err := w.Invoice(ctx, inv.ID, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if confirmed.Status != InvoiceUnpaid || confirmed.Pending != test.pending {
+			t.Errorf("after paying %v: confirmed status %v pending %v", test.pay, confirmed.Status, confirmed.Pending)
+		}
+	}
+
+	// Invoices without enough payment expire.
+	invs, err := w.Invoices(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(invs) != 2 || len(invs[0].Payments) != 3 || invs[1].Status != InvoiceUnpaid {
+		t.Fatalf("listed invoices %+v", invs)
+	}
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		rec, err := w.TxStore.Invoice(dbtx.ReadBucket(wtxmgrNamespaceKey), inv.ID)
+		if err != nil {
+			return err
+		}
+		expired, err := w.invoice(dbtx, rec, 1, time.Now().Add(2*time.Hour))
+		if err != nil {
+			return err
+		}
+		if expired.Status != InvoiceExpired {
+			t.Errorf("unconfirmed invoice status %v after expiry", expired.Status)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Invoice(ctx, 3, 0); !errors.Is(err, errors.NotExist) {
+		t.Errorf("missing invoice: expected NotExist, got %v", err)
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3/udb"
+	"github.com/decred/dcrwallet/wallet/v3/walletdb"
+)
+
+// SetLabel attaches a label to an address, transaction or transaction output,
+// replacing any previous label.  Addresses are not required to belong to the
+// wallet, but must be valid for the wallet's network.  An empty label removes
+// the label.
+func (w *Wallet) SetLabel(ctx context.Context, target *udb.LabelTarget, label string) error {
+	const op errors.Op = "wallet.SetLabel"
+	if target.Kind == udb.LabelAddress {
+		addr, err := dcrutil.DecodeAddress(target.Address, w.chainParams)
+		if err != nil {
+			return errors.E(op, errors.Invalid, err)
+		}
+		t := udb.AddressLabelTarget(addr.Address())
+		target = &t
+	}
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		return w.TxStore.SetLabel(txmgrNs, target, label)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// Label returns the label of an address, transaction or transaction output.
+// Errors with the NotExist kind are returned if no label is recorded.
+func (w *Wallet) Label(ctx context.Context, target *udb.LabelTarget) (string, error) {
+	const op errors.Op = "wallet.Label"
+	var label string
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		label = w.TxStore.Label(txmgrNs, target)
+		return nil
+	})
+	if err != nil {
+		return "", errors.E(op, err)
+	}
+	if label == "" {
+		return "", errors.E(op, errors.NotExist, "no label is recorded")
+	}
+	return label, nil
+}
+
+// Labels returns all recorded labels keyed by their targets.
+func (w *Wallet) Labels(ctx context.Context) (map[udb.LabelTarget]string, error) {
+	const op errors.Op = "wallet.Labels"
+	labels := make(map[udb.LabelTarget]string)
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		return w.TxStore.ForEachLabel(txmgrNs, func(target *udb.LabelTarget, label string) error {
+			labels[*target] = label
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return labels, nil
+}
+
+// OutputLabel returns the label describing a transaction output from a set of
+// labels returned by Labels.  The output's own label is preferred, followed by
+// the label of its transaction and then the label of the address it pays.  The
+// address may be empty if it is unknown.
+func OutputLabel(labels map[udb.LabelTarget]string, txHash *chainhash.Hash, index uint32, address string) string {
+	if l := labels[udb.OutputLabelTarget(txHash, index)]; l != "" {
+		return l
+	}
+	if l := labels[udb.TxLabelTarget(txHash)]; l != "" {
+		return l
+	}
+	if address != "" {
+		return labels[udb.AddressLabelTarget(address)]
+	}
+	return ""
+}
+
+// outputLabel returns the label describing a transaction output, with the
+// same precedence as OutputLabel.
+func (w *Wallet) outputLabel(txmgrNs walletdb.ReadBucket, txHash *chainhash.Hash, index uint32, addr dcrutil.Address) string {
+	t := udb.OutputLabelTarget(txHash, index)
+	if l := w.TxStore.Label(txmgrNs, &t); l != "" {
+		return l
+	}
+	t = udb.TxLabelTarget(txHash)
+	if l := w.TxStore.Label(txmgrNs, &t); l != "" {
+		return l
+	}
+	if addr != nil {
+		t = udb.AddressLabelTarget(addr.Address())
+		return w.TxStore.Label(txmgrNs, &t)
+	}
+	return ""
+}
@@ -254,31 +254,9 @@ func (w *Wallet) MixAccount(ctx context.Context, dialTLS DialFunc, csppserver st
 // This involves reading all UTXOs from the underlying source into memory.
 func randomInputSource(source txauthor.InputSource) txauthor.InputSource {
 	all, err := source(dcrutil.MaxAmount)
-	if err == nil {
-		shuffleUTXOs(all)
-	}
-	var n int
-	var tot dcrutil.Amount
-	return func(target dcrutil.Amount) (*txauthor.InputDetail, error) {
-		if err != nil {
-			return nil, err
-		}
-		if all.Amount <= target {
-			return all, nil
-		}
-		for n < len(all.Inputs) {
-			tot += dcrutil.Amount(all.Inputs[n].ValueIn)
-			n++
-			if tot >= target {
-				break
-			}
-		}
-		selected := &txauthor.InputDetail{
-			Amount:            tot,
-			Inputs:            all.Inputs[:n],
-			Scripts:           all.Scripts[:n],
-			RedeemScriptSizes: all.RedeemScriptSizes[:n],
-		}
-		return selected, nil
+	if err != nil {
+		return errInputSource(err)
 	}
+	shuffleUTXOs(all)
+	return prefixInputSource(all)
 }
@@ -0,0 +1,163 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/hdkeychain/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3/descriptor"
+	"github.com/decred/dcrwallet/wallet/v3/internal/txsizes"
+	"github.com/decred/dcrwallet/wallet/v3/txauthor"
+	"github.com/decred/dcrwallet/wallet/v3/udb"
+	"github.com/decred/dcrwallet/wallet/v3/walletdb"
+)
+
+// CreateMultisigAccount creates an M-of-N multisig vault account from the
+// extended public keys of every cosigner.  The account is a descriptor account
+// of the descriptor
+//
+//	sh(sortedmulti(M,xpub1/0/*,...,xpubN/0/*))
+//
+// with the extended keys ordered by their string encoding, so every cosigner
+// which creates the account from the same keys, in any order, derives the
+// same P2SH addresses and records the same descriptor.
+//
+// Cosigners are expected to dedicate one of their wallet's accounts to the
+// vault and share the account extended public key.  When any of the keys is
+// an account key of this wallet, the account's external branch is kept
+// recorded through every vault address so that this wallet can provide its
+// signature for transactions spending from the vault.
+func (w *Wallet) CreateMultisigAccount(ctx context.Context, name string, nRequired int, xpubs []string) (uint32, error) {
+	const op errors.Op = "wallet.CreateMultisigAccount"
+	if len(xpubs) == 0 {
+		return 0, errors.E(op, errors.Invalid, "no cosigner keys")
+	}
+	keys := make([]string, len(xpubs))
+	seen := make(map[string]struct{}, len(xpubs))
+	for i, s := range xpubs {
+		xpub, err := hdkeychain.NewKeyFromString(strings.TrimSpace(s), w.chainParams)
+		if err != nil {
+			return 0, errors.E(op, errors.Encoding, err)
+		}
+		if xpub.IsPrivate() {
+			return 0, errors.E(op, errors.Invalid, "cosigner keys must be public")
+		}
+		keys[i] = xpub.String()
+		if _, ok := seen[keys[i]]; ok {
+			return 0, errors.E(op, errors.Invalid, errors.Errorf("duplicate cosigner key %v", keys[i]))
+		}
+		seen[keys[i]] = struct{}{}
+	}
+	sort.Strings(keys)
+
+	branch := "/" + strconv.Itoa(int(udb.ExternalBranch)) + "/*"
+	for i := range keys {
+		keys[i] += branch
+	}
+	desc := "sh(sortedmulti(" + strconv.Itoa(nRequired) + "," + strings.Join(keys, ",") + "))"
+	account, err := w.ImportDescriptorAccount(ctx, name, desc)
+	if err != nil {
+		return 0, errors.E(op, err)
+	}
+	return account, nil
+}
+
+// MultisigAccountTx creates an unsigned transaction spending unspent outputs of
+// a multisig vault account to the outputs.  Any change is returned to the next
+// address of the vault.  Inputs are selected largest first, skipping outputs
+// locked by the wallet, and the fee is estimated for signature scripts with
+// the required number of signatures.
+//
+// The transaction may be signed by each cosigner with SignTransaction, which
+// merges the signatures already present in the input scripts, until the
+// required number of signatures have been added.
+func (w *Wallet) MultisigAccountTx(ctx context.Context, account uint32, outputs []*wire.TxOut,
+	minConf int32) (*txauthor.AuthoredTx, error) {
+
+	const op errors.Op = "wallet.MultisigAccountTx"
+
+	var d *descriptor.Descriptor
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		_, d, err = w.descriptorAccount(dbtx.ReadBucket(waddrmgrNamespaceKey), account)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if d.Type != descriptor.SHMulti && d.Type != descriptor.SHSortedMulti {
+		return nil, errors.E(op, errors.Invalid, errors.Errorf("account %d is not a multisig account", account))
+	}
+	scriptSize := txsizes.RedeemP2SHMultiSigScriptSize(d.Threshold, len(d.Keys))
+
+	unspent, err := w.UnspentOutputs(ctx, OutputSelectionPolicy{
+		Account:               account,
+		RequiredConfirmations: minConf,
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	sort.Slice(unspent, func(i, j int) bool {
+		return unspent[i].Output.Value > unspent[j].Output.Value
+	})
+	inputSource := func(target dcrutil.Amount) (*txauthor.InputDetail, error) {
+		detail := new(txauthor.InputDetail)
+		for _, out := range unspent {
+			if detail.Amount >= target {
+				break
+			}
+			if w.LockedOutpoint(out.OutPoint) {
+				continue
+			}
+			outPoint := out.OutPoint
+			detail.Amount += dcrutil.Amount(out.Output.Value)
+			detail.Inputs = append(detail.Inputs, wire.NewTxIn(&outPoint, out.Output.Value, nil))
+			detail.Scripts = append(detail.Scripts, out.Output.PkScript)
+			detail.RedeemScriptSizes = append(detail.RedeemScriptSizes, scriptSize)
+		}
+		return detail, nil
+	}
+	changeSource := &multisigChangeSource{
+		wallet:  w,
+		ctx:     ctx,
+		account: account,
+	}
+
+	tx, err := txauthor.NewUnsignedTransaction(outputs, w.txFeeRate(), inputSource, changeSource)
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	if tx.ChangeIndex >= 0 {
+		tx.RandomizeChangePosition()
+	}
+	return tx, nil
+}
+
+// multisigChangeSource is an implementation of txauthor.ChangeSource which
+// returns change to the next address of a multisig vault account.
+type multisigChangeSource struct {
+	wallet  *Wallet
+	ctx     context.Context
+	account uint32
+}
+
+func (src *multisigChangeSource) Script() ([]byte, uint16, error) {
+	changeAddress, err := src.wallet.nextDescriptorAddress(src.ctx, "", src.account, WithGapPolicyWrap())
+	if err != nil {
+		return nil, 0, err
+	}
+	return addressScript(changeAddress)
+}
+
+func (src *multisigChangeSource) ScriptSize() int {
+	return txsizes.P2SHPkScriptSize
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/hdkeychain/v2"
+	"github.com/decred/dcrd/txscript/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3/udb"
+	"github.com/decred/dcrwallet/wallet/v3/walletdb"
+)
+
+func TestMultisigAccounts(t *testing.T) {
+	ctx := context.Background()
+
+	// Two cosigners hold wallets and the third key is held offline.
+	cfgA, cfgB := basicWalletConfig, basicWalletConfig
+	a, teardownA := testWalletSeed(t, &cfgA, bytes.Repeat([]byte{1}, 32))
+	defer teardownA()
+	b, teardownB := testWalletSeed(t, &cfgB, bytes.Repeat([]byte{2}, 32))
+	defer teardownB()
+	xpubs := make([]string, 3)
+	for i, w := range []*Wallet{a, b} {
+		xpub, err := w.MasterPubKey(ctx, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		xpubs[i] = xpub.String()
+	}
+	offline, err := hdkeychain.NewMaster(bytes.Repeat([]byte{3}, 32), cfgA.Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	offlinePub, err := offline.Neuter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	xpubs[2] = offlinePub.String()
+
+	_, err = a.CreateMultisigAccount(ctx, "bad", 2, []string{xpubs[0], xpubs[0]})
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("duplicate cosigner keys: expected Invalid, got %v", err)
+	}
+	_, err = a.CreateMultisigAccount(ctx, "bad", 4, xpubs)
+	if !errors.Is(err, errors.Invalid) {
+		t.Errorf("threshold above cosigner count: expected Invalid, got %v", err)
+	}
+
+	// Cosigners derive the same vault regardless of key order.
+	accountA, err := a.CreateMultisigAccount(ctx, "vault", 2, xpubs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	accountB, err := b.CreateMultisigAccount(ctx, "vault", 2, []string{xpubs[2], xpubs[1], xpubs[0]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	propsA, err := a.DescriptorAccount(ctx, accountA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	propsB, err := b.DescriptorAccount(ctx, accountB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if propsA.Descriptor != propsB.Descriptor {
+		t.Fatalf("cosigner descriptors differ:\n%s\n%s", propsA.Descriptor, propsB.Descriptor)
+	}
+	addr, err := a.NewExternalAddress(ctx, accountA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addrB, err := b.NewExternalAddress(ctx, accountB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if addr.Address() != addrB.Address() {
+		t.Fatalf("cosigner addresses differ: %v %v", addr, addrB)
+	}
+	if _, ok := addr.(*dcrutil.AddressScriptHash); !ok {
+		t.Fatalf("vault address %v is not P2SH", addr)
+	}
+
+	// Fund the vault address in both wallets.
+	pkScript, _, err := addressScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	funding := wire.NewMsgTx()
+	funding.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 0}, 5e8, nil))
+	funding.AddTxOut(wire.NewTxOut(5e8, pkScript))
+	for _, w := range []*Wallet{a, b} {
+		rec, err := udb.NewTxRecordFromMsgTx(funding, time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+			_, err := w.processTransactionRecord(ctx, dbtx, rec, nil, nil)
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	balances, err := a.CalculateAccountBalances(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bal := balances[accountA]; bal == nil || bal.Total != 5e8 {
+		t.Fatalf("vault balance %+v", bal)
+	}
+
+	// Spend from the vault and sign with each cosigner wallet.
+	dest, err := a.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	destScript, _, err := addressScript(dest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	atx, err := a.MultisigAccountTx(ctx, accountA, []*wire.TxOut{wire.NewTxOut(2e8, destScript)}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(atx.Tx.TxIn) != 1 || len(atx.Tx.TxOut) != 2 || atx.ChangeIndex < 0 {
+		t.Fatalf("unexpected vault transaction %+v", atx.Tx)
+	}
+	change := atx.Tx.TxOut[atx.ChangeIndex]
+	if txscript.GetScriptClass(0, change.PkScript) != txscript.ScriptHashTy {
+		t.Errorf("change is not returned to the vault")
+	}
+	_, err = a.MultisigAccountTx(ctx, 0, nil, 0)
+	if !errors.Is(err, errors.NotExist) {
+		t.Errorf("BIP0044 account: expected NotExist, got %v", err)
+	}
+
+	tx := atx.Tx
+	for i, w := range []*Wallet{a, b} {
+		err := w.Unlock(ctx, testPrivPass, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sigErrs, err := w.SignTransaction(ctx, tx, txscript.SigHashAll, nil, nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		complete := len(sigErrs) == 0
+		if complete != (i == 1) {
+			t.Errorf("cosigner %d: signature complete %v, errors %v", i, complete, sigErrs)
+		}
+	}
+	vm, err := txscript.NewEngine(pkScript, tx, 0, sanityVerifyFlags, 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := vm.Execute(); err != nil {
+		t.Errorf("signed vault input does not validate: %v", err)
+	}
+}
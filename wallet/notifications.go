@@ -45,6 +45,7 @@ type NotificationServer struct {
 	accountClients    []chan *AccountNotification
 	tipChangedClients []chan *MainTipChangedNotification
 	confClients       []*ConfirmationNotificationsClient
+	invoiceClients    []chan *Invoice
 	mu                sync.Mutex // Only protects registered clients
 	wallet            *Wallet    // smells like hacks
 }
@@ -139,6 +140,7 @@ func makeTxSummary(dbtx walletdb.ReadTx, w *Wallet, details *udb.TxDetails) Tran
 			}
 		}
 	}
+	txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
 	outputs := make([]TransactionSummaryOutput, 0, len(details.MsgTx.TxOut))
 	for i := range details.MsgTx.TxOut {
 		credIndex := len(outputs)
@@ -154,6 +156,7 @@ func makeTxSummary(dbtx walletdb.ReadTx, w *Wallet, details *udb.TxDetails) Tran
 			Amount:       dcrutil.Amount(amount),
 			Address:      address,
 			OutputScript: outputScript,
+			Label:        w.outputLabel(txmgrNs, &details.Hash, uint32(i), address),
 		}
 		outputs = append(outputs, output)
 	}
@@ -166,6 +169,8 @@ func makeTxSummary(dbtx walletdb.ReadTx, w *Wallet, details *udb.TxDetails) Tran
 		receiveTime = details.Block.Time
 	}
 
+	txLabel := udb.TxLabelTarget(&details.Hash)
+
 	return TransactionSummary{
 		Hash:        &details.Hash,
 		Transaction: serializedTx,
@@ -174,6 +179,7 @@ func makeTxSummary(dbtx walletdb.ReadTx, w *Wallet, details *udb.TxDetails) Tran
 		Fee:         fee,
 		Timestamp:   receiveTime.Unix(),
 		Type:        transactionType,
+		Label:       w.TxStore.Label(txmgrNs, &txLabel),
 	}
 }
 
@@ -475,6 +481,7 @@ type TransactionSummary struct {
 	Fee         dcrutil.Amount
 	Timestamp   int64
 	Type        TransactionType
+	Label       string
 }
 
 // TransactionType describes the which type of transaction is has been observed to be.
@@ -538,6 +545,7 @@ type TransactionSummaryOutput struct {
 	Amount       dcrutil.Amount
 	Address      dcrutil.Address
 	OutputScript []byte
+	Label        string
 }
 
 // AccountBalance associates a total (zero confirmation) balance with an
@@ -683,6 +691,60 @@ func (c *AccountNotificationsClient) Done() {
 	}()
 }
 
+func (s *NotificationServer) notifyInvoice(inv *Invoice) {
+	defer s.mu.Unlock()
+	s.mu.Lock()
+	for _, c := range s.invoiceClients {
+		c <- inv
+	}
+}
+
+// InvoiceNotificationsClient receives invoices over the channel C whenever a
+// transaction paying an invoice is added to the wallet or mined.  Received
+// amounts and statuses count payments with at least one confirmation.
+type InvoiceNotificationsClient struct {
+	C      chan *Invoice
+	server *NotificationServer
+}
+
+// InvoiceNotifications returns a client for receiving invoice notifications
+// over a channel.  The channel is unbuffered.  When finished, the client's Done
+// method should be called to disassociate the client from the server.
+func (s *NotificationServer) InvoiceNotifications() InvoiceNotificationsClient {
+	c := make(chan *Invoice)
+	s.mu.Lock()
+	s.invoiceClients = append(s.invoiceClients, c)
+	s.mu.Unlock()
+	return InvoiceNotificationsClient{
+		C:      c,
+		server: s,
+	}
+}
+
+// Done deregisters the client from the server and drains any remaining
+// messages.  It must be called exactly once when the client is finished
+// receiving notifications.
+func (c *InvoiceNotificationsClient) Done() {
+	go func() {
+		for range c.C {
+		}
+	}()
+	go func() {
+		s := c.server
+		s.mu.Lock()
+		clients := s.invoiceClients
+		for i, ch := range clients {
+			if c.C == ch {
+				clients[i] = clients[len(clients)-1]
+				s.invoiceClients = clients[:len(clients)-1]
+				close(ch)
+				break
+			}
+		}
+		s.mu.Unlock()
+	}()
+}
+
 // MainTipChangedNotification describes processed changes to the main chain tip
 // block.  Attached and detached blocks are sorted by increasing heights.
 //
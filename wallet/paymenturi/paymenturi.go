@@ -0,0 +1,234 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package paymenturi encodes and decodes decred: payment URIs.
+//
+// Payment URIs follow BIP0021, replacing the bitcoin: scheme with decred:.
+// The path is the address to pay, and the optional query parameters are
+//
+//	amount   requested amount in DCR, as a decimal without an exponent
+//	label    name of the recipient
+//	message  description of the payment
+//	invoice  identifier of the invoice being paid
+//
+// for example
+//
+//	decred:DsQxuVRvS4eaJ42dhQEsCXauMWjvopWgrVg?amount=1.5&label=Shop&invoice=12
+//
+// As required by BIP0021, unknown parameters are ignored unless their names
+// begin with req-, in which case the URI is rejected.
+package paymenturi
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrwallet/errors/v2"
+)
+
+// Scheme is the URI scheme of Decred payment requests.
+const Scheme = "decred"
+
+// URI is a request for payment to an address.  Amount is zero, and the string
+// fields empty, when the corresponding parameters are omitted.
+type URI struct {
+	Address dcrutil.Address
+	Amount  dcrutil.Amount
+	Label   string
+	Message string
+	Invoice string
+}
+
+// IsURI returns whether s has the decred: scheme.  It does not check that the
+// remainder of s is a valid payment URI.
+func IsURI(s string) bool {
+	return len(s) > len(Scheme) && s[len(Scheme)] == ':' &&
+		strings.EqualFold(s[:len(Scheme)], Scheme)
+}
+
+// Parse decodes a payment URI paying an address of the network described by
+// params.
+func Parse(s string, params dcrutil.AddressParams) (*URI, error) {
+	const op errors.Op = "paymenturi.Parse"
+	if !IsURI(s) {
+		return nil, errors.E(op, errors.Encoding, "missing decred: scheme")
+	}
+	s = s[len(Scheme)+1:]
+	var query string
+	if i := strings.IndexByte(s, '?'); i != -1 {
+		s, query = s[:i], s[i+1:]
+	}
+	if s == "" {
+		return nil, errors.E(op, errors.Encoding, "missing address")
+	}
+	addr, err := dcrutil.DecodeAddress(s, params)
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	u := &URI{Address: addr}
+	if query == "" {
+		return u, nil
+	}
+	seen := make(map[string]bool)
+	for _, param := range strings.Split(query, "&") {
+		name, value := param, ""
+		if i := strings.IndexByte(param, '='); i != -1 {
+			name, value = param[:i], param[i+1:]
+		}
+		name, err := unescape(name)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		value, err = unescape(value)
+		if err != nil {
+			return nil, errors.E(op, err)
+		}
+		if seen[name] {
+			return nil, errors.E(op, errors.Encoding, errors.Errorf("duplicate parameter %q", name))
+		}
+		seen[name] = true
+		switch name {
+		case "amount":
+			u.Amount, err = parseAmount(value)
+			if err != nil {
+				return nil, errors.E(op, err)
+			}
+		case "label":
+			u.Label = value
+		case "message":
+			u.Message = value
+		case "invoice":
+			u.Invoice = value
+		default:
+			if strings.HasPrefix(name, "req-") {
+				return nil, errors.E(op, errors.Encoding, errors.Errorf("unsupported required parameter %q", name))
+			}
+		}
+	}
+	return u, nil
+}
+
+// String encodes the payment URI.  Parameters with zero values are omitted.
+func (u *URI) String() string {
+	var b strings.Builder
+	b.WriteString(Scheme)
+	b.WriteByte(':')
+	b.WriteString(u.Address.Address())
+	sep := byte('?')
+	param := func(name, value string) {
+		if value == "" {
+			return
+		}
+		b.WriteByte(sep)
+		b.WriteString(name)
+		b.WriteByte('=')
+		b.WriteString(escape(value))
+		sep = '&'
+	}
+	if u.Amount != 0 {
+		param("amount", formatAmount(u.Amount))
+	}
+	param("label", u.Label)
+	param("message", u.Message)
+	param("invoice", u.Invoice)
+	return b.String()
+}
+
+// escape percent-encodes a parameter value.  Spaces are encoded as %20 rather
+// than +, which BIP0021 does not define.
+func escape(s string) string {
+	const hex = "0123456789ABCDEF"
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9',
+			c == '-', c == '.', c == '_', c == '~':
+			b.WriteByte(c)
+		default:
+			b.WriteByte('%')
+			b.WriteByte(hex[c>>4])
+			b.WriteByte(hex[c&0xf])
+		}
+	}
+	return b.String()
+}
+
+// unescape decodes a percent-encoded parameter name or value.
+func unescape(s string) (string, error) {
+	if strings.IndexByte(s, '%') == -1 {
+		return s, nil
+	}
+	b := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		if s[i] != '%' {
+			b = append(b, s[i])
+			continue
+		}
+		if i+2 >= len(s) {
+			return "", errors.E(errors.Encoding, errors.Errorf("invalid escape in %q", s))
+		}
+		c, err := strconv.ParseUint(s[i+1:i+3], 16, 8)
+		if err != nil {
+			return "", errors.E(errors.Encoding, errors.Errorf("invalid escape in %q", s))
+		}
+		b = append(b, byte(c))
+		i += 2
+	}
+	return string(b), nil
+}
+
+// parseAmount parses a decimal DCR amount with at most eight fractional digits
+// without the rounding of floating point conversion.
+func parseAmount(s string) (dcrutil.Amount, error) {
+	invalid := func() (dcrutil.Amount, error) {
+		return 0, errors.E(errors.Encoding, errors.Errorf("invalid amount %q", s))
+	}
+	whole, frac := s, ""
+	if i := strings.IndexByte(s, '.'); i != -1 {
+		whole, frac = s[:i], s[i+1:]
+	}
+	if whole == "" && frac == "" || len(frac) > 8 {
+		return invalid()
+	}
+	for _, part := range []string{whole, frac} {
+		for i := 0; i < len(part); i++ {
+			if part[i] < '0' || part[i] > '9' {
+				return invalid()
+			}
+		}
+	}
+	var atoms int64
+	if whole != "" {
+		w, err := strconv.ParseInt(whole, 10, 64)
+		if err != nil || w > dcrutil.MaxAmount/dcrutil.AtomsPerCoin {
+			return invalid()
+		}
+		atoms = w * dcrutil.AtomsPerCoin
+	}
+	if frac != "" {
+		f, err := strconv.ParseInt(frac+strings.Repeat("0", 8-len(frac)), 10, 64)
+		if err != nil {
+			return invalid()
+		}
+		atoms += f
+	}
+	if atoms > dcrutil.MaxAmount {
+		return invalid()
+	}
+	return dcrutil.Amount(atoms), nil
+}
+
+// formatAmount formats an amount in DCR without trailing fractional zeros.
+func formatAmount(a dcrutil.Amount) string {
+	s := strconv.FormatInt(int64(a)/dcrutil.AtomsPerCoin, 10)
+	frac := int64(a) % dcrutil.AtomsPerCoin
+	if frac == 0 {
+		return s
+	}
+	f := strconv.FormatInt(frac, 10)
+	f = strings.Repeat("0", 8-len(f)) + f
+	return s + "." + strings.TrimRight(f, "0")
+}
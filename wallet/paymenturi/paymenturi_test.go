@@ -0,0 +1,105 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package paymenturi
+
+import (
+	"testing"
+
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrwallet/errors/v2"
+)
+
+func TestParse(t *testing.T) {
+	params := chaincfg.MainNetParams()
+	addr, err := dcrutil.NewAddressPubKeyHash(make([]byte, 20), params, dcrec.STEcdsaSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := addr.Address()
+	testnetAddr, err := dcrutil.NewAddressPubKeyHash(make([]byte, 20), chaincfg.TestNet3Params(),
+		dcrec.STEcdsaSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		uri  string
+		want *URI
+	}{
+		{"address", "decred:" + a, &URI{Address: addr}},
+		{"uppercase scheme", "DECRED:" + a + "?", &URI{Address: addr}},
+		{"all params", "decred:" + a + "?amount=1.5&label=Coffee%20Shop&message=order+7%26more&invoice=12",
+			&URI{Address: addr, Amount: 15e7, Label: "Coffee Shop", Message: "order+7&more", Invoice: "12"}},
+		{"smallest amount", "decred:" + a + "?amount=.00000001", &URI{Address: addr, Amount: 1}},
+		{"whole amount", "decred:" + a + "?amount=20.", &URI{Address: addr, Amount: 20e8}},
+		{"unknown param", "decred:" + a + "?foo=bar&label=x", &URI{Address: addr, Label: "x"}},
+		{"bitcoin scheme", "bitcoin:" + a, nil},
+		{"no address", "decred:?amount=1", nil},
+		{"wrong network", "decred:" + testnetAddr.Address(), nil},
+		{"exponent amount", "decred:" + a + "?amount=1e3", nil},
+		{"negative amount", "decred:" + a + "?amount=-1", nil},
+		{"precise amount", "decred:" + a + "?amount=0.000000001", nil},
+		{"large amount", "decred:" + a + "?amount=21000001", nil},
+		{"duplicate param", "decred:" + a + "?amount=1&amount=2", nil},
+		{"required param", "decred:" + a + "?req-expires=100", nil},
+		{"bad escape", "decred:" + a + "?label=%zz", nil},
+	}
+	for _, test := range tests {
+		u, err := Parse(test.uri, params)
+		if test.want == nil {
+			if !errors.Is(err, errors.Encoding) {
+				t.Errorf("%s: expected Encoding error, got %v", test.name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if u.Address.Address() != a || u.Amount != test.want.Amount || u.Label != test.want.Label ||
+			u.Message != test.want.Message || u.Invoice != test.want.Invoice {
+			t.Errorf("%s: parsed %+v, expected %+v", test.name, u, test.want)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	params := chaincfg.MainNetParams()
+	addr, err := dcrutil.NewAddressPubKeyHash(make([]byte, 20), params, dcrec.STEcdsaSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := addr.Address()
+
+	tests := []struct {
+		uri  *URI
+		want string
+	}{
+		{&URI{Address: addr}, "decred:" + a},
+		{&URI{Address: addr, Amount: 1}, "decred:" + a + "?amount=0.00000001"},
+		{&URI{Address: addr, Amount: 12e8, Invoice: "3"}, "decred:" + a + "?amount=12&invoice=3"},
+		{&URI{Address: addr, Label: "Coffee Shop", Message: "a+b=c&d"},
+			"decred:" + a + "?label=Coffee%20Shop&message=a%2Bb%3Dc%26d"},
+	}
+	for _, test := range tests {
+		s := test.uri.String()
+		if s != test.want {
+			t.Errorf("encoded %q, expected %q", s, test.want)
+			continue
+		}
+		u, err := Parse(s, params)
+		if err != nil {
+			t.Errorf("%s: %v", s, err)
+			continue
+		}
+		if u.Amount != test.uri.Amount || u.Label != test.uri.Label || u.Message != test.uri.Message ||
+			u.Invoice != test.uri.Invoice {
+			t.Errorf("%s: round trip parsed %+v", s, u)
+		}
+	}
+}
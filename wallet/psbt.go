@@ -0,0 +1,324 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/hdkeychain/v2"
+	"github.com/decred/dcrd/txscript/v2"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3/psbt"
+	"github.com/decred/dcrwallet/wallet/v3/udb"
+	"github.com/decred/dcrwallet/wallet/v3/walletdb"
+)
+
+// keyOrigins caches the BIP0044 coin type and account key fingerprints used
+// to describe the derivation of wallet keys.
+type keyOrigins struct {
+	coinType     uint32
+	fingerprints map[uint32]uint32
+}
+
+func (w *Wallet) newKeyOrigins(dbtx walletdb.ReadTx) *keyOrigins {
+	coinType, err := w.Manager.CoinType(dbtx)
+	if err != nil {
+		// Watching-only wallets do not record the coin type.
+		coinType = w.chainParams.SLIP0044CoinType
+	}
+	return &keyOrigins{
+		coinType:     coinType,
+		fingerprints: make(map[uint32]uint32),
+	}
+}
+
+// derivation returns the key origin of a wallet address.  ok is false for
+// imported addresses, which were not derived from any wallet account, and for
+// addresses of imported xpub and descriptor accounts, whose keys were not
+// derived from the wallet's BIP0044 coin type key and whose origin is unknown.
+func (w *Wallet) derivation(ns walletdb.ReadBucket, ko *keyOrigins,
+	addr udb.ManagedPubKeyAddress) (d psbt.Derivation, ok bool, err error) {
+
+	if addr.Imported() || addr.Account() > udb.MaxAccountNum {
+		return d, false, nil
+	}
+	account := addr.Account()
+	fp, ok := ko.fingerprints[account]
+	if !ok {
+		xpubStr, err := w.Manager.GetMasterPubkey(ns, account)
+		if err != nil {
+			return d, false, err
+		}
+		xpub, err := hdkeychain.NewKeyFromString(xpubStr, w.chainParams)
+		if err != nil {
+			return d, false, err
+		}
+		pubKey, err := xpub.ECPubKey()
+		if err != nil {
+			return d, false, err
+		}
+		fp = binary.BigEndian.Uint32(dcrutil.Hash160(pubKey.SerializeCompressed())[:4])
+		ko.fingerprints[account] = fp
+	}
+	branch := udb.ExternalBranch
+	if addr.Internal() {
+		branch = udb.InternalBranch
+	}
+	d = psbt.Derivation{
+		PubKey:      serializeManagedPubKey(addr),
+		Fingerprint: fp,
+		Path: []uint32{
+			44 + hdkeychain.HardenedKeyStart,
+			ko.coinType + hdkeychain.HardenedKeyStart,
+			account + hdkeychain.HardenedKeyStart,
+			branch,
+			addr.Index(),
+		},
+	}
+	return d, true, nil
+}
+
+func serializeManagedPubKey(addr udb.ManagedPubKeyAddress) []byte {
+	if addr.Compressed() {
+		return addr.PubKey().SerializeCompressed()
+	}
+	return addr.PubKey().SerializeUncompressed()
+}
+
+// scriptPubKeyAddrs returns the public key addresses that may provide
+// signatures for a script, along with the script used as the signature hash
+// subscript.  P2SH scripts, including stake-tagged P2SH scripts, are resolved
+// using redeemScript.
+func (w *Wallet) scriptPubKeyAddrs(version uint16, pkScript, redeemScript []byte) (
+	addrs []dcrutil.Address, subScript []byte, err error) {
+
+	_, addrs, _, err = txscript.ExtractPkScriptAddrs(version, pkScript, w.chainParams)
+	if err != nil {
+		return nil, nil, err
+	}
+	subScript = pkScript
+	if len(addrs) == 1 {
+		if _, ok := addrs[0].(*dcrutil.AddressScriptHash); ok {
+			if redeemScript == nil {
+				return nil, nil, nil
+			}
+			_, addrs, _, err = txscript.ExtractPkScriptAddrs(0, redeemScript, w.chainParams)
+			if err != nil {
+				return nil, nil, err
+			}
+			subScript = redeemScript
+		}
+	}
+	return addrs, subScript, nil
+}
+
+// ProcessPSBT updates a partially signed transaction with the previous outputs,
+// redeem scripts and key derivations known by the wallet, and when sign is
+// true, adds partial signatures for every input the wallet holds a private key
+// for.  Inputs which specify a sighash type different from hashType are not
+// signed and result in an error.  Finalized inputs are not modified.  The
+// wallet's copy of the previous output of an input replaces the packet's, and
+// packets describing a previous output differently than the wallet are
+// rejected.
+//
+// Signing requires the wallet to be unlocked.  Keys of watching-only accounts,
+// such as imported xpub and descriptor accounts, and of accounts which remain
+// locked by their own passphrase are not used to sign.
+func (w *Wallet) ProcessPSBT(ctx context.Context, p *psbt.Packet, hashType txscript.SigHashType, sign bool) error {
+	const op errors.Op = "wallet.ProcessPSBT"
+
+	if len(p.Inputs) != len(p.UnsignedTx.TxIn) || len(p.Outputs) != len(p.UnsignedTx.TxOut) {
+		return errors.E(op, errors.Invalid, "packet does not describe its transaction")
+	}
+	if sign && !w.Manager.WatchingOnly() && w.Locked() {
+		return errors.E(op, errors.Locked)
+	}
+
+	var doneFuncs []func()
+	defer func() {
+		for _, f := range doneFuncs {
+			f()
+		}
+	}()
+
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		ko := w.newKeyOrigins(dbtx)
+
+		redeemScript := func(addr dcrutil.Address) ([]byte, error) {
+			script, err := w.TxStore.GetTxScript(txmgrNs, addr.ScriptAddress())
+			if errors.Is(err, errors.NotExist) {
+				sc, done, err := w.Manager.RedeemScript(addrmgrNs, addr)
+				if err != nil {
+					return nil, err
+				}
+				doneFuncs = append(doneFuncs, done)
+				return sc, nil
+			}
+			return script, err
+		}
+
+		for i, txIn := range p.UnsignedTx.TxIn {
+			in := &p.Inputs[i]
+			if in.FinalScriptSig != nil {
+				continue
+			}
+
+			// The wallet's copy of a previous output is used when it is
+			// known, and packets describing it differently are rejected,
+			// so that signatures never commit to amounts or scripts
+			// provided by other parties.
+			prevHash := &txIn.PreviousOutPoint.Hash
+			prevIndex := txIn.PreviousOutPoint.Index
+			txDetails, err := w.TxStore.TxDetails(txmgrNs, prevHash)
+			switch {
+			case errors.Is(err, errors.NotExist):
+				if in.PrevOut == nil {
+					continue
+				}
+			case err != nil:
+				return err
+			default:
+				if prevIndex >= uint32(len(txDetails.MsgTx.TxOut)) {
+					return errors.E(errors.Invalid, errors.Errorf(
+						"input %d spends nonexistent output", i))
+				}
+				prevOut := *txDetails.MsgTx.TxOut[prevIndex]
+				if in.PrevOut != nil && (in.PrevOut.Value != prevOut.Value ||
+					in.PrevOut.Version != prevOut.Version ||
+					!bytes.Equal(in.PrevOut.PkScript, prevOut.PkScript)) {
+					return errors.E(errors.Invalid, errors.Errorf(
+						"input %d previous output differs from the wallet's", i))
+				}
+				in.PrevOut = &prevOut
+			}
+
+			if in.RedeemScript == nil {
+				_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+					in.PrevOut.Version, in.PrevOut.PkScript, w.chainParams)
+				if err != nil {
+					return err
+				}
+				if len(addrs) == 1 {
+					if _, ok := addrs[0].(*dcrutil.AddressScriptHash); ok {
+						script, err := redeemScript(addrs[0])
+						if err != nil && !errors.Is(err, errors.NotExist) {
+							return err
+						}
+						in.RedeemScript = script
+					}
+				}
+			}
+
+			addrs, subScript, err := w.scriptPubKeyAddrs(in.PrevOut.Version,
+				in.PrevOut.PkScript, in.RedeemScript)
+			if err != nil {
+				return err
+			}
+			if sign && in.SigHashType != 0 && in.SigHashType != hashType {
+				return errors.E(errors.Invalid, errors.Errorf(
+					"input %d requires sighash type %#x", i, in.SigHashType))
+			}
+			for _, addr := range addrs {
+				ma, err := w.Manager.Address(addrmgrNs, addr)
+				if errors.Is(err, errors.NotExist) {
+					continue
+				}
+				if err != nil {
+					return err
+				}
+				pka, ok := ma.(udb.ManagedPubKeyAddress)
+				if !ok {
+					continue
+				}
+				d, ok, err := w.derivation(addrmgrNs, ko, pka)
+				if err != nil {
+					return err
+				}
+				if ok {
+					in.AddDerivation(d)
+				}
+				if !sign {
+					continue
+				}
+
+				key, done, err := w.Manager.PrivateKey(addrmgrNs, addr)
+				switch {
+				case errors.Is(err, errors.Locked), errors.Is(err, errors.WatchingOnly):
+					// Keys of watching-only and locked accounts are
+					// skipped and the input is left for other signers.
+					continue
+				case err != nil:
+					return err
+				}
+				doneFuncs = append(doneFuncs, done)
+				sig, err := txscript.RawTxInSignature(p.UnsignedTx, i,
+					subScript, hashType, key)
+				if err != nil {
+					return err
+				}
+				in.AddPartialSig(serializeManagedPubKey(pka), sig)
+			}
+			if sign && len(in.PartialSigs) != 0 && in.SigHashType == 0 {
+				in.SigHashType = hashType
+			}
+		}
+
+		for i, txOut := range p.UnsignedTx.TxOut {
+			out := &p.Outputs[i]
+			_, addrs, _, err := txscript.ExtractPkScriptAddrs(txOut.Version,
+				txOut.PkScript, w.chainParams)
+			if err != nil || len(addrs) != 1 {
+				continue
+			}
+			if _, ok := addrs[0].(*dcrutil.AddressScriptHash); ok {
+				if out.RedeemScript == nil {
+					script, err := redeemScript(addrs[0])
+					if err != nil && !errors.Is(err, errors.NotExist) {
+						return err
+					}
+					out.RedeemScript = script
+				}
+				if out.RedeemScript == nil {
+					continue
+				}
+				_, addrs, _, err = txscript.ExtractPkScriptAddrs(0,
+					out.RedeemScript, w.chainParams)
+				if err != nil {
+					continue
+				}
+			}
+			for _, addr := range addrs {
+				ma, err := w.Manager.Address(addrmgrNs, addr)
+				if errors.Is(err, errors.NotExist) {
+					continue
+				}
+				if err != nil {
+					return err
+				}
+				pka, ok := ma.(udb.ManagedPubKeyAddress)
+				if !ok {
+					continue
+				}
+				d, ok, err := w.derivation(addrmgrNs, ko, pka)
+				if err != nil {
+					return err
+				}
+				if ok {
+					out.AddDerivation(d)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
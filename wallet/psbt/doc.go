@@ -0,0 +1,46 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+/*
+Package psbt implements a versioned container for partially signed Decred
+transactions.
+
+A Packet carries an unsigned transaction together with all of the metadata that
+signers need to add their signatures without access to the wallet that created
+it: the previous outputs being spent, the redeem scripts of any P2SH inputs, the
+HD key origins of the public keys involved, and the signatures that have been
+created so far.  Packets are intended to be passed between an online wallet
+that creates and funds a transaction, any number of (possibly offline) signers,
+and finally the party that finalizes and broadcasts the transaction.
+
+Roles
+
+The lifecycle of a packet follows the roles described by BIP0174:
+
+    Creator     New creates a packet from an unsigned transaction.
+    Updater     Previous outputs, redeem scripts and key derivations are added
+                to the packet's inputs and outputs.
+    Signer      Partial signatures are added for each input a key is held for.
+    Combiner    Combine merges packets describing the same transaction.
+    Finalizer   Finalize replaces partial signatures with final signature
+                scripts once enough signatures are present.
+    Extractor   Extract returns the fully signed transaction.
+
+The updater and signer roles require wallet access and are implemented by
+(*wallet.Wallet).ProcessPSBT.
+
+Serialization
+
+Packets are serialized as a magic prefix followed by a sequence of key-value
+maps, one for global data, one for each transaction input and one for each
+transaction output.  Each key-value pair is encoded as
+
+    <keylen varint> <keytype byte> <keydata> <valuelen varint> <value>
+
+and each map is terminated by a single zero byte.  Unrecognized key-value pairs
+are retained so that packets created by newer software survive a round trip
+through older software.  The base64 encoding of the serialization is used by
+the JSON-RPC server.
+*/
+package psbt
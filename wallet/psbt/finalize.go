@@ -0,0 +1,168 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package psbt
+
+import (
+	"github.com/decred/dcrd/txscript/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/errors/v2"
+)
+
+// verifyFlags are the script flags used to check finalized inputs.
+const verifyFlags = txscript.ScriptDiscourageUpgradableNops |
+	txscript.ScriptVerifyCleanStack |
+	txscript.ScriptVerifyCheckLockTimeVerify |
+	txscript.ScriptVerifyCheckSequenceVerify
+
+// verifyInput executes the scripts of input idx using sigScript as the
+// signature script.
+func (p *Packet) verifyInput(idx int, sigScript []byte) error {
+	prevOut := p.Inputs[idx].PrevOut
+	tx := p.UnsignedTx.Copy()
+	tx.TxIn[idx].SignatureScript = sigScript
+	vm, err := txscript.NewEngine(prevOut.PkScript, tx, idx, verifyFlags,
+		prevOut.Version, nil)
+	if err != nil {
+		return err
+	}
+	return vm.Execute()
+}
+
+// Finalize creates final signature scripts for every input that has collected
+// enough partial signatures.  Inputs which can not be finalized yet are left
+// unmodified.  Finalization is supported for P2PK, P2PKH and P2SH multisig
+// previous outputs, including their stake-tagged variants.  An error is only
+// returned when the packet is malformed.
+func (p *Packet) Finalize() error {
+	const op errors.Op = "psbt.Finalize"
+	if err := p.sanityCheck(); err != nil {
+		return errors.E(op, err)
+	}
+	for i := range p.Inputs {
+		in := &p.Inputs[i]
+		if in.FinalScriptSig != nil || in.PrevOut == nil || len(in.PartialSigs) == 0 {
+			continue
+		}
+		sigScript, err := p.finalScriptSig(i)
+		if err != nil {
+			return errors.E(op, errors.Errorf("input %d: %v", i, err))
+		}
+		if sigScript == nil {
+			continue
+		}
+		in.FinalScriptSig = sigScript
+		in.clearSigningData()
+	}
+	return nil
+}
+
+// finalScriptSig returns the signature script of input idx, or nil if it
+// can not be created from the currently available partial signatures.
+func (p *Packet) finalScriptSig(idx int) ([]byte, error) {
+	in := &p.Inputs[idx]
+	pkScript := in.PrevOut.PkScript
+	class := txscript.GetScriptClass(in.PrevOut.Version, pkScript)
+	switch class {
+	case txscript.StakeSubmissionTy, txscript.StakeGenTy,
+		txscript.StakeRevocationTy, txscript.StakeSubChangeTy:
+		var err error
+		class, err = txscript.GetStakeOutSubclass(pkScript)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch class {
+	case txscript.PubKeyTy, txscript.PubKeyHashTy:
+		// Any partial signature that satisfies the script is used.
+		for _, s := range in.PartialSigs {
+			b := txscript.NewScriptBuilder().AddData(s.Signature)
+			if class == txscript.PubKeyHashTy {
+				b.AddData(s.PubKey)
+			}
+			sigScript, err := b.Script()
+			if err != nil {
+				return nil, err
+			}
+			if p.verifyInput(idx, sigScript) == nil {
+				return sigScript, nil
+			}
+		}
+		return nil, nil
+
+	case txscript.ScriptHashTy:
+		if in.RedeemScript == nil {
+			return nil, nil
+		}
+		if !txscript.IsMultisigScript(in.RedeemScript) {
+			return nil, errors.New("unsupported redeem script")
+		}
+		_, nRequired, err := txscript.CalcMultiSigStats(in.RedeemScript)
+		if err != nil {
+			return nil, err
+		}
+		pubKeys, err := txscript.PushedData(in.RedeemScript)
+		if err != nil {
+			return nil, err
+		}
+		// Signatures must appear in the same order as their public
+		// keys in the redeem script.
+		b := txscript.NewScriptBuilder()
+		n := 0
+		for _, pk := range pubKeys {
+			sig := in.partialSig(pk)
+			if sig == nil {
+				continue
+			}
+			b.AddData(sig)
+			n++
+			if n == nRequired {
+				break
+			}
+		}
+		if n < nRequired {
+			return nil, nil
+		}
+		sigScript, err := b.AddData(in.RedeemScript).Script()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.verifyInput(idx, sigScript); err != nil {
+			return nil, err
+		}
+		return sigScript, nil
+
+	default:
+		return nil, errors.Errorf("unsupported previous output script class %v", class)
+	}
+}
+
+// Extract returns the signed transaction described by a finalized packet.
+// Each input with a known previous output is verified by executing its
+// scripts.  The packet is not modified.
+func (p *Packet) Extract() (*wire.MsgTx, error) {
+	const op errors.Op = "psbt.Extract"
+	if err := p.sanityCheck(); err != nil {
+		return nil, errors.E(op, err)
+	}
+	if !p.IsComplete() {
+		return nil, errors.E(op, errors.Invalid, "packet is not finalized")
+	}
+	for i := range p.Inputs {
+		if p.Inputs[i].PrevOut == nil {
+			continue
+		}
+		err := p.verifyInput(i, p.Inputs[i].FinalScriptSig)
+		if err != nil {
+			return nil, errors.E(op, errors.ScriptFailure,
+				errors.Errorf("input %d: %v", i, err))
+		}
+	}
+	tx := p.UnsignedTx.Copy()
+	for i, in := range tx.TxIn {
+		in.SignatureScript = p.Inputs[i].FinalScriptSig
+	}
+	return tx, nil
+}
@@ -0,0 +1,323 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package psbt
+
+import (
+	"bytes"
+
+	"github.com/decred/dcrd/txscript/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/errors/v2"
+)
+
+// Version is the latest version of the packet serialization.
+const Version = 0
+
+// Derivation describes the HD key origin of a public key.
+//
+// dcrwallet does not retain the HD master key after wallet creation, so the
+// Fingerprint of keys derived by the wallet identifies the BIP0044 account
+// extended public key rather than the master key.  Path always records the
+// full BIP0044 path, including hardened purpose, coin type and account
+// elements.
+type Derivation struct {
+	PubKey      []byte
+	Fingerprint uint32
+	Path        []uint32
+}
+
+// PartialSig is a signature for an input, created by the private key of
+// PubKey.  The signature is serialized with the trailing sighash type byte.
+type PartialSig struct {
+	PubKey    []byte
+	Signature []byte
+}
+
+// Unknown is a key-value pair that is not recognized by this version of the
+// package.  Unknown pairs are preserved through serialization and combining.
+type Unknown struct {
+	Key   []byte
+	Value []byte
+}
+
+// Input describes the signing state and metadata of a single transaction
+// input.
+type Input struct {
+	// PrevOut is the previous output spent by the input.  Signing and
+	// finalizing an input requires the previous output to be known.
+	PrevOut *wire.TxOut
+
+	// RedeemScript is the redeem script of a P2SH previous output.
+	RedeemScript []byte
+
+	// SigHashType is the signature hash type that signers must use.  The
+	// zero value indicates that no sighash type was specified, in which
+	// case txscript.SigHashAll is assumed.
+	SigHashType txscript.SigHashType
+
+	Derivations []Derivation
+	PartialSigs []PartialSig
+
+	// FinalScriptSig is the complete signature script of a finalized input.
+	// Once set, all other signing metadata of the input is discarded.
+	FinalScriptSig []byte
+
+	Unknowns []Unknown
+}
+
+// Output describes metadata of a single transaction output.  This allows
+// signers to recognize outputs (e.g. change) paying back to their own keys.
+type Output struct {
+	RedeemScript []byte
+	Derivations  []Derivation
+	Unknowns     []Unknown
+}
+
+// Packet is a partially signed transaction.  There is exactly one Input for
+// every transaction input and one Output for every transaction output of the
+// unsigned transaction.
+type Packet struct {
+	UnsignedTx *wire.MsgTx
+	Inputs     []Input
+	Outputs    []Output
+	Unknowns   []Unknown
+}
+
+// New creates a new packet for an unsigned transaction.  The transaction must
+// not contain any signature scripts.
+func New(tx *wire.MsgTx) (*Packet, error) {
+	const op errors.Op = "psbt.New"
+	if err := checkUnsigned(tx); err != nil {
+		return nil, errors.E(op, err)
+	}
+	return &Packet{
+		UnsignedTx: tx,
+		Inputs:     make([]Input, len(tx.TxIn)),
+		Outputs:    make([]Output, len(tx.TxOut)),
+	}, nil
+}
+
+func checkUnsigned(tx *wire.MsgTx) error {
+	if len(tx.TxIn) == 0 {
+		return errors.E(errors.Invalid, "transaction has no inputs")
+	}
+	for i, in := range tx.TxIn {
+		if len(in.SignatureScript) != 0 {
+			return errors.E(errors.Invalid,
+				errors.Errorf("input %d has a signature script", i))
+		}
+	}
+	return nil
+}
+
+// sanityCheck ensures the packet describes its unsigned transaction.
+func (p *Packet) sanityCheck() error {
+	if p.UnsignedTx == nil {
+		return errors.E(errors.Invalid, "packet has no unsigned transaction")
+	}
+	if err := checkUnsigned(p.UnsignedTx); err != nil {
+		return err
+	}
+	if len(p.Inputs) != len(p.UnsignedTx.TxIn) {
+		return errors.E(errors.Invalid, "input count does not match transaction")
+	}
+	if len(p.Outputs) != len(p.UnsignedTx.TxOut) {
+		return errors.E(errors.Invalid, "output count does not match transaction")
+	}
+	return nil
+}
+
+// IsComplete returns whether every input of the packet has been finalized.
+func (p *Packet) IsComplete() bool {
+	for i := range p.Inputs {
+		if p.Inputs[i].FinalScriptSig == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// AddPartialSig adds a signature to the input, replacing any previous
+// signature by the same public key.
+func (in *Input) AddPartialSig(pubKey, sig []byte) {
+	for i := range in.PartialSigs {
+		if bytes.Equal(in.PartialSigs[i].PubKey, pubKey) {
+			in.PartialSigs[i].Signature = sig
+			return
+		}
+	}
+	in.PartialSigs = append(in.PartialSigs, PartialSig{PubKey: pubKey, Signature: sig})
+}
+
+// partialSig returns the signature by pubKey, or nil if the input has not
+// been signed by this key.
+func (in *Input) partialSig(pubKey []byte) []byte {
+	for i := range in.PartialSigs {
+		if bytes.Equal(in.PartialSigs[i].PubKey, pubKey) {
+			return in.PartialSigs[i].Signature
+		}
+	}
+	return nil
+}
+
+// AddDerivation records the key origin of a public key, replacing any
+// previous origin of the same key.
+func (in *Input) AddDerivation(d Derivation) {
+	in.Derivations = addDerivation(in.Derivations, d)
+}
+
+// AddDerivation records the key origin of a public key, replacing any
+// previous origin of the same key.
+func (out *Output) AddDerivation(d Derivation) {
+	out.Derivations = addDerivation(out.Derivations, d)
+}
+
+func addDerivation(ds []Derivation, d Derivation) []Derivation {
+	for i := range ds {
+		if bytes.Equal(ds[i].PubKey, d.PubKey) {
+			ds[i] = d
+			return ds
+		}
+	}
+	return append(ds, d)
+}
+
+func addUnknown(us []Unknown, u Unknown) []Unknown {
+	for i := range us {
+		if bytes.Equal(us[i].Key, u.Key) {
+			return us
+		}
+	}
+	return append(us, u)
+}
+
+// mergeBytes returns whichever of a and b is set, erroring if both are set to
+// different values.
+func mergeBytes(a, b []byte, what string) ([]byte, error) {
+	switch {
+	case a == nil:
+		return b, nil
+	case b == nil, bytes.Equal(a, b):
+		return a, nil
+	default:
+		return nil, errors.E(errors.Invalid, "conflicting "+what)
+	}
+}
+
+// Combine merges the metadata and signatures of several packets describing
+// the same unsigned transaction into a single packet.  Conflicting values
+// (for example, two different redeem scripts for a single input) result in an
+// error.  The packets passed as arguments are not modified.
+func Combine(packets ...*Packet) (*Packet, error) {
+	const op errors.Op = "psbt.Combine"
+	if len(packets) == 0 {
+		return nil, errors.E(op, errors.Invalid, "no packets to combine")
+	}
+	for _, p := range packets {
+		if err := p.sanityCheck(); err != nil {
+			return nil, errors.E(op, err)
+		}
+	}
+	txHash := packets[0].UnsignedTx.TxHash()
+	for _, p := range packets[1:] {
+		if p.UnsignedTx.TxHash() != txHash {
+			return nil, errors.E(op, errors.Invalid,
+				"packets describe different transactions")
+		}
+	}
+
+	res, err := New(packets[0].UnsignedTx.Copy())
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	for _, p := range packets {
+		for _, u := range p.Unknowns {
+			res.Unknowns = addUnknown(res.Unknowns, u)
+		}
+		for i := range p.Inputs {
+			err := res.Inputs[i].merge(&p.Inputs[i])
+			if err != nil {
+				return nil, errors.E(op, errors.Errorf("input %d: %v", i, err))
+			}
+		}
+		for i := range p.Outputs {
+			err := res.Outputs[i].merge(&p.Outputs[i])
+			if err != nil {
+				return nil, errors.E(op, errors.Errorf("output %d: %v", i, err))
+			}
+		}
+	}
+	// Signing metadata is no longer useful for any input that has been
+	// finalized by one of the combined packets.
+	for i := range res.Inputs {
+		if res.Inputs[i].FinalScriptSig != nil {
+			res.Inputs[i].clearSigningData()
+		}
+	}
+	return res, nil
+}
+
+func (in *Input) merge(other *Input) error {
+	if in.PrevOut == nil && other.PrevOut != nil {
+		prevOut := *other.PrevOut
+		in.PrevOut = &prevOut
+	} else if in.PrevOut != nil && other.PrevOut != nil {
+		if in.PrevOut.Value != other.PrevOut.Value ||
+			in.PrevOut.Version != other.PrevOut.Version ||
+			!bytes.Equal(in.PrevOut.PkScript, other.PrevOut.PkScript) {
+			return errors.E(errors.Invalid, "conflicting previous outputs")
+		}
+	}
+	var err error
+	in.RedeemScript, err = mergeBytes(in.RedeemScript, other.RedeemScript, "redeem scripts")
+	if err != nil {
+		return err
+	}
+	in.FinalScriptSig, err = mergeBytes(in.FinalScriptSig, other.FinalScriptSig, "final signature scripts")
+	if err != nil {
+		return err
+	}
+	switch {
+	case in.SigHashType == 0:
+		in.SigHashType = other.SigHashType
+	case other.SigHashType != 0 && other.SigHashType != in.SigHashType:
+		return errors.E(errors.Invalid, "conflicting sighash types")
+	}
+	for _, d := range other.Derivations {
+		in.AddDerivation(d)
+	}
+	for _, s := range other.PartialSigs {
+		in.AddPartialSig(s.PubKey, s.Signature)
+	}
+	for _, u := range other.Unknowns {
+		in.Unknowns = addUnknown(in.Unknowns, u)
+	}
+	return nil
+}
+
+func (out *Output) merge(other *Output) error {
+	var err error
+	out.RedeemScript, err = mergeBytes(out.RedeemScript, other.RedeemScript, "redeem scripts")
+	if err != nil {
+		return err
+	}
+	for _, d := range other.Derivations {
+		out.AddDerivation(d)
+	}
+	for _, u := range other.Unknowns {
+		out.Unknowns = addUnknown(out.Unknowns, u)
+	}
+	return nil
+}
+
+// clearSigningData removes all metadata that is only required to sign and
+// finalize the input.  The previous output is retained so that the final
+// transaction can still be verified.
+func (in *Input) clearSigningData() {
+	in.RedeemScript = nil
+	in.SigHashType = 0
+	in.Derivations = nil
+	in.PartialSigs = nil
+}
@@ -0,0 +1,269 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package psbt
+
+import (
+	"bytes"
+	"encoding/base64"
+	"reflect"
+	"testing"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrd/chaincfg/v2/chainec"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/txscript/v2"
+	"github.com/decred/dcrd/wire"
+)
+
+var params = chaincfg.MainNetParams()
+
+func testKey(b byte) (chainec.PrivateKey, []byte) {
+	priv, pub := chainec.Secp256k1.PrivKeyFromBytes(bytes.Repeat([]byte{b}, 32))
+	return priv, pub.SerializeCompressed()
+}
+
+func p2pkhScript(t *testing.T, pubKey []byte) []byte {
+	addr, err := dcrutil.NewAddressPubKeyHash(dcrutil.Hash160(pubKey), params,
+		dcrec.STEcdsaSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	script, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return script
+}
+
+func multisig(t *testing.T, nRequired int, pubKeys ...[]byte) (redeemScript, pkScript []byte) {
+	addrs := make([]*dcrutil.AddressSecpPubKey, len(pubKeys))
+	for i, pk := range pubKeys {
+		var err error
+		addrs[i], err = dcrutil.NewAddressSecpPubKey(pk, params)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	redeemScript, err := txscript.MultiSigScript(addrs, nRequired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p2sh, err := dcrutil.NewAddressScriptHash(redeemScript, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err = txscript.PayToAddrScript(p2sh)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return redeemScript, pkScript
+}
+
+func unsignedTx(inputs int) *wire.MsgTx {
+	tx := wire.NewMsgTx()
+	for i := 0; i < inputs; i++ {
+		prevHash := chainhash.HashH([]byte{byte(i)})
+		tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&prevHash, uint32(i), wire.TxTreeRegular), 1e8, nil))
+	}
+	tx.AddTxOut(wire.NewTxOut(1e8, []byte{txscript.OP_TRUE}))
+	return tx
+}
+
+func sign(t *testing.T, p *Packet, idx int, subScript []byte, key chainec.PrivateKey, pubKey []byte) {
+	sig, err := txscript.RawTxInSignature(p.UnsignedTx, idx, subScript, txscript.SigHashAll, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Inputs[idx].AddPartialSig(pubKey, sig)
+}
+
+func TestSerializeRoundTrip(t *testing.T) {
+	_, pk1 := testKey(1)
+	_, pk2 := testKey(2)
+	redeemScript, pkScript := multisig(t, 2, pk1, pk2)
+
+	p, err := New(unsignedTx(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Unknowns = []Unknown{{Key: []byte{0x70, 1}, Value: []byte{2}}}
+	p.Inputs[0] = Input{
+		PrevOut:      &wire.TxOut{Value: 1e8, Version: 0, PkScript: pkScript},
+		RedeemScript: redeemScript,
+		SigHashType:  txscript.SigHashAll,
+		Derivations: []Derivation{
+			{PubKey: pk1, Fingerprint: 0xdeadbeef, Path: []uint32{0x8000002c, 0x8000002a, 0x80000000, 0, 7}},
+		},
+		PartialSigs: []PartialSig{{PubKey: pk1, Signature: []byte{0x30, 0x01}}},
+		Unknowns:    []Unknown{{Key: []byte{0x70}, Value: []byte{}}},
+	}
+	p.Inputs[1] = Input{FinalScriptSig: []byte{txscript.OP_TRUE}}
+	p.Outputs[0] = Output{
+		RedeemScript: []byte{txscript.OP_TRUE},
+		Derivations:  []Derivation{{PubKey: pk2, Path: []uint32{}}},
+	}
+
+	s, err := p.EncodeBase64()
+	if err != nil {
+		t.Fatal(err)
+	}
+	p2, err := DecodeBase64(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s2, err := p2.EncodeBase64()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s != s2 {
+		t.Fatalf("serialization changed after round trip:\nbefore %s\nafter  %s", s, s2)
+	}
+	if p2.UnsignedTx.TxHash() != p.UnsignedTx.TxHash() {
+		t.Errorf("unsigned transaction changed after round trip")
+	}
+	if !reflect.DeepEqual(p.Inputs[0].Derivations, p2.Inputs[0].Derivations) {
+		t.Errorf("derivations changed after round trip: %v != %v",
+			p.Inputs[0].Derivations, p2.Inputs[0].Derivations)
+	}
+	if !reflect.DeepEqual(p.Inputs[0].PrevOut, p2.Inputs[0].PrevOut) {
+		t.Errorf("previous output changed after round trip")
+	}
+}
+
+func TestDeserializeErrors(t *testing.T) {
+	p, err := New(unsignedTx(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := p.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		b    []byte
+	}{
+		{"empty", nil},
+		{"bad magic", append([]byte("pszt\xff"), b[5:]...)},
+		{"truncated", b[:len(b)-1]},
+		{"trailing", append(b[:len(b):len(b)], 0)},
+	}
+	for _, test := range tests {
+		_, err := DecodeBase64(base64.StdEncoding.EncodeToString(test.b))
+		if err == nil {
+			t.Errorf("%s: expected error", test.name)
+		}
+	}
+}
+
+func TestFinalizeMultisig(t *testing.T) {
+	k1, pk1 := testKey(1)
+	_, pk2 := testKey(2)
+	k3, pk3 := testKey(3)
+	redeemScript, pkScript := multisig(t, 2, pk1, pk2, pk3)
+
+	p, err := New(unsignedTx(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Inputs[0].PrevOut = &wire.TxOut{Value: 1e8, PkScript: pkScript}
+	p.Inputs[0].RedeemScript = redeemScript
+
+	// Two signers sign copies of the same packet.  Signatures are added in
+	// the reverse order of their keys in the redeem script.
+	b, err := p.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer1, _ := Deserialize(bytes.NewReader(b))
+	signer2, _ := Deserialize(bytes.NewReader(b))
+	sign(t, signer1, 0, redeemScript, k3, pk3)
+	sign(t, signer2, 0, redeemScript, k1, pk1)
+
+	// A single signature is not enough to finalize the input.
+	if err := signer1.Finalize(); err != nil {
+		t.Fatal(err)
+	}
+	if signer1.IsComplete() {
+		t.Fatal("finalized input with too few signatures")
+	}
+
+	combined, err := Combine(signer1, signer2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(combined.Inputs[0].PartialSigs) != 2 {
+		t.Fatalf("expected 2 partial sigs, got %d", len(combined.Inputs[0].PartialSigs))
+	}
+	if err := combined.Finalize(); err != nil {
+		t.Fatal(err)
+	}
+	if !combined.IsComplete() {
+		t.Fatal("packet was not finalized")
+	}
+	if combined.Inputs[0].PartialSigs != nil || combined.Inputs[0].RedeemScript != nil {
+		t.Fatal("signing data not cleared after finalization")
+	}
+	tx, err := combined.Extract()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.TxHash() != p.UnsignedTx.TxHash() {
+		t.Fatal("extracted transaction does not match unsigned transaction")
+	}
+}
+
+func TestFinalizeP2PKH(t *testing.T) {
+	k1, pk1 := testKey(1)
+	_, pk2 := testKey(2)
+	pkScript := p2pkhScript(t, pk1)
+
+	p, err := New(unsignedTx(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Inputs[0].PrevOut = &wire.TxOut{Value: 1e8, PkScript: pkScript}
+
+	// A signature by an unrelated key does not finalize the input.
+	p.Inputs[0].AddPartialSig(pk2, []byte{0x30, 0x00, 0x01})
+	if err := p.Finalize(); err != nil {
+		t.Fatal(err)
+	}
+	if p.IsComplete() {
+		t.Fatal("finalized input using the wrong key")
+	}
+	if _, err := p.Extract(); err == nil {
+		t.Fatal("extracted incomplete packet")
+	}
+
+	sign(t, p, 0, pkScript, k1, pk1)
+	if err := p.Finalize(); err != nil {
+		t.Fatal(err)
+	}
+	if !p.IsComplete() {
+		t.Fatal("packet was not finalized")
+	}
+	if _, err := p.Extract(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCombineConflicts(t *testing.T) {
+	p1, _ := New(unsignedTx(1))
+	p2, _ := New(unsignedTx(1))
+	p1.Inputs[0].RedeemScript = []byte{1}
+	p2.Inputs[0].RedeemScript = []byte{2}
+	if _, err := Combine(p1, p2); err == nil {
+		t.Error("combined conflicting redeem scripts")
+	}
+
+	p3, _ := New(unsignedTx(2))
+	if _, err := Combine(p1, p3); err == nil {
+		t.Error("combined packets for different transactions")
+	}
+}
@@ -0,0 +1,415 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package psbt
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+
+	"github.com/decred/dcrd/txscript/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/errors/v2"
+)
+
+// magic prefixes every serialized packet.
+var magic = [5]byte{'p', 's', 'b', 't', 0xff}
+
+// maxItemSize limits the size of any single key or value read during
+// deserialization.
+const maxItemSize = wire.MaxMessagePayload
+
+// Global map key types.
+const (
+	globalUnsignedTx = 0x00
+	globalVersion    = 0xfb
+)
+
+// Input map key types.
+const (
+	inputPrevOut        = 0x00
+	inputPartialSig     = 0x02
+	inputSigHashType    = 0x03
+	inputRedeemScript   = 0x04
+	inputDerivation     = 0x06
+	inputFinalScriptSig = 0x07
+)
+
+// Output map key types.
+const (
+	outputRedeemScript = 0x00
+	outputDerivation   = 0x02
+)
+
+type kvWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (kw *kvWriter) pair(keyType byte, keyData, value []byte) {
+	if kw.err != nil {
+		return
+	}
+	key := make([]byte, 1+len(keyData))
+	key[0] = keyType
+	copy(key[1:], keyData)
+	kw.raw(key, value)
+}
+
+func (kw *kvWriter) raw(key, value []byte) {
+	if kw.err != nil {
+		return
+	}
+	kw.err = wire.WriteVarBytes(kw.w, 0, key)
+	if kw.err != nil {
+		return
+	}
+	kw.err = wire.WriteVarBytes(kw.w, 0, value)
+}
+
+func (kw *kvWriter) separator() {
+	if kw.err != nil {
+		return
+	}
+	_, kw.err = kw.w.Write([]byte{0})
+}
+
+func serializeDerivation(d *Derivation) []byte {
+	v := make([]byte, 4+4*len(d.Path))
+	binary.LittleEndian.PutUint32(v, d.Fingerprint)
+	for i, p := range d.Path {
+		binary.LittleEndian.PutUint32(v[4+4*i:], p)
+	}
+	return v
+}
+
+func serializePrevOut(out *wire.TxOut) []byte {
+	var buf bytes.Buffer
+	buf.Grow(8 + 2 + wire.VarIntSerializeSize(uint64(len(out.PkScript))) + len(out.PkScript))
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], uint64(out.Value))
+	buf.Write(b[:])
+	binary.LittleEndian.PutUint16(b[:], out.Version)
+	buf.Write(b[:2])
+	wire.WriteVarBytes(&buf, 0, out.PkScript)
+	return buf.Bytes()
+}
+
+func uint32Bytes(v uint32) []byte {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, v)
+	return b
+}
+
+// Serialize writes the binary serialization of the packet to w.
+func (p *Packet) Serialize(w io.Writer) error {
+	const op errors.Op = "psbt.Serialize"
+	if err := p.sanityCheck(); err != nil {
+		return errors.E(op, err)
+	}
+
+	var txBuf bytes.Buffer
+	txBuf.Grow(p.UnsignedTx.SerializeSize())
+	if err := p.UnsignedTx.Serialize(&txBuf); err != nil {
+		return errors.E(op, errors.Encoding, err)
+	}
+
+	if _, err := w.Write(magic[:]); err != nil {
+		return errors.E(op, errors.IO, err)
+	}
+	kw := &kvWriter{w: w}
+	kw.pair(globalUnsignedTx, nil, txBuf.Bytes())
+	kw.pair(globalVersion, nil, uint32Bytes(Version))
+	for _, u := range p.Unknowns {
+		kw.raw(u.Key, u.Value)
+	}
+	kw.separator()
+
+	for i := range p.Inputs {
+		in := &p.Inputs[i]
+		if in.PrevOut != nil {
+			kw.pair(inputPrevOut, nil, serializePrevOut(in.PrevOut))
+		}
+		for _, s := range in.PartialSigs {
+			kw.pair(inputPartialSig, s.PubKey, s.Signature)
+		}
+		if in.SigHashType != 0 {
+			kw.pair(inputSigHashType, nil, uint32Bytes(uint32(in.SigHashType)))
+		}
+		if in.RedeemScript != nil {
+			kw.pair(inputRedeemScript, nil, in.RedeemScript)
+		}
+		for j := range in.Derivations {
+			d := &in.Derivations[j]
+			kw.pair(inputDerivation, d.PubKey, serializeDerivation(d))
+		}
+		if in.FinalScriptSig != nil {
+			kw.pair(inputFinalScriptSig, nil, in.FinalScriptSig)
+		}
+		for _, u := range in.Unknowns {
+			kw.raw(u.Key, u.Value)
+		}
+		kw.separator()
+	}
+
+	for i := range p.Outputs {
+		out := &p.Outputs[i]
+		if out.RedeemScript != nil {
+			kw.pair(outputRedeemScript, nil, out.RedeemScript)
+		}
+		for j := range out.Derivations {
+			d := &out.Derivations[j]
+			kw.pair(outputDerivation, d.PubKey, serializeDerivation(d))
+		}
+		for _, u := range out.Unknowns {
+			kw.raw(u.Key, u.Value)
+		}
+		kw.separator()
+	}
+
+	if kw.err != nil {
+		return errors.E(op, errors.IO, kw.err)
+	}
+	return nil
+}
+
+// Bytes returns the binary serialization of the packet.
+func (p *Packet) Bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := p.Serialize(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// EncodeBase64 returns the base64 encoding of the packet serialization.
+func (p *Packet) EncodeBase64() (string, error) {
+	b, err := p.Bytes()
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(b), nil
+}
+
+// readMap reads a single key-value map, calling fn for each pair.  It returns
+// after reading the zero byte separator that terminates the map.
+func readMap(r io.Reader, fn func(key, value []byte) error) error {
+	seen := make(map[string]struct{})
+	for {
+		key, err := wire.ReadVarBytes(r, 0, maxItemSize, "key")
+		if err != nil {
+			return err
+		}
+		if len(key) == 0 {
+			return nil
+		}
+		if _, ok := seen[string(key)]; ok {
+			return errors.Errorf("duplicate key %x", key)
+		}
+		seen[string(key)] = struct{}{}
+		value, err := wire.ReadVarBytes(r, 0, maxItemSize, "value")
+		if err != nil {
+			return err
+		}
+		if err := fn(key, value); err != nil {
+			return err
+		}
+	}
+}
+
+func deserializeDerivation(pubKey, value []byte) (Derivation, error) {
+	if len(value) < 4 || len(value)%4 != 0 {
+		return Derivation{}, errors.New("invalid key derivation")
+	}
+	d := Derivation{
+		PubKey:      pubKey,
+		Fingerprint: binary.LittleEndian.Uint32(value),
+		Path:        make([]uint32, len(value)/4-1),
+	}
+	for i := range d.Path {
+		d.Path[i] = binary.LittleEndian.Uint32(value[4+4*i:])
+	}
+	return d, nil
+}
+
+func deserializePrevOut(value []byte) (*wire.TxOut, error) {
+	if len(value) < 10 {
+		return nil, errors.New("invalid previous output")
+	}
+	out := &wire.TxOut{
+		Value:   int64(binary.LittleEndian.Uint64(value)),
+		Version: binary.LittleEndian.Uint16(value[8:]),
+	}
+	r := bytes.NewReader(value[10:])
+	script, err := wire.ReadVarBytes(r, 0, maxItemSize, "pkScript")
+	if err != nil {
+		return nil, err
+	}
+	if r.Len() != 0 {
+		return nil, errors.New("invalid previous output")
+	}
+	out.PkScript = script
+	return out, nil
+}
+
+func requireKeyLen(key []byte, n int) error {
+	if len(key) != n {
+		return errors.Errorf("invalid key length for key type %#02x", key[0])
+	}
+	return nil
+}
+
+// Deserialize reads a packet from its binary serialization.
+func Deserialize(r io.Reader) (*Packet, error) {
+	const op errors.Op = "psbt.Deserialize"
+
+	var m [len(magic)]byte
+	if _, err := io.ReadFull(r, m[:]); err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	if m != magic {
+		return nil, errors.E(op, errors.Encoding, "invalid magic")
+	}
+
+	p := new(Packet)
+	var version uint32
+	err := readMap(r, func(key, value []byte) error {
+		switch key[0] {
+		case globalUnsignedTx:
+			if err := requireKeyLen(key, 1); err != nil {
+				return err
+			}
+			tx := new(wire.MsgTx)
+			if err := tx.Deserialize(bytes.NewReader(value)); err != nil {
+				return err
+			}
+			p.UnsignedTx = tx
+		case globalVersion:
+			if err := requireKeyLen(key, 1); err != nil {
+				return err
+			}
+			if len(value) != 4 {
+				return errors.New("invalid version")
+			}
+			version = binary.LittleEndian.Uint32(value)
+		default:
+			p.Unknowns = append(p.Unknowns, Unknown{Key: key, Value: value})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	if version > Version {
+		return nil, errors.E(op, errors.Encoding,
+			errors.Errorf("unsupported version %d", version))
+	}
+	if p.UnsignedTx == nil {
+		return nil, errors.E(op, errors.Encoding, "missing unsigned transaction")
+	}
+	if err := checkUnsigned(p.UnsignedTx); err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+
+	p.Inputs = make([]Input, len(p.UnsignedTx.TxIn))
+	for i := range p.Inputs {
+		in := &p.Inputs[i]
+		err := readMap(r, func(key, value []byte) error {
+			switch key[0] {
+			case inputPrevOut:
+				if err := requireKeyLen(key, 1); err != nil {
+					return err
+				}
+				prevOut, err := deserializePrevOut(value)
+				if err != nil {
+					return err
+				}
+				in.PrevOut = prevOut
+			case inputPartialSig:
+				in.PartialSigs = append(in.PartialSigs, PartialSig{
+					PubKey:    key[1:],
+					Signature: value,
+				})
+			case inputSigHashType:
+				if err := requireKeyLen(key, 1); err != nil {
+					return err
+				}
+				if len(value) != 4 {
+					return errors.New("invalid sighash type")
+				}
+				in.SigHashType = txscript.SigHashType(binary.LittleEndian.Uint32(value))
+			case inputRedeemScript:
+				if err := requireKeyLen(key, 1); err != nil {
+					return err
+				}
+				in.RedeemScript = value
+			case inputDerivation:
+				d, err := deserializeDerivation(key[1:], value)
+				if err != nil {
+					return err
+				}
+				in.Derivations = append(in.Derivations, d)
+			case inputFinalScriptSig:
+				if err := requireKeyLen(key, 1); err != nil {
+					return err
+				}
+				in.FinalScriptSig = value
+			default:
+				in.Unknowns = append(in.Unknowns, Unknown{Key: key, Value: value})
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, errors.E(op, errors.Encoding, errors.Errorf("input %d: %v", i, err))
+		}
+	}
+
+	p.Outputs = make([]Output, len(p.UnsignedTx.TxOut))
+	for i := range p.Outputs {
+		out := &p.Outputs[i]
+		err := readMap(r, func(key, value []byte) error {
+			switch key[0] {
+			case outputRedeemScript:
+				if err := requireKeyLen(key, 1); err != nil {
+					return err
+				}
+				out.RedeemScript = value
+			case outputDerivation:
+				d, err := deserializeDerivation(key[1:], value)
+				if err != nil {
+					return err
+				}
+				out.Derivations = append(out.Derivations, d)
+			default:
+				out.Unknowns = append(out.Unknowns, Unknown{Key: key, Value: value})
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, errors.E(op, errors.Encoding, errors.Errorf("output %d: %v", i, err))
+		}
+	}
+
+	return p, nil
+}
+
+// DecodeBase64 decodes a packet from the base64 encoding of its serialization.
+func DecodeBase64(s string) (*Packet, error) {
+	const op errors.Op = "psbt.DecodeBase64"
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.E(op, errors.Encoding, err)
+	}
+	r := bytes.NewReader(b)
+	p, err := Deserialize(r)
+	if err != nil {
+		return nil, err
+	}
+	if r.Len() != 0 {
+		return nil, errors.E(op, errors.Encoding, "trailing bytes after packet")
+	}
+	return p, nil
+}
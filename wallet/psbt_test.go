@@ -0,0 +1,186 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/hdkeychain/v2"
+	"github.com/decred/dcrd/txscript/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3/psbt"
+	"github.com/decred/dcrwallet/wallet/v3/udb"
+	"github.com/decred/dcrwallet/wallet/v3/walletdb"
+)
+
+func TestProcessPSBTWatchingOnlyAccount(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	seed := bytes.Repeat([]byte{0x03}, hdkeychain.RecommendedSeedLen)
+	master, err := hdkeychain.NewMaster(seed, w.ChainParams())
+	if err != nil {
+		t.Fatal(err)
+	}
+	xpub, err := master.Neuter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	watched, err := w.ImportDescriptorAccount(ctx, "watched", "pkh("+xpub.String()+"/0/*)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	watchedAddr, err := w.NewExternalAddress(ctx, watched)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ownedAddr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Spend an output of each address.
+	tx := wire.NewMsgTx()
+	var prevOuts []*wire.TxOut
+	for i, addr := range []dcrutil.Address{watchedAddr, ownedAddr} {
+		var script []byte
+		switch addr := addr.(type) {
+		case V0Scripter:
+			script = addr.ScriptV0()
+		default:
+			script, err = txscript.PayToAddrScript(addr)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		prevOuts = append(prevOuts, wire.NewTxOut(1e8, script))
+		prevHash := chainhash.HashH([]byte{byte(i)})
+		tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&prevHash, 0, wire.TxTreeRegular), 1e8, nil))
+	}
+	tx.AddTxOut(wire.NewTxOut(1e8, prevOuts[1].PkScript))
+
+	newPacket := func() *psbt.Packet {
+		p, err := psbt.New(tx.Copy())
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := range p.Inputs {
+			p.Inputs[i].PrevOut = prevOuts[i]
+		}
+		return p
+	}
+
+	// Signing requires the wallet to be unlocked.
+	err = w.ProcessPSBT(ctx, newPacket(), txscript.SigHashAll, true)
+	if !errors.Is(err, errors.Locked) {
+		t.Fatalf("signing with locked wallet: got %v, want Locked", err)
+	}
+
+	err = w.Unlock(ctx, []byte("private"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := newPacket()
+	err = w.ProcessPSBT(ctx, p, txscript.SigHashAll, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.Inputs[0].PartialSigs) != 0 {
+		t.Errorf("watching-only input was signed")
+	}
+	if len(p.Inputs[1].PartialSigs) != 1 {
+		t.Errorf("owned input has %d signatures, want 1", len(p.Inputs[1].PartialSigs))
+	}
+
+	// Keys of the descriptor account were not derived from the wallet's
+	// coin type key and have no BIP0044 derivation.
+	if len(p.Inputs[0].Derivations) != 0 {
+		t.Errorf("watching-only input has derivations %v", p.Inputs[0].Derivations)
+	}
+	if len(p.Inputs[1].Derivations) != 1 {
+		t.Errorf("owned input has %d derivations, want 1", len(p.Inputs[1].Derivations))
+	}
+}
+
+func TestProcessPSBTPrevOut(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	err := w.Unlock(ctx, testPrivPass, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	script, _, err := addressScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	funding := wire.NewMsgTx()
+	funding.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 0}, 10e8, nil))
+	funding.AddTxOut(wire.NewTxOut(10e8, script))
+	rec, err := udb.NewTxRecordFromMsgTx(funding, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		_, err := w.processTransactionRecord(ctx, dbtx, rec, nil, nil)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&rec.Hash, 0, wire.TxTreeRegular), 10e8, nil))
+	tx.AddTxOut(wire.NewTxOut(9e8, script))
+	tests := []struct {
+		name    string
+		prevOut *wire.TxOut
+		invalid bool
+	}{
+		{"missing", nil, false},
+		{"matching", wire.NewTxOut(10e8, script), false},
+		{"value", wire.NewTxOut(1e8, script), true},
+		{"script", wire.NewTxOut(10e8, []byte{txscript.OP_TRUE}), true},
+	}
+	for _, test := range tests {
+		p, err := psbt.New(tx.Copy())
+		if err != nil {
+			t.Fatal(err)
+		}
+		p.Inputs[0].PrevOut = test.prevOut
+		err = w.ProcessPSBT(ctx, p, txscript.SigHashAll, true)
+		if test.invalid {
+			if !errors.Is(err, errors.Invalid) {
+				t.Errorf("%s: expected Invalid, got %v", test.name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		in := &p.Inputs[0]
+		if in.PrevOut == nil || in.PrevOut.Value != 10e8 || !bytes.Equal(in.PrevOut.PkScript, script) {
+			t.Errorf("%s: previous output %v is not the wallet's", test.name, in.PrevOut)
+		}
+		if len(in.PartialSigs) != 1 {
+			t.Errorf("%s: input has %d signatures, want 1", test.name, len(in.PartialSigs))
+		}
+	}
+}
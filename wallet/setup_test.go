@@ -24,6 +24,12 @@ var basicWalletConfig = Config{
 }
 
 func testWallet(t *testing.T, cfg *Config) (w *Wallet, teardown func()) {
+	return testWalletSeed(t, cfg, nil)
+}
+
+// testWalletSeed creates a test wallet from a seed.  A random seed is used if
+// seed is nil.
+func testWalletSeed(t *testing.T, cfg *Config, seed []byte) (w *Wallet, teardown func()) {
 	ctx := context.Background()
 	f, err := ioutil.TempFile("", "dcrwallet.testdb")
 	if err != nil {
@@ -38,7 +44,7 @@ func testWallet(t *testing.T, cfg *Config) (w *Wallet, teardown func()) {
 		db.Close()
 		os.Remove(f.Name())
 	}
-	err = Create(ctx, opaqueDB{db}, []byte(InsecurePubPassphrase), []byte("private"), nil, cfg.Params)
+	err = Create(ctx, opaqueDB{db}, []byte(InsecurePubPassphrase), []byte("private"), seed, cfg.Params)
 	if err != nil {
 		rm()
 		t.Fatal(err)
@@ -54,3 +60,34 @@ func testWallet(t *testing.T, cfg *Config) (w *Wallet, teardown func()) {
 	}
 	return
 }
+
+// testWatchingOnlyWallet creates a watching-only test wallet from the
+// extended public key of account 0.
+func testWatchingOnlyWallet(t *testing.T, cfg *Config, xpub string) (w *Wallet, teardown func()) {
+	ctx := context.Background()
+	f, err := ioutil.TempFile("", "dcrwallet.testdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	db, err := walletdb.Create("bdb", f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	rm := func() {
+		db.Close()
+		os.Remove(f.Name())
+	}
+	err = CreateWatchOnly(ctx, opaqueDB{db}, xpub, []byte(InsecurePubPassphrase), cfg.Params)
+	if err != nil {
+		rm()
+		t.Fatal(err)
+	}
+	cfg.DB = opaqueDB{db}
+	w, err = Open(ctx, cfg)
+	if err != nil {
+		rm()
+		t.Fatal(err)
+	}
+	return w, rm
+}
@@ -0,0 +1,403 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"sort"
+	"time"
+
+	"github.com/decred/dcrd/blockchain/stake/v2"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/txscript/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3/txauthor"
+	"github.com/decred/dcrwallet/wallet/v3/udb"
+	"github.com/decred/dcrwallet/wallet/v3/walletdb"
+)
+
+// Spending limit windows.
+const (
+	spendLimitDay  = 24 * time.Hour
+	spendLimitWeek = 7 * spendLimitDay
+)
+
+// largeSpendApprovalWindow is the duration after the time lock of a large
+// spend expires during which the spend may be resubmitted.
+const largeSpendApprovalWindow = 24 * time.Hour
+
+// AccountSpendPolicy describes the limits imposed on regular transactions
+// spending from an account.  Zero values disable the limit.
+//
+// The amount spent by a transaction is the total value of outputs paying
+// addresses not controlled by the wallet; moving funds between BIP0044
+// accounts of the wallet is not limited.  Addresses of imported xpub,
+// descriptor and multisig accounts and imported keys and scripts are not
+// considered controlled by the wallet, as their keys may be held by other
+// parties.
+type AccountSpendPolicy struct {
+	// DailyLimit and WeeklyLimit cap the amount spent within the last 24
+	// hours and 7 days.
+	DailyLimit  dcrutil.Amount
+	WeeklyLimit dcrutil.Amount
+
+	// MaxOutput is the maximum value of any single output paying an
+	// address not controlled by the wallet.
+	MaxOutput dcrutil.Amount
+
+	// AllowedDestinations, when not empty, restricts outputs to pay only
+	// these addresses or addresses controlled by the wallet.
+	AllowedDestinations []dcrutil.Address
+
+	// Spends of at least LargeSpend are time locked for LargeSpendDelay.
+	// The first attempt of a large spend is rejected, and an identical
+	// spend (paying the same outputs) is allowed once the delay has passed.
+	LargeSpend      dcrutil.Amount
+	LargeSpendDelay time.Duration
+}
+
+// SpendPolicy describes the spending policies of the wallet accounts.  Accounts
+// without a policy in Accounts use the Default policy, if set.
+//
+// Policies are enforced only for transactions the wallet creates or
+// publishes, and for transactions authorized with AuthorizeSpend.  Signing a transaction with
+// SignTransaction or ProcessPSBT does not check the policy, and a transaction
+// signed this way may be broadcast without the wallet.  Clients which must be
+// limited by a policy should not be granted access to these methods.
+type SpendPolicy struct {
+	Default  *AccountSpendPolicy
+	Accounts map[uint32]*AccountSpendPolicy
+}
+
+func (p *SpendPolicy) account(account uint32) *AccountSpendPolicy {
+	if p == nil {
+		return nil
+	}
+	if ap, ok := p.Accounts[account]; ok {
+		return ap
+	}
+	return p.Default
+}
+
+// SetSpendPolicy sets the spending policy enforced when creating and
+// publishing transactions.  A nil policy removes all limits.
+func (w *Wallet) SetSpendPolicy(p *SpendPolicy) {
+	w.spendPolicyMu.Lock()
+	w.spendPolicy = p
+	w.largeSpends = nil
+	w.spendPolicyMu.Unlock()
+}
+
+// SpendPolicy returns the spending policy set by SetSpendPolicy.
+func (w *Wallet) SpendPolicy() *SpendPolicy {
+	w.spendPolicyMu.Lock()
+	defer w.spendPolicyMu.Unlock()
+	return w.spendPolicy
+}
+
+type spendLimitKey struct{}
+
+// WithSpendLimit returns a context limiting the amount spent by every regular
+// transaction created or published with it.  The amount spent is calculated as
+// for spending policies.  When the parent context is already limited, the
+// lower limit applies.
+func WithSpendLimit(ctx context.Context, limit dcrutil.Amount) context.Context {
+	if parent, ok := spendLimit(ctx); ok && parent < limit {
+		return ctx
+	}
+	return context.WithValue(ctx, spendLimitKey{}, limit)
+}
+
+func spendLimit(ctx context.Context) (dcrutil.Amount, bool) {
+	limit, ok := ctx.Value(spendLimitKey{}).(dcrutil.Amount)
+	return limit, ok
+}
+
+// policySpend is the amount a transaction spends from an account limited by a
+// spending policy.  largeSpend identifies the time locked large spend which
+// was allowed, if any.
+type policySpend struct {
+	account    uint32
+	amount     dcrutil.Amount
+	largeSpend *chainhash.Hash
+}
+
+// policyOutput is a transaction output paying addresses not controlled by the
+// wallet.
+type policyOutput struct {
+	index int
+	addrs []dcrutil.Address
+	out   *wire.TxOut
+}
+
+// checkSpendPolicy checks that a transaction spending previous outputs with
+// the scripts prevScripts is allowed by the spending policy of every account
+// it spends from and by the spend limit of ctx, if any.  A nil or empty
+// prevScripts causes the previous output scripts to be looked up from the
+// transaction store.  The output at changeIndex, if not negative, is the
+// change output of a transaction created by the wallet, whose address may not
+// be recorded yet.  The spends which must be recorded by recordPolicySpends
+// after publishing the transaction are returned.  Violations are reported as
+// Policy errors.
+//
+// This method must be called with the spendPolicyMu held.
+func (w *Wallet) checkSpendPolicy(ctx context.Context, dbtx walletdb.ReadTx, tx *wire.MsgTx, prevScripts [][]byte,
+	changeIndex int, now time.Time) ([]policySpend, error) {
+
+	limit, limited := spendLimit(ctx)
+	if w.spendPolicy == nil && !limited {
+		return nil, nil
+	}
+	if stake.DetermineTxType(tx) != stake.TxTypeRegular {
+		return nil, nil
+	}
+
+	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+	txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+	// Determine the accounts of the spent previous outputs.
+	var accounts []uint32
+	seen := make(map[uint32]struct{})
+	for i, in := range tx.TxIn {
+		var pkScript []byte
+		if i < len(prevScripts) {
+			pkScript = prevScripts[i]
+		} else {
+			details, err := w.TxStore.TxDetails(txmgrNs, &in.PreviousOutPoint.Hash)
+			if errors.Is(err, errors.NotExist) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			if in.PreviousOutPoint.Index >= uint32(len(details.MsgTx.TxOut)) {
+				continue
+			}
+			pkScript = details.MsgTx.TxOut[in.PreviousOutPoint.Index].PkScript
+		}
+		_, addrs, _, err := txscript.ExtractPkScriptAddrs(0, pkScript, w.chainParams)
+		if err != nil || len(addrs) != 1 {
+			continue
+		}
+		account, err := w.Manager.AddrAccount(addrmgrNs, addrs[0])
+		if err != nil {
+			continue
+		}
+		if _, ok := seen[account]; !ok {
+			seen[account] = struct{}{}
+			accounts = append(accounts, account)
+		}
+	}
+	sort.Slice(accounts, func(i, j int) bool { return accounts[i] < accounts[j] })
+
+	// Determine the outputs paying addresses not controlled by the wallet.
+	var external []policyOutput
+	var total dcrutil.Amount
+	for i, out := range tx.TxOut {
+		if i == changeIndex {
+			continue
+		}
+		_, addrs, _, _ := txscript.ExtractPkScriptAddrs(out.Version, out.PkScript, w.chainParams)
+		if w.ownedBIP0044Addrs(addrmgrNs, addrs) || (out.Value == 0 && txscript.GetScriptClass(out.Version, out.PkScript) == txscript.NullDataTy) {
+			continue
+		}
+		external = append(external, policyOutput{i, addrs, out})
+		total += dcrutil.Amount(out.Value)
+	}
+	if limited && total > limit {
+		return nil, errors.E(errors.Policy, errors.Errorf("spend of %v exceeds request "+
+			"spend limit %v", total, limit))
+	}
+	if w.spendPolicy == nil {
+		return nil, nil
+	}
+
+	var spends []policySpend
+	for _, account := range accounts {
+		p := w.spendPolicy.account(account)
+		if p == nil {
+			continue
+		}
+
+		for _, out := range external {
+			value := dcrutil.Amount(out.out.Value)
+			if p.MaxOutput != 0 && value > p.MaxOutput {
+				return nil, errors.E(errors.Policy, errors.Errorf("output %d value %v exceeds "+
+					"maximum output value %v of account %d", out.index, value, p.MaxOutput, account))
+			}
+			if len(p.AllowedDestinations) != 0 && !destinationsAllowed(out.addrs, p.AllowedDestinations) {
+				return nil, errors.E(errors.Policy, errors.Errorf("output %d destination is not "+
+					"allowed by the policy of account %d", out.index, account))
+			}
+		}
+		if total == 0 {
+			continue
+		}
+
+		limits := []struct {
+			name   string
+			limit  dcrutil.Amount
+			window time.Duration
+		}{
+			{"daily", p.DailyLimit, spendLimitDay},
+			{"weekly", p.WeeklyLimit, spendLimitWeek},
+		}
+		for _, l := range limits {
+			if l.limit == 0 {
+				continue
+			}
+			spent, err := w.TxStore.SpentSince(txmgrNs, account, now.Add(-l.window))
+			if err != nil {
+				return nil, err
+			}
+			if spent+total > l.limit {
+				return nil, errors.E(errors.Policy, errors.Errorf("spend of %v exceeds %s "+
+					"limit %v of account %d (%v already spent)", total, l.name, l.limit, account, spent))
+			}
+		}
+
+		spend := policySpend{account: account, amount: total}
+		if p.LargeSpend != 0 && total >= p.LargeSpend && p.LargeSpendDelay > 0 {
+			key := largeSpendKey(account, external)
+			unlock, ok := w.largeSpends[key]
+			if !ok || now.After(unlock.Add(largeSpendApprovalWindow)) {
+				unlock = now.Add(p.LargeSpendDelay)
+				if w.largeSpends == nil {
+					w.largeSpends = make(map[chainhash.Hash]time.Time)
+				}
+				w.largeSpends[key] = unlock
+			}
+			if now.Before(unlock) {
+				return nil, errors.E(errors.Policy, errors.Errorf("large spend of %v from account %d "+
+					"is time locked until %v; resubmit the spend after this time",
+					total, account, unlock.Format(time.RFC3339)))
+			}
+			spend.largeSpend = &key
+		}
+
+		spends = append(spends, spend)
+	}
+	return spends, nil
+}
+
+// ownedBIP0044Addrs returns whether every address is an address of a BIP0044
+// account for which the wallet holds the private keys.
+func (w *Wallet) ownedBIP0044Addrs(addrmgrNs walletdb.ReadBucket, addrs []dcrutil.Address) bool {
+	if len(addrs) == 0 || w.Manager.WatchingOnly() {
+		return false
+	}
+	for _, a := range addrs {
+		account, err := w.Manager.AddrAccount(addrmgrNs, a)
+		if err != nil || account > udb.MaxAccountNum {
+			return false
+		}
+	}
+	return true
+}
+
+func destinationsAllowed(addrs, allowed []dcrutil.Address) bool {
+	if len(addrs) == 0 {
+		return false
+	}
+	for _, a := range addrs {
+		found := false
+		for _, b := range allowed {
+			if a.Address() == b.Address() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// largeSpendKey identifies a large spend from an account by the account and
+// the outputs paying addresses not controlled by the wallet, so that a spend
+// which is recreated with different inputs and change is recognized.
+func largeSpendKey(account uint32, external []policyOutput) chainhash.Hash {
+	outputs := make([][]byte, len(external))
+	for i, o := range external {
+		b := make([]byte, 10+len(o.out.PkScript))
+		binary.LittleEndian.PutUint64(b[0:8], uint64(o.out.Value))
+		binary.LittleEndian.PutUint16(b[8:10], o.out.Version)
+		copy(b[10:], o.out.PkScript)
+		outputs[i] = b
+	}
+	sort.Slice(outputs, func(i, j int) bool { return bytes.Compare(outputs[i], outputs[j]) < 0 })
+
+	var buf bytes.Buffer
+	var b [4]byte
+	binary.LittleEndian.PutUint32(b[:], account)
+	buf.Write(b[:])
+	for _, o := range outputs {
+		buf.Write(o)
+	}
+	return chainhash.HashH(buf.Bytes())
+}
+
+// AuthorizeSpend checks that an unsigned transaction created by
+// NewUnsignedTransaction, which will be signed by the wallet but published by
+// another party, is allowed by the spending policy of every account it spends
+// from and by the spend limit of ctx, if any.  The spend is recorded before
+// returning, even if the transaction is never published.  Violations are
+// reported as Policy errors.
+func (w *Wallet) AuthorizeSpend(ctx context.Context, atx *txauthor.AuthoredTx) error {
+	const op errors.Op = "wallet.AuthorizeSpend"
+
+	w.spendPolicyMu.Lock()
+	defer w.spendPolicyMu.Unlock()
+	now := time.Now()
+
+	var spends []policySpend
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
+		spends, err = w.checkSpendPolicy(ctx, dbtx, atx.Tx, atx.PrevScripts, atx.ChangeIndex, now)
+		return err
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	txHash := atx.Tx.TxHash()
+	err = w.recordPolicySpends(ctx, &txHash, spends, now)
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// recordPolicySpends records the amounts spent from accounts limited by a
+// spending policy.  Recorded spends older than the longest limit window are
+// pruned.
+//
+// This method must be called with the spendPolicyMu held.
+func (w *Wallet) recordPolicySpends(ctx context.Context, txHash *chainhash.Hash, spends []policySpend,
+	now time.Time) error {
+
+	if len(spends) == 0 {
+		return nil
+	}
+	for _, s := range spends {
+		if s.largeSpend != nil {
+			delete(w.largeSpends, *s.largeSpend)
+		}
+	}
+	return walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		for _, s := range spends {
+			err := w.TxStore.RecordSpend(ns, s.account, now, txHash, s.amount)
+			if err != nil {
+				return err
+			}
+		}
+		return w.TxStore.PruneSpends(ns, now.Add(-spendLimitWeek))
+	})
+}
@@ -0,0 +1,347 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrec/secp256k1/v2"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/hdkeychain/v2"
+	"github.com/decred/dcrd/txscript/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3/udb"
+	"github.com/decred/dcrwallet/wallet/v3/walletdb"
+)
+
+// spendPolicyTestWallet creates an unlocked wallet with a 10 DCR output paying
+// the returned address of the default account.
+func spendPolicyTestWallet(t *testing.T) (*Wallet, dcrutil.Address, func()) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	w.SetNetworkBackend(mockNetwork{})
+
+	err := w.Unlock(ctx, testPrivPass, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fundAddr, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fundScript, _, err := addressScript(fundAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	funding := wire.NewMsgTx()
+	funding.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 0}, 10e8, nil))
+	funding.AddTxOut(wire.NewTxOut(10e8, fundScript))
+	rec, err := udb.NewTxRecordFromMsgTx(funding, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		_, err := w.processTransactionRecord(ctx, dbtx, rec, nil, nil)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return w, fundAddr, teardown
+}
+
+func TestSpendPolicy(t *testing.T) {
+	ctx := context.Background()
+	w, fundAddr, teardown := spendPolicyTestWallet(t)
+	defer teardown()
+
+	dest := func(b byte) dcrutil.Address {
+		addr, err := dcrutil.NewAddressPubKeyHash(bytes.Repeat([]byte{b}, 20),
+			w.chainParams, dcrec.STEcdsaSecp256k1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return addr
+	}
+	destA, destB, destC := dest(1), dest(2), dest(3)
+	w.SetSpendPolicy(&SpendPolicy{
+		Accounts: map[uint32]*AccountSpendPolicy{
+			0: {
+				DailyLimit:          3e8,
+				MaxOutput:           2e8,
+				AllowedDestinations: []dcrutil.Address{destA, destB},
+				LargeSpend:          15e7,
+				LargeSpendDelay:     time.Millisecond,
+			},
+		},
+	})
+	sendContext := func(ctx context.Context, addr dcrutil.Address, amount dcrutil.Amount) error {
+		pkScript, _, err := addressScript(addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = w.SendOutputs(ctx, []*wire.TxOut{wire.NewTxOut(int64(amount), pkScript)}, 0, 0, 0, 0)
+		return err
+	}
+	send := func(addr dcrutil.Address, amount dcrutil.Amount) error {
+		return sendContext(ctx, addr, amount)
+	}
+
+	tests := []struct {
+		name   string
+		addr   dcrutil.Address
+		amount dcrutil.Amount
+		policy bool
+	}{
+		{"allowed spend", destA, 1e8, false},
+		{"output above maximum", destA, 25e7, true},
+		{"destination not allowed", destC, 1e8, true},
+		{"send to wallet", fundAddr, 5e8, false},
+		{"time locked large spend", destB, 19e7, true},
+	}
+	for _, test := range tests {
+		err := send(test.addr, test.amount)
+		if test.policy != errors.Is(err, errors.Policy) || (!test.policy && err != nil) {
+			t.Errorf("%s: unexpected error %v", test.name, err)
+		}
+	}
+
+	// The large spend is allowed once resubmitted after the time lock, after
+	// which the daily limit is reached.
+	time.Sleep(10 * time.Millisecond)
+	if err := send(destB, 19e7); err != nil {
+		t.Fatalf("resubmitted large spend: %v", err)
+	}
+	if err := send(destA, 2e7); !errors.Is(err, errors.Policy) {
+		t.Fatalf("spend above daily limit: expected Policy, got %v", err)
+	}
+
+	// Spends of the last day are recorded and expire with the window.
+	var spent, spentBefore dcrutil.Amount
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		var err error
+		spent, err = w.TxStore.SpentSince(ns, 0, time.Now().Add(-spendLimitDay))
+		if err != nil {
+			return err
+		}
+		spentBefore, err = w.TxStore.SpentSince(ns, 0, time.Now().Add(time.Minute))
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if spent != 29e7 || spentBefore != 0 {
+		t.Errorf("recorded spends %v (%v in the future)", spent, spentBefore)
+	}
+
+	w.SetSpendPolicy(nil)
+	if err := send(destC, 1e8); err != nil {
+		t.Errorf("spend without policy: %v", err)
+	}
+
+	// Spend limits of a context apply without a policy, and only to
+	// addresses not controlled by the wallet.
+	limited := WithSpendLimit(ctx, 5e7)
+	if err := sendContext(limited, destC, 6e7); !errors.Is(err, errors.Policy) {
+		t.Errorf("spend above context limit: expected Policy, got %v", err)
+	}
+	if err := sendContext(WithSpendLimit(limited, 1e8), destC, 6e7); !errors.Is(err, errors.Policy) {
+		t.Errorf("raised context limit: expected Policy, got %v", err)
+	}
+	if err := sendContext(limited, fundAddr, 1e8); err != nil {
+		t.Errorf("send to wallet with context limit: %v", err)
+	}
+	if err := sendContext(limited, destC, 4e7); err != nil {
+		t.Errorf("spend below context limit: %v", err)
+	}
+}
+
+func TestSpendPolicyMultisig(t *testing.T) {
+	ctx := context.Background()
+	w, _, teardown := spendPolicyTestWallet(t)
+	defer teardown()
+
+	_, pub := secp256k1.PrivKeyFromBytes(bytes.Repeat([]byte{1}, 32))
+	pubkey, err := dcrutil.NewAddressSecpPubKey(pub.SerializeCompressed(), w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	send := func(amount dcrutil.Amount) error {
+		_, _, _, err := w.CreateMultisigTx(ctx, 0, amount,
+			[]*dcrutil.AddressSecpPubKey{pubkey}, 1, 0)
+		return err
+	}
+
+	w.SetSpendPolicy(&SpendPolicy{
+		Accounts: map[uint32]*AccountSpendPolicy{
+			0: {DailyLimit: 15e7, MaxOutput: 2e8},
+		},
+	})
+	if err := send(3e8); !errors.Is(err, errors.Policy) {
+		t.Fatalf("multisig output above maximum: expected Policy, got %v", err)
+	}
+	if err := send(1e8); err != nil {
+		t.Fatalf("allowed multisig spend: %v", err)
+	}
+
+	// The multisig script is recorded by the wallet after the first send but
+	// remains an external destination.
+	if err := send(1e8); !errors.Is(err, errors.Policy) {
+		t.Fatalf("multisig spend above daily limit: expected Policy, got %v", err)
+	}
+	var spent dcrutil.Amount
+	err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		var err error
+		spent, err = w.TxStore.SpentSince(ns, 0, time.Now().Add(-spendLimitDay))
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if spent != 1e8 {
+		t.Errorf("recorded multisig spends %v", spent)
+	}
+
+	w.SetSpendPolicy(nil)
+	if err := send(1e8); err != nil {
+		t.Errorf("multisig spend without policy: %v", err)
+	}
+	if _, _, _, err := w.CreateMultisigTx(WithSpendLimit(ctx, 5e7), 0, 6e7,
+		[]*dcrutil.AddressSecpPubKey{pubkey}, 1, 0); !errors.Is(err, errors.Policy) {
+		t.Errorf("multisig spend above context limit: expected Policy, got %v", err)
+	}
+}
+
+func TestSpendPolicyImported(t *testing.T) {
+	ctx := context.Background()
+	w, _, teardown := spendPolicyTestWallet(t)
+	defer teardown()
+
+	// Record an xpub account and a script controlled by another party.
+	xprv, err := hdkeychain.NewMaster(bytes.Repeat([]byte{4}, 32), w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	xpub, err := xprv.Neuter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.ImportXpubAccount(ctx, "watched", xpub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	account, err := w.AccountNumber(ctx, "watched")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		return w.Manager.SyncAccountToAddrIndex(ns, account, 1, udb.ExternalBranch)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	child, err := xpub.Child(udb.ExternalBranch)
+	if err == nil {
+		child, err = child.Child(0)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	childPub, err := child.ECPubKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	xpubAddr, err := dcrutil.NewAddressPubKeyHash(dcrutil.Hash160(childPub.SerializeCompressed()),
+		w.chainParams, dcrec.STEcdsaSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, pub := secp256k1.PrivKeyFromBytes(bytes.Repeat([]byte{5}, 32))
+	pubkey, err := dcrutil.NewAddressSecpPubKey(pub.SerializeCompressed(), w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	script, err := txscript.MultiSigScript([]*dcrutil.AddressSecpPubKey{pubkey}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.ImportScript(ctx, script)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scriptAddr, err := dcrutil.NewAddressScriptHash(script, w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w.SetSpendPolicy(&SpendPolicy{
+		Accounts: map[uint32]*AccountSpendPolicy{
+			0: {MaxOutput: 2e8},
+		},
+	})
+	for _, addr := range []dcrutil.Address{xpubAddr, scriptAddr} {
+		err = walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+			_, err := w.Manager.AddrAccount(dbtx.ReadBucket(waddrmgrNamespaceKey), addr)
+			return err
+		})
+		if err != nil {
+			t.Fatalf("imported address %v is not recorded: %v", addr, err)
+		}
+		pkScript, _, err := addressScript(addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = w.SendOutputs(ctx, []*wire.TxOut{wire.NewTxOut(3e8, pkScript)}, 0, 0, 0, 0)
+		if !errors.Is(err, errors.Policy) {
+			t.Errorf("send to imported address %v: expected Policy, got %v", addr, err)
+		}
+	}
+}
+
+func TestAuthorizeSpend(t *testing.T) {
+	ctx := context.Background()
+	w, _, teardown := spendPolicyTestWallet(t)
+	defer teardown()
+
+	dest, err := dcrutil.NewAddressPubKeyHash(bytes.Repeat([]byte{1}, 20),
+		w.chainParams, dcrec.STEcdsaSecp256k1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, _, err := addressScript(dest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	authorize := func(amount dcrutil.Amount) error {
+		outputs := []*wire.TxOut{wire.NewTxOut(int64(amount), pkScript)}
+		atx, err := w.NewUnsignedTransaction(ctx, outputs, w.RelayFee(), 0, 0,
+			OutputSelectionAlgorithmDefault, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return w.AuthorizeSpend(ctx, atx)
+	}
+
+	w.SetSpendPolicy(&SpendPolicy{
+		Accounts: map[uint32]*AccountSpendPolicy{
+			0: {DailyLimit: 15e7},
+		},
+	})
+	if err := authorize(1e8); err != nil {
+		t.Fatalf("allowed spend: %v", err)
+	}
+	if err := authorize(1e8); !errors.Is(err, errors.Policy) {
+		t.Fatalf("spend above daily limit: expected Policy, got %v", err)
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"math"
+	"time"
+
+	blockchain "github.com/decred/dcrd/blockchain/standalone"
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/deployments/v2"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3/walletdb"
+)
+
+// StakeDifficultyEstimate describes the projected ticket price of the next
+// ticket window (stake difficulty interval).
+type StakeDifficultyEstimate struct {
+	// Min and Max are the prices if no more tickets, or the maximum number
+	// of tickets, are purchased in the remainder of the current window.
+	Min dcrutil.Amount
+	Max dcrutil.Amount
+
+	// Expected is the price if tickets continue to be purchased at the
+	// average rate of the current window.
+	Expected dcrutil.Amount
+
+	// User is the price if the number of tickets passed to
+	// EstimateStakeDifficulty are purchased in the remainder of the current
+	// window.  It is only set when a number of tickets was passed.
+	User dcrutil.Amount
+}
+
+// EstimateStakeDifficulty projects the ticket price of the next ticket window
+// from the pool size and the tickets purchased in the current window as of the
+// main chain tip block.  If tickets is non-nil, the price is also estimated
+// for that number of tickets being purchased in the remainder of the window.
+// This function only succeeds when DCP0001 is known to be active.
+func (w *Wallet) EstimateStakeDifficulty(ctx context.Context, tickets *uint32) (*StakeDifficultyEstimate, error) {
+	const op errors.Op = "wallet.EstimateStakeDifficulty"
+	var est StakeDifficultyEstimate
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		tipHash, tipHeight := w.TxStore.MainChainTip(ns)
+		if !deployments.DCP0001.Active(tipHeight, w.chainParams.Net) {
+			return errors.E(errors.Deployment, "DCP0001 is not known to be active")
+		}
+		tip, err := w.TxStore.GetBlockHeader(dbtx, &tipHash)
+		if err != nil {
+			return err
+		}
+
+		est.Min, err = w.estimateNextStakeDifficultyV2(dbtx, tip, 0, false)
+		if err != nil {
+			return err
+		}
+		est.Max, err = w.estimateNextStakeDifficultyV2(dbtx, tip, 0, true)
+		if err != nil {
+			return err
+		}
+
+		// Extrapolate the tickets purchased so far in the window over the
+		// remaining blocks of the window.
+		intervalSize := int32(w.chainParams.StakeDiffWindowSize)
+		windowStart := tipHeight / intervalSize * intervalSize
+		purchased, err := w.sumPurchasedTickets(dbtx, tip, nil, int64(tipHeight-windowStart+1))
+		if err != nil {
+			return err
+		}
+		blocksSince := float64(tipHeight - windowStart + 1)
+		remaining := float64(windowStart + intervalSize - tipHeight - 1)
+		expectedTickets := int64(math.Floor(float64(purchased) / blocksSince * remaining))
+		est.Expected, err = w.estimateNextStakeDifficultyV2(dbtx, tip, expectedTickets, false)
+		if err != nil {
+			return err
+		}
+
+		if tickets != nil {
+			est.User, err = w.estimateNextStakeDifficultyV2(dbtx, tip, int64(*tickets), false)
+			if err != nil {
+				return errors.E(errors.Invalid, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return &est, nil
+}
+
+// StakeRewardEstimate describes the expected outcome of a ticket purchased at
+// the next block.
+type StakeRewardEstimate struct {
+	// TicketPrice is the price of a ticket purchased at the next block, and
+	// PoolSize is the live ticket pool size used to estimate the chance of
+	// the ticket being called to vote.
+	TicketPrice dcrutil.Amount
+	PoolSize    uint32
+
+	// VoteProbability and ExpiryProbability are the chances of the ticket
+	// voting or expiring before it is called to vote.
+	VoteProbability   float64
+	ExpiryProbability float64
+
+	// AverageVoteBlocks and AverageVoteTime are the average number of
+	// blocks and the average time from purchase until the ticket votes,
+	// given that it votes.
+	AverageVoteBlocks float64
+	AverageVoteTime   time.Duration
+
+	// VoteReward is the vote subsidy at the average vote height.
+	VoteReward dcrutil.Amount
+
+	// ROI is the expected reward, accounting for the chance of expiry, as
+	// a proportion of the ticket price.  AnnualROI scales ROI by the average
+	// time the ticket price is locked, whether the ticket votes or expires.
+	// Transaction fees are not included.
+	ROI       float64
+	AnnualROI float64
+}
+
+// EstimateStakeReward estimates the chance of voting, the average vote time,
+// and the return of a ticket purchased at the next block after the main chain
+// tip block.  This function only succeeds when DCP0001 is known to be active.
+func (w *Wallet) EstimateStakeReward(ctx context.Context) (*StakeRewardEstimate, error) {
+	const op errors.Op = "wallet.EstimateStakeReward"
+	var tip *wire.BlockHeader
+	var sdiff dcrutil.Amount
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		tipHash, tipHeight := w.TxStore.MainChainTip(ns)
+		if !deployments.DCP0001.Active(tipHeight, w.chainParams.Net) {
+			return errors.E(errors.Deployment, "DCP0001 is not known to be active")
+		}
+		var err error
+		tip, err = w.TxStore.GetBlockHeader(dbtx, &tipHash)
+		if err != nil {
+			return err
+		}
+		sdiff, err = w.nextRequiredDCP0001PoSDifficulty(dbtx, tip, nil)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return estimateStakeReward(w.chainParams, w.subsidyCache, int32(tip.Height),
+		tip.PoolSize, sdiff), nil
+}
+
+// estimateStakeReward estimates the outcome of a ticket purchased at the block
+// after height, with a live ticket pool of poolSize tickets.  An empty pool
+// size is replaced by the target pool size of the network.
+func estimateStakeReward(params *chaincfg.Params, subsidy *blockchain.SubsidyCache,
+	height int32, poolSize uint32, price dcrutil.Amount) *StakeRewardEstimate {
+
+	if poolSize == 0 {
+		poolSize = uint32(params.TicketPoolSize) * uint32(params.TicketsPerBlock)
+	}
+
+	// Each block selects TicketsPerBlock of the live tickets to vote, so the
+	// number of blocks a live ticket waits to be selected is geometrically
+	// distributed.  The ticket expires if it is not selected within
+	// TicketExpiry blocks.
+	p := float64(params.TicketsPerBlock) / float64(poolSize)
+	if p > 1 {
+		p = 1
+	}
+	q := 1 - p
+	n := float64(params.TicketExpiry)
+	qn := math.Pow(q, n)
+	voteProb := 1 - qn
+
+	// The mean wait of tickets selected within n blocks is
+	// sum(t*p*q^(t-1), t=1..n) / (1-q^n).
+	var wait float64
+	if voteProb > 0 {
+		wait = (1 - (n+1)*qn + n*qn*q) / p / voteProb
+	}
+
+	// The ticket is mined in the next block and must mature before it is
+	// live.
+	immature := 1 + float64(params.TicketMaturity)
+	voteBlocks := immature + wait
+	voteHeight := int64(height) + int64(math.Round(voteBlocks))
+	reward := dcrutil.Amount(subsidy.CalcStakeVoteSubsidy(voteHeight))
+	blockTime := float64(params.TargetTimePerBlock)
+
+	est := &StakeRewardEstimate{
+		TicketPrice:       price,
+		PoolSize:          poolSize,
+		VoteProbability:   voteProb,
+		ExpiryProbability: qn,
+		AverageVoteBlocks: voteBlocks,
+		AverageVoteTime:   time.Duration(voteBlocks * blockTime),
+		VoteReward:        reward,
+	}
+	if price > 0 {
+		est.ROI = voteProb * float64(reward) / float64(price)
+		lockBlocks := immature + voteProb*wait + qn*n
+		const year = float64(365 * 24 * time.Hour)
+		est.AnnualROI = est.ROI * year / (lockBlocks * blockTime)
+	}
+	return est
+}
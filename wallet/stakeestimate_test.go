@@ -0,0 +1,73 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"math"
+	"testing"
+
+	blockchain "github.com/decred/dcrd/blockchain/standalone"
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrd/dcrutil/v2"
+)
+
+func TestEstimateStakeReward(t *testing.T) {
+	params := chaincfg.MainNetParams()
+	subsidy := blockchain.NewSubsidyCache(params)
+	price := dcrutil.Amount(100e8)
+	targetPoolSize := uint32(params.TicketPoolSize) * uint32(params.TicketsPerBlock)
+
+	est := estimateStakeReward(params, subsidy, 400000, targetPoolSize, price)
+	if est.TicketPrice != price || est.PoolSize != targetPoolSize {
+		t.Fatalf("unexpected price %v and pool size %v", est.TicketPrice, est.PoolSize)
+	}
+
+	// With the target pool size, a ticket is expected to be selected after
+	// TicketPoolSize blocks and expires with a probability of about e^-5.
+	wantExpiry := math.Exp(-float64(params.TicketExpiry) / float64(params.TicketPoolSize))
+	if math.Abs(est.ExpiryProbability-wantExpiry) > 1e-4 {
+		t.Errorf("expiry probability %v, want %v", est.ExpiryProbability, wantExpiry)
+	}
+	if math.Abs(est.VoteProbability+est.ExpiryProbability-1) > 1e-9 {
+		t.Errorf("vote and expiry probabilities %v and %v do not sum to 1",
+			est.VoteProbability, est.ExpiryProbability)
+	}
+	immature := float64(1 + params.TicketMaturity)
+	wait := est.AverageVoteBlocks - immature
+	if wait <= 0 || wait >= float64(params.TicketPoolSize) {
+		t.Errorf("average wait of %v blocks is not below the untruncated mean %v",
+			wait, params.TicketPoolSize)
+	}
+	wantTime := est.AverageVoteBlocks * float64(params.TargetTimePerBlock)
+	if math.Abs(float64(est.AverageVoteTime)-wantTime) > 1 {
+		t.Errorf("average vote time %v, want %v", est.AverageVoteTime, wantTime)
+	}
+	voteHeight := 400000 + int64(math.Round(est.AverageVoteBlocks))
+	if want := dcrutil.Amount(subsidy.CalcStakeVoteSubsidy(voteHeight)); est.VoteReward != want {
+		t.Errorf("vote reward %v, want %v", est.VoteReward, want)
+	}
+	wantROI := est.VoteProbability * float64(est.VoteReward) / float64(price)
+	if math.Abs(est.ROI-wantROI) > 1e-12 {
+		t.Errorf("ROI %v, want %v", est.ROI, wantROI)
+	}
+	if est.AnnualROI <= est.ROI {
+		t.Errorf("annual ROI %v is not greater than ROI %v for a ticket voting "+
+			"within a year", est.AnnualROI, est.ROI)
+	}
+
+	// An empty pool is replaced by the target pool size.
+	empty := estimateStakeReward(params, subsidy, 400000, 0, price)
+	if *empty != *est {
+		t.Errorf("empty pool estimate %+v differs from target pool estimate %+v",
+			empty, est)
+	}
+
+	// A larger pool increases the wait and expiry probability.
+	large := estimateStakeReward(params, subsidy, 400000, 2*targetPoolSize, price)
+	if large.ExpiryProbability <= est.ExpiryProbability ||
+		large.AverageVoteBlocks <= est.AverageVoteBlocks {
+		t.Errorf("larger pool did not increase expiry probability or wait")
+	}
+}
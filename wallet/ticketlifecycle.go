@@ -0,0 +1,275 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/decred/dcrd/blockchain/stake/v2"
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3/udb"
+	"github.com/decred/dcrwallet/wallet/v3/walletdb"
+)
+
+// TicketLifecycle describes the purchase and outcome of a ticket with
+// commitments controlled by the wallet.
+type TicketLifecycle struct {
+	Hash chainhash.Hash
+
+	// Account is the account of the first wallet-controlled commitment.
+	Account uint32
+	Status  TicketStatus
+
+	// Price is the ticket price and PurchaseFee the transaction fee of the
+	// ticket purchase.  Commitment is the total amount of the commitments
+	// controlled by the wallet.  VSPFee is the total of the commitments
+	// paid to other parties, such as stakepool fees, and of the fee paid to
+	// a fee-based VSP.
+	Price       dcrutil.Amount
+	PurchaseFee dcrutil.Amount
+	Commitment  dcrutil.Amount
+	VSPFee      dcrutil.Amount
+
+	// PurchaseHeight and MaturityHeight are -1 when the ticket is unmined.
+	// PurchaseTime is the block time, or the time the ticket was received
+	// when unmined.
+	PurchaseHeight int32
+	PurchaseTime   time.Time
+	MaturityHeight int32
+
+	// SpenderHash is the vote or revocation spending the ticket, or nil.
+	// SpenderHeight is -1 when the spender is unmined or nil.  Subsidy is
+	// the vote subsidy, and Returned is the total of the spender outputs
+	// paying the wallet-controlled commitments.
+	SpenderHash   *chainhash.Hash
+	SpenderHeight int32
+	SpenderTime   time.Time
+	Subsidy       dcrutil.Amount
+	Returned      dcrutil.Amount
+}
+
+// Spent returns whether the ticket was voted or revoked.
+func (t *TicketLifecycle) Spent() bool {
+	return t.Status == TicketStatusVoted || t.Status == TicketStatusRevoked
+}
+
+// Reward returns the amount returned to the wallet by the vote or revocation
+// in excess of the wallet-controlled commitments.  It is negative when a
+// revocation pays a transaction fee, and zero for unspent tickets.
+func (t *TicketLifecycle) Reward() dcrutil.Amount {
+	if !t.Spent() {
+		return 0
+	}
+	return t.Returned - t.Commitment
+}
+
+// Profit returns the reward of the ticket less the purchase and VSP fees.
+func (t *TicketLifecycle) Profit() dcrutil.Amount {
+	return t.Reward() - t.PurchaseFee - t.VSPFee
+}
+
+// SpendDuration returns the time from the purchase of the ticket until it was
+// voted or revoked, or zero for unspent tickets.
+func (t *TicketLifecycle) SpendDuration() time.Duration {
+	if !t.Spent() || t.SpenderTime.IsZero() {
+		return 0
+	}
+	return t.SpenderTime.Sub(t.PurchaseTime)
+}
+
+// TicketLifecycles returns the lifecycle of every ticket with commitments
+// controlled by the wallet, ordered by purchase height with unmined tickets
+// last.  Tickets which are unspent and mature are reported live, as missed
+// tickets can not be determined without a network backend.
+func (w *Wallet) TicketLifecycles(ctx context.Context) ([]*TicketLifecycle, error) {
+	const op errors.Op = "wallet.TicketLifecycles"
+	var tickets []*TicketLifecycle
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		_, tipHeight := w.TxStore.MainChainTip(ns)
+		it := w.TxStore.IterateTickets(dbtx)
+		defer it.Close()
+		for it.Next() {
+			t, err := w.ticketLifecycle(dbtx, &it.Ticket, tipHeight)
+			if err != nil {
+				return err
+			}
+			if t != nil {
+				tickets = append(tickets, t)
+			}
+		}
+		return it.Err()
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	sort.Slice(tickets, func(i, j int) bool {
+		hi, hj := tickets[i].PurchaseHeight, tickets[j].PurchaseHeight
+		if hi != hj {
+			return hj == -1 || hi != -1 && hi < hj
+		}
+		return tickets[i].PurchaseTime.Before(tickets[j].PurchaseTime)
+	})
+	return tickets, nil
+}
+
+// ticketLifecycle returns the lifecycle of a ticket, or nil if the wallet does
+// not control any of its commitments.
+func (w *Wallet) ticketLifecycle(dbtx walletdb.ReadTx, ticket *udb.Ticket, tipHeight int32) (*TicketLifecycle, error) {
+	ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+	owned := make(map[uint32]ticketCommitment)
+	for i := uint32(1); i < uint32(len(ticket.MsgTx.TxOut)); i += 2 {
+		amount, account, err := w.TxStore.TicketCommitment(ns, &ticket.Hash, i)
+		if errors.Is(err, errors.NotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		owned[i] = ticketCommitment{amount: amount, account: account}
+	}
+	if len(owned) == 0 {
+		return nil, nil
+	}
+
+	purchaseTime := ticket.Received
+	if ticket.Block.Height != -1 {
+		h, err := w.TxStore.GetBlockHeader(dbtx, &ticket.Block.Hash)
+		if err != nil {
+			return nil, err
+		}
+		purchaseTime = h.Timestamp
+	}
+
+	var spender *udb.TxDetails
+	if ticket.SpenderHash != (chainhash.Hash{}) {
+		var err error
+		spender, err = w.TxStore.TxDetails(ns, &ticket.SpenderHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	t, err := newTicketLifecycle(w.chainParams, &ticket.MsgTx, owned, ticket.Block.Height,
+		purchaseTime, spender, tipHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	// Include the fee paid to a fee-based VSP.
+	vspTicket, err := w.TxStore.VSPTicket(ns, &ticket.Hash)
+	switch {
+	case errors.Is(err, errors.NotExist):
+	case err != nil:
+		return nil, err
+	case vspTicket.State == udb.VSPFeePaid, vspTicket.State == udb.VSPFeeConfirmed:
+		t.VSPFee += vspTicket.FeeAmount
+	}
+	return t, nil
+}
+
+// ticketCommitment describes a wallet-controlled ticket commitment.
+type ticketCommitment struct {
+	amount  dcrutil.Amount
+	account uint32
+}
+
+// newTicketLifecycle creates the lifecycle of a ticket mined at height (-1 if
+// unmined) with the wallet-controlled commitments owned, keyed by output
+// index, and spent by spender (nil if unspent).
+func newTicketLifecycle(params *chaincfg.Params, ticket *wire.MsgTx, owned map[uint32]ticketCommitment,
+	height int32, purchaseTime time.Time, spender *udb.TxDetails, tipHeight int32) (*TicketLifecycle, error) {
+
+	t := &TicketLifecycle{
+		Hash:           ticket.TxHash(),
+		Account:        ^uint32(0),
+		Price:          dcrutil.Amount(ticket.TxOut[0].Value),
+		PurchaseHeight: height,
+		PurchaseTime:   purchaseTime,
+		MaturityHeight: -1,
+		SpenderHeight:  -1,
+	}
+	if height != -1 {
+		// Tickets mature one block later than the maturity parameter
+		// indicates; see ticketMatured.
+		t.MaturityHeight = height + int32(params.TicketMaturity) + 1
+	}
+
+	var in, out dcrutil.Amount
+	for _, txIn := range ticket.TxIn {
+		in += dcrutil.Amount(txIn.ValueIn)
+	}
+	for _, txOut := range ticket.TxOut {
+		out += dcrutil.Amount(txOut.Value)
+	}
+	if in > out {
+		t.PurchaseFee = in - out
+	}
+
+	for i := 1; i < len(ticket.TxOut); i += 2 {
+		c, ok := owned[uint32(i)]
+		if !ok {
+			amount, err := stake.AmountFromSStxPkScrCommitment(ticket.TxOut[i].PkScript)
+			if err != nil {
+				return nil, err
+			}
+			t.VSPFee += amount
+			continue
+		}
+		if t.Account == ^uint32(0) {
+			t.Account = c.account
+		}
+		t.Commitment += c.amount
+	}
+
+	if spender == nil {
+		switch {
+		case height == -1:
+			t.Status = TicketStatusUnmined
+		case !ticketMatured(params, height, tipHeight):
+			t.Status = TicketStatusImmature
+		case ticketExpired(params, height, tipHeight):
+			t.Status = TicketStatusExpired
+		default:
+			t.Status = TicketStatusLive
+		}
+		return t, nil
+	}
+
+	// Votes include an additional stakebase input and two outputs before
+	// the outputs paying each commitment.
+	var offset int
+	switch spender.TxType {
+	case stake.TxTypeSSGen:
+		t.Status = TicketStatusVoted
+		t.Subsidy = dcrutil.Amount(spender.MsgTx.TxIn[0].ValueIn)
+		offset = 2
+	case stake.TxTypeSSRtx:
+		t.Status = TicketStatusRevoked
+	default:
+		return nil, errors.E(errors.Invalid, "ticket spender is not a vote or revocation")
+	}
+	hash := spender.Hash
+	t.SpenderHash = &hash
+	t.SpenderTime = spender.Received
+	if spender.Block.Height != -1 {
+		t.SpenderHeight = spender.Block.Height
+		t.SpenderTime = spender.Block.Time
+	}
+	for i := range owned {
+		idx := int(i-1)/2 + offset
+		if idx < len(spender.MsgTx.TxOut) {
+			t.Returned += dcrutil.Amount(spender.MsgTx.TxOut[idx].Value)
+		}
+	}
+	return t, nil
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/blockchain/stake/v2"
+	"github.com/decred/dcrd/chaincfg/v2"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/txscript/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/wallet/v3/udb"
+)
+
+func TestTicketLifecycle(t *testing.T) {
+	params := chaincfg.SimNetParams()
+	addr, err := dcrutil.NewAddressPubKeyHash(make([]byte, 20), params, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	commitment := func(amount dcrutil.Amount) *wire.TxOut {
+		script, err := txscript.GenerateSStxAddrPush(addr, amount, 0x5800)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return wire.NewTxOut(0, script)
+	}
+
+	// A stakepool ticket with a 1 DCR pool fee commitment and a 99.0001 DCR
+	// wallet commitment paying a 0.0001 DCR purchase fee.
+	ticket := wire.NewMsgTx()
+	ticket.AddTxIn(&wire.TxIn{ValueIn: 1e8})
+	ticket.AddTxIn(&wire.TxIn{ValueIn: 99.0001e8})
+	ticket.AddTxOut(wire.NewTxOut(100e8, []byte{txscript.OP_SSTX}))
+	ticket.AddTxOut(commitment(1e8))
+	ticket.AddTxOut(wire.NewTxOut(0, []byte{txscript.OP_SSTXCHANGE}))
+	ticket.AddTxOut(commitment(99.0001e8))
+	ticket.AddTxOut(wire.NewTxOut(0, []byte{txscript.OP_SSTXCHANGE}))
+	owned := map[uint32]ticketCommitment{3: {amount: 99.0001e8, account: 2}}
+	purchased := time.Unix(1e9, 0)
+
+	// The vote pays 2 DCR of subsidy proportionally to both commitments.
+	vote := &udb.TxDetails{
+		TxRecord: udb.TxRecord{TxType: stake.TxTypeSSGen},
+		Block:    udb.BlockMeta{Block: udb.Block{Height: 1200}, Time: purchased.Add(48 * time.Hour)},
+	}
+	vote.MsgTx.AddTxIn(&wire.TxIn{ValueIn: 2e8})
+	vote.MsgTx.AddTxIn(&wire.TxIn{ValueIn: 100e8})
+	vote.MsgTx.AddTxOut(wire.NewTxOut(0, nil))
+	vote.MsgTx.AddTxOut(wire.NewTxOut(0, nil))
+	vote.MsgTx.AddTxOut(wire.NewTxOut(1.02e8, nil))
+	vote.MsgTx.AddTxOut(wire.NewTxOut(100.98e8, nil))
+	vote.Hash = vote.MsgTx.TxHash()
+
+	lc, err := newTicketLifecycle(params, ticket, owned, 1000, purchased, vote, 1300)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lc.Hash != ticket.TxHash() || lc.Account != 2 || lc.Status != TicketStatusVoted {
+		t.Errorf("unexpected hash %v, account %d or status %v", lc.Hash, lc.Account, lc.Status)
+	}
+	if lc.Price != 100e8 || lc.PurchaseFee != 0.0001e8 || lc.Commitment != 99.0001e8 || lc.VSPFee != 1e8 {
+		t.Errorf("unexpected price %v, purchase fee %v, commitment %v or VSP fee %v",
+			lc.Price, lc.PurchaseFee, lc.Commitment, lc.VSPFee)
+	}
+	if lc.MaturityHeight != 1000+int32(params.TicketMaturity)+1 {
+		t.Errorf("maturity height %d", lc.MaturityHeight)
+	}
+	if *lc.SpenderHash != vote.Hash || lc.SpenderHeight != 1200 || lc.SpendDuration() != 48*time.Hour {
+		t.Errorf("unexpected spender %v at height %d after %v", lc.SpenderHash,
+			lc.SpenderHeight, lc.SpendDuration())
+	}
+	if lc.Subsidy != 2e8 || lc.Returned != 100.98e8 {
+		t.Errorf("subsidy %v, returned %v", lc.Subsidy, lc.Returned)
+	}
+	if lc.Reward() != 1.9799e8 || lc.Profit() != 0.9798e8 {
+		t.Errorf("reward %v, profit %v", lc.Reward(), lc.Profit())
+	}
+
+	// A revocation returns the commitments less its fee.
+	revocation := &udb.TxDetails{
+		TxRecord: udb.TxRecord{TxType: stake.TxTypeSSRtx, Received: purchased.Add(time.Hour)},
+		Block:    udb.BlockMeta{Block: udb.Block{Height: -1}},
+	}
+	revocation.MsgTx.AddTxIn(&wire.TxIn{ValueIn: 100e8})
+	revocation.MsgTx.AddTxOut(wire.NewTxOut(1e8, nil))
+	revocation.MsgTx.AddTxOut(wire.NewTxOut(98.9991e8, nil))
+	lc, err = newTicketLifecycle(params, ticket, owned, 1000, purchased, revocation, 1300)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lc.Status != TicketStatusRevoked || lc.SpenderHeight != -1 || lc.Subsidy != 0 ||
+		lc.Reward() != -0.001e8 || lc.SpendDuration() != time.Hour {
+		t.Errorf("unexpected revocation lifecycle %+v", lc)
+	}
+
+	// Unspent tickets have no reward and a status depending on the tip.
+	tests := []struct {
+		height, tip int32
+		status      TicketStatus
+	}{
+		{-1, 1300, TicketStatusUnmined},
+		{1000, 1000 + int32(params.TicketMaturity), TicketStatusImmature},
+		{1000, 1001 + int32(params.TicketMaturity), TicketStatusLive},
+		{1000, 1001 + int32(params.TicketMaturity) + int32(params.TicketExpiry), TicketStatusExpired},
+	}
+	for _, test := range tests {
+		lc, err := newTicketLifecycle(params, ticket, owned, test.height, purchased, nil, test.tip)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if lc.Status != test.status {
+			t.Errorf("height %d tip %d: status %v, want %v", test.height, test.tip, lc.Status, test.status)
+		}
+		if lc.Reward() != 0 || lc.Profit() != -lc.PurchaseFee-lc.VSPFee || lc.SpenderHash != nil {
+			t.Errorf("height %d tip %d: unspent ticket has reward %v or spender %v",
+				test.height, test.tip, lc.Reward(), lc.SpenderHash)
+		}
+	}
+}
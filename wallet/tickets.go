@@ -294,6 +294,9 @@ func (w *Wallet) RevokeTickets(ctx context.Context, rpcCaller Caller) error {
 	if err != nil {
 		return errors.E(op, err)
 	}
+	if len(ticketHashes) == 0 {
+		return nil
+	}
 
 	ticketHashPtrs := make([]*chainhash.Hash, len(ticketHashes))
 	for i := range ticketHashes {
@@ -399,10 +402,15 @@ func (w *Wallet) RevokeExpiredTickets(ctx context.Context, p Peer) (err error) {
 		it := w.TxStore.IterateTickets(dbtx)
 		defer it.Close()
 		for it.Next() {
-			// Spent tickets are excluded
+			// Spent tickets are excluded, including tickets spent by an
+			// unmined revocation published earlier.
 			if it.SpenderHash != (chainhash.Hash{}) {
 				continue
 			}
+			op := wire.OutPoint{Hash: it.Hash, Index: 0, Tree: wire.TxTreeStake}
+			if !w.TxStore.IsUnspentOutpoint(dbtx, &op) {
+				continue
+			}
 
 			// Include ticket hash when it has reached expiry confirmations.
 			if ticketExpired(w.chainParams, it.Block.Height, tipHeight) {
@@ -460,14 +468,16 @@ func (w *Wallet) RevokeExpiredTickets(ctx context.Context, p Peer) (err error) {
 
 	var watchOutPoints []wire.OutPoint
 	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
-		for i, revocation := range revocations {
+		for _, revocation := range revocations {
 			rec, err := udb.NewTxRecordFromMsgTx(revocation, time.Now())
 			if err != nil {
 				return err
 			}
 
-			log.Infof("Revoking ticket %v with revocation %v", &expired[i],
-				&rec.Hash)
+			// Tickets without voting authority are skipped, so the
+			// revoked ticket is read from the revocation input.
+			log.Infof("Revoking ticket %v with revocation %v",
+				&revocation.TxIn[0].PreviousOutPoint.Hash, &rec.Hash)
 
 			watch, err := w.processTransactionRecord(ctx, dbtx, rec, nil, nil)
 			if err != nil {
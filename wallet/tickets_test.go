@@ -0,0 +1,125 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrd/txscript/v2"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/wallet/v3/udb"
+	"github.com/decred/dcrwallet/wallet/v3/walletdb"
+)
+
+// publishRecorder is a network backend which records published transactions.
+type publishRecorder struct {
+	mockNetwork
+	mu        sync.Mutex
+	published []*wire.MsgTx
+}
+
+func (r *publishRecorder) PublishTransactions(ctx context.Context, txs ...*wire.MsgTx) error {
+	r.mu.Lock()
+	r.published = append(r.published, txs...)
+	r.mu.Unlock()
+	return nil
+}
+
+func TestRevokeExpiredTickets(t *testing.T) {
+	ctx := context.Background()
+	params := *basicWalletConfig.Params
+	params.TicketMaturity = 1
+	params.TicketExpiry = 2
+	cfg := basicWalletConfig
+	cfg.Params = &params
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+	err := w.Unlock(ctx, []byte("private"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Attach blocks 1-6 to the main chain.
+	tg := maketg(t, cfg.Params)
+	tw := &tw{t, w}
+	forest := new(SidechainForest)
+	blocks := []*gblock{tg.createBlockOne("block-one")}
+	for i := 2; i <= 6; i++ {
+		blocks = append(blocks, tg.nextBlock(fmt.Sprintf("block-%d", i), nil, nil))
+	}
+	for _, b := range blocks {
+		mustAddBlockNode(t, forest, b.BlockNode)
+	}
+	tw.chainSwitch(forest, tw.evaluateBestChain(forest, len(blocks), blocks[len(blocks)-1].Hash))
+
+	// Record a ticket with voting rights controlled by the wallet in block 2,
+	// which is expired at the tip.
+	a, err := w.NewExternalAddress(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr, err := dcrutil.DecodeAddress(a.Address(), cfg.Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	voteScript, err := txscript.PayToSStx(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	commitmentScript, err := txscript.GenerateSStxAddrPush(addr, 10e8, 0x5800)
+	if err != nil {
+		t.Fatal(err)
+	}
+	changeScript, err := txscript.PayToSStxChange(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	prevHash := chainhash.HashH([]byte("ticket input"))
+	ticket := wire.NewMsgTx()
+	ticket.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&prevHash, 0, wire.TxTreeRegular), 10e8, nil))
+	ticket.AddTxOut(wire.NewTxOut(10e8, voteScript))
+	ticket.AddTxOut(wire.NewTxOut(0, commitmentScript))
+	ticket.AddTxOut(wire.NewTxOut(0, changeScript))
+	rec, err := udb.NewTxRecordFromMsgTx(ticket, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	mined := blocks[1]
+	blockMeta := &udb.BlockMeta{
+		Block: udb.Block{Hash: *mined.Hash, Height: int32(mined.BlockNode.Header.Height)},
+		Time:  mined.BlockNode.Header.Timestamp,
+	}
+	err = walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		_, err := w.processTransactionRecord(ctx, dbtx, rec, mined.BlockNode.Header, blockMeta)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	n := new(publishRecorder)
+	err = w.RevokeExpiredTickets(ctx, n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(n.published) != 1 || n.published[0].TxIn[0].PreviousOutPoint.Hash != rec.Hash {
+		t.Fatalf("expected revocation of ticket %v, published %d transactions", &rec.Hash, len(n.published))
+	}
+
+	// The ticket is spent by the unmined revocation and is not revoked again.
+	err = w.RevokeExpiredTickets(ctx, n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(n.published) != 1 {
+		t.Fatalf("ticket spent by an unmined revocation was revoked again")
+	}
+}
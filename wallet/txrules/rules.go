@@ -15,6 +15,12 @@ import (
 // DefaultRelayFeePerKb is the default minimum relay fee policy for a mempool.
 const DefaultRelayFeePerKb dcrutil.Amount = 1e4
 
+// DustInputSize is the size of the compressed P2PKH redeem input assumed to
+// spend an output when determining whether the output is dust.  It is the
+// average input size rather than the largest possible
+// (txsizes.RedeemP2PKHInputSize).
+const DustInputSize = 165
+
 // IsDustAmount determines whether a transaction output value and script length would
 // cause the output to be considered dust.  Transactions with dust outputs are
 // not standard and are rejected by mempools with default policies.
@@ -22,11 +28,9 @@ func IsDustAmount(amount dcrutil.Amount, scriptSize int, relayFeePerKb dcrutil.A
 	// Calculate the total (estimated) cost to the network.  This is
 	// calculated using the serialize size of the output plus the serial
 	// size of a transaction input which redeems it.  The output is assumed
-	// to be compressed P2PKH as this is the most common script type.  Use
-	// the average size of a compressed P2PKH redeem input (165) rather than
-	// the largest possible (txsizes.RedeemP2PKHInputSize).
+	// to be compressed P2PKH as this is the most common script type.
 	totalSize := 8 + 2 + wire.VarIntSerializeSize(uint64(scriptSize)) +
-		scriptSize + 165
+		scriptSize + DustInputSize
 
 	// Dust is defined as an output value where the total cost to the network
 	// (output size + input size) is greater than 1/3 of the relay fee.
@@ -0,0 +1,300 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"crypto/sha256"
+
+	"github.com/decred/dcrd/hdkeychain/v2"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3/internal/snacl"
+	"github.com/decred/dcrwallet/wallet/v3/walletdb"
+)
+
+// secretKeyParamsSize is the size of marshaled snacl secret key parameters.
+const secretKeyParamsSize = snacl.KeySize + sha256.Size + 24
+
+// acctPassphraseBucketName is the bucket of the keys protecting accounts
+// encrypted by their own passphrase, keyed by account number.  Values are
+// serialized as:
+//
+//   [0:88]  Secret key parameters, derived from the account passphrase
+//   [88:]   Account crypto key, encrypted by the secret key
+//
+// The account row of these accounts records the account extended private key
+// encrypted by the account crypto key rather than the crypto private key.
+var acctPassphraseBucketName = []byte("acctpassphrase")
+
+func fetchAccountPassphraseKeys(ns walletdb.ReadBucket, account uint32) (params, cryptoKeyEnc []byte) {
+	v := ns.NestedReadBucket(acctPassphraseBucketName).Get(uint32ToBytes(account))
+	if len(v) < secretKeyParamsSize {
+		return nil, nil
+	}
+	return v[:secretKeyParamsSize], v[secretKeyParamsSize:]
+}
+
+func existsAccountPassphrase(ns walletdb.ReadBucket, account uint32) bool {
+	params, _ := fetchAccountPassphraseKeys(ns, account)
+	return params != nil
+}
+
+func putAccountPassphraseKeys(ns walletdb.ReadWriteBucket, account uint32, params, cryptoKeyEnc []byte) error {
+	v := make([]byte, 0, len(params)+len(cryptoKeyEnc))
+	v = append(v, params...)
+	v = append(v, cryptoKeyEnc...)
+	err := ns.NestedReadWriteBucket(acctPassphraseBucketName).Put(uint32ToBytes(account), v)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+func deleteAccountPassphraseKeys(ns walletdb.ReadWriteBucket, account uint32) error {
+	err := ns.NestedReadWriteBucket(acctPassphraseBucketName).Delete(uint32ToBytes(account))
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// AccountHasPassphrase returns whether the private keys of a BIP0044 account
+// are encrypted by the account's own passphrase.
+func (m *Manager) AccountHasPassphrase(ns walletdb.ReadBucket, account uint32) bool {
+	return existsAccountPassphrase(ns, account)
+}
+
+// AnyAccountHasPassphrase returns whether the private keys of any account are
+// encrypted by the account's own passphrase.
+func (m *Manager) AnyAccountHasPassphrase(ns walletdb.ReadBucket) bool {
+	c := ns.NestedReadBucket(acctPassphraseBucketName).ReadCursor()
+	k, _ := c.First()
+	c.Close()
+	return k != nil
+}
+
+// SetAccountPassphrase encrypts the private keys of a BIP0044 account with a
+// passphrase separate from the private passphrase of the wallet.  Once set,
+// unlocking the manager does not provide access to the account private keys
+// and the account must also be unlocked with UnlockAccount before signing
+// with any of its keys.  Setting an empty passphrase removes the account
+// passphrase and the account is once again unlocked with the manager.
+//
+// The manager must be unlocked, and an account which already has a passphrase
+// must be unlocked, to change the account passphrase.  An account is locked
+// after a new passphrase is set.
+//
+// Only the database is modified.  The returned function updates the cached
+// account state and must be called after the database transaction commits.
+//
+// The account passphrase does not isolate the account keys cryptographically.
+// The coin type private key, from which the keys of every account are
+// derived, remains encrypted by the crypto private key, so the private
+// passphrase of the manager is sufficient to recover the account keys.
+func (m *Manager) SetAccountPassphrase(ns walletdb.ReadWriteBucket, account uint32, passphrase []byte) (commit func(), err error) {
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	if m.watchingOnly {
+		return nil, errors.E(errors.WatchingOnly)
+	}
+	if m.locked {
+		return nil, errors.E(errors.Locked)
+	}
+	if account > MaxAccountNum {
+		return nil, errors.E(errors.Invalid, errors.Errorf("account %d does not support passphrases", account))
+	}
+
+	acctInfo, err := m.loadAccountInfo(ns, account)
+	if err != nil {
+		return nil, err
+	}
+	if acctInfo.acctKeyPriv == nil {
+		return nil, errors.E(errors.Locked, errors.Errorf("account %d is locked", account))
+	}
+	row, err := fetchAccountInfo(ns, account, DBVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	serializedKeyPriv := []byte(acctInfo.acctKeyPriv.String())
+	defer zero(serializedKeyPriv)
+
+	var privKeyEnc []byte
+	if len(passphrase) == 0 {
+		privKeyEnc, err = m.cryptoKeyPriv.Encrypt(serializedKeyPriv)
+		if err != nil {
+			return nil, errors.E(errors.Crypto, errors.Errorf("encrypt account %d privkey: %v", account, err))
+		}
+		err = deleteAccountPassphraseKeys(ns, account)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		secretKey, err := newSecretKey(&passphrase, &defaultScryptOptions)
+		if err != nil {
+			return nil, err
+		}
+		defer secretKey.Zero()
+		acctCryptoKey, err := newCryptoKey()
+		if err != nil {
+			return nil, err
+		}
+		defer acctCryptoKey.Zero()
+
+		privKeyEnc, err = acctCryptoKey.Encrypt(serializedKeyPriv)
+		if err != nil {
+			return nil, errors.E(errors.Crypto, errors.Errorf("encrypt account %d privkey: %v", account, err))
+		}
+		cryptoKeyEnc, err := secretKey.Encrypt(acctCryptoKey.Bytes())
+		if err != nil {
+			return nil, errors.E(errors.Crypto, errors.Errorf("encrypt account %d crypto key: %v", account, err))
+		}
+		err = putAccountPassphraseKeys(ns, account, secretKey.Marshal(), cryptoKeyEnc)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	row = bip0044AccountInfo(row.pubKeyEncrypted, privKeyEnc, 0, 0,
+		row.lastUsedExternalIndex, row.lastUsedInternalIndex,
+		row.lastReturnedExternalIndex, row.lastReturnedInternalIndex,
+		row.name, DBVersion)
+	err = putAccountRow(ns, account, &row.dbAccountRow)
+	if err != nil {
+		return nil, err
+	}
+
+	hasPassphrase := len(passphrase) != 0
+	commit = func() {
+		defer m.mtx.Unlock()
+		m.mtx.Lock()
+
+		acctInfo, ok := m.acctInfo[account]
+		if !ok {
+			return
+		}
+		acctInfo.acctKeyEncrypted = privKeyEnc
+		acctInfo.hasPassphrase = hasPassphrase
+		if hasPassphrase && acctInfo.acctKeyPriv != nil {
+			acctInfo.acctKeyPriv.Zero()
+			acctInfo.acctKeyPriv = nil
+			m.zeroReturnedPrivKeys()
+		}
+	}
+	return commit, nil
+}
+
+// UnlockAccount decrypts the private keys of an account which is encrypted by
+// its own passphrase.  The manager must be unlocked first, and locking the
+// manager also locks every unlocked account.
+func (m *Manager) UnlockAccount(ns walletdb.ReadBucket, account uint32, passphrase []byte) error {
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	if m.watchingOnly {
+		return errors.E(errors.WatchingOnly)
+	}
+	if m.locked {
+		return errors.E(errors.Locked, "wallet must be unlocked before unlocking accounts")
+	}
+
+	acctInfo, err := m.loadAccountInfo(ns, account)
+	if err != nil {
+		return err
+	}
+	params, cryptoKeyEnc := fetchAccountPassphraseKeys(ns, account)
+	if !acctInfo.hasPassphrase || params == nil {
+		return errors.E(errors.Invalid, errors.Errorf("account %d has no passphrase", account))
+	}
+
+	var secretKey snacl.SecretKey
+	err = secretKey.Unmarshal(params)
+	if err != nil {
+		return err
+	}
+	defer secretKey.Zero()
+	err = secretKey.DeriveKey(&passphrase)
+	if err != nil {
+		return err
+	}
+	decryptedKey, err := secretKey.Decrypt(cryptoKeyEnc)
+	if err != nil {
+		return errors.E(errors.Crypto, errors.Errorf("decrypt account %d crypto key: %v", account, err))
+	}
+	acctCryptoKey := &cryptoKey{}
+	acctCryptoKey.CopyBytes(decryptedKey)
+	zero(decryptedKey)
+	defer acctCryptoKey.Zero()
+
+	decrypted, err := acctCryptoKey.Decrypt(acctInfo.acctKeyEncrypted)
+	if err != nil {
+		return errors.E(errors.Crypto, errors.Errorf("decrypt account %d privkey: %v", account, err))
+	}
+	acctKeyPriv, err := hdkeychain.NewKeyFromString(string(decrypted), m.chainParams)
+	zero(decrypted)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	if acctInfo.acctKeyPriv != nil {
+		acctInfo.acctKeyPriv.Zero()
+	}
+	acctInfo.acctKeyPriv = acctKeyPriv
+	return nil
+}
+
+// LockAccount removes the private keys of an account which is encrypted by
+// its own passphrase from memory.  Keys of other accounts remain available
+// while the manager is unlocked.
+func (m *Manager) LockAccount(ns walletdb.ReadBucket, account uint32) error {
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	acctInfo, err := m.loadAccountInfo(ns, account)
+	if err != nil {
+		return err
+	}
+	if !acctInfo.hasPassphrase {
+		return errors.E(errors.Invalid, errors.Errorf("account %d has no passphrase", account))
+	}
+	if acctInfo.acctKeyPriv != nil {
+		acctInfo.acctKeyPriv.Zero()
+		acctInfo.acctKeyPriv = nil
+		m.zeroReturnedPrivKeys()
+	}
+	return nil
+}
+
+// zeroReturnedPrivKeys zeroes and forgets every private key previously returned
+// by PrivateKey so that keys of a locked account can not be returned again
+// from the cache.  Keys of accounts which remain unlocked are derived again
+// when next requested.
+//
+// This function MUST be called with the manager lock held for writes.
+func (m *Manager) zeroReturnedPrivKeys() {
+	m.returnedSecretsMu.Lock()
+	for _, privKey := range m.returnedPrivKeys {
+		zeroBigInt(privKey.GetD())
+	}
+	m.returnedPrivKeys = nil
+	m.returnedSecretsMu.Unlock()
+}
+
+// AccountLocked returns whether the private keys of an account are
+// unavailable, either because the manager is locked or because the account is
+// encrypted by its own passphrase and has not been unlocked.  Accounts which
+// do not record private keys report the lock state of the manager.
+func (m *Manager) AccountLocked(ns walletdb.ReadBucket, account uint32) (bool, error) {
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	if m.locked || account > ImportedAddrAccount {
+		return m.locked, nil
+	}
+	acctInfo, err := m.loadAccountInfo(ns, account)
+	if err != nil {
+		return false, err
+	}
+	return acctInfo.acctKeyPriv == nil, nil
+}
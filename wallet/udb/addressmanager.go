@@ -137,6 +137,12 @@ type accountInfo struct {
 	acctKeyEncrypted []byte
 	acctKeyPriv      *hdkeychain.ExtendedKey
 	acctKeyPub       *hdkeychain.ExtendedKey
+
+	// hasPassphrase records whether the account private key is encrypted
+	// by the account's own passphrase.  The accountKeyPriv of these
+	// accounts remains nil after unlocking the address manager until the
+	// account is unlocked.
+	hasPassphrase bool
 }
 
 // AccountProperties contains properties associated with each account, such as
@@ -490,11 +496,12 @@ func (m *Manager) loadAccountInfo(ns walletdb.ReadBucket, account uint32) (*acco
 		acctName:         row.name,
 		acctKeyEncrypted: row.privKeyEncrypted,
 		acctKeyPub:       acctKeyPub,
+		hasPassphrase:    existsAccountPassphrase(ns, account),
 	}
 
-	if !m.locked {
+	if !m.locked && account <= ImportedAddrAccount && !acctInfo.hasPassphrase {
 		// Use the crypto private key to decrypt the account private
-		// extended keys.
+		// extended keys.  Imported xpub accounts have no private keys.
 		decrypted, err := m.cryptoKeyPriv.Decrypt(acctInfo.acctKeyEncrypted)
 		if err != nil {
 			return nil, errors.E(errors.Crypto, errors.Errorf("decrypt account %d privkey: %v", account, err))
@@ -536,7 +543,20 @@ func (m *Manager) AccountProperties(ns walletdb.ReadBucket, account uint32) (*Ac
 	// of imported keys for any other account is zero, and since the
 	// imported account cannot contain non-imported keys, the external and
 	// internal key counts for it are zero.
-	if account != ImportedAddrAccount {
+	switch {
+	case IsDescriptorAccount(account):
+		// Descriptor accounts only derive addresses for a single branch,
+		// which is reported as the external branch.
+		desc, err := fetchDescriptorAccount(ns, account)
+		if err != nil {
+			return nil, err
+		}
+		props.AccountName = desc.AccountName
+		props.LastUsedExternalIndex = desc.LastUsedIndex
+		props.LastUsedInternalIndex = ^uint32(0)
+		props.LastReturnedExternalIndex = desc.LastReturnedIndex
+		props.LastReturnedInternalIndex = ^uint32(0)
+	case account != ImportedAddrAccount:
 		acctInfo, err := m.loadAccountInfo(ns, account)
 		if err != nil {
 			return nil, err
@@ -550,7 +570,7 @@ func (m *Manager) AccountProperties(ns walletdb.ReadBucket, account uint32) (*Ac
 		props.LastUsedInternalIndex = row.lastUsedInternalIndex
 		props.LastReturnedExternalIndex = row.lastReturnedExternalIndex
 		props.LastReturnedInternalIndex = row.lastReturnedInternalIndex
-	} else {
+	default:
 		props.AccountName = ImportedAddrAccountName // reserved, nonchangable
 
 		// Could be more efficient if this was tracked by the db.
@@ -604,6 +624,9 @@ func (m *Manager) AccountExtendedPrivKey(dbtx walletdb.ReadTx, account uint32) (
 		err = errors.E(errors.Locked, "locked address manager cannot fetch extended privkey")
 	} else {
 		acctInfo, err = m.loadAccountInfo(ns, account)
+		if err == nil && acctInfo.acctKeyPriv == nil {
+			err = errors.E(errors.Locked, errors.Errorf("account %d is locked", account))
+		}
 	}
 	m.mtx.Unlock()
 	if err != nil {
@@ -724,6 +747,9 @@ func (m *Manager) UpgradeToSLIP0044CoinType(dbtx walletdb.ReadWriteTx) error {
 	if err != nil {
 		return err
 	}
+	if existsAccountPassphrase(ns, 0) {
+		return errors.E(errors.Invalid, "SLIP0044 coin type upgrade not possible with an account 0 passphrase")
+	}
 	if lastAcct != 0 || acctProps.LastReturnedExternalIndex != ^uint32(0) ||
 		acctProps.LastReturnedInternalIndex != ^uint32(0) {
 		return errors.E(errors.Invalid, "wallets with returned addresses may not be upgraded to SLIP0044 coin type")
@@ -819,7 +845,7 @@ func (m *Manager) UpgradeToSLIP0044CoinType(dbtx walletdb.ReadWriteTx) error {
 // This function MUST be called with the manager lock held for writes.
 func (m *Manager) deriveKeyFromPath(ns walletdb.ReadBucket, account, branch, index uint32, private bool) (*hdkeychain.ExtendedKey, error) {
 	if private && account > ImportedAddrAccount {
-		return nil, errors.E(errors.Invalid, "account does not record private keys")
+		return nil, errors.E(errors.WatchingOnly, "account does not record private keys")
 	}
 
 	// Look up the account key information.
@@ -827,6 +853,9 @@ func (m *Manager) deriveKeyFromPath(ns walletdb.ReadBucket, account, branch, ind
 	if err != nil {
 		return nil, err
 	}
+	if private && acctInfo.acctKeyPriv == nil {
+		return nil, errors.E(errors.Locked, errors.Errorf("account %d is locked", account))
+	}
 
 	return deriveKey(acctInfo, branch, index, private)
 }
@@ -1117,6 +1146,14 @@ func (m *Manager) ConvertToWatchingOnly(ns walletdb.ReadWriteBucket) error {
 	if err != nil {
 		return err
 	}
+	err = ns.DeleteNestedBucket(acctPassphraseBucketName)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	_, err = ns.CreateBucket(acctPassphraseBucketName)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
 
 	err = putWatchingOnly(ns, true)
 	if err != nil {
@@ -1321,7 +1358,7 @@ func (m *Manager) ImportXpubAccount(ns walletdb.ReadWriteBucket, name string, xp
 		return err
 	}
 	account++
-	if account < MaxAccountNum {
+	if account < MaxAccountNum || IsDescriptorAccount(account) {
 		return errors.E(errors.Invalid, "exhausted possible imported accounts")
 	}
 
@@ -1465,7 +1502,7 @@ func (m *Manager) Unlock(ns walletdb.ReadBucket, passphrase []byte) error {
 	// Use the crypto private key to decrypt all of the account private
 	// extended keys.
 	for account, acctInfo := range m.acctInfo {
-		if account > ImportedAddrAccount {
+		if account > ImportedAddrAccount || acctInfo.hasPassphrase {
 			continue
 		}
 		decrypted, err := m.cryptoKeyPriv.Decrypt(acctInfo.acctKeyEncrypted)
@@ -1661,7 +1698,7 @@ func (m *Manager) syncAccountToAddrIndex(ns walletdb.ReadWriteBucket, account ui
 		if err != nil {
 			return err
 		}
-		if m.locked || account > ImportedAddrAccount {
+		if m.locked || account > ImportedAddrAccount || acctInfo.acctKeyPriv == nil {
 			break
 		}
 		xprivBranch, err = acctInfo.acctKeyPriv.Child(branch)
@@ -2001,6 +2038,11 @@ func (m *Manager) PrivateKey(ns walletdb.ReadBucket, addr dcrutil.Address) (key
 		}
 
 	case *dbImportedAddressRow:
+		// Addresses of descriptor accounts are recorded without a
+		// private key.
+		if len(a.encryptedPrivKey) == 0 {
+			return nil, nil, errors.E(errors.WatchingOnly, "address does not record a private key")
+		}
 		privKeyBytes, err := m.cryptoKeyPriv.Decrypt(a.encryptedPrivKey)
 		if err != nil {
 			return nil, nil, errors.E(errors.Crypto, errors.Errorf("decrypt imported privkey: %v", err))
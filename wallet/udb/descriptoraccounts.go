@@ -0,0 +1,267 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"encoding/binary"
+
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3/walletdb"
+)
+
+// DescriptorAccountStart is the first account number of descriptor accounts.
+// Descriptor accounts are numbered from a range above the imported xpub
+// accounts so that neither range must be interleaved with the other.
+const DescriptorAccountStart = 3 << 30
+
+// IsDescriptorAccount returns whether an account number describes a
+// descriptor account.
+func IsDescriptorAccount(account uint32) bool {
+	return account >= DescriptorAccountStart
+}
+
+var (
+	// descAcctBucketName is the bucket of descriptor accounts, keyed by
+	// account number.  Values are serialized as:
+	//
+	//   [0:4]   Last used child index (4 bytes)
+	//   [4:8]   Last returned child index (4 bytes)
+	//   [8:12]  Number of recorded child addresses (4 bytes)
+	//   [12:]   Descriptor string
+	//
+	// Account names are recorded in the account name and ID indexes shared
+	// with BIP0044 accounts.
+	descAcctBucketName = []byte("descacct")
+
+	// descAddrBucketName indexes descriptor account addresses, keyed by the
+	// address ID (pubkey hash or script hash).  Values are the 4 byte
+	// account number followed by the 4 byte child index.
+	descAddrBucketName = []byte("descaddr")
+
+	// lastDescriptorAccountName is the metadata key of the last descriptor
+	// account number.
+	lastDescriptorAccountName = []byte("lastdescriptoraccount")
+)
+
+// DescriptorAccountProperties describes a descriptor account.
+type DescriptorAccountProperties struct {
+	AccountNumber uint32
+	AccountName   string
+	Descriptor    string
+
+	// LastUsedIndex and LastReturnedIndex are the last used and returned
+	// child indexes, or ^uint32(0) if no child has been used or returned.
+	LastUsedIndex     uint32
+	LastReturnedIndex uint32
+
+	// RecordedCount is the number of child addresses recorded by the
+	// address manager, beginning at index 0.
+	RecordedCount uint32
+}
+
+func serializeDescriptorAccount(p *DescriptorAccountProperties) []byte {
+	v := make([]byte, 12+len(p.Descriptor))
+	binary.LittleEndian.PutUint32(v[0:4], p.LastUsedIndex)
+	binary.LittleEndian.PutUint32(v[4:8], p.LastReturnedIndex)
+	binary.LittleEndian.PutUint32(v[8:12], p.RecordedCount)
+	copy(v[12:], p.Descriptor)
+	return v
+}
+
+func fetchDescriptorAccount(ns walletdb.ReadBucket, account uint32) (*DescriptorAccountProperties, error) {
+	v := ns.NestedReadBucket(descAcctBucketName).Get(uint32ToBytes(account))
+	if v == nil {
+		return nil, errors.E(errors.NotExist, errors.Errorf("no descriptor account %d", account))
+	}
+	if len(v) < 12 {
+		return nil, errors.E(errors.IO, errors.Errorf("bad descriptor account %d row len %d", account, len(v)))
+	}
+	name, err := fetchAccountName(ns, account)
+	if err != nil {
+		return nil, err
+	}
+	return &DescriptorAccountProperties{
+		AccountNumber:     account,
+		AccountName:       name,
+		Descriptor:        string(v[12:]),
+		LastUsedIndex:     binary.LittleEndian.Uint32(v[0:4]),
+		LastReturnedIndex: binary.LittleEndian.Uint32(v[4:8]),
+		RecordedCount:     binary.LittleEndian.Uint32(v[8:12]),
+	}, nil
+}
+
+func putDescriptorAccount(ns walletdb.ReadWriteBucket, p *DescriptorAccountProperties) error {
+	err := ns.NestedReadWriteBucket(descAcctBucketName).Put(uint32ToBytes(p.AccountNumber),
+		serializeDescriptorAccount(p))
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// NewDescriptorAccount creates a descriptor account with a unique name.  The
+// descriptor is not validated by the address manager and must be the
+// canonical encoding of a ranged descriptor describing P2PKH or P2SH outputs.
+// Addresses are not recorded until RecordDescriptorAddress is called.
+func (m *Manager) NewDescriptorAccount(ns walletdb.ReadWriteBucket, name, descriptor string) (uint32, error) {
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	if err := ValidateAccountName(name); err != nil {
+		return 0, err
+	}
+	if _, err := fetchAccountByName(ns, name); err == nil {
+		return 0, errors.E(errors.Exist, "account name in use")
+	}
+
+	account := uint32(DescriptorAccountStart)
+	meta := ns.NestedReadWriteBucket(metaBucketName)
+	if v := meta.Get(lastDescriptorAccountName); v != nil {
+		if len(v) != 4 {
+			return 0, errors.E(errors.IO, errors.Errorf("bad last descriptor account len %d", len(v)))
+		}
+		account = binary.LittleEndian.Uint32(v) + 1
+		if account < DescriptorAccountStart {
+			return 0, errors.E(errors.Invalid, "exhausted possible descriptor accounts")
+		}
+	}
+
+	err := putDescriptorAccount(ns, &DescriptorAccountProperties{
+		AccountNumber:     account,
+		Descriptor:        descriptor,
+		LastUsedIndex:     ^uint32(0),
+		LastReturnedIndex: ^uint32(0),
+	})
+	if err != nil {
+		return 0, err
+	}
+	if err := putAccountIDIndex(ns, account, name); err != nil {
+		return 0, err
+	}
+	if err := putAccountNameIndex(ns, account, name); err != nil {
+		return 0, err
+	}
+	err = meta.Put(lastDescriptorAccountName, uint32ToBytes(account))
+	if err != nil {
+		return 0, errors.E(errors.IO, err)
+	}
+	return account, nil
+}
+
+// DescriptorAccount returns the properties of a descriptor account.
+func (m *Manager) DescriptorAccount(ns walletdb.ReadBucket, account uint32) (*DescriptorAccountProperties, error) {
+	return fetchDescriptorAccount(ns, account)
+}
+
+// ForEachDescriptorAccount calls fn with each descriptor account number,
+// breaking early on error.
+func (m *Manager) ForEachDescriptorAccount(ns walletdb.ReadBucket, fn func(account uint32) error) error {
+	return ns.NestedReadBucket(descAcctBucketName).ForEach(func(k, _ []byte) error {
+		return fn(binary.LittleEndian.Uint32(k))
+	})
+}
+
+// RecordDescriptorAddress records the address of a descriptor account child
+// so it is recognized by the address manager.  P2PKH addresses must be
+// recorded with the serialized public key of the address.  Redeem scripts of
+// P2SH addresses are not saved by the address manager.
+//
+// Recording an address which is already known to the address manager (such as
+// a previously imported script) has no effect other than updating the count
+// of recorded child addresses.
+func (m *Manager) RecordDescriptorAddress(ns walletdb.ReadWriteBucket, account, index uint32,
+	addr dcrutil.Address, pubKey []byte) error {
+
+	defer m.mtx.Unlock()
+	m.mtx.Lock()
+
+	props, err := fetchDescriptorAccount(ns, account)
+	if err != nil {
+		return err
+	}
+
+	id := addr.ScriptAddress()
+	if !existsAddress(ns, id) {
+		switch addr.(type) {
+		case *dcrutil.AddressPubKeyHash:
+			encryptedPubKey, err := m.cryptoKeyPub.Encrypt(pubKey)
+			if err != nil {
+				return errors.E(errors.Crypto, errors.Errorf("encrypt pubkey: %v", err))
+			}
+			err = putImportedAddress(ns, id, account, ssNone, encryptedPubKey, nil)
+			if err != nil {
+				return err
+			}
+		case *dcrutil.AddressScriptHash:
+			encryptedHash, err := m.cryptoKeyPub.Encrypt(id)
+			if err != nil {
+				return errors.E(errors.Crypto, errors.Errorf("encrypt script hash: %v", err))
+			}
+			err = putScriptAddress(ns, id, account, ssNone, encryptedHash, nil)
+			if err != nil {
+				return err
+			}
+		default:
+			return errors.E(errors.Invalid, errors.Errorf("address type %T", addr))
+		}
+
+		v := make([]byte, 8)
+		binary.LittleEndian.PutUint32(v[0:4], account)
+		binary.LittleEndian.PutUint32(v[4:8], index)
+		err = ns.NestedReadWriteBucket(descAddrBucketName).Put(id, v)
+		if err != nil {
+			return errors.E(errors.IO, err)
+		}
+	}
+
+	if index >= props.RecordedCount {
+		props.RecordedCount = index + 1
+		return putDescriptorAccount(ns, props)
+	}
+	return nil
+}
+
+// DescriptorAddressIndex returns the account and child index of a descriptor
+// account address.
+func (m *Manager) DescriptorAddressIndex(ns walletdb.ReadBucket, addr dcrutil.Address) (account, index uint32, err error) {
+	addr = normalizeAddress(addr)
+	v := ns.NestedReadBucket(descAddrBucketName).Get(addr.ScriptAddress())
+	if v == nil {
+		return 0, 0, errors.E(errors.NotExist, errors.Errorf("no descriptor account address %v", addr))
+	}
+	if len(v) != 8 {
+		return 0, 0, errors.E(errors.IO, errors.Errorf("bad descriptor address index len %d", len(v)))
+	}
+	return binary.LittleEndian.Uint32(v[0:4]), binary.LittleEndian.Uint32(v[4:8]), nil
+}
+
+// MarkDescriptorChildUsed marks a descriptor account child as used.  The last
+// returned child is increased to the used child if necessary.
+func (m *Manager) MarkDescriptorChildUsed(ns walletdb.ReadWriteBucket, account, index uint32) error {
+	props, err := fetchDescriptorAccount(ns, account)
+	if err != nil {
+		return err
+	}
+	if props.LastUsedIndex != ^uint32(0) && index <= props.LastUsedIndex {
+		return nil
+	}
+	props.LastUsedIndex = index
+	props.LastReturnedIndex = maxUint32(index+1, props.LastReturnedIndex+1) - 1
+	return putDescriptorAccount(ns, props)
+}
+
+// MarkDescriptorChildReturned marks a descriptor account child as returned.
+func (m *Manager) MarkDescriptorChildReturned(ns walletdb.ReadWriteBucket, account, index uint32) error {
+	props, err := fetchDescriptorAccount(ns, account)
+	if err != nil {
+		return err
+	}
+	if props.LastReturnedIndex != ^uint32(0) && index <= props.LastReturnedIndex {
+		return nil
+	}
+	props.LastReturnedIndex = index
+	return putDescriptorAccount(ns, props)
+}
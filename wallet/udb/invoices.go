@@ -0,0 +1,214 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3/walletdb"
+)
+
+// Invoice is a request for payment to a wallet address.  Payments are the
+// outputs paying the address, whether or not they are mined.
+type Invoice struct {
+	ID       uint64
+	Account  uint32
+	Address  string
+	Amount   dcrutil.Amount
+	Memo     string
+	Created  int64 // Unix time
+	Expires  int64 // Unix time, or zero when the invoice does not expire
+	Payments []InvoicePayment
+}
+
+// InvoicePayment is a transaction output paying an invoice.
+type InvoicePayment struct {
+	TxHash chainhash.Hash
+	Index  uint32
+	Amount dcrutil.Amount
+}
+
+// Keys to the invoice buckets.  The invoices bucket maps big endian invoice IDs
+// to serialized invoices.  The invoice addresses bucket maps the encoded
+// address of each invoice to its ID.
+//
+// Invoices are serialized as:
+//
+//   [0:4]   Account (4 bytes)
+//   [4:12]  Amount (8 bytes)
+//   [12:20] Created unix time (8 bytes)
+//   [20:28] Expiry unix time (8 bytes)
+//   [28:32] Address length (4 bytes)
+//   ...     Address
+//   ...     Memo length (4 bytes)
+//   ...     Memo
+//   ...     Payment count (4 bytes)
+//   ...     Payments (44 bytes each: tx hash, output index, amount)
+
+const invoicePaymentSize = 32 + 4 + 8
+
+func keyInvoice(id uint64) []byte {
+	k := make([]byte, 8)
+	byteOrder.PutUint64(k, id)
+	return k
+}
+
+func serializeInvoice(inv *Invoice) []byte {
+	v := make([]byte, 28, 28+4+len(inv.Address)+4+len(inv.Memo)+4+len(inv.Payments)*invoicePaymentSize)
+	byteOrder.PutUint32(v[0:4], inv.Account)
+	byteOrder.PutUint64(v[4:12], uint64(inv.Amount))
+	byteOrder.PutUint64(v[12:20], uint64(inv.Created))
+	byteOrder.PutUint64(v[20:28], uint64(inv.Expires))
+	var b [8]byte
+	for _, s := range []string{inv.Address, inv.Memo} {
+		byteOrder.PutUint32(b[:4], uint32(len(s)))
+		v = append(v, b[:4]...)
+		v = append(v, s...)
+	}
+	byteOrder.PutUint32(b[:4], uint32(len(inv.Payments)))
+	v = append(v, b[:4]...)
+	for i := range inv.Payments {
+		p := &inv.Payments[i]
+		v = append(v, p.TxHash[:]...)
+		byteOrder.PutUint32(b[:4], p.Index)
+		v = append(v, b[:4]...)
+		byteOrder.PutUint64(b[:], uint64(p.Amount))
+		v = append(v, b[:]...)
+	}
+	return v
+}
+
+func deserializeInvoice(id uint64, v []byte) (*Invoice, error) {
+	short := func() (*Invoice, error) {
+		return nil, errors.E(errors.IO, errors.Errorf("short invoice %d value", id))
+	}
+	if len(v) < 28 {
+		return short()
+	}
+	inv := &Invoice{
+		ID:      id,
+		Account: byteOrder.Uint32(v[0:4]),
+		Amount:  dcrutil.Amount(byteOrder.Uint64(v[4:12])),
+		Created: int64(byteOrder.Uint64(v[12:20])),
+		Expires: int64(byteOrder.Uint64(v[20:28])),
+	}
+	v = v[28:]
+	for _, s := range []*string{&inv.Address, &inv.Memo} {
+		if len(v) < 4 {
+			return short()
+		}
+		n := byteOrder.Uint32(v)
+		v = v[4:]
+		if uint32(len(v)) < n {
+			return short()
+		}
+		*s = string(v[:n])
+		v = v[n:]
+	}
+	if len(v) < 4 {
+		return short()
+	}
+	n := byteOrder.Uint32(v)
+	v = v[4:]
+	if uint64(len(v)) != uint64(n)*invoicePaymentSize {
+		return nil, errors.E(errors.IO, errors.Errorf("bad invoice %d payments length", id))
+	}
+	inv.Payments = make([]InvoicePayment, n)
+	for i := range inv.Payments {
+		p := &inv.Payments[i]
+		copy(p.TxHash[:], v[0:32])
+		p.Index = byteOrder.Uint32(v[32:36])
+		p.Amount = dcrutil.Amount(byteOrder.Uint64(v[36:44]))
+		v = v[invoicePaymentSize:]
+	}
+	return inv, nil
+}
+
+func putInvoice(ns walletdb.ReadWriteBucket, inv *Invoice) error {
+	err := ns.NestedReadWriteBucket(bucketInvoices).Put(keyInvoice(inv.ID), serializeInvoice(inv))
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// CreateInvoice records a new invoice, assigning its ID.  Each address may
+// only be requested by a single invoice.
+func (s *Store) CreateInvoice(ns walletdb.ReadWriteBucket, inv *Invoice) error {
+	addrs := ns.NestedReadWriteBucket(bucketInvoiceAddrs)
+	if addrs.Get([]byte(inv.Address)) != nil {
+		return errors.E(errors.Exist, errors.Errorf("address %s is already requested by an invoice", inv.Address))
+	}
+	c := ns.NestedReadWriteBucket(bucketInvoices).ReadCursor()
+	k, _ := c.Last()
+	c.Close()
+	inv.ID = 1
+	if k != nil {
+		inv.ID = byteOrder.Uint64(k) + 1
+	}
+	err := addrs.Put([]byte(inv.Address), keyInvoice(inv.ID))
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return putInvoice(ns, inv)
+}
+
+// Invoice returns the invoice with an ID.
+func (s *Store) Invoice(ns walletdb.ReadBucket, id uint64) (*Invoice, error) {
+	v := ns.NestedReadBucket(bucketInvoices).Get(keyInvoice(id))
+	if v == nil {
+		return nil, errors.E(errors.NotExist, errors.Errorf("no invoice %d", id))
+	}
+	return deserializeInvoice(id, v)
+}
+
+// Invoices returns every invoice, ordered by ID.
+func (s *Store) Invoices(ns walletdb.ReadBucket) ([]*Invoice, error) {
+	var invs []*Invoice
+	err := ns.NestedReadBucket(bucketInvoices).ForEach(func(k, v []byte) error {
+		if len(k) != 8 {
+			return errors.E(errors.IO, errors.Errorf("bad invoice key length %d", len(k)))
+		}
+		inv, err := deserializeInvoice(byteOrder.Uint64(k), v)
+		if err != nil {
+			return err
+		}
+		invs = append(invs, inv)
+		return nil
+	})
+	return invs, err
+}
+
+// RecordInvoicePayment records an output paying the address of an invoice.
+// The invoice is returned, or nil if the address is not requested by any
+// invoice.  Recording the same output again does not modify the invoice.
+func (s *Store) RecordInvoicePayment(ns walletdb.ReadWriteBucket, addr string, txHash *chainhash.Hash,
+	index uint32, amount dcrutil.Amount) (*Invoice, error) {
+
+	k := ns.NestedReadBucket(bucketInvoiceAddrs).Get([]byte(addr))
+	if k == nil {
+		return nil, nil
+	}
+	if len(k) != 8 {
+		return nil, errors.E(errors.IO, errors.Errorf("bad invoice ID length %d", len(k)))
+	}
+	inv, err := s.Invoice(ns, byteOrder.Uint64(k))
+	if err != nil {
+		return nil, err
+	}
+	for i := range inv.Payments {
+		p := &inv.Payments[i]
+		if p.TxHash == *txHash && p.Index == index {
+			return inv, nil
+		}
+	}
+	inv.Payments = append(inv.Payments, InvoicePayment{
+		TxHash: *txHash,
+		Index:  index,
+		Amount: amount,
+	})
+	return inv, putInvoice(ns, inv)
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"unicode/utf8"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3/walletdb"
+)
+
+// MaxLabelLen is the maximum length, in bytes, of a label.
+const MaxLabelLen = 256
+
+// LabelKind describes the kind of object a label is attached to.
+type LabelKind byte
+
+// Label kinds.  The values are recorded in the database and must not be
+// changed.
+const (
+	LabelAddress     LabelKind = 'a'
+	LabelTransaction LabelKind = 't'
+	LabelOutput      LabelKind = 'o'
+)
+
+// String returns the name of the label kind.
+func (k LabelKind) String() string {
+	switch k {
+	case LabelAddress:
+		return "address"
+	case LabelTransaction:
+		return "transaction"
+	case LabelOutput:
+		return "output"
+	default:
+		return "unknown"
+	}
+}
+
+// LabelTarget identifies the address, transaction or transaction output a
+// label is attached to.  Address is only used by address labels, Hash is used
+// by transaction and output labels, and Index is only used by output labels.
+type LabelTarget struct {
+	Kind    LabelKind
+	Address string
+	Hash    chainhash.Hash
+	Index   uint32
+}
+
+// AddressLabelTarget returns the label target for an encoded address.
+func AddressLabelTarget(addr string) LabelTarget {
+	return LabelTarget{Kind: LabelAddress, Address: addr}
+}
+
+// TxLabelTarget returns the label target for a transaction.
+func TxLabelTarget(txHash *chainhash.Hash) LabelTarget {
+	return LabelTarget{Kind: LabelTransaction, Hash: *txHash}
+}
+
+// OutputLabelTarget returns the label target for a transaction output.
+func OutputLabelTarget(txHash *chainhash.Hash, index uint32) LabelTarget {
+	return LabelTarget{Kind: LabelOutput, Hash: *txHash, Index: index}
+}
+
+// Key to the labels bucket.  Keys are prefixed with the label kind, followed
+// by either the encoded address (address labels), the transaction hash
+// (transaction labels), or the transaction hash and big endian output index
+// (output labels).  Values are the label string.
+
+func keyLabel(t *LabelTarget) ([]byte, error) {
+	switch t.Kind {
+	case LabelAddress:
+		if t.Address == "" {
+			return nil, errors.E(errors.Invalid, "empty address label target")
+		}
+		k := make([]byte, 1+len(t.Address))
+		k[0] = byte(t.Kind)
+		copy(k[1:], t.Address)
+		return k, nil
+	case LabelTransaction:
+		k := make([]byte, 1+32)
+		k[0] = byte(t.Kind)
+		copy(k[1:], t.Hash[:])
+		return k, nil
+	case LabelOutput:
+		k := make([]byte, 1+36)
+		k[0] = byte(t.Kind)
+		copy(k[1:], canonicalOutPoint(&t.Hash, t.Index))
+		return k, nil
+	default:
+		return nil, errors.E(errors.Invalid, errors.Errorf("unknown label kind %d", t.Kind))
+	}
+}
+
+func readKeyLabel(k []byte, t *LabelTarget) error {
+	if len(k) < 1 {
+		return errors.E(errors.IO, "empty label key")
+	}
+	*t = LabelTarget{Kind: LabelKind(k[0])}
+	switch t.Kind {
+	case LabelAddress:
+		t.Address = string(k[1:])
+	case LabelTransaction:
+		if len(k) != 1+32 {
+			return errors.E(errors.IO, errors.Errorf("bad transaction label key length %d", len(k)))
+		}
+		copy(t.Hash[:], k[1:])
+	case LabelOutput:
+		if len(k) != 1+36 {
+			return errors.E(errors.IO, errors.Errorf("bad output label key length %d", len(k)))
+		}
+		copy(t.Hash[:], k[1:33])
+		t.Index = byteOrder.Uint32(k[33:37])
+	default:
+		return errors.E(errors.IO, errors.Errorf("unknown label kind %d", k[0]))
+	}
+	return nil
+}
+
+// SetLabel records a label for an address, transaction or output, replacing
+// any existing label.  An empty label removes the existing label.
+func (s *Store) SetLabel(ns walletdb.ReadWriteBucket, target *LabelTarget, label string) error {
+	if len(label) > MaxLabelLen {
+		return errors.E(errors.Invalid, errors.Errorf("label exceeds maximum length %d", MaxLabelLen))
+	}
+	if !utf8.ValidString(label) {
+		return errors.E(errors.Invalid, "label is not valid UTF-8")
+	}
+	k, err := keyLabel(target)
+	if err != nil {
+		return err
+	}
+	b := ns.NestedReadWriteBucket(bucketLabels)
+	if label == "" {
+		err = b.Delete(k)
+	} else {
+		err = b.Put(k, []byte(label))
+	}
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// Label returns the label of an address, transaction or output, or the empty
+// string if no label is recorded.
+func (s *Store) Label(ns walletdb.ReadBucket, target *LabelTarget) string {
+	k, err := keyLabel(target)
+	if err != nil {
+		return ""
+	}
+	return string(ns.NestedReadBucket(bucketLabels).Get(k))
+}
+
+// ForEachLabel calls f for every recorded label.  Labels are iterated by kind,
+// and then by the ordering of their target keys.  Iteration stops early if f
+// returns an error, and that error is returned.
+func (s *Store) ForEachLabel(ns walletdb.ReadBucket, f func(target *LabelTarget, label string) error) error {
+	c := ns.NestedReadBucket(bucketLabels).ReadCursor()
+	defer c.Close()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		var t LabelTarget
+		err := readKeyLabel(k, &t)
+		if err != nil {
+			return err
+		}
+		err = f(&t, string(v))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3/walletdb"
+)
+
+// Key to the spend log bucket.  Keys are the big endian account number,
+// followed by the big endian unix time of the spend and the transaction hash,
+// so that cursor scans iterate over the spends of an account in time order.
+// Values are the 8 byte big endian amount sent from the account.
+
+func keySpendLog(account uint32, t time.Time, txHash *chainhash.Hash) []byte {
+	k := make([]byte, 44)
+	byteOrder.PutUint32(k[0:4], account)
+	byteOrder.PutUint64(k[4:12], uint64(t.Unix()))
+	copy(k[12:44], txHash[:])
+	return k
+}
+
+// RecordSpend records the amount sent from an account by a transaction for
+// later accounting of spending limits.
+func (s *Store) RecordSpend(ns walletdb.ReadWriteBucket, account uint32, t time.Time, txHash *chainhash.Hash,
+	amount dcrutil.Amount) error {
+
+	v := make([]byte, 8)
+	byteOrder.PutUint64(v, uint64(amount))
+	err := ns.NestedReadWriteBucket(bucketSpendLog).Put(keySpendLog(account, t, txHash), v)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// SpentSince returns the total amount recorded as sent from an account at or
+// after a time.
+func (s *Store) SpentSince(ns walletdb.ReadBucket, account uint32, since time.Time) (dcrutil.Amount, error) {
+	seek := keySpendLog(account, since, &chainhash.Hash{})
+	prefix := seek[:4]
+	c := ns.NestedReadBucket(bucketSpendLog).ReadCursor()
+	defer c.Close()
+	var total dcrutil.Amount
+	for k, v := c.Seek(seek); bytes.HasPrefix(k, prefix); k, v = c.Next() {
+		if len(v) != 8 {
+			return 0, errors.E(errors.IO, errors.Errorf("bad spend log value length %d", len(v)))
+		}
+		total += dcrutil.Amount(byteOrder.Uint64(v))
+	}
+	return total, nil
+}
+
+// PruneSpends removes every recorded spend of every account before a time.
+func (s *Store) PruneSpends(ns walletdb.ReadWriteBucket, before time.Time) error {
+	b := ns.NestedReadWriteBucket(bucketSpendLog)
+	var prune [][]byte
+	c := b.ReadCursor()
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		if len(k) != 44 {
+			c.Close()
+			return errors.E(errors.IO, errors.Errorf("bad spend log key length %d", len(k)))
+		}
+		if int64(byteOrder.Uint64(k[4:12])) < before.Unix() {
+			prune = append(prune, append([]byte(nil), k...))
+		}
+	}
+	c.Close()
+	for _, k := range prune {
+		err := b.Delete(k)
+		if err != nil {
+			return errors.E(errors.IO, err)
+		}
+	}
+	return nil
+}
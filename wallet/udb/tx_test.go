@@ -6,6 +6,7 @@
 package udb
 
 import (
+	"bytes"
 	"context"
 	"testing"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/decred/dcrd/chaincfg/chainhash"
 	"github.com/decred/dcrd/dcrutil/v2"
 	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrwallet/errors/v2"
 	_ "github.com/decred/dcrwallet/wallet/v3/drivers/bdb"
 	"github.com/decred/dcrwallet/wallet/v3/walletdb"
 )
@@ -583,3 +585,173 @@ func TestCoinbases(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestTxReplacement(t *testing.T) {
+	ctx := context.Background()
+	db, _, s, _, teardown, err := cloneDB("txreplacement.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g := makeBlockGenerator()
+	b1H := g.generate(dcrutil.BlockValid)
+	b1Hash := b1H.BlockHash()
+	b2H := g.generate(dcrutil.BlockValid)
+	b2Hash := b2H.BlockHash()
+	headerData := makeHeaderDataSlice(b1H, b2H)
+	filters := emptyFilters(2)
+
+	newRec := func(tx *wire.MsgTx) *TxRecord {
+		rec, err := NewTxRecordFromMsgTx(tx, time.Time{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return rec
+	}
+	prevHash := chainhash.HashH([]byte("funding"))
+	orig1 := newRec(spendOutput(&prevHash, 0, 0, 9e8))
+	repl1 := newRec(spendOutput(&prevHash, 0, 0, 8e8))
+	orig2 := newRec(spendOutput(&prevHash, 1, 0, 9e8))
+	repl2 := newRec(spendOutput(&prevHash, 1, 0, 8e8))
+
+	err = walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(wtxmgrBucketKey)
+		addrmgrNs := tx.ReadBucket(waddrmgrBucketKey)
+
+		err := insertMainChainHeaders(s, ns, addrmgrNs, headerData, filters)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, test := range []struct{ orig, repl *TxRecord }{{orig1, repl1}, {orig2, repl2}} {
+			err = s.InsertMemPoolTx(ns, test.orig)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = s.InsertMemPoolTx(ns, test.repl)
+			if !errors.Is(err, errors.DoubleSpend) {
+				t.Fatalf("expected double spend error inserting unrecorded replacement, got %v", err)
+			}
+			err = s.RecordTxReplacement(ns, &test.orig.Hash, &test.repl.Hash)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = s.InsertMemPoolTx(ns, test.repl)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if existsRawUnmined(ns, test.orig.Hash[:]) != nil {
+				t.Fatal("replaced transaction remains unmined")
+			}
+			if r := existsRawTxReplacement(ns, test.orig.Hash[:]); !bytes.Equal(r, test.repl.Hash[:]) {
+				t.Fatalf("wrong replacement recorded: %x", r)
+			}
+			if r := rawTxReplaced(ns, test.repl.Hash[:]); len(r) != 1 || !bytes.Equal(r[0], test.orig.Hash[:]) {
+				t.Fatalf("wrong replaced transactions indexed: %x", r)
+			}
+		}
+
+		// Mining the replaced transaction removes the replacement.
+		err = s.InsertMinedTx(ns, addrmgrNs, orig1, &b1Hash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if existsRawUnmined(ns, repl1.Hash[:]) != nil {
+			t.Error("replacement remains unmined after replaced tx was mined")
+		}
+		if existsRawTxReplacement(ns, orig1.Hash[:]) != nil || len(rawTxReplaced(ns, repl1.Hash[:])) != 0 {
+			t.Error("replacement record remains after replaced tx was mined")
+		}
+
+		// Mining the replacement removes the replacement record.
+		err = s.InsertMinedTx(ns, addrmgrNs, repl2, &b2Hash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if existsRawTxReplacement(ns, orig2.Hash[:]) != nil || len(rawTxReplaced(ns, repl2.Hash[:])) != 0 {
+			t.Error("replacement record remains after replacement was mined")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLabels(t *testing.T) {
+	ctx := context.Background()
+	db, _, s, _, teardown, err := cloneDB("labels.kv")
+	defer teardown()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	txHash := chainhash.HashH([]byte("labeled"))
+	targets := []LabelTarget{
+		AddressLabelTarget("TsR28UZRprhgQQhzWns2M6cAwchrNVvbYq2"),
+		TxLabelTarget(&txHash),
+		OutputLabelTarget(&txHash, 1),
+	}
+
+	err = walletdb.Update(ctx, db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(wtxmgrBucketKey)
+		for i := range targets {
+			label := targets[i].Kind.String() + " label"
+			err := s.SetLabel(ns, &targets[i], label)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := s.Label(ns, &targets[i]); got != label {
+				t.Errorf("%v: got label %q, want %q", targets[i].Kind, got, label)
+			}
+		}
+		other := OutputLabelTarget(&txHash, 0)
+		if got := s.Label(ns, &other); got != "" {
+			t.Errorf("unlabeled output has label %q", got)
+		}
+
+		var n int
+		err := s.ForEachLabel(ns, func(target *LabelTarget, label string) error {
+			n++
+			if label != target.Kind.String()+" label" {
+				t.Errorf("%v: iterated label %q", target.Kind, label)
+			}
+			for i := range targets {
+				if *target == targets[i] {
+					return nil
+				}
+			}
+			t.Errorf("iterated unknown target %+v", target)
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != len(targets) {
+			t.Errorf("iterated %d labels, want %d", n, len(targets))
+		}
+
+		// Empty labels remove the existing label.
+		err = s.SetLabel(ns, &targets[1], "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := s.Label(ns, &targets[1]); got != "" {
+			t.Errorf("removed label is %q", got)
+		}
+
+		long := make([]byte, MaxLabelLen+1)
+		for i := range long {
+			long[i] = 'x'
+		}
+		err = s.SetLabel(ns, &targets[0], string(long))
+		if !errors.Is(err, errors.Invalid) {
+			t.Errorf("expected Invalid error for long label, got %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
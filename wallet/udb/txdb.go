@@ -147,6 +147,13 @@ var (
 	bucketCFilters                = []byte("cf")
 	bucketTicketCommitments       = []byte("cmt")
 	bucketTicketCommitmentsUsp    = []byte("cmu")
+	bucketTxReplacements          = []byte("rpl")
+	bucketTxReplaced              = []byte("rpr")
+	bucketLabels                  = []byte("lbl")
+	bucketSpendLog                = []byte("spl")
+	bucketInvoices                = []byte("inv")
+	bucketInvoiceAddrs            = []byte("inva")
+	bucketVSPTickets              = []byte("vspt")
 )
 
 // Root (namespace) bucket keys
@@ -2119,6 +2126,76 @@ func (it *unspentTicketCommitsIterator) close() {
 	it.c.Close()
 }
 
+// Transaction replacements record unmined transactions which were replaced by
+// a conflicting transaction paying a higher fee, so the replacement can be
+// recognized and cleaned up if the replaced transaction is mined instead.
+//
+// The key is the hash of the replaced transaction.  Values are serialized
+// using the following format:
+//
+//   [0:32]  Replacement transaction hash (32 bytes)
+//
+// Each record is indexed by the replacement in the replaced bucket, keyed by
+// the replacement transaction hash followed by the replaced transaction hash,
+// with empty values.
+
+func keyTxReplaced(replacement, replaced []byte) []byte {
+	k := make([]byte, 64)
+	copy(k, replacement)
+	copy(k[32:], replaced)
+	return k
+}
+
+func putRawTxReplacement(ns walletdb.ReadWriteBucket, replaced, replacement []byte) error {
+	if v := existsRawTxReplacement(ns, replaced); v != nil {
+		err := ns.NestedReadWriteBucket(bucketTxReplaced).Delete(keyTxReplaced(v, replaced))
+		if err != nil {
+			return errors.E(errors.IO, err)
+		}
+	}
+	err := ns.NestedReadWriteBucket(bucketTxReplacements).Put(replaced, replacement)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	err = ns.NestedReadWriteBucket(bucketTxReplaced).Put(keyTxReplaced(replacement, replaced), nil)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+func existsRawTxReplacement(ns walletdb.ReadBucket, replaced []byte) []byte {
+	return ns.NestedReadBucket(bucketTxReplacements).Get(replaced)
+}
+
+func deleteRawTxReplacement(ns walletdb.ReadWriteBucket, replaced []byte) error {
+	replacement := existsRawTxReplacement(ns, replaced)
+	if replacement == nil {
+		return nil
+	}
+	err := ns.NestedReadWriteBucket(bucketTxReplaced).Delete(keyTxReplaced(replacement, replaced))
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	err = ns.NestedReadWriteBucket(bucketTxReplacements).Delete(replaced)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// rawTxReplaced returns the hashes of all transactions recorded as replaced by
+// the replacement transaction.
+func rawTxReplaced(ns walletdb.ReadBucket, replacement []byte) [][]byte {
+	var replaced [][]byte
+	c := ns.NestedReadBucket(bucketTxReplaced).ReadCursor()
+	for k, _ := c.Seek(replacement); bytes.HasPrefix(k, replacement); k, _ = c.Next() {
+		replaced = append(replaced, append([]byte(nil), k[32:]...))
+	}
+	c.Close()
+	return replaced
+}
+
 // createStore creates the tx store (with the latest db version) in the passed
 // namespace.
 func createStore(ns walletdb.ReadWriteBucket, chainParams *chaincfg.Params) error {
@@ -1231,6 +1231,12 @@ func (s *Store) InsertMinedTx(ns walletdb.ReadWriteBucket, addrmgrNs walletdb.Re
 		if invalidated {
 			panic(fmt.Sprintf("unimplemented: moveMinedTx called on a stake-invalidated tx: block %v height %v tx %v", &block.Hash, block.Height, &rec.Hash))
 		}
+		// A mined replacement resolves the conflict with the
+		// transaction it replaced.
+		err := deleteTxReplacements(ns, &rec.Hash)
+		if err != nil {
+			return err
+		}
 		return s.moveMinedTx(ns, addrmgrNs, rec, k, v, &block)
 	}
 
@@ -1495,6 +1501,27 @@ func (s *Store) AddTicketCommitment(ns walletdb.ReadWriteBucket, rec *TxRecord,
 	return putRawUnspentTicketCommitment(ns, k, v)
 }
 
+// TicketCommitment returns the amount and account of a wallet-controlled
+// commitment output of a ticket.  Errors with code NotExist are returned when
+// the output is not a recorded commitment of the wallet.
+func (s *Store) TicketCommitment(ns walletdb.ReadBucket, ticketHash *chainhash.Hash,
+	index uint32) (dcrutil.Amount, uint32, error) {
+
+	v := existsRawTicketCommitment(ns, keyTicketCommitment(*ticketHash, index))
+	if v == nil {
+		return 0, 0, errors.E(errors.NotExist, "no ticket commitment")
+	}
+	amount, err := fetchRawTicketCommitmentAmount(v)
+	if err != nil {
+		return 0, 0, err
+	}
+	account, err := fetchRawTicketCommitmentAccount(v)
+	if err != nil {
+		return 0, 0, err
+	}
+	return amount, account, nil
+}
+
 // originalTicketInfo returns the transaction hash and output count for the
 // ticket spent by the given transaction.
 //
@@ -74,6 +74,26 @@ func (s *Store) InsertMemPoolTx(ns walletdb.ReadWriteBucket, rec *TxRecord) erro
 				continue
 			}
 
+			// Another exception is made for recorded replacements of the
+			// conflicting transaction, which replace it in the unmined set.
+			if v := existsRawTxReplacement(ns, spenderHash[:]); v != nil &&
+				bytes.Equal(v, rec.Hash[:]) {
+
+				spenderVal := existsRawUnmined(ns, spenderHash[:])
+				var spender TxRecord
+				err := readRawTxRecord(&spenderHash, spenderVal, &spender)
+				if err != nil {
+					return err
+				}
+				log.Infof("Replacing unconfirmed transaction %v with %v",
+					&spenderHash, &rec.Hash)
+				err = s.RemoveUnconfirmed(ns, &spender.MsgTx, &spenderHash)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+
 			err := errors.Errorf("%v conflicts with %v by double spending %v", &rec.Hash, &spenderHash, prevOut)
 			return errors.E(errors.DoubleSpend, err)
 		}
@@ -124,10 +144,37 @@ func (s *Store) InsertMemPoolTx(ns walletdb.ReadWriteBucket, rec *TxRecord) erro
 	return nil
 }
 
+// RecordTxReplacement records that the unmined transaction replaced is being
+// replaced by a conflicting transaction.  When the replacement is inserted with
+// InsertMemPoolTx, the replaced transaction is removed from the unmined set
+// rather than causing a double spend error.  The record is removed once either
+// transaction is mined.
+func (s *Store) RecordTxReplacement(ns walletdb.ReadWriteBucket, replaced, replacement *chainhash.Hash) error {
+	if existsRawUnmined(ns, replaced[:]) == nil {
+		return errors.E(errors.NotExist, errors.Errorf("no unmined transaction %v", replaced))
+	}
+	return putRawTxReplacement(ns, replaced[:], replacement[:])
+}
+
+// deleteTxReplacements removes all replacement records in which the transaction
+// is either the replaced or the replacement transaction.
+func deleteTxReplacements(ns walletdb.ReadWriteBucket, txHash *chainhash.Hash) error {
+	keys := append(rawTxReplaced(ns, txHash[:]), txHash[:])
+	for _, k := range keys {
+		err := deleteRawTxReplacement(ns, k)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // removeDoubleSpends checks for any unmined transactions which would introduce
 // a double spend if tx was added to the store (either as a confirmed or unmined
 // transaction).  Each conflicting transaction and all transactions which spend
-// it are recursively removed.
+// it are recursively removed.  Replacement records of the transaction and of
+// each conflicting transaction are removed as well, as the conflict between
+// them has been resolved.
 func (s *Store) removeDoubleSpends(ns walletdb.ReadWriteBucket, rec *TxRecord) error {
 	for _, input := range rec.MsgTx.TxIn {
 		prevOut := &input.PreviousOutPoint
@@ -149,9 +196,13 @@ func (s *Store) removeDoubleSpends(ns walletdb.ReadWriteBucket, rec *TxRecord) e
 			if err != nil {
 				return err
 			}
+			err = deleteTxReplacements(ns, &doubleSpend.Hash)
+			if err != nil {
+				return err
+			}
 		}
 	}
-	return nil
+	return deleteTxReplacements(ns, &rec.Hash)
 }
 
 // RemoveUnconfirmed removes an unmined transaction record and all spend chains
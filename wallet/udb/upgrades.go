@@ -122,10 +122,50 @@ const (
 	// panics.
 	importedXpubAccountVersion = 13
 
+	// txReplacementsVersion is the fourteenth version of the database.  It
+	// adds the transaction replacements buckets to the txmgr namespace.
+	// These buckets record unmined transactions which were replaced by a
+	// conflicting transaction paying a higher fee, and index them by the
+	// replacement, so that the conflict can be resolved once either
+	// transaction is mined.
+	txReplacementsVersion = 14
+
+	// labelsVersion is the fifteenth version of the database.  It adds the
+	// labels bucket to the txmgr namespace, which records user-provided
+	// labels for addresses, transactions and transaction outputs.
+	labelsVersion = 15
+
+	// descriptorAccountsVersion is the sixteenth version of the database.
+	// It adds the descriptor account and descriptor address buckets to the
+	// address manager namespace.  Descriptor accounts derive addresses from
+	// output script descriptors rather than BIP0044 account keys.
+	descriptorAccountsVersion = 16
+
+	// accountPassphrasesVersion is the seventeenth version of the database.
+	// It adds the account passphrases bucket to the address manager
+	// namespace, which records the keys protecting the private keys of
+	// accounts encrypted by their own passphrase.
+	accountPassphrasesVersion = 17
+
+	// spendLogVersion is the eighteenth version of the database.  It adds
+	// the spend log bucket to the transaction store namespace, which records
+	// the amounts sent from accounts to enforce spending policy limits.
+	spendLogVersion = 18
+
+	// invoicesVersion is the nineteenth version of the database.  It adds
+	// the invoices and invoice addresses buckets to the transaction store
+	// namespace, which record payment requests and the outputs paying them.
+	invoicesVersion = 19
+
+	// vspTicketsVersion is the twentieth version of the database.  It adds
+	// the VSP tickets bucket to the transaction store namespace, which
+	// records the state of fee payments to voting service providers.
+	vspTicketsVersion = 20
+
 	// DBVersion is the latest version of the database that is understood by the
 	// program.  Databases with recorded versions higher than this will fail to
 	// open (meaning any upgrades prevent reverting to older software).
-	DBVersion = importedXpubAccountVersion
+	DBVersion = vspTicketsVersion
 )
 
 // upgrades maps between old database versions and the upgrade function to
@@ -144,6 +184,13 @@ var upgrades = [...]func(walletdb.ReadWriteTx, []byte, *chaincfg.Params) error{
 	lastProcessedTxsBlockVersion - 1: lastProcessedTxsBlockUpgrade,
 	ticketCommitmentsVersion - 1:     ticketCommitmentsUpgrade,
 	importedXpubAccountVersion - 1:   importedXpubAccountUpgrade,
+	txReplacementsVersion - 1:        txReplacementsUpgrade,
+	labelsVersion - 1:                labelsUpgrade,
+	descriptorAccountsVersion - 1:    descriptorAccountsUpgrade,
+	accountPassphrasesVersion - 1:    accountPassphrasesUpgrade,
+	spendLogVersion - 1:              spendLogUpgrade,
+	invoicesVersion - 1:              invoicesUpgrade,
+	vspTicketsVersion - 1:            vspTicketsUpgrade,
 }
 
 func lastUsedAddressIndexUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
@@ -1026,6 +1073,191 @@ func importedXpubAccountUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte
 	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
 }
 
+func txReplacementsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 13
+	const newVersion = 14
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	txmgrBucket := tx.ReadWriteBucket(wtxmgrBucketKey)
+
+	// Assert that this function is only called on version 13 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "txReplacementsUpgrade inappropriately called")
+	}
+
+	_, err = txmgrBucket.CreateBucket(bucketTxReplacements)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	_, err = txmgrBucket.CreateBucket(bucketTxReplaced)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func labelsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 14
+	const newVersion = 15
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	txmgrBucket := tx.ReadWriteBucket(wtxmgrBucketKey)
+
+	// Assert that this function is only called on version 14 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "labelsUpgrade inappropriately called")
+	}
+
+	_, err = txmgrBucket.CreateBucket(bucketLabels)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func descriptorAccountsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 15
+	const newVersion = 16
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	addrmgrBucket := tx.ReadWriteBucket(waddrmgrBucketKey)
+
+	// Assert that this function is only called on version 15 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "descriptorAccountsUpgrade inappropriately called")
+	}
+
+	_, err = addrmgrBucket.CreateBucket(descAcctBucketName)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	_, err = addrmgrBucket.CreateBucket(descAddrBucketName)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func accountPassphrasesUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 16
+	const newVersion = 17
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	addrmgrBucket := tx.ReadWriteBucket(waddrmgrBucketKey)
+
+	// Assert that this function is only called on version 16 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "accountPassphrasesUpgrade inappropriately called")
+	}
+
+	_, err = addrmgrBucket.CreateBucket(acctPassphraseBucketName)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func spendLogUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 17
+	const newVersion = 18
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	txmgrBucket := tx.ReadWriteBucket(wtxmgrBucketKey)
+
+	// Assert that this function is only called on version 17 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "spendLogUpgrade inappropriately called")
+	}
+
+	_, err = txmgrBucket.CreateBucket(bucketSpendLog)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func invoicesUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 18
+	const newVersion = 19
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	txmgrBucket := tx.ReadWriteBucket(wtxmgrBucketKey)
+
+	// Assert that this function is only called on version 18 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "invoicesUpgrade inappropriately called")
+	}
+
+	for _, k := range [][]byte{bucketInvoices, bucketInvoiceAddrs} {
+		_, err = txmgrBucket.CreateBucket(k)
+		if err != nil {
+			return errors.E(errors.IO, err)
+		}
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
+func vspTicketsUpgrade(tx walletdb.ReadWriteTx, publicPassphrase []byte, params *chaincfg.Params) error {
+	const oldVersion = 19
+	const newVersion = 20
+
+	metadataBucket := tx.ReadWriteBucket(unifiedDBMetadata{}.rootBucketKey())
+	txmgrBucket := tx.ReadWriteBucket(wtxmgrBucketKey)
+
+	// Assert that this function is only called on version 19 databases.
+	dbVersion, err := unifiedDBMetadata{}.getVersion(metadataBucket)
+	if err != nil {
+		return err
+	}
+	if dbVersion != oldVersion {
+		return errors.E(errors.Invalid, "vspTicketsUpgrade inappropriately called")
+	}
+
+	_, err = txmgrBucket.CreateBucket(bucketVSPTickets)
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+
+	// Write the new database version.
+	return unifiedDBMetadata{}.putVersion(metadataBucket, newVersion)
+}
+
 // Upgrade checks whether the any upgrades are necessary before the database is
 // ready for application usage.  If any are, they are performed.
 func Upgrade(ctx context.Context, db walletdb.DB, publicPassphrase []byte, params *chaincfg.Params) error {
@@ -0,0 +1,177 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package udb
+
+import (
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/dcrutil/v2"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3/walletdb"
+)
+
+// VSPFeeState describes the progress of paying the fee of a ticket to a voting
+// service provider.
+type VSPFeeState uint8
+
+// VSP fee states.
+const (
+	// VSPFeeUnpaid tickets have not been assigned a fee address.
+	VSPFeeUnpaid VSPFeeState = iota
+
+	// VSPFeeAddressed tickets have been assigned a fee address and amount
+	// which have not been paid.
+	VSPFeeAddressed
+
+	// VSPFeePaid tickets have had their fee transaction and voting key
+	// accepted by the VSP, which broadcasts the fee transaction.
+	VSPFeePaid
+
+	// VSPFeeConfirmed tickets have a fee transaction the VSP reports as
+	// confirmed.  The VSP votes the ticket.
+	VSPFeeConfirmed
+
+	// VSPFeeAbandoned tickets are no longer processed because the ticket
+	// was removed or can no longer vote before the fee was confirmed.
+	VSPFeeAbandoned
+)
+
+// String returns the name of the state.
+func (s VSPFeeState) String() string {
+	switch s {
+	case VSPFeeUnpaid:
+		return "unpaid"
+	case VSPFeeAddressed:
+		return "addressed"
+	case VSPFeePaid:
+		return "paid"
+	case VSPFeeConfirmed:
+		return "confirmed"
+	case VSPFeeAbandoned:
+		return "abandoned"
+	default:
+		return "unknown"
+	}
+}
+
+// VSPTicket records the fee payment of a ticket to a voting service provider.
+type VSPTicket struct {
+	TicketHash chainhash.Hash
+	Host       string // Base URL of the VSP
+	FeeAccount uint32
+	State      VSPFeeState
+	FeeAddress string
+	FeeAmount  dcrutil.Amount
+	Expiration int64  // Unix time after which the fee address is unusable
+	FeeTx      []byte // Serialized signed fee transaction, nil until created
+	Attempts   uint32 // Consecutive failed attempts to progress the state
+	LastError  string
+}
+
+// VSP tickets are keyed by ticket hash in the VSP tickets bucket and
+// serialized as:
+//
+//   [0:4]   Fee account (4 bytes)
+//   [4]     State (1 byte)
+//   [5:13]  Fee amount (8 bytes)
+//   [13:21] Fee address expiration unix time (8 bytes)
+//   [21:25] Attempts (4 bytes)
+//   ...     Host, fee address, fee transaction and last error, each
+//           prefixed by a 4 byte length
+
+func serializeVSPTicket(t *VSPTicket) []byte {
+	fields := [][]byte{[]byte(t.Host), []byte(t.FeeAddress), t.FeeTx, []byte(t.LastError)}
+	size := 25
+	for _, f := range fields {
+		size += 4 + len(f)
+	}
+	v := make([]byte, 25, size)
+	byteOrder.PutUint32(v[0:4], t.FeeAccount)
+	v[4] = byte(t.State)
+	byteOrder.PutUint64(v[5:13], uint64(t.FeeAmount))
+	byteOrder.PutUint64(v[13:21], uint64(t.Expiration))
+	byteOrder.PutUint32(v[21:25], t.Attempts)
+	var n [4]byte
+	for _, f := range fields {
+		byteOrder.PutUint32(n[:], uint32(len(f)))
+		v = append(v, n[:]...)
+		v = append(v, f...)
+	}
+	return v
+}
+
+func deserializeVSPTicket(k, v []byte) (*VSPTicket, error) {
+	if len(k) != chainhash.HashSize {
+		return nil, errors.E(errors.IO, errors.Errorf("bad VSP ticket key length %d", len(k)))
+	}
+	short := func() (*VSPTicket, error) {
+		return nil, errors.E(errors.IO, errors.Errorf("short VSP ticket %x value", k))
+	}
+	if len(v) < 25 {
+		return short()
+	}
+	t := &VSPTicket{
+		FeeAccount: byteOrder.Uint32(v[0:4]),
+		State:      VSPFeeState(v[4]),
+		FeeAmount:  dcrutil.Amount(byteOrder.Uint64(v[5:13])),
+		Expiration: int64(byteOrder.Uint64(v[13:21])),
+		Attempts:   byteOrder.Uint32(v[21:25]),
+	}
+	copy(t.TicketHash[:], k)
+	v = v[25:]
+	var fields [4][]byte
+	for i := range fields {
+		if len(v) < 4 {
+			return short()
+		}
+		n := byteOrder.Uint32(v)
+		v = v[4:]
+		if uint32(len(v)) < n {
+			return short()
+		}
+		fields[i] = v[:n:n]
+		v = v[n:]
+	}
+	t.Host = string(fields[0])
+	t.FeeAddress = string(fields[1])
+	if len(fields[2]) != 0 {
+		t.FeeTx = append([]byte(nil), fields[2]...)
+	}
+	t.LastError = string(fields[3])
+	return t, nil
+}
+
+// PutVSPTicket records the fee payment state of a ticket, replacing any
+// previous state.
+func (s *Store) PutVSPTicket(ns walletdb.ReadWriteBucket, t *VSPTicket) error {
+	err := ns.NestedReadWriteBucket(bucketVSPTickets).Put(t.TicketHash[:], serializeVSPTicket(t))
+	if err != nil {
+		return errors.E(errors.IO, err)
+	}
+	return nil
+}
+
+// VSPTicket returns the fee payment state of a ticket.
+func (s *Store) VSPTicket(ns walletdb.ReadBucket, ticketHash *chainhash.Hash) (*VSPTicket, error) {
+	v := ns.NestedReadBucket(bucketVSPTickets).Get(ticketHash[:])
+	if v == nil {
+		return nil, errors.E(errors.NotExist, errors.Errorf("no VSP ticket %v", ticketHash))
+	}
+	return deserializeVSPTicket(ticketHash[:], v)
+}
+
+// VSPTickets returns the fee payment state of every recorded ticket, ordered
+// by ticket hash.
+func (s *Store) VSPTickets(ns walletdb.ReadBucket) ([]*VSPTicket, error) {
+	var tickets []*VSPTicket
+	err := ns.NestedReadBucket(bucketVSPTickets).ForEach(func(k, v []byte) error {
+		t, err := deserializeVSPTicket(k, v)
+		if err != nil {
+			return err
+		}
+		tickets = append(tickets, t)
+		return nil
+	})
+	return tickets, err
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/decred/dcrd/txscript/v2"
 	"github.com/decred/dcrd/wire"
 	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3/internal/txsizes"
 	"github.com/decred/dcrwallet/wallet/v3/txauthor"
 	"github.com/decred/dcrwallet/wallet/v3/udb"
 	"github.com/decred/dcrwallet/wallet/v3/walletdb"
@@ -23,6 +24,11 @@ import (
 type OutputSelectionPolicy struct {
 	Account               uint32
 	RequiredConfirmations int32
+
+	// Algorithm is the algorithm used to select outputs.  Branch-and-bound
+	// selection searches for outputs summing to the exact target amount,
+	// allowing an excess of up to the dust limit of the wallet's relay fee.
+	Algorithm OutputSelectionAlgorithm
 }
 
 func (p *OutputSelectionPolicy) meetsRequiredConfs(txHeight, curHeight int32) bool {
@@ -125,8 +131,16 @@ func (w *Wallet) SelectInputs(ctx context.Context, targetAmount dcrutil.Amount,
 
 		sourceImpl := w.TxStore.MakeInputSource(txmgrNs, addrmgrNs, policy.Account,
 			policy.RequiredConfirmations, tipHeight)
-		var err error
-		inputDetail, err = sourceImpl.SelectInputs(targetAmount)
+		inputSource, err := w.selectionInputSource(tx, sourceImpl.SelectInputs,
+			policy.Algorithm, &changelessTarget{
+				amount:           targetAmount,
+				relayFeePerKb:    w.RelayFee(),
+				changeScriptSize: txsizes.P2PKHPkScriptSize,
+			})
+		if err != nil {
+			return err
+		}
+		inputDetail, err = inputSource(targetAmount)
 		return err
 	})
 	if err != nil {
@@ -0,0 +1,63 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"context"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3/udb"
+	"github.com/decred/dcrwallet/wallet/v3/walletdb"
+)
+
+// PutVSPTicket records the state of the fee payment of a ticket to a voting
+// service provider, replacing any previously recorded state.
+func (w *Wallet) PutVSPTicket(ctx context.Context, t *udb.VSPTicket) error {
+	const op errors.Op = "wallet.PutVSPTicket"
+	err := walletdb.Update(ctx, w.db, func(dbtx walletdb.ReadWriteTx) error {
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		return w.TxStore.PutVSPTicket(txmgrNs, t)
+	})
+	if err != nil {
+		return errors.E(op, err)
+	}
+	return nil
+}
+
+// VSPTicket returns the recorded state of the fee payment of a ticket to a
+// voting service provider.  Tickets which were not purchased through a VSP
+// return a NotExist error.
+func (w *Wallet) VSPTicket(ctx context.Context, ticketHash *chainhash.Hash) (*udb.VSPTicket, error) {
+	const op errors.Op = "wallet.VSPTicket"
+	var t *udb.VSPTicket
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		var err error
+		t, err = w.TxStore.VSPTicket(txmgrNs, ticketHash)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return t, nil
+}
+
+// VSPTickets returns the recorded fee payment state of every ticket purchased
+// through a voting service provider, ordered by ticket hash.
+func (w *Wallet) VSPTickets(ctx context.Context) ([]*udb.VSPTicket, error) {
+	const op errors.Op = "wallet.VSPTickets"
+	var tickets []*udb.VSPTicket
+	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		var err error
+		tickets, err = w.TxStore.VSPTickets(txmgrNs)
+		return err
+	})
+	if err != nil {
+		return nil, errors.E(op, err)
+	}
+	return tickets, nil
+}
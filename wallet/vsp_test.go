@@ -0,0 +1,71 @@
+// Copyright (c) 2019 The Decred developers
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrwallet/errors/v2"
+	"github.com/decred/dcrwallet/wallet/v3/udb"
+)
+
+func TestVSPTickets(t *testing.T) {
+	ctx := context.Background()
+	cfg := basicWalletConfig
+	w, teardown := testWallet(t, &cfg)
+	defer teardown()
+
+	hash := chainhash.Hash{1}
+	if _, err := w.VSPTicket(ctx, &hash); !errors.Is(err, errors.NotExist) {
+		t.Fatalf("missing ticket: expected NotExist, got %v", err)
+	}
+
+	tickets := []*udb.VSPTicket{{
+		TicketHash: chainhash.Hash{2},
+		Host:       "https://vsp.example.com",
+		State:      udb.VSPFeeUnpaid,
+	}, {
+		TicketHash: hash,
+		Host:       "https://vsp.example.com",
+		FeeAccount: 3,
+		State:      udb.VSPFeePaid,
+		FeeAddress: "TsfDLrRkk9ciUuwfp2b8PawwnukYD7yAjGd",
+		FeeAmount:  1e6,
+		Expiration: 1e9,
+		FeeTx:      []byte{1, 2, 3},
+		Attempts:   2,
+		LastError:  "connection refused",
+	}}
+	for _, ticket := range tickets {
+		err := w.PutVSPTicket(ctx, ticket)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := w.VSPTicket(ctx, &hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := tickets[1]
+	if got.TicketHash != want.TicketHash || got.Host != want.Host || got.FeeAccount != want.FeeAccount ||
+		got.State != want.State || got.FeeAddress != want.FeeAddress || got.FeeAmount != want.FeeAmount ||
+		got.Expiration != want.Expiration || !bytes.Equal(got.FeeTx, want.FeeTx) ||
+		got.Attempts != want.Attempts || got.LastError != want.LastError {
+		t.Errorf("read ticket %+v, expected %+v", got, want)
+	}
+
+	all, err := w.VSPTickets(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 2 || all[0].TicketHash != hash || all[1].TicketHash != tickets[0].TicketHash ||
+		all[1].FeeTx != nil {
+		t.Errorf("read tickets %+v", all)
+	}
+}
@@ -101,11 +101,16 @@ type Wallet struct {
 	networkBackend   NetworkBackend
 	networkBackendMu sync.Mutex
 
+	externalSigner   ExternalSigner
+	externalSignerMu sync.Mutex
+
 	lockedOutpoints  map[wire.OutPoint]struct{}
 	lockedOutpointMu sync.Mutex
 
 	relayFee                dcrutil.Amount
 	relayFeeMu              sync.Mutex
+	feeEst                  *feeEstimator
+	feeEstTarget            int32
 	ticketFeeIncrementLock  sync.Mutex
 	ticketFeeIncrement      dcrutil.Amount
 	DisallowFree            bool
@@ -120,11 +125,25 @@ type Wallet struct {
 	addressBuffers   map[uint32]*bip0044AccountData
 	addressBuffersMu sync.Mutex
 
+	// Number of watched child addresses of descriptor accounts.  Protected
+	// by addressBuffersMu.
+	descriptorWatched map[uint32]uint32
+
 	// Passphrase unlock
 	passphraseUsedMu        sync.RWMutex
 	passphraseTimeoutMu     sync.Mutex
 	passphraseTimeoutCancel chan struct{}
 
+	// Timeouts of accounts unlocked by their own passphrase.  Protected by
+	// passphraseTimeoutMu.
+	accountTimeoutCancel map[uint32]chan struct{}
+
+	// Spending policy.  largeSpends records the time locks of large spends
+	// by the hash of the account and external outputs.
+	spendPolicy   *SpendPolicy
+	largeSpends   map[chainhash.Hash]time.Time
+	spendPolicyMu sync.Mutex
+
 	NtfnServer *NotificationServer
 
 	chainParams *chaincfg.Params
@@ -656,7 +675,12 @@ func (w *Wallet) watchHDAddrs(ctx context.Context, firstWatch bool, n NetworkBac
 	case <-ctx.Done():
 		err = ctx.Err()
 	}
-	return count, err
+	if err != nil {
+		return count, err
+	}
+
+	descCount, err := w.watchDescriptorAddrs(ctx, firstWatch, n)
+	return count + descCount, err
 }
 
 // CoinType returns the active BIP0044 coin type. For watching-only wallets,
@@ -676,11 +700,17 @@ func (w *Wallet) CoinType(ctx context.Context) (uint32, error) {
 	return coinType, nil
 }
 
-// CoinTypePrivKey returns the BIP0044 coin type private key.
+// CoinTypePrivKey returns the BIP0044 coin type private key.  The key derives
+// the private keys of every account, so it is not returned while any account
+// is encrypted by its own passphrase.
 func (w *Wallet) CoinTypePrivKey(ctx context.Context) (*hdkeychain.ExtendedKey, error) {
 	const op errors.Op = "wallet.CoinTypePrivKey"
 	var coinTypePrivKey *hdkeychain.ExtendedKey
 	err := walletdb.View(ctx, w.db, func(tx walletdb.ReadTx) error {
+		if w.Manager.AnyAccountHasPassphrase(tx.ReadBucket(waddrmgrNamespaceKey)) {
+			return errors.E(errors.Invalid, "coin type key derives keys of accounts "+
+				"encrypted by their own passphrase")
+		}
 		var err error
 		coinTypePrivKey, err = w.Manager.CoinTypePrivKey(tx)
 		return err
@@ -1429,6 +1459,12 @@ func (w *Wallet) Lock() {
 	w.passphraseTimeoutMu.Lock()
 	_ = w.Manager.Lock()
 	w.passphraseTimeoutCancel = nil
+	for _, cancel := range w.accountTimeoutCancel {
+		if cancel != nil {
+			cancel <- struct{}{}
+		}
+	}
+	w.accountTimeoutCancel = nil
 	w.passphraseTimeoutMu.Unlock()
 	w.passphraseUsedMu.Unlock()
 }
@@ -1519,14 +1555,15 @@ func (w *Wallet) CalculateAccountBalance(ctx context.Context, account uint32, co
 
 // CalculateAccountBalances calculates the values for the wtxmgr struct Balance,
 // which includes the total balance, the spendable balance, and the balance
-// which has yet to mature.
+// which has yet to mature.  Balances of descriptor accounts, such as multisig
+// vault accounts, are included.
 func (w *Wallet) CalculateAccountBalances(ctx context.Context, confirms int32) (map[uint32]*udb.Balances, error) {
 	const op errors.Op = "wallet.CalculateAccountBalances"
 	balances := make(map[uint32]*udb.Balances)
 	err := walletdb.View(ctx, w.db, func(tx walletdb.ReadTx) error {
 		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
 		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
-		return w.Manager.ForEachAccount(addrmgrNs, func(acct uint32) error {
+		balanceFn := func(acct uint32) error {
 			balance, err := w.TxStore.AccountBalance(txmgrNs, addrmgrNs,
 				confirms, acct)
 			if err != nil {
@@ -1534,7 +1571,12 @@ func (w *Wallet) CalculateAccountBalances(ctx context.Context, confirms int32) (
 			}
 			balances[acct] = &balance
 			return nil
-		})
+		}
+		err := w.Manager.ForEachAccount(addrmgrNs, balanceFn)
+		if err != nil {
+			return err
+		}
+		return w.Manager.ForEachDescriptorAccount(addrmgrNs, balanceFn)
 	})
 	if err != nil {
 		return nil, errors.E(op, err)
@@ -2828,7 +2870,7 @@ func (w *Wallet) GetTransactions(ctx context.Context, f func(*Block) (bool, erro
 		} else {
 			err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
 				ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
-				serHeader, err := w.TxStore.GetSerializedBlockHeader(ns, endBlock.hash)
+				serHeader, err := w.TxStore.GetSerializedBlockHeader(ns, startBlock.hash)
 				if err != nil {
 					return err
 				}
@@ -3863,7 +3905,8 @@ func (w *Wallet) TotalReceivedForAccounts(ctx context.Context, minConf int32) ([
 						outputAcct, err = w.Manager.AddrAccount(
 							addrmgrNs, addrs[0])
 					}
-					if err == nil {
+					// Descriptor accounts are not reported.
+					if err == nil && !udb.IsDescriptorAccount(outputAcct) {
 						acctIndex := int(outputAcct)
 						if outputAcct == udb.ImportedAddrAccount {
 							acctIndex = len(results) - 1
@@ -3937,9 +3980,11 @@ func (w *Wallet) TotalReceivedForAddr(ctx context.Context, addr dcrutil.Address,
 	return amount, nil
 }
 
-// SendOutputs creates and sends payment transactions. It returns the
-// transaction hash upon success
-func (w *Wallet) SendOutputs(ctx context.Context, outputs []*wire.TxOut, account, changeAccount uint32, minconf int32) (*chainhash.Hash, error) {
+// SendOutputs creates and sends payment transactions, selecting outputs to
+// spend using the output selection algorithm algo. It returns the transaction
+// hash upon success
+func (w *Wallet) SendOutputs(ctx context.Context, outputs []*wire.TxOut, account, changeAccount uint32, minconf int32,
+	algo OutputSelectionAlgorithm) (*chainhash.Hash, error) {
 	const op errors.Op = "wallet.SendOutputs"
 	relayFee := w.RelayFee()
 	for _, output := range outputs {
@@ -3954,7 +3999,7 @@ func (w *Wallet) SendOutputs(ctx context.Context, outputs []*wire.TxOut, account
 		return nil, err
 	}
 	defer heldUnlock.release()
-	tx, err := w.txToOutputs(ctx, "wallet.SendOutputs", outputs, account, changeAccount, minconf, nil, true, relayFee)
+	tx, err := w.txToOutputs(ctx, "wallet.SendOutputs", outputs, account, changeAccount, minconf, nil, true, w.txFeeRate(), algo)
 	if err != nil {
 		return nil, err
 	}
@@ -3977,6 +4022,13 @@ type SignatureError struct {
 // The final error return is reserved for unexpected or fatal errors, such as
 // being unable to determine a previous output script to redeem.
 //
+// Watching-only wallets with an external signer request signatures for P2PKH
+// inputs from the signer when no additional keys are passed.
+//
+// Inputs of accounts encrypted by their own passphrase are not signed while
+// the account is locked, and a SignatureError with a Locked error is returned
+// for the input.
+//
 // The transaction pointed to by tx is modified by this function.
 func (w *Wallet) SignTransaction(ctx context.Context, tx *wire.MsgTx, hashType txscript.SigHashType, additionalPrevScripts map[wire.OutPoint][]byte,
 	additionalKeysByAddress map[string]*dcrutil.WIF, p2shRedeemScriptsByAddress map[string][]byte) ([]SignatureError, error) {
@@ -3990,6 +4042,14 @@ func (w *Wallet) SignTransaction(ctx context.Context, tx *wire.MsgTx, hashType t
 		}
 	}()
 
+	// Watching-only wallets sign with the external signer, if set, unless
+	// the keys are provided by the caller.
+	var signer ExternalSigner
+	if len(additionalKeysByAddress) == 0 {
+		signer = w.watchingOnlySigner()
+	}
+	externalPrevScripts := make(map[int][]byte)
+
 	var signErrors []SignatureError
 	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
 		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
@@ -4019,6 +4079,11 @@ func (w *Wallet) SignTransaction(ctx context.Context, tx *wire.MsgTx, hashType t
 				prevOutScript = txDetails.MsgTx.TxOut[prevIndex].PkScript
 			}
 
+			if signer != nil {
+				externalPrevScripts[i] = prevOutScript
+				continue
+			}
+
 			// Set up our callbacks that we pass to txscript so it can
 			// look up the appropriate keys and scripts by address.
 			getKey := txscript.KeyClosure(func(addr dcrutil.Address) (
@@ -4155,12 +4220,42 @@ func (w *Wallet) SignTransaction(ctx context.Context, tx *wire.MsgTx, hashType t
 	if err != nil {
 		return nil, errors.E(op, err)
 	}
+
+	for i := range tx.TxIn {
+		prevOutScript, ok := externalPrevScripts[i]
+		if !ok {
+			continue
+		}
+		if (hashType&txscript.SigHashSingle) == txscript.SigHashSingle && i >= len(tx.TxOut) {
+			continue
+		}
+		script, err := w.signInputExternal(ctx, signer, tx, i, prevOutScript, hashType)
+		if err == nil {
+			tx.TxIn[i].SignatureScript = script
+			var vm *txscript.Engine
+			vm, err = txscript.NewEngine(prevOutScript, tx, i,
+				sanityVerifyFlags, 0, nil)
+			if err == nil {
+				err = vm.Execute()
+			}
+		}
+		if err != nil {
+			signErrors = append(signErrors, SignatureError{
+				InputIndex: uint32(i),
+				Error:      errors.E(op, err),
+			})
+		}
+	}
+
 	return signErrors, nil
 }
 
 // CreateSignature returns the raw signature created by the private key of addr
 // for tx's idx'th input script and the serialized compressed pubkey for the
 // address.
+// Signatures are refused with a Locked error when the wallet is locked or when
+// the address belongs to an account encrypted by its own passphrase which has
+// not been unlocked.
 func (w *Wallet) CreateSignature(ctx context.Context, tx *wire.MsgTx, idx uint32, addr dcrutil.Address,
 	hashType txscript.SigHashType, prevPkScript []byte) (sig, pubkey []byte, err error) {
 	const op errors.Op = "wallet.CreateSignature"
@@ -4319,12 +4414,20 @@ func (w *Wallet) AbandonTransaction(ctx context.Context, hash *chainhash.Hash) e
 // PublishTransaction saves (if relevant) and sends the transaction to the
 // consensus RPC server so it can be propagated to other nodes and eventually
 // mined.  If the send fails, the transaction is not added to the wallet.
+// Transactions violating the spending policy of any account they spend from
+// are rejected with a Policy error.
 func (w *Wallet) PublishTransaction(ctx context.Context, tx *wire.MsgTx, serializedTx []byte, n NetworkBackend) (*chainhash.Hash, error) {
 	const opf = "wallet.PublishTransaction(%v)"
 
 	txHash := tx.TxHash()
 
+	// Hold the spending policy lock until the spend is recorded.
+	w.spendPolicyMu.Lock()
+	defer w.spendPolicyMu.Unlock()
+	now := time.Now()
+
 	var relevant bool
+	var spends []policySpend
 	err := walletdb.View(ctx, w.db, func(dbtx walletdb.ReadTx) error {
 		relevant = w.isRelevantTx(dbtx, tx)
 
@@ -4341,6 +4444,16 @@ func (w *Wallet) PublishTransaction(ctx context.Context, tx *wire.MsgTx, seriali
 			}
 		}
 
+		// Prevent transactions violating the spending policy of any
+		// spent account from being published.
+		if relevant {
+			var err error
+			spends, err = w.checkSpendPolicy(ctx, dbtx, tx, nil, -1, now)
+			if err != nil {
+				return err
+			}
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -4374,6 +4487,10 @@ func (w *Wallet) PublishTransaction(ctx context.Context, tx *wire.MsgTx, seriali
 		op := errors.Opf(opf, &txHash)
 		return nil, errors.E(op, err)
 	}
+	err = w.recordPolicySpends(ctx, &txHash, spends, now)
+	if err != nil {
+		log.Errorf("Failed to record spend of transaction %v: %v", &txHash, err)
+	}
 
 	if len(watchOutPoints) > 0 {
 		err := n.LoadTxFilter(ctx, false, nil, watchOutPoints)
@@ -4588,7 +4705,8 @@ func Open(ctx context.Context, cfg *Config) (*Wallet, error) {
 
 		recentlyPublished: make(map[chainhash.Hash]struct{}),
 
-		addressBuffers: make(map[uint32]*bip0044AccountData),
+		addressBuffers:    make(map[uint32]*bip0044AccountData),
+		descriptorWatched: make(map[uint32]uint32),
 	}
 
 	// Open database managers
@@ -4659,6 +4777,9 @@ func Open(ctx context.Context, cfg *Config) (*Wallet, error) {
 	w.NtfnServer = newNotificationServer(w)
 	w.voteBits = vb
 
+	_, tipHeight := w.MainChainTip(ctx)
+	w.feeEst = newFeeEstimator(tipHeight)
+
 	w.stakePoolColdAddrs, err = decodeStakePoolColdExtKey(cfg.StakePoolColdExtKey,
 		cfg.Params)
 	if err != nil {